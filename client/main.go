@@ -5,10 +5,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/config"
 	"github.com/T4t4KAU/TikBase/pkg/rpc/data"
 	"github.com/T4t4KAU/TikBase/pkg/rpc/data/dataservice"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/replica"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/replica/replicaservice"
+	"github.com/T4t4KAU/TikBase/pkg/secure"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/remote/trans/gonet"
 
 	"io"
 	"os"
@@ -24,10 +30,21 @@ var (
 	errInvalidCommand = errors.New("invalid command")
 
 	address = "127.0.0.1:10081"
+
+	db = "0" // 当前连接选择的逻辑数据库
+
+	consistency  = data.Consistency_DEFAULT // 读请求的一致性级别
+	maxStaleness int64                      // 跟随者读允许落后的毫秒数
+
+	region int32 // 副本管理命令操作的分区
 )
 
 var cli dataservice.Client
 
+var replicaClients = make(map[string]replicaservice.Client) // 副本服务地址 -> 客户端
+
+var replicaOptions []client.Option // 连接副本服务的选项 由tls命令设置
+
 func main() {
 	reader := bufio.NewReader(os.Stdin)
 	time.Sleep(time.Second)
@@ -85,22 +102,46 @@ func parseLine(writer io.Writer, line string) {
 		parseDelCommand(writer, command)
 	case "expire":
 		parseExpireCommand(writer, command)
+	case "select":
+		parseSelectCommand(writer, command)
+	case "consistency":
+		parseConsistencyCommand(writer, command)
+	case "flushdb":
+		parseFlushDBCommand(writer, command)
+	case "dbsize":
+		parseDBSizeCommand(writer, command)
+	case "region":
+		parseRegionCommand(writer, command)
+	case "replica":
+		parseReplicaCommand(writer, command)
+	case "tls":
+		parseTLSCommand(writer, command)
 	default:
 		Error(writer, errInvalidCommand)
 	}
 }
 
+// set <key> <value> [ttl] ttl为过期时间 单位秒
 func parseSetCommand(writer io.Writer, command []string) {
-	if len(command) != 3 {
+	if len(command) != 3 && len(command) != 4 {
 		_, _ = fmt.Fprintln(writer, errNumOfArguments.Error())
 		return
 	}
 
 	ctx := context.Background()
 	req := &data.SetReq{
+		Db:    &db,
 		Key:   command[1],
 		Value: utils.S2B(command[2]),
 	}
+	if len(command) == 4 {
+		ttl, err := strconv.ParseInt(command[3], 10, 64)
+		if err != nil || ttl <= 0 {
+			Error(writer, errInvalidCommand)
+			return
+		}
+		req.Ttl = &ttl
+	}
 
 	_, err := cli.Set(ctx, req)
 	if err != nil {
@@ -118,7 +159,10 @@ func parseGetCommand(writer io.Writer, command []string) {
 
 	ctx := context.Background()
 	req := &data.GetReq{
-		Key: command[1],
+		Db:           &db,
+		Key:          command[1],
+		Consistency:  &consistency,
+		MaxStaleness: &maxStaleness,
 	}
 
 	resp, err := cli.Get(ctx, req)
@@ -137,6 +181,7 @@ func parseDelCommand(writer io.Writer, command []string) {
 
 	ctx := context.Background()
 	req := &data.DelReq{
+		Db:  &db,
 		Key: command[1],
 	}
 
@@ -162,6 +207,7 @@ func parseExpireCommand(writer io.Writer, command []string) {
 
 	ctx := context.Background()
 	req := &data.ExpireReq{
+		Db:   &db,
 		Key:  command[1],
 		Time: int64(ttl),
 	}
@@ -174,6 +220,235 @@ func parseExpireCommand(writer io.Writer, command []string) {
 	OK(writer)
 }
 
+func parseSelectCommand(writer io.Writer, command []string) {
+	if len(command) != 2 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	db = command[1]
+	OK(writer)
+}
+
+// consistency <default|stale|consistent> [max_staleness_ms]
+func parseConsistencyCommand(writer io.Writer, command []string) {
+	if len(command) != 2 && len(command) != 3 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	level, err := iface.ParseConsistency(command[1])
+	if err != nil {
+		Error(writer, err)
+		return
+	}
+	var staleness int64
+	if len(command) == 3 {
+		staleness, err = strconv.ParseInt(command[2], 10, 64)
+		if err != nil {
+			Error(writer, err)
+			return
+		}
+	}
+
+	consistency = data.Consistency(level)
+	maxStaleness = staleness
+	OK(writer)
+}
+
+func parseFlushDBCommand(writer io.Writer, command []string) {
+	if len(command) != 1 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	ctx := context.Background()
+	resp, err := cli.FlushDB(ctx, &data.FlushDBReq{Db: &db})
+	if err != nil {
+		Error(writer, err)
+		return
+	}
+	if !resp.Success {
+		Error(writer, errors.New(resp.Message))
+		return
+	}
+	OK(writer)
+}
+
+func parseDBSizeCommand(writer io.Writer, command []string) {
+	if len(command) != 1 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	ctx := context.Background()
+	resp, err := cli.DBSize(ctx, &data.DBSizeReq{
+		Db:           &db,
+		Consistency:  &consistency,
+		MaxStaleness: &maxStaleness,
+	})
+	if err != nil {
+		Error(writer, err)
+		return
+	}
+	_, _ = fmt.Fprintln(writer, resp.Size)
+}
+
+// region <region_id>
+func parseRegionCommand(writer io.Writer, command []string) {
+	if len(command) != 2 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	id, err := strconv.ParseInt(command[1], 10, 32)
+	if err != nil || id < 0 {
+		Error(writer, errInvalidCommand)
+		return
+	}
+	region = int32(id)
+	OK(writer)
+}
+
+// tls <cert_file> <key_file> <ca_file>
+// 副本服务开启双向TLS时 使用同一个CA签发的客户端证书连接
+func parseTLSCommand(writer io.Writer, command []string) {
+	if len(command) != 4 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	cfg, err := secure.ClientConfig(config.TLSConfig{CertFile: command[1], KeyFile: command[2], CAFile: command[3]})
+	if err != nil {
+		Error(writer, err)
+		return
+	}
+	replicaOptions = []client.Option{
+		client.WithDialer(&secure.Dialer{Config: cfg}),
+		client.WithTransHandlerFactory(gonet.NewCliTransHandlerFactory()),
+	}
+	replicaClients = make(map[string]replicaservice.Client)
+	OK(writer)
+}
+
+// 以下命令作用于region命令选择的分区
+// replica <addr> members
+// replica <addr> learner <node_id> <raft_addr> <service_addr>
+// replica <addr> remove <node_id>
+// replica <addr> demote <node_id>
+// replica <addr> transfer [node_id]
+// replica <addr> status
+func parseReplicaCommand(writer io.Writer, command []string) {
+	if len(command) < 3 {
+		Error(writer, errNumOfArguments)
+		return
+	}
+
+	rc, ok := replicaClients[command[1]]
+	if !ok {
+		var err error
+		rc, err = replicaservice.NewClient(command[1], append(replicaOptions, client.WithHostPorts(command[1]))...)
+		if err != nil {
+			Error(writer, err)
+			return
+		}
+		replicaClients[command[1]] = rc
+	}
+
+	ctx := context.Background()
+	args := command[3:]
+	var (
+		success bool
+		message string
+		err     error
+	)
+
+	switch strings.ToLower(command[2]) {
+	case "members":
+		var resp *replica.MembersResp
+		if resp, err = rc.Members(ctx, &replica.MembersReq{RegionId: &region}); err == nil {
+			success, message = resp.Success, resp.Message
+			for _, m := range resp.Members {
+				leader := ""
+				if m.Leader {
+					leader = " leader"
+				}
+				_, _ = fmt.Fprintf(writer, "%s %s %s%s\n", m.NodeId, m.RaftAddr, m.Suffrage, leader)
+			}
+			if success {
+				return
+			}
+		}
+	case "learner":
+		if len(args) != 3 {
+			Error(writer, errNumOfArguments)
+			return
+		}
+		var resp *replica.AddNonvoterResp
+		if resp, err = rc.AddNonvoter(ctx, &replica.AddNonvoterReq{NodeId: args[0], RaftAddr: args[1], ServiceAddr: args[2], RegionId: &region}); err == nil {
+			success, message = resp.Success, resp.Message
+		}
+	case "remove":
+		if len(args) != 1 {
+			Error(writer, errNumOfArguments)
+			return
+		}
+		var resp *replica.RemoveServerResp
+		if resp, err = rc.RemoveServer(ctx, &replica.RemoveServerReq{NodeId: args[0], RegionId: &region}); err == nil {
+			success, message = resp.Success, resp.Message
+		}
+	case "demote":
+		if len(args) != 1 {
+			Error(writer, errNumOfArguments)
+			return
+		}
+		var resp *replica.DemoteVoterResp
+		if resp, err = rc.DemoteVoter(ctx, &replica.DemoteVoterReq{NodeId: args[0], RegionId: &region}); err == nil {
+			success, message = resp.Success, resp.Message
+		}
+	case "transfer":
+		if len(args) > 1 {
+			Error(writer, errNumOfArguments)
+			return
+		}
+		req := &replica.TransferLeadershipReq{RegionId: &region}
+		if len(args) == 1 {
+			req.NodeId = &args[0]
+		}
+		var resp *replica.TransferLeadershipResp
+		if resp, err = rc.TransferLeadership(ctx, req); err == nil {
+			success, message = resp.Success, resp.Message
+		}
+	case "status":
+		var resp *replica.StatusResp
+		if resp, err = rc.Status(ctx, &replica.StatusReq{RegionId: &region}); err == nil {
+			success, message = resp.Success, resp.Message
+			if success {
+				t := resp.Tuning
+				_, _ = fmt.Fprintf(writer, "node %s %s leader %s\n", resp.NodeId, resp.State, resp.Leader)
+				_, _ = fmt.Fprintf(writer, "heartbeat_timeout %dms election_timeout %dms leader_lease_timeout %dms\n",
+					t.HeartbeatTimeout, t.ElectionTimeout, t.LeaderLeaseTimeout)
+				_, _ = fmt.Fprintf(writer, "snapshot_interval %dms snapshot_threshold %d trailing_logs %d retain_snapshots %d max_append_entries %d\n",
+					t.SnapshotInterval, t.SnapshotThreshold, t.TrailingLogs, t.RetainSnapshots, t.MaxAppendEntries)
+				return
+			}
+		}
+	default:
+		Error(writer, errInvalidCommand)
+		return
+	}
+
+	if err != nil {
+		Error(writer, err)
+		return
+	}
+	if !success {
+		Error(writer, errors.New(message))
+		return
+	}
+	OK(writer)
+}
+
 func Error(writer io.Writer, err error) {
 	_, _ = fmt.Fprintln(writer, "["+strings.ToUpper(err.Error())+"]")
 }
@@ -0,0 +1,197 @@
+package cdc
+
+import (
+	"context"
+	"errors"
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/poll"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/cdc"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/cdc/cdcservice"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/server"
+	"net"
+	"sync"
+	"time"
+)
+
+/// 变更数据捕获服务 客户端打开变更流后通过长轮询拉取事件
+
+const (
+	DefaultMaxEvents   = 100              // 单次拉取的默认事件数
+	DefaultPollTimeout = time.Second * 30 // 单次拉取的默认等待时间
+	IdleTimeout        = time.Minute      // 变更流空闲超时时间 超时后自动关闭
+)
+
+var ErrStreamNotFound = errors.New("change stream not found")
+
+// 变更流
+type stream struct {
+	mutex  sync.Mutex // 同一变更流的拉取串行执行
+	reader *bases.ChangeReader
+}
+
+// Service 变更数据捕获服务
+type Service struct {
+	address string
+	base    *bases.Base
+	streams *poll.Sessions[*stream]
+}
+
+func NewService(base *bases.Base, addr string) *Service {
+	return &Service{
+		address: addr,
+		base:    base,
+		streams: poll.NewSessions[*stream](IdleTimeout, nil),
+	}
+}
+
+func (s *Service) Start() error {
+	addr, err := net.ResolveTCPAddr("tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	srv := cdcservice.NewServer(s,
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
+		server.WithServiceAddr(addr),
+	)
+
+	go s.streams.Reap()
+
+	klog.Infof("start cdc service at %s", s.address)
+
+	return srv.Run()
+}
+
+func (s *Service) Name() string {
+	return consts.CDCServiceName
+}
+
+func toPosition(pos bases.ChangePosition) *cdc.Position {
+	return &cdc.Position{
+		Fid:    int32(pos.Fid),
+		Offset: pos.Offset,
+	}
+}
+
+func fromPosition(pos *cdc.Position) bases.ChangePosition {
+	if pos == nil {
+		return bases.ChangePosition{}
+	}
+	return bases.ChangePosition{
+		Fid:    uint32(pos.Fid),
+		Offset: pos.Offset,
+	}
+}
+
+// OpenStream implements the CDCService interface.
+func (s *Service) OpenStream(ctx context.Context, req *cdc.OpenStreamReq) (resp *cdc.OpenStreamResp, err error) {
+	start := fromPosition(req.Start)
+	if req.FromCommitted {
+		start, err = s.base.ChangeOffset(req.Consumer)
+		if err != nil {
+			return &cdc.OpenStreamResp{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+	}
+
+	id := s.streams.Open(&stream{
+		reader: s.base.NewChangeReader(start),
+	})
+
+	return &cdc.OpenStreamResp{
+		Success:  true,
+		StreamId: id,
+		Start:    toPosition(start),
+	}, nil
+}
+
+// Poll implements the CDCService interface.
+// 等待直到有新的变更事件或超时
+func (s *Service) Poll(ctx context.Context, req *cdc.PollReq) (resp *cdc.PollResp, err error) {
+	st, ok := s.streams.Get(req.StreamId)
+	if !ok {
+		return &cdc.PollResp{
+			Success: false,
+			Message: ErrStreamNotFound.Error(),
+		}, nil
+	}
+
+	maxEvents, timeout := poll.Limits(req.MaxEvents, req.Timeout, DefaultMaxEvents, DefaultPollTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	st.mutex.Lock()
+	events, err := st.reader.Read(ctx, maxEvents)
+	st.mutex.Unlock()
+
+	resp = &cdc.PollResp{Success: true}
+	if err != nil {
+		// 等待超时不视为错误
+		if !errors.Is(err, context.DeadlineExceeded) {
+			resp.Success = false
+			resp.Message = err.Error()
+		}
+		return resp, nil
+	}
+
+	for _, event := range events {
+		var db *string
+		if name := event.DB; name != "" {
+			db = &name
+		}
+		resp.Events = append(resp.Events, &cdc.ChangeEvent{
+			Key:       event.Key,
+			Db:        db,
+			Value:     event.Value,
+			Op:        event.Op.String(),
+			SeqNo:     int64(event.SeqNo),
+			Timestamp: event.Timestamp,
+			Pos:       toPosition(event.Pos),
+			Next:      toPosition(event.Next),
+		})
+	}
+
+	return resp, nil
+}
+
+// CloseStream implements the CDCService interface.
+func (s *Service) CloseStream(ctx context.Context, req *cdc.CloseStreamReq) (resp *cdc.CloseStreamResp, err error) {
+	if !s.streams.Close(req.StreamId) {
+		return &cdc.CloseStreamResp{
+			Success: false,
+			Message: ErrStreamNotFound.Error(),
+		}, nil
+	}
+
+	return &cdc.CloseStreamResp{
+		Success: true,
+	}, nil
+}
+
+// Commit implements the CDCService interface.
+func (s *Service) Commit(ctx context.Context, req *cdc.CommitReq) (resp *cdc.CommitResp, err error) {
+	err = s.base.CommitChangeOffset(req.Consumer, fromPosition(req.Pos))
+
+	return &cdc.CommitResp{
+		Success: err == nil,
+		Message: utils.WithMessage(err),
+	}, nil
+}
+
+// Offset implements the CDCService interface.
+func (s *Service) Offset(ctx context.Context, req *cdc.OffsetReq) (resp *cdc.OffsetResp, err error) {
+	pos, err := s.base.ChangeOffset(req.Consumer)
+
+	return &cdc.OffsetResp{
+		Success: err == nil,
+		Pos:     toPosition(pos),
+		Message: utils.WithMessage(err),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/data"
+)
+
+/// 其他接口的写请求 与RPC写请求相同 通过key所在分区的raft提交
+/// 跟随者转发给领导者 转发后仍不是领导者时返回领导者的数据服务地址
+
+// Apply 提交写命令 支持SET DEL和FLUSHDB
+func (s *Service) Apply(ctx context.Context, c iface.Command) (iface.Result, string, error) {
+	db := c.DB
+
+	switch c.Ins {
+	case iface.SET_STR:
+		req := &data.SetReq{Key: c.Key, Value: c.Value, Db: &db}
+		if c.TTL != 0 {
+			req.Ttl = &c.TTL
+		}
+		resp, err := s.Set(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+		return applyResult(resp.Success, resp.Message, resp.StatusCode)
+	case iface.DEL:
+		resp, err := s.Del(ctx, &data.DelReq{Key: c.Key, Db: &db})
+		if err != nil {
+			return nil, "", err
+		}
+		return applyResult(resp.Success, resp.Message, resp.StatusCode)
+	case iface.FLUSH_DB:
+		resp, err := s.FlushDB(ctx, &data.FlushDBReq{Db: &db})
+		if err != nil {
+			return nil, "", err
+		}
+		return applyResult(resp.Success, resp.Message, resp.StatusCode)
+	}
+	return nil, "", errno.ErrApplyNotSupported
+}
+
+// 将写请求的响应转换为执行结果 重定向时返回领导者地址
+func applyResult(success bool, message string, code int32) (iface.Result, string, error) {
+	switch {
+	case code == consts.Redirect:
+		return nil, message, nil
+	case code == consts.Error:
+		return nil, "", errors.New(message)
+	case !success:
+		return engine.NewBaseErrResult(errors.New(message)), "", nil
+	}
+	return engine.NewSuccBaseResult(), "", nil
+}
@@ -0,0 +1,268 @@
+package data
+
+import (
+	"context"
+	"github.com/T4t4KAU/TikBase/cluster/router"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/data"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/data/dataservice"
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/// 请求转发 所有写操作都通过raft提交 跟随者收到写请求或需要领导者处理的读请求时转发给领导者
+/// 转发的请求带有标记 收到转发请求的节点如果也不是领导者 则返回重定向而不再转发
+/// 访问全部分区的请求按分区转发 转发时带上分区编号 收到的节点只处理该分区
+
+const (
+	forwardedKey = "TIKBASE_FORWARDED"
+	regionKey    = "TIKBASE_REGION"
+	RequestIDKey = "TIKBASE_REQUEST_ID" // 客户端通过持久元信息传递请求ID 相同ID的写请求只执行一次 转发时一并传递
+)
+
+type forwarder struct {
+	mutex   sync.Mutex
+	clients map[string]dataservice.Client // 领导者地址到客户端的映射
+}
+
+func newForwarder() *forwarder {
+	return &forwarder{
+		clients: make(map[string]dataservice.Client),
+	}
+}
+
+// 返回到领导者的客户端 请求已经被转发过时返回false
+func (f *forwarder) client(ctx context.Context, leader string) (dataservice.Client, bool) {
+	if _, ok := metainfo.GetValue(ctx, forwardedKey); ok {
+		return nil, false
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if cli, ok := f.clients[leader]; ok {
+		return cli, true
+	}
+	cli, err := dataservice.NewClient(consts.DataServiceName,
+		client.WithHostPorts(leader),
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
+	if err != nil {
+		return nil, false
+	}
+	f.clients[leader] = cli
+	return cli, true
+}
+
+func forwarded(ctx context.Context) context.Context {
+	return metainfo.WithValue(ctx, forwardedKey, "1")
+}
+
+// 转发到复制组的领导者 只由领导者处理该分区
+func forwardedTo(ctx context.Context, g *router.Group) context.Context {
+	return metainfo.WithValue(forwarded(ctx), regionKey, strconv.Itoa(g.ID))
+}
+
+// 返回请求需要访问的复制组 按分区转发的请求只访问指定的分区
+func (s *Service) groups(ctx context.Context) ([]*router.Group, error) {
+	id, ok := metainfo.GetValue(ctx, regionKey)
+	if !ok {
+		return s.router.Groups(), nil
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, errno.ErrRegionNotFound
+	}
+	g, err := s.router.Group(n)
+	if err != nil {
+		return nil, err
+	}
+	return []*router.Group{g}, nil
+}
+
+// 当前节点不是复制组的领导者时 返回领导者的数据服务地址
+func (s *Service) leaderFor(g *router.Group, err error) (string, error) {
+	if err != errno.ErrNotLeader {
+		return "", err
+	}
+	leader := g.Peer.LeaderAPIAddr()
+	if leader == "" {
+		return "", errno.ErrNoLeader
+	}
+	return leader, nil
+}
+
+// 通过raft提交写命令 返回状态机的执行结果
+// 当前节点不是领导者时返回领导者的数据服务地址
+func (s *Service) apply(ctx context.Context, g *router.Group, c iface.Command) (iface.Result, string, error) {
+	if id, ok := metainfo.GetPersistentValue(ctx, RequestIDKey); ok {
+		c.RequestID = id
+	}
+	res, err := g.Peer.Apply(c)
+	if err != nil {
+		leader, err := s.leaderFor(g, err)
+		return nil, leader, err
+	}
+	return res, "", nil
+}
+
+// 按请求的一致性级别等待读屏障 读请求需要由领导者处理时返回领导者的数据服务地址
+func (s *Service) readBarrier(g *router.Group, level data.Consistency, maxStaleness int64) (string, error) {
+	err := g.Peer.ReadBarrier(iface.ConsistencyLevel(level), time.Duration(maxStaleness)*time.Millisecond)
+	if err != nil {
+		return s.leaderFor(g, err)
+	}
+	return "", nil
+}
+
+func (s *Service) ForwardGet(ctx context.Context, req *data.GetReq, leader string) (resp *data.GetResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectGet(ctx, req, leader)
+	}
+	return cli.Get(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardHGet(ctx context.Context, req *data.HGetReq, leader string) (resp *data.HGetResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectHGet(ctx, req, leader)
+	}
+	return cli.HGet(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardDBSize(ctx context.Context, req *data.DBSizeReq, g *router.Group, leader string) (resp *data.DBSizeResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		resp = new(data.DBSizeResp)
+		resp.Success = false
+		resp.Message = leader
+		resp.StatusCode = consts.Redirect
+		return
+	}
+	return cli.DBSize(forwardedTo(ctx, g), req)
+}
+
+func (s *Service) ForwardSet(ctx context.Context, req *data.SetReq, leader string) (resp *data.SetResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectSet(ctx, req, leader)
+	}
+	return cli.Set(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardDel(ctx context.Context, req *data.DelReq, leader string) (resp *data.DelResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectDel(ctx, req, leader)
+	}
+	return cli.Del(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardExpire(ctx context.Context, req *data.ExpireReq, leader string) (resp *data.ExpireResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectExpire(ctx, req, leader)
+	}
+	return cli.Expire(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardHSet(ctx context.Context, req *data.HSetReq, leader string) (resp *data.HSetResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectHSet(ctx, req, leader)
+	}
+	return cli.HSet(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardHDel(ctx context.Context, req *data.HDelReq, leader string) (resp *data.HDelResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectHDel(ctx, req, leader)
+	}
+	return cli.HDel(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardLPush(ctx context.Context, req *data.LPushReq, leader string) (resp *data.LPushResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectLPush(ctx, req, leader)
+	}
+	return cli.LPush(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardRPush(ctx context.Context, req *data.RPushReq, leader string) (resp *data.RPushResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectRPush(ctx, req, leader)
+	}
+	return cli.RPush(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardLPop(ctx context.Context, req *data.LPopReq, leader string) (resp *data.LPopResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectLPop(ctx, req, leader)
+	}
+	return cli.LPop(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardRPop(ctx context.Context, req *data.RPopReq, leader string) (resp *data.RPopResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectRPop(ctx, req, leader)
+	}
+	return cli.RPop(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardSAdd(ctx context.Context, req *data.SAddReq, leader string) (resp *data.SAddResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectSAdd(ctx, req, leader)
+	}
+	return cli.SAdd(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardSRem(ctx context.Context, req *data.SRemReq, leader string) (resp *data.SRemResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectSRem(ctx, req, leader)
+	}
+	return cli.SRem(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardZAdd(ctx context.Context, req *data.ZAddReq, leader string) (resp *data.ZAddResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectZAdd(ctx, req, leader)
+	}
+	return cli.ZAdd(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardZRem(ctx context.Context, req *data.ZRemReq, leader string) (resp *data.ZRemResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		return s.RedirectZRem(ctx, req, leader)
+	}
+	return cli.ZRem(forwarded(ctx), req)
+}
+
+func (s *Service) ForwardFlushDB(ctx context.Context, req *data.FlushDBReq, g *router.Group, leader string) (resp *data.FlushDBResp, err error) {
+	cli, ok := s.forwarder.client(ctx, leader)
+	if !ok {
+		resp = new(data.FlushDBResp)
+		resp.Success = false
+		resp.Message = leader
+		resp.StatusCode = consts.Redirect
+		return
+	}
+	return cli.FlushDB(forwardedTo(ctx, g), req)
+}
@@ -2,8 +2,7 @@ package data
 
 import (
 	"context"
-	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
-	"github.com/T4t4KAU/TikBase/cluster/slice"
+	"github.com/T4t4KAU/TikBase/cluster/router"
 	"github.com/T4t4KAU/TikBase/engine"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/consts"
@@ -12,24 +11,29 @@ import (
 	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
 	"github.com/cloudwego/kitex/server"
 	"net"
+	"strconv"
+	"time"
 )
 
 /// 数据服务 处理数据请求
+/// 请求按key路由到所在分区的复制组 写请求通过该复制组的raft提交 由状态机执行后将结果返回给客户端
+/// 读请求按指定的一致性级别通过读屏障后读取本地数据
 
 // Service implements the last service interface defined in the IDL.
 type Service struct {
-	address string
-	slice   *slice.Slice
-	peer    *raft.Peer
+	address   string
+	router    *router.Router
+	forwarder *forwarder
 }
 
-func NewService(sc *slice.Slice, addr string, peer *raft.Peer) *Service {
+func NewService(rt *router.Router, addr string) *Service {
 	return &Service{
-		slice:   sc,
-		address: addr,
-		peer:    peer,
+		router:    rt,
+		address:   addr,
+		forwarder: newForwarder(),
 	}
 }
 
@@ -42,6 +46,7 @@ func (s *Service) Start() error {
 	srv := dataservice.NewServer(s,
 		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
 		server.WithServiceAddr(addr),
+		server.WithMetaHandler(transmeta.ServerTTHeaderHandler),
 	)
 
 	klog.Infof("start data service at %s", s.address)
@@ -53,24 +58,31 @@ func (s *Service) Name() string {
 	return consts.DataServiceName
 }
 
+// 返回复制组中请求指定的逻辑数据库
+func (s *Service) db(g *router.Group, name string) iface.Engine {
+	return engine.Select(g.Engine, name)
+}
+
 // Get implements the Service interface.
 func (s *Service) Get(ctx context.Context, req *data.GetReq) (resp *data.GetResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	leader, err := s.readBarrier(g, req.GetConsistency(), req.GetMaxStaleness())
+	if leader != "" {
+		return s.ForwardGet(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.GetResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectGet(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.GetResp)
 
 	// 执行指令
-	res := s.slice.Exec(iface.GET_STR, utils.KeyBytes(req.Key))
+	res := s.db(g, req.GetDb()).Exec(iface.GET_STR, utils.KeyBytes(req.Key))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 	resp.Value = res.Data()
@@ -80,83 +92,83 @@ func (s *Service) Get(ctx context.Context, req *data.GetReq) (resp *data.GetResp
 
 // Set implements the Service interface.
 func (s *Service) Set(ctx context.Context, req *data.SetReq) (resp *data.SetResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.SET_STR,
+		Key:   req.Key,
+		Value: req.Value,
+		DB:    req.GetDb(),
+		TTL:   req.GetTtl(),
+	})
+	if leader != "" {
+		return s.ForwardSet(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.SetResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectSet(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.SetResp)
-	res := s.slice.Exec(iface.SET_STR, utils.KeyValueBytes(req.Key, req.Value))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
-	if e := s.peer.Apply(iface.Command{
-		Ins:   iface.SET_STR,
-		Key:   req.Key,
-		Value: req.Value,
-	}); e != nil {
-		klog.Error("failed to apply command: ", e)
-	} else {
-		klog.Infof("apply command ok")
-	}
-
 	return
 }
 
 // Del implements the Service interface.
 func (s *Service) Del(ctx context.Context, req *data.DelReq) (resp *data.DelResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins: iface.DEL,
+		Key: req.Key,
+		DB:  req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardDel(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.DelResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectDel(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.DelResp)
-	res := s.slice.Exec(iface.DEL, utils.KeyBytes(req.Key))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
-	if e := s.peer.Apply(iface.Command{
-		Ins: iface.SET_STR,
-		Key: req.Key,
-	}); e != nil {
-		klog.Error("failed to apply command: ", e)
-	} else {
-		klog.Infof("apply command ok")
-	}
-
 	return
 }
 
 // Expire implements the Service interface.
 func (s *Service) Expire(ctx context.Context, req *data.ExpireReq) (resp *data.ExpireResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	// 换算为绝对时间后提交 各副本按相同的时间过期
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.EXPIRE_AT,
+		Key:   req.Key,
+		Value: utils.I642B(time.Now().Unix() + req.Time),
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardExpire(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.ExpireResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectExpire(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.ExpireResp)
-	res := s.slice.Exec(iface.EXPIRE, utils.KeyBytes(req.Key))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -165,20 +177,27 @@ func (s *Service) Expire(ctx context.Context, req *data.ExpireReq) (resp *data.E
 
 // HSet implements the Service interface.
 func (s *Service) HSet(ctx context.Context, req *data.HSetReq) (resp *data.HSetResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.SET_HASH,
+		Key:   req.Key,
+		Field: string(req.Field),
+		Value: req.Value,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardHSet(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.HSetResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectHSet(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.HSetResp)
-	res := s.slice.Exec(iface.SET_HASH, engine.MakeHashSetArgs(req.Key, req.Field, req.Value))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -187,20 +206,22 @@ func (s *Service) HSet(ctx context.Context, req *data.HSetReq) (resp *data.HSetR
 
 // HGet implements the Service interface.
 func (s *Service) HGet(ctx context.Context, req *data.HGetReq) (resp *data.HGetResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	leader, err := s.readBarrier(g, req.GetConsistency(), req.GetMaxStaleness())
+	if leader != "" {
+		return s.ForwardHGet(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.HGetResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectHGet(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.HGetResp)
-	res := s.slice.Exec(iface.GET_HASH, engine.MakeHashGetArgs(req.Key, req.Field))
+	res := s.db(g, req.GetDb()).Exec(iface.GET_HASH, engine.MakeHashGetArgs(req.Key, req.Field))
 	resp.Success = res.Success()
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Value = res.Data()
@@ -210,19 +231,26 @@ func (s *Service) HGet(ctx context.Context, req *data.HGetReq) (resp *data.HGetR
 
 // HDel implements the Service interface.
 func (s *Service) HDel(ctx context.Context, req *data.HDelReq) (resp *data.HDelResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.DEL_HASH,
+		Key:   req.Key,
+		Field: string(req.Field),
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardHDel(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.HDelResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectHDel(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.HDelResp)
-	res := s.slice.Exec(iface.DEL_HASH, engine.MakeHashDelArgs(req.Key, req.Field))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -231,19 +259,26 @@ func (s *Service) HDel(ctx context.Context, req *data.HDelReq) (resp *data.HDelR
 
 // LPush implements the Service interface.
 func (s *Service) LPush(ctx context.Context, req *data.LPushReq) (resp *data.LPushResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.LEFT_PUSH_LIST,
+		Key:   req.Key,
+		Value: req.Element,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardLPush(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.LPushResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectLPush(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.LPushResp)
-	res := s.slice.Exec(iface.LEFT_PUSH_LIST, engine.MakeListPushArgs(req.Key, req.Element))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -252,17 +287,26 @@ func (s *Service) LPush(ctx context.Context, req *data.LPushReq) (resp *data.LPu
 
 // RPush implements the Service interface.
 func (s *Service) RPush(ctx context.Context, req *data.RPushReq) (resp *data.RPushResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
-	if err != nil {
-		resp.Message = err.Error()
-		return
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.RIGHT_PUSH_LIST,
+		Key:   req.Key,
+		Value: req.Element,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardRPush(ctx, req, leader)
 	}
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectRPush(ctx, req, node)
+	if err != nil {
+		return &data.RPushResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.RPushResp)
-	res := s.slice.Exec(iface.RIGHT_PUSH_LIST, engine.MakeListPushArgs(req.Key, req.Element))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -271,63 +315,82 @@ func (s *Service) RPush(ctx context.Context, req *data.RPushReq) (resp *data.RPu
 
 // LPop implements the Service interface.
 func (s *Service) LPop(ctx context.Context, req *data.LPopReq) (resp *data.LPopResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins: iface.LEFT_POP_LIST,
+		Key: req.Key,
+		DB:  req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardLPop(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.LPopResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectLPop(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.LPopResp)
-	res := s.slice.Exec(iface.LEFT_POP_LIST, engine.MakeListPopArgs(req.Key))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
+	resp.Element = res.Data()
 
 	return
 }
 
 // RPop implements the Service interface.
 func (s *Service) RPop(ctx context.Context, req *data.RPopReq) (resp *data.RPopResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins: iface.RIGHT_POP_LIST,
+		Key: req.Key,
+		DB:  req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardRPop(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.RPopResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectRPop(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.RPopResp)
-	res := s.slice.Exec(iface.RIGHT_POP_LIST, engine.MakeListPopArgs(req.Key))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
+	resp.Element = res.Data()
 
 	return
 }
 
 // SAdd implements the Service interface.
 func (s *Service) SAdd(ctx context.Context, req *data.SAddReq) (resp *data.SAddResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.ADD_SET,
+		Key:   req.Key,
+		Value: req.Element,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardSAdd(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.SAddResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectSAdd(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.SAddResp)
-	res := s.slice.Exec(iface.ADD_SET, engine.MakeSetAddArgs(req.Key, req.Element))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -336,19 +399,26 @@ func (s *Service) SAdd(ctx context.Context, req *data.SAddReq) (resp *data.SAddR
 
 // SRem implements the Service interface.
 func (s *Service) SRem(ctx context.Context, req *data.SRemReq) (resp *data.SRemResp, err error) {
-	node, err := s.slice.SelectNode(req.Key)
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.REM_SET,
+		Key:   req.Key,
+		Value: req.Element,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardSRem(ctx, req, leader)
+	}
 	if err != nil {
 		return &data.SRemResp{
-			Message: err.Error(),
-			Success: false,
-		}, err
-	}
-	if !s.slice.IsCurrentNode(node) {
-		return s.RedirectSRem(ctx, req, node)
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
 	}
 
 	resp = new(data.SRemResp)
-	res := s.slice.Exec(iface.REM_SET, engine.MakeSetRemArgs(req.Key, req.Element))
 	resp.Message = utils.WithMessage(res.Error())
 	resp.Success = res.Success()
 
@@ -357,13 +427,151 @@ func (s *Service) SRem(ctx context.Context, req *data.SRemReq) (resp *data.SRemR
 
 // ZAdd implements the Service interface.
 func (s *Service) ZAdd(ctx context.Context, req *data.ZAddReq) (resp *data.ZAddResp, err error) {
-	// TODO: Your code here...
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:    iface.ADD_ZSET,
+		Key:    req.Key,
+		Params: engine.MakeZSetAddArgs(req.Key, req.GetScore(), req.Element),
+		DB:     req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardZAdd(ctx, req, leader)
+	}
+	if err != nil {
+		return &data.ZAddResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	resp = new(data.ZAddResp)
+	resp.Message = utils.WithMessage(res.Error())
+	resp.Success = res.Success()
+
 	return
 }
 
 // ZRem implements the Service interface.
 func (s *Service) ZRem(ctx context.Context, req *data.ZRemReq) (resp *data.ZRemResp, err error) {
-	// TODO: Your code here...
+	g := s.router.Route(req.GetDb(), req.Key)
+
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins:   iface.REM_ZSET,
+		Key:   req.Key,
+		Value: req.Element,
+		DB:    req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardZRem(ctx, req, leader)
+	}
+	if err != nil {
+		return &data.ZRemResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	resp = new(data.ZRemResp)
+	resp.Message = utils.WithMessage(res.Error())
+	resp.Success = res.Success()
+
+	return
+}
+
+// FlushDB implements the Service interface.
+// 清空全部分区中的逻辑数据库 当前节点不是领导者的分区转发给该分区的领导者
+func (s *Service) FlushDB(ctx context.Context, req *data.FlushDBReq) (resp *data.FlushDBResp, err error) {
+	groups, err := s.groups(ctx)
+	if err != nil {
+		return &data.FlushDBResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	for _, g := range groups {
+		resp, err = s.flushDB(ctx, req, g)
+		if err != nil || !resp.Success {
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Service) flushDB(ctx context.Context, req *data.FlushDBReq, g *router.Group) (resp *data.FlushDBResp, err error) {
+	res, leader, err := s.apply(ctx, g, iface.Command{
+		Ins: iface.FLUSH_DB,
+		DB:  req.GetDb(),
+	})
+	if leader != "" {
+		return s.ForwardFlushDB(ctx, req, g, leader)
+	}
+	if err != nil {
+		return &data.FlushDBResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	resp = new(data.FlushDBResp)
+	resp.Message = utils.WithMessage(res.Error())
+	resp.Success = res.Success()
+
+	return
+}
+
+// DBSize implements the Service interface.
+// 汇总全部分区中的key数量 每个分区按请求的一致性级别读取
+func (s *Service) DBSize(ctx context.Context, req *data.DBSizeReq) (resp *data.DBSizeResp, err error) {
+	groups, err := s.groups(ctx)
+	if err != nil {
+		return &data.DBSizeResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	var size int64
+	for _, g := range groups {
+		resp, err = s.dbSize(ctx, req, g)
+		if err != nil || !resp.Success {
+			return
+		}
+		size += resp.Size
+	}
+	resp.Size = size
+
+	return
+}
+
+func (s *Service) dbSize(ctx context.Context, req *data.DBSizeReq, g *router.Group) (resp *data.DBSizeResp, err error) {
+	leader, err := s.readBarrier(g, req.GetConsistency(), req.GetMaxStaleness())
+	if leader != "" {
+		return s.ForwardDBSize(ctx, req, g, leader)
+	}
+	if err != nil {
+		return &data.DBSizeResp{
+			Message:    err.Error(),
+			Success:    false,
+			StatusCode: consts.Error,
+		}, nil
+	}
+
+	resp = new(data.DBSizeResp)
+	res := s.db(g, req.GetDb()).Exec(iface.DB_SIZE, nil)
+	resp.Message = utils.WithMessage(res.Error())
+	resp.Success = res.Success()
+	if res.Success() {
+		resp.Size, _ = strconv.ParseInt(res.String(), 10, 64)
+	}
+
 	return
 }
 
@@ -497,3 +705,23 @@ func (s *Service) RedirectSRem(ctx context.Context, req *data.SRemReq, node stri
 
 	return
 }
+
+func (s *Service) RedirectZAdd(ctx context.Context, req *data.ZAddReq, node string) (resp *data.ZAddResp, err error) {
+	resp = new(data.ZAddResp)
+
+	resp.Success = false
+	resp.Message = node
+	resp.StatusCode = consts.Redirect
+
+	return
+}
+
+func (s *Service) RedirectZRem(ctx context.Context, req *data.ZRemReq, node string) (resp *data.ZRemResp, err error) {
+	resp = new(data.ZRemResp)
+
+	resp.Success = false
+	resp.Message = node
+	resp.StatusCode = consts.Redirect
+
+	return
+}
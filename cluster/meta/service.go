@@ -4,21 +4,64 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/T4t4KAU/TikBase/cluster/replica"
-	"github.com/T4t4KAU/TikBase/cluster/slice"
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/cluster/router"
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	meta0 "github.com/T4t4KAU/TikBase/pkg/rpc/meta"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/meta/metaservice"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/server"
+	"net"
+	"strconv"
+	"time"
 )
 
 // Service implements the last service interface defined in the IDL.
 type Service struct {
-	sc slice.Slice
-	re replica.Service
+	address  string
+	router   *router.Router
+	re       *replica.Service
+	reporter quota.Reporter     // 存储引擎不支持配额时为空
+	indexer  engine.HashIndexer // 存储引擎不支持二级索引时为空
+}
+
+func NewService(addr string, rt *router.Router, re *replica.Service) *Service {
+	s := &Service{
+		address: addr,
+		router:  rt,
+		re:      re,
+	}
+	// 各分区使用相同类型的存储引擎 配额和二级索引汇总全部分区
+	eng := rt.Groups()[0].Engine
+	if _, ok := eng.(quota.Reporter); ok {
+		s.reporter = rt
+	}
+	if _, ok := eng.(engine.HashIndexer); ok {
+		s.indexer = rt
+	}
+	return s
 }
 
 func (s *Service) Start() error {
-	//TODO implement me
-	panic("implement me")
+	addr, err := net.ResolveTCPAddr("tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	srv := metaservice.NewServer(s,
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
+		server.WithServiceAddr(addr),
+	)
+
+	klog.Infof("start meta service at %s", s.address)
+
+	return srv.Run()
 }
 
 func (s *Service) Name() string {
@@ -26,11 +69,11 @@ func (s *Service) Name() string {
 }
 
 // RegionList implements the Service interface.
+// 返回各分区对应的复制组
 func (s *Service) RegionList(ctx context.Context, req *meta0.RegionListReq) (resp *meta0.RegionListResp, err error) {
 	resp = &meta0.RegionListResp{}
 
-	nodes := s.sc.Nodes()
-	bytes, err := json.Marshal(nodes)
+	bytes, err := json.Marshal(s.router.Regions())
 	if err != nil {
 		resp.Message = err.Error()
 		return resp, err
@@ -42,20 +85,249 @@ func (s *Service) RegionList(ctx context.Context, req *meta0.RegionListReq) (res
 }
 
 // RegionStatus implements the Service interface.
+// 返回各分区在其领导者上的状态 未指定分区时返回全部分区和集群汇总
+// 本节点不是领导者时向领导者查询 无法查询时使用本节点的状态
 func (s *Service) RegionStatus(ctx context.Context, req *meta0.RegionStatusReq) (resp *meta0.RegionStatusResp, err error) {
-	// TODO: Your code here...
-	return
+	resp = &meta0.RegionStatusResp{
+		Name:    req.Name,
+		Address: s.address,
+		Regions: make([]*meta0.ReplicaState, 0),
+		Cluster: &meta0.ClusterState{},
+	}
+
+	var ids []int
+	if req.Name == "" {
+		for _, g := range s.router.Groups() {
+			ids = append(ids, g.ID)
+		}
+	} else if id, err := strconv.Atoi(req.Name); err == nil {
+		ids = []int{id}
+	} else {
+		resp.Message = errno.ErrRegionNotFound.Error()
+		return resp, nil
+	}
+
+	regions := make([]router.RegionStatus, 0, len(ids))
+	for _, id := range ids {
+		region, err := s.re.LeaderStatus(ctx, id)
+		if err != nil {
+			resp.Message = err.Error()
+			return resp, nil
+		}
+		regions = append(regions, region)
+	}
+
+	for _, region := range regions {
+		resp.Regions = append(resp.Regions, replicaState(region.ID, region.Node))
+	}
+	cs := router.Summarize(regions)
+	resp.ReplicaCount = int64(cs.Replicas)
+	resp.Cluster = &meta0.ClusterState{
+		Regions:       int32(cs.Regions),
+		Leaders:       int32(cs.Leaders),
+		Leaderless:    int32(cs.Leaderless),
+		Replicas:      int32(cs.Replicas),
+		Lagging:       int32(cs.Lagging),
+		MaxLagEntries: int64(cs.MaxLagEntries),
+		MaxLagTime:    cs.MaxLagTime.Milliseconds(),
+	}
+	resp.Success = true
+
+	return resp, nil
 }
 
 // ReplicaList implements the Service interface.
 func (s *Service) ReplicaList(ctx context.Context, req *meta0.ReplicaListReq) (resp *meta0.ReplicaListResp, err error) {
+	resp = &meta0.ReplicaListResp{}
 	resp.Message = s.re.ReplicaList()
 
 	return
 }
 
 // ReplicaStatus implements the Service interface.
+// 本节点返回在各分区的状态 其他节点返回其在本节点担任领导者的分区中的复制进度
 func (s *Service) ReplicaStatus(ctx context.Context, req *meta0.ReplicaStatusReq) (resp *meta0.ReplicaStatusResp, err error) {
-	// TODO: Your code here...
-	return
+	resp = &meta0.ReplicaStatusResp{
+		Name:     req.Name,
+		Address:  s.address,
+		Replicas: make([]*meta0.ReplicaState, 0),
+		Progress: make([]*meta0.ReplicaProgress, 0),
+	}
+
+	regions, err := s.router.Statuses()
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	for _, region := range regions {
+		if req.Name == "" || req.Name == region.Node.ID {
+			resp.Replicas = append(resp.Replicas, replicaState(region.ID, region.Node))
+			continue
+		}
+		for _, p := range region.Node.Followers {
+			if p.ID == req.Name {
+				resp.Progress = append(resp.Progress, replicaProgress(region.ID, p))
+			}
+		}
+	}
+	if len(resp.Replicas) == 0 && len(resp.Progress) == 0 {
+		resp.Message = errno.ErrServerNotFound.Error()
+		return resp, nil
+	}
+	resp.Success = true
+
+	return resp, nil
+}
+
+func replicaState(regionId int, status raft.Status) *meta0.ReplicaState {
+	state := &meta0.ReplicaState{
+		RegionId:      int32(regionId),
+		NodeId:        status.ID,
+		Address:       status.Address,
+		State:         status.State,
+		Leader:        status.Leader,
+		Term:          int64(status.Term),
+		LastIndex:     int64(status.LastIndex),
+		CommitIndex:   int64(status.CommitIndex),
+		AppliedIndex:  int64(status.AppliedIndex),
+		SnapshotIndex: int64(status.SnapshotIndex),
+		LastContact:   milliseconds(status.LastContact),
+		Followers:     make([]*meta0.ReplicaProgress, 0, len(status.Followers)),
+	}
+	for _, p := range status.Followers {
+		state.Followers = append(state.Followers, replicaProgress(regionId, p))
+	}
+	return state
+}
+
+func replicaProgress(regionId int, p raft.Progress) *meta0.ReplicaProgress {
+	return &meta0.ReplicaProgress{
+		RegionId:    int32(regionId),
+		NodeId:      p.ID,
+		Address:     p.Address,
+		Suffrage:    p.Suffrage,
+		MatchIndex:  int64(p.MatchIndex),
+		LagEntries:  int64(p.LagEntries),
+		LagTime:     milliseconds(p.LagTime),
+		LastContact: milliseconds(p.LastContact),
+	}
+}
+
+// 转换为毫秒 未知的时间保持为-1
+func milliseconds(d time.Duration) int64 {
+	if d < 0 {
+		return -1
+	}
+	return d.Milliseconds()
+}
+
+// NamespaceUsage implements the Service interface.
+func (s *Service) NamespaceUsage(ctx context.Context, req *meta0.NamespaceUsageReq) (resp *meta0.NamespaceUsageResp, err error) {
+	resp = &meta0.NamespaceUsageResp{
+		Namespaces: make([]*meta0.NamespaceUsage, 0),
+	}
+
+	if s.reporter == nil {
+		resp.Message = errno.ErrQuotaNotSupported.Error()
+		return resp, nil
+	}
+
+	for _, usage := range s.reporter.NamespaceUsage() {
+		resp.Namespaces = append(resp.Namespaces, &meta0.NamespaceUsage{
+			Prefix:       usage.Prefix,
+			MaxBytes:     usage.MaxBytes,
+			MaxKeys:      usage.MaxKeys,
+			MaxValueSize: usage.MaxValueSize,
+			Bytes:        usage.Bytes,
+			Keys:         usage.Keys,
+		})
+	}
+	resp.Success = true
+
+	return resp, nil
+}
+
+// CreateHashIndex implements the Service interface.
+func (s *Service) CreateHashIndex(ctx context.Context, req *meta0.CreateHashIndexReq) (resp *meta0.CreateHashIndexResp, err error) {
+	resp = &meta0.CreateHashIndexResp{}
+
+	if s.indexer == nil {
+		resp.Message = errno.ErrHashIndexNotSupported.Error()
+		return resp, nil
+	}
+	if err = s.indexer.CreateHashIndex(req.Name, req.Field); err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	resp.Success = true
+
+	return resp, nil
+}
+
+// DropHashIndex implements the Service interface.
+func (s *Service) DropHashIndex(ctx context.Context, req *meta0.DropHashIndexReq) (resp *meta0.DropHashIndexResp, err error) {
+	resp = &meta0.DropHashIndexResp{}
+
+	if s.indexer == nil {
+		resp.Message = errno.ErrHashIndexNotSupported.Error()
+		return resp, nil
+	}
+	if err = s.indexer.DropHashIndex(req.Name); err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	resp.Success = true
+
+	return resp, nil
+}
+
+// ListHashIndexes implements the Service interface.
+func (s *Service) ListHashIndexes(ctx context.Context, req *meta0.ListHashIndexesReq) (resp *meta0.ListHashIndexesResp, err error) {
+	resp = &meta0.ListHashIndexesResp{
+		Indexes: make([]*meta0.HashIndex, 0),
+	}
+
+	if s.indexer == nil {
+		resp.Message = errno.ErrHashIndexNotSupported.Error()
+		return resp, nil
+	}
+	for _, idx := range s.indexer.HashIndexes() {
+		resp.Indexes = append(resp.Indexes, &meta0.HashIndex{
+			Name:  idx.Name,
+			Field: idx.Field,
+			Ready: idx.Ready,
+		})
+	}
+	resp.Success = true
+
+	return resp, nil
+}
+
+// QueryHashIndex implements the Service interface.
+func (s *Service) QueryHashIndex(ctx context.Context, req *meta0.QueryHashIndexReq) (resp *meta0.QueryHashIndexResp, err error) {
+	resp = &meta0.QueryHashIndexResp{
+		Keys: make([]string, 0),
+	}
+
+	if s.indexer == nil {
+		resp.Message = errno.ErrHashIndexNotSupported.Error()
+		return resp, nil
+	}
+	keys, err := s.indexer.RangeHashIndex(req.Name, req.GetMin(), req.GetMax())
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	// 只返回所选逻辑数据库中的key
+	prefix := iface.DBPrefix(req.GetDb())
+	for _, key := range keys {
+		if iface.InDB(prefix, key) {
+			resp.Keys = append(resp.Keys, string(key[len(prefix):]))
+		}
+	}
+	resp.Success = true
+
+	return resp, nil
 }
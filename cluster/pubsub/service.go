@@ -0,0 +1,156 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/poll"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/pubsub"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/pubsub/pubsubservice"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/server"
+	"net"
+	"time"
+)
+
+/// 发布订阅服务 客户端通过长轮询拉取订阅的消息
+
+const (
+	DefaultMaxMessages = 100              // 单次拉取的默认消息数
+	DefaultPollTimeout = time.Second * 30 // 单次拉取的默认等待时间
+	IdleTimeout        = time.Minute      // 订阅空闲超时时间 超时后自动取消订阅
+)
+
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// Service 发布订阅服务
+type Service struct {
+	address string
+	queue   *queue.MessageQueue
+	subs    *poll.Sessions[queue.Subscriber]
+}
+
+func NewService(mq *queue.MessageQueue, addr string) *Service {
+	return &Service{
+		address: addr,
+		queue:   mq,
+		subs:    poll.NewSessions[queue.Subscriber](IdleTimeout, mq.Evict),
+	}
+}
+
+func (s *Service) Start() error {
+	addr, err := net.ResolveTCPAddr("tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	srv := pubsubservice.NewServer(s,
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
+		server.WithServiceAddr(addr),
+	)
+
+	go s.subs.Reap()
+
+	klog.Infof("start pubsub service at %s", s.address)
+
+	return srv.Run()
+}
+
+func (s *Service) Name() string {
+	return consts.PubSubServiceName
+}
+
+// Publish implements the PubSubService interface.
+func (s *Service) Publish(ctx context.Context, req *pubsub.PublishReq) (resp *pubsub.PublishResp, err error) {
+	s.queue.Publish(queue.Message{
+		Topic: req.Topic,
+		Data:  req.Data,
+	})
+
+	return &pubsub.PublishResp{
+		Success: true,
+	}, nil
+}
+
+// Subscribe implements the PubSubService interface.
+func (s *Service) Subscribe(ctx context.Context, req *pubsub.SubscribeReq) (resp *pubsub.SubscribeResp, err error) {
+	if len(req.Patterns) == 0 {
+		return &pubsub.SubscribeResp{
+			Success: false,
+			Message: ErrEmptyPatterns.Error(),
+		}, nil
+	}
+
+	id := s.subs.Open(s.queue.SubscribeTopic(req.Patterns...))
+
+	return &pubsub.SubscribeResp{
+		Success:        true,
+		SubscriptionId: id,
+	}, nil
+}
+
+// Poll implements the PubSubService interface.
+// 等待直到收到第一条消息或超时 之后取走已到达的消息
+func (s *Service) Poll(ctx context.Context, req *pubsub.PollReq) (resp *pubsub.PollResp, err error) {
+	sub, ok := s.subs.Get(req.SubscriptionId)
+	if !ok {
+		return &pubsub.PollResp{
+			Success: false,
+			Message: ErrSubscriptionNotFound.Error(),
+		}, nil
+	}
+
+	maxMessages, timeout := poll.Limits(req.MaxMessages, req.Timeout, DefaultMaxMessages, DefaultPollTimeout)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	resp = &pubsub.PollResp{Success: true}
+	for len(resp.Messages) < maxMessages {
+		var msg queue.Message
+		var ok bool
+
+		if len(resp.Messages) == 0 {
+			select {
+			case msg, ok = <-sub:
+			case <-timer.C:
+				return
+			case <-ctx.Done():
+				return
+			}
+		} else {
+			select {
+			case msg, ok = <-sub:
+			default:
+				return
+			}
+		}
+
+		// 订阅已被取消
+		if !ok {
+			return
+		}
+		resp.Messages = append(resp.Messages, &pubsub.Message{
+			Topic: msg.Topic,
+			Data:  msg.Bytes(),
+		})
+	}
+
+	return
+}
+
+// Unsubscribe implements the PubSubService interface.
+func (s *Service) Unsubscribe(ctx context.Context, req *pubsub.UnsubscribeReq) (resp *pubsub.UnsubscribeResp, err error) {
+	if !s.subs.Close(req.SubscriptionId) {
+		return &pubsub.UnsubscribeResp{
+			Success: false,
+			Message: ErrSubscriptionNotFound.Error(),
+		}, nil
+	}
+
+	return &pubsub.UnsubscribeResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/serviceinfo"
+	"github.com/cloudwego/kitex/pkg/streaming"
+	"github.com/cloudwego/kitex/server"
+	"github.com/cloudwego/kitex/transport"
+	"google.golang.org/protobuf/encoding/protowire"
+	"net"
+)
+
+/// 流式订阅服务 服务端通过gRPC流持续推送消息 客户端断开连接时自动取消订阅
+/// 当前版本的kitex只支持protobuf编码的流式调用 因此流式订阅使用独立的端口 消息按protobuf格式手工编码
+
+const streamMethod = "Subscribe"
+
+var ErrEmptyPatterns = errors.New("empty patterns")
+
+// StreamRequest 流式订阅请求
+type StreamRequest struct {
+	Patterns []string // 主题模式 支持通配符
+}
+
+// Marshal 编码为protobuf格式 字段1为主题模式
+func (r *StreamRequest) Marshal(out []byte) ([]byte, error) {
+	for _, pattern := range r.Patterns {
+		out = protowire.AppendTag(out, 1, protowire.BytesType)
+		out = protowire.AppendString(out, pattern)
+	}
+	return out, nil
+}
+
+// Unmarshal 从protobuf格式解码
+func (r *StreamRequest) Unmarshal(in []byte) error {
+	r.Patterns = nil
+	return consumeFields(in, func(num protowire.Number, v []byte) {
+		if num == 1 {
+			r.Patterns = append(r.Patterns, string(v))
+		}
+	})
+}
+
+// StreamMessage 推送给订阅者的消息
+type StreamMessage struct {
+	Topic string
+	Data  []byte
+}
+
+// Marshal 编码为protobuf格式 字段1为主题 字段2为消息内容
+func (m *StreamMessage) Marshal(out []byte) ([]byte, error) {
+	out = protowire.AppendTag(out, 1, protowire.BytesType)
+	out = protowire.AppendString(out, m.Topic)
+	out = protowire.AppendTag(out, 2, protowire.BytesType)
+	out = protowire.AppendBytes(out, m.Data)
+	return out, nil
+}
+
+// Unmarshal 从protobuf格式解码
+func (m *StreamMessage) Unmarshal(in []byte) error {
+	m.Topic, m.Data = "", nil
+	return consumeFields(in, func(num protowire.Number, v []byte) {
+		switch num {
+		case 1:
+			m.Topic = string(v)
+		case 2:
+			m.Data = utils.Copy(v)
+		}
+	})
+}
+
+// 解析长度分隔的字段 跳过其他类型的字段
+func consumeFields(in []byte, fn func(num protowire.Number, v []byte)) error {
+	for len(in) > 0 {
+		num, typ, n := protowire.ConsumeTag(in)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		in = in[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, in)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			in = in[n:]
+			continue
+		}
+
+		v, n := protowire.ConsumeBytes(in)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		fn(num, v)
+		in = in[n:]
+	}
+	return nil
+}
+
+// StreamHandler 处理流式订阅
+type StreamHandler interface {
+	SubscribeStream(req *StreamRequest, stream streaming.Stream) error
+}
+
+// NewStreamServiceInfo 返回流式订阅服务的描述
+func NewStreamServiceInfo() *serviceinfo.ServiceInfo {
+	return &serviceinfo.ServiceInfo{
+		ServiceName: consts.PubSubStreamServiceName,
+		HandlerType: (*StreamHandler)(nil),
+		Methods: map[string]serviceinfo.MethodInfo{
+			streamMethod: serviceinfo.NewMethodInfo(subscribeStreamHandler, newStreamArgs, newStreamResult, false),
+		},
+		PayloadCodec: serviceinfo.Protobuf,
+		Extra: map[string]interface{}{
+			"PackageName": "pubsub",
+			"streaming":   true,
+		},
+	}
+}
+
+func subscribeStreamHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	st := arg.(*streaming.Args).Stream
+	req := new(StreamRequest)
+	if err := st.RecvMsg(req); err != nil {
+		return err
+	}
+	return handler.(StreamHandler).SubscribeStream(req, st)
+}
+
+func newStreamArgs() interface{} {
+	return new(StreamRequest)
+}
+
+func newStreamResult() interface{} {
+	return new(StreamMessage)
+}
+
+// StreamService 流式订阅服务
+type StreamService struct {
+	address string
+	queue   *queue.MessageQueue
+}
+
+func NewStreamService(mq *queue.MessageQueue, addr string) *StreamService {
+	return &StreamService{
+		address: addr,
+		queue:   mq,
+	}
+}
+
+func (s *StreamService) Start() error {
+	addr, err := net.ResolveTCPAddr("tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	srv := server.NewServer(
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
+		server.WithServiceAddr(addr),
+	)
+	if err = srv.RegisterService(NewStreamServiceInfo(), s); err != nil {
+		return err
+	}
+
+	klog.Infof("start pubsub stream service at %s", s.address)
+
+	return srv.Run()
+}
+
+func (s *StreamService) Name() string {
+	return consts.PubSubStreamServiceName
+}
+
+// SubscribeStream 订阅并持续推送消息 直到客户端断开连接
+func (s *StreamService) SubscribeStream(req *StreamRequest, stream streaming.Stream) error {
+	if len(req.Patterns) == 0 {
+		return ErrEmptyPatterns
+	}
+
+	sub := s.queue.SubscribeTopic(req.Patterns...)
+	defer s.queue.Evict(sub)
+
+	for {
+		select {
+		case msg, ok := <-sub:
+			if !ok {
+				return nil
+			}
+			err := stream.SendMsg(&StreamMessage{
+				Topic: msg.Topic,
+				Data:  msg.Bytes(),
+			})
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// StreamClient 流式订阅客户端
+type StreamClient struct {
+	client client.Client
+}
+
+func NewStreamClient(addr string, opts ...client.Option) (*StreamClient, error) {
+	options := []client.Option{
+		client.WithDestService(consts.PubSubStreamServiceName),
+		client.WithHostPorts(addr),
+		client.WithTransportProtocol(transport.GRPC),
+	}
+	options = append(options, opts...)
+
+	cli, err := client.NewClient(NewStreamServiceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamClient{client: cli}, nil
+}
+
+// Subscription 流式订阅 取消ctx后结束订阅
+type Subscription struct {
+	stream streaming.Stream
+}
+
+// Subscribe 按主题模式发起流式订阅
+func (c *StreamClient) Subscribe(ctx context.Context, patterns ...string) (*Subscription, error) {
+	res := new(streaming.Result)
+	if err := c.client.(client.Streaming).Stream(ctx, streamMethod, nil, res); err != nil {
+		return nil, err
+	}
+	if err := res.Stream.SendMsg(&StreamRequest{Patterns: patterns}); err != nil {
+		return nil, err
+	}
+	if err := res.Stream.Close(); err != nil {
+		return nil, err
+	}
+	return &Subscription{stream: res.Stream}, nil
+}
+
+// Recv 阻塞直到收到下一条消息
+func (sub *Subscription) Recv() (*StreamMessage, error) {
+	msg := new(StreamMessage)
+	return msg, sub.stream.RecvMsg(msg)
+}
@@ -1,63 +1,155 @@
 package region
 
 import (
+	"github.com/T4t4KAU/TikBase/cluster/cdc"
 	"github.com/T4t4KAU/TikBase/cluster/data"
+	"github.com/T4t4KAU/TikBase/cluster/meta"
+	"github.com/T4t4KAU/TikBase/cluster/pubsub"
 	"github.com/T4t4KAU/TikBase/cluster/replica"
 	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
-	"github.com/T4t4KAU/TikBase/cluster/slice"
+	"github.com/T4t4KAU/TikBase/cluster/router"
+	"github.com/T4t4KAU/TikBase/cluster/web"
+	"github.com/T4t4KAU/TikBase/engine"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/config"
 	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
 	"github.com/cloudwego/kitex/pkg/klog"
+	"net"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
+const regionCountFile = "REGIONS"
+
 // Region 数据分区
 type Region struct {
 	services map[string]iface.IService
 }
 
-func New(replicaConfig *config.ReplicaConfig, serverConfig *config.ServerConfig, eng iface.Engine) (*Region, error) {
+func New(replicaConfig *config.ReplicaConfig, serverConfig *config.ServerConfig, engines []iface.Engine, mq *queue.MessageQueue) (*Region, error) {
 	re := &Region{
 		services: make(map[string]iface.IService),
 	}
 
-	// 创建节点
-	peer, err := raft.NewPeer(raft.Option{
-		RaftDir:       replicaConfig.DirPath,
-		RaftBind:      replicaConfig.RaftAddr,
-		MaxPool:       replicaConfig.WorkerNum,
-		SnapshotCount: replicaConfig.SnapshotCount,
-		Timeout:       time.Duration(replicaConfig.Timeout),
-		Single:        replicaConfig.JoinAddr == "", // 是否单节点
-	}, replicaConfig.Id, eng)
+	if err := replicaConfig.Validate(); err != nil {
+		return &Region{}, err
+	}
+	if err := checkRegionCount(replicaConfig.DirPath, len(engines)); err != nil {
+		return &Region{}, err
+	}
+
+	clients, err := replica.NewClients(replicaConfig.TLS)
 	if err != nil {
 		return &Region{}, err
 	}
 
-	// 创建数据分区
-	sc, err := slice.New(slice.Options{
-		Name:                 serverConfig.Id,
-		Address:              serverConfig.Address,
-		ServerType:           "tcp",
-		VirtualNodeCount:     serverConfig.VirtualNodeCount,
-		UpdateCircleDuration: slice.DefaultOptions.UpdateCircleDuration,
-		Cluster:              []string{serverConfig.JoinAddr},
-	}, eng)
+	// 每个分区创建一个复制组 各分区的raft使用独立的目录和地址
+	// raft消息通过副本服务转发时 各分区共用副本服务地址 按分区编号区分
+	groups := make([]*router.Group, len(engines))
+	for i, eng := range engines {
+		raftAddr, err := replicaConfig.RegionRaftAddr(i)
+		if err != nil {
+			return &Region{}, err
+		}
+		var caller raft.Caller
+		if replicaConfig.KitexTransport() {
+			caller = clients.Caller(i)
+		}
+		peer, err := raft.NewPeer(raft.Option{
+			RaftDir:       config.RegionDir(replicaConfig.DirPath, i),
+			RaftBind:      raftAddr,
+			APIAddr:       apiAddr(replicaConfig.ServiceAddr, serverConfig.Port),
+			ReplicaAddr:   replicaConfig.ServiceAddr,
+			MaxPool:       replicaConfig.WorkerNum,
+			SnapshotCount: replicaConfig.SnapshotCount,
+			Timeout:       time.Duration(replicaConfig.Timeout),
+			Store:         replicaConfig.LogStore,
+			Single:        replicaConfig.JoinAddr == "", // 是否单节点
+			Caller:        caller,
 
+			SnapshotInterval:  time.Duration(replicaConfig.SnapshotInterval) * time.Millisecond,
+			SnapshotThreshold: uint64(replicaConfig.SnapshotThreshold),
+			TrailingLogs:      uint64(replicaConfig.TrailingLogs),
+			HeartbeatTimeout:  time.Duration(replicaConfig.HeartbeatTimeout) * time.Millisecond,
+			ElectionTimeout:   time.Duration(replicaConfig.ElectionTimeout) * time.Millisecond,
+			MaxAppendEntries:  replicaConfig.MaxAppendEntries,
+		}, replicaConfig.Id, eng)
+		if err != nil {
+			return &Region{}, err
+		}
+		groups[i] = &router.Group{ID: i, Peer: peer, Engine: eng}
+	}
+
+	rt, err := router.New(groups)
 	if err != nil {
 		return &Region{}, err
 	}
 
 	/// 注册服务
-	re.registerService(consts.ReplicaServiceName, replica.NewService(peer, replicaConfig.ServiceAddr, replicaConfig))
-	re.registerService(consts.DataServiceName, data.NewService(sc, ":"+strconv.Itoa(serverConfig.Port), peer))
+	rs := replica.NewService(rt, replicaConfig.ServiceAddr, replicaConfig, clients)
+	re.registerService(consts.ReplicaServiceName, rs)
+	ds := data.NewService(rt, ":"+strconv.Itoa(serverConfig.Port))
+	re.registerService(consts.DataServiceName, ds)
+	re.registerService(consts.PubSubServiceName, pubsub.NewService(mq, ":"+strconv.Itoa(serverConfig.PubSubPort)))
+	if serverConfig.PubSubStreamPort > 0 {
+		re.registerService(consts.PubSubStreamServiceName, pubsub.NewStreamService(mq, ":"+strconv.Itoa(serverConfig.PubSubStreamPort)))
+	}
+	// HTTP写请求与数据服务的写请求相同 通过raft提交 跟随者转发给领导者
+	if serverConfig.WebPort > 0 {
+		re.registerService(consts.WebServiceName, web.NewService(":"+strconv.Itoa(serverConfig.WebPort), rt, mq, rt, ds))
+	}
+	if serverConfig.MetaPort > 0 {
+		re.registerService(consts.MetaServiceName, meta.NewService(":"+strconv.Itoa(serverConfig.MetaPort), rt, rs))
+	}
+	// 变更数据捕获只支持bases引擎 且只支持单个分区
+	if be, ok := engines[0].(*engine.BaseEngine); ok && serverConfig.CDCPort > 0 {
+		if len(engines) == 1 {
+			re.registerService(consts.CDCServiceName, cdc.NewService(be.Base, ":"+strconv.Itoa(serverConfig.CDCPort)))
+		} else {
+			klog.Warnf("change data capture is disabled with %d regions", len(engines))
+		}
+	}
 
 	return re, nil
 }
 
+// 分区数量记录在raft目录中 重启时分区数量改变会使key路由到其他分区 拒绝启动
+func checkRegionCount(dir string, n int) error {
+	if dir == "" {
+		return nil
+	}
+	path := filepath.Join(dir, regionCountFile)
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if strings.TrimSpace(string(data)) != strconv.Itoa(n) {
+			return errno.ErrRegionCountMismatch
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strconv.Itoa(n)), 0644)
+}
+
+// 数据服务地址 与副本服务使用相同的主机
+func apiAddr(serviceAddr string, port int) string {
+	host, _, err := net.SplitHostPort(serviceAddr)
+	if err != nil {
+		return ""
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
 func (r *Region) registerService(name string, service iface.IService) {
 	r.services[name] = service
 }
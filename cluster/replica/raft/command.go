@@ -1,10 +1,12 @@
 package raft
 
+import "github.com/T4t4KAU/TikBase/iface"
+
 // ConsistencyLevel 一致性级别
-type ConsistencyLevel int
+type ConsistencyLevel = iface.ConsistencyLevel
 
 const (
-	Default ConsistencyLevel = iota
-	Stale
-	Consistent
+	Default    = iface.ReadDefault
+	Stale      = iface.ReadStale
+	Consistent = iface.ReadConsistent
 )
@@ -1,51 +1,173 @@
 package raft
 
 import (
-	"encoding/json"
+	"encoding/binary"
+	"errors"
+	"github.com/T4t4KAU/TikBase/engine"
 	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/hashicorp/raft"
 	"io"
+	"strconv"
+	"sync/atomic"
 )
 
-// Apply 应用日志项
+const (
+	maxAppliedRequests = 4096 // 记录执行过的请求ID的槽位数量
+
+	requestKeyMark  = "\x00raft:req:"  // 请求ID -> 执行结果
+	requestSlotMark = "\x00raft:slot:" // 槽位 -> 占用该槽位的请求ID
+)
+
+// Apply 应用日志项 返回命令的执行结果 由Peer.Apply交给调用方
 func (fsm *FSM) Apply(entry *raft.Log) any {
-	var c iface.Command
+	defer fsm.applied.set(entry.Index)
 
 	// 反序列化数据 获取执行命令
-	if err := unmarshal(entry.Data, &c); err != nil {
-		klog.Errorf("failed to unmarshal command: %s", err.Error())
+	c, err := iface.DecodeCommand(entry.Data)
+	if err != nil {
+		klog.Errorf("failed to decode command: %s", err.Error())
+		return engine.NewBaseErrResult(err)
 	}
+	return fsm.apply(c, entry.Index)
+}
 
-	// 执行命令
-	return fsm.store.Exec(c.Ins, c.Args()).Error()
+// StoreConfiguration 配置变更日志不修改存储引擎 只记录应用索引
+func (fsm *FSM) StoreConfiguration(index uint64, _ raft.Configuration) {
+	fsm.applied.set(index)
 }
 
-// Snapshot 状态机快照
+// 执行命令 带有请求ID的命令重复提交时返回第一次执行的结果
+func (fsm *FSM) apply(c iface.Command, index uint64) iface.Result {
+	if c.RequestID != "" {
+		if res, ok := fsm.appliedResult(c.RequestID); ok {
+			return res
+		}
+	}
+
+	var res iface.Result
+	if len(c.Batch) > 0 {
+		res = fsm.applyBatch(c.Batch)
+	} else {
+		res = fsm.exec(c)
+	}
+
+	if c.RequestID != "" {
+		fsm.recordResult(index, c.RequestID, res)
+	}
+	return res
+}
+
+// 批量命令按顺序全部执行 返回第一个失败的结果 全部成功时返回最后一个结果
+// 请求ID只对整条日志生效 批量命令中的子命令不单独去重
+func (fsm *FSM) applyBatch(batch []iface.Command) iface.Result {
+	var failed, last iface.Result
+	for _, c := range batch {
+		if len(c.Batch) > 0 {
+			last = fsm.applyBatch(c.Batch)
+		} else {
+			last = fsm.exec(c)
+		}
+		if failed == nil && !last.Success() {
+			failed = last
+		}
+	}
+	if failed != nil {
+		return failed
+	}
+	return last
+}
+
+// 在命令指定的逻辑数据库中执行 执行成功后按日志中的绝对时间设置过期时间
+// 不按应用日志时的时钟换算 应用较晚的副本与其他副本的状态相同
+func (fsm *FSM) exec(c iface.Command) iface.Result {
+	db := engine.Select(fsm.store, c.DB)
+	args := c.Args()
+	res := db.Exec(c.Ins, args)
+	if !res.Success() || c.ExpireAt == 0 || len(args) == 0 {
+		return res
+	}
+
+	if exp := db.Exec(iface.EXPIRE_AT, engine.MakeExpireKeyArgs(utils.B2S(args[0]), c.ExpireAt)); !exp.Success() {
+		return exp
+	}
+	return res
+}
+
+// Snapshot 状态机快照 只记录生成时刻的状态 由Persist流式写入
 func (fsm *FSM) Snapshot() (raft.FSMSnapshot, error) {
-	data, err := fsm.store.Snapshot()
+	snap, err := fsm.store.Snapshot()
 	if err != nil {
 		return nil, err
 	}
 	return &Snapshot{
-		data: data,
+		snap: snap,
 	}, nil
 }
 
-// Restore 从快照恢复数据
+// Restore 从快照恢复数据 替换存储引擎的全部数据
 func (fsm *FSM) Restore(snapshot io.ReadCloser) error {
-	data := make([]byte, 0)
-	_, err := snapshot.Read(data)
-	if err != nil {
+	defer snapshot.Close()
+
+	if err := fsm.store.Restore(snapshot); err != nil {
 		return err
 	}
-	return fsm.store.RecoverFromBytes(data)
+
+	fsm.applied.set(atomic.LoadUint64(&fsm.restoreIndex))
+	return nil
+}
+
+// 执行过的请求记录在存储引擎的内部key中 随快照复制 重启后依然保留
+// 请求按日志索引分配槽位 新的请求淘汰同一槽位上的旧请求
+// 所有节点按相同的顺序应用相同的日志 因此记录的内容一致
+func (fsm *FSM) appliedResult(id string) (iface.Result, bool) {
+	res := fsm.store.Exec(iface.GET_STR, utils.KeyBytes(requestKeyMark+id))
+	if !res.Success() {
+		return nil, false
+	}
+	return decodeResult(res.Data())
+}
+
+func (fsm *FSM) recordResult(index uint64, id string, res iface.Result) {
+	slot := requestSlotMark + strconv.FormatUint(index%maxAppliedRequests, 10)
+	if old := fsm.store.Exec(iface.GET_STR, utils.KeyBytes(slot)); old.Success() {
+		fsm.store.Exec(iface.DEL, utils.KeyBytes(requestKeyMark+old.String()))
+	}
+	fsm.store.Exec(iface.SET_STR, engine.MakeStrSetArgs(slot, []byte(id)))
+	fsm.store.Exec(iface.SET_STR, engine.MakeStrSetArgs(requestKeyMark+id, encodeResult(res)))
 }
 
-func unmarshal(data []byte, v any) error {
-	return json.Unmarshal(data, v)
+// 编码执行结果 是否成功 + 数据长度 + 数据 + 错误信息
+func encodeResult(res iface.Result) []byte {
+	var msg string
+	if err := res.Error(); err != nil {
+		msg = err.Error()
+	}
+	data := res.Data()
+
+	buf := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(data)+len(msg))
+	if res.Success() {
+		buf[0] = 1
+	}
+	n := binary.PutUvarint(buf[1:], uint64(len(data)))
+	buf = append(buf[:1+n], data...)
+	return append(buf, msg...)
 }
 
-func marshal(v any) ([]byte, error) {
-	return json.Marshal(v)
+func decodeResult(buf []byte) (iface.Result, bool) {
+	if len(buf) < 1 {
+		return nil, false
+	}
+	size, n := binary.Uvarint(buf[1:])
+	if n <= 0 || size > uint64(len(buf)-1-n) {
+		return nil, false
+	}
+	data := buf[1+n : 1+n+int(size)]
+	msg := string(buf[1+n+int(size):])
+
+	if buf[0] == 1 {
+		return engine.NewBaseResult(true, data, nil), true
+	}
+	return engine.NewBaseErrResult(errors.New(msg)), true
 }
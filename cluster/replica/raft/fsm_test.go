@@ -0,0 +1,143 @@
+package raft
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFSM_AppliedRequests(t *testing.T) {
+	peers, _ := newTestCluster(t, 1)
+	leader := peers[0]
+
+	for _, v := range []string{"a", "b"} {
+		res, err := leader.Apply(iface.Command{Ins: iface.LEFT_PUSH_LIST, Key: "list", Value: []byte(v)})
+		assert.Nil(t, err)
+		assert.True(t, res.Success())
+	}
+
+	// 相同请求ID的命令只执行一次 重复提交时返回第一次的结果
+	pop := iface.Command{Ins: iface.LEFT_POP_LIST, Key: "list", RequestID: "req-1"}
+	for i := 0; i < 2; i++ {
+		res, err := leader.Apply(pop)
+		assert.Nil(t, err)
+		assert.True(t, res.Success())
+		assert.Equal(t, "b", res.String())
+	}
+
+	// 批量命令在一条日志中提交
+	res, err := leader.Apply(iface.Command{Batch: []iface.Command{
+		metaCommand("node8", "127.0.0.1:10088"),
+		metaCommand("node9", "127.0.0.1:10089"),
+	}})
+	assert.Nil(t, err)
+	assert.True(t, res.Success())
+	addr, err := leader.GetMeta("node9")
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:10089", addr)
+
+	// 请求记录保存在存储引擎中 通过快照恢复到其他节点后依然有效
+	snap, err := leader.Engine().Snapshot()
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	_, err = snap.WriteTo(&buf)
+	assert.Nil(t, err)
+	snap.Release()
+
+	eng, err := engine.NewBaseEngineWith(config.BaseStoreConfig{
+		Directory:    t.TempDir(),
+		DatafileSize: 1 << 20,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, eng.Restore(&buf))
+	peer, err := NewPeer(Option{RaftDir: t.TempDir()}, "node2", eng)
+	assert.Nil(t, err)
+
+	res = (*FSM)(peer).apply(pop, leader.raftNode.LastIndex()+1)
+	assert.True(t, res.Success())
+	assert.Equal(t, "b", res.String())
+	assert.Equal(t, "a", eng.Exec(iface.LEFT_POP_LIST, utils.KeyBytes("list")).String())
+
+	// 请求记录不属于用户的键空间
+	assert.False(t, eng.Exec(iface.GET_STR, utils.KeyBytes("req-1")).Success())
+}
+
+func TestFSM_RecordSlots(t *testing.T) {
+	eng, err := engine.NewBaseEngineWith(config.BaseStoreConfig{
+		Directory:    t.TempDir(),
+		DatafileSize: 1 << 20,
+	})
+	assert.Nil(t, err)
+	peer, err := NewPeer(Option{RaftDir: t.TempDir()}, "node1", eng)
+	assert.Nil(t, err)
+	fsm := (*FSM)(peer)
+
+	set := iface.Command{Ins: iface.SET_STR, Key: "key", Value: []byte("value"), RequestID: "req-1"}
+	assert.True(t, fsm.apply(set, 1).Success())
+	_, ok := fsm.appliedResult("req-1")
+	assert.True(t, ok)
+
+	// 同一槽位上的新请求淘汰旧请求
+	set.RequestID = "req-2"
+	assert.True(t, fsm.apply(set, 1+maxAppliedRequests).Success())
+	_, ok = fsm.appliedResult("req-1")
+	assert.False(t, ok)
+	_, ok = fsm.appliedResult("req-2")
+	assert.True(t, ok)
+}
+
+func TestFSM_ExpireAt(t *testing.T) {
+	eng, err := engine.NewCacheEngine()
+	assert.Nil(t, err)
+	peer, err := NewPeer(Option{RaftDir: t.TempDir()}, "node1", eng)
+	assert.Nil(t, err)
+	fsm := (*FSM)(peer)
+
+	// 过期时间按日志中的绝对时间设置 应用较晚的副本不删除key 只是读取时已经过期
+	now := time.Now().Unix()
+	assert.True(t, fsm.exec(iface.Command{Ins: iface.SET_STR, Key: "key1", Value: []byte("v"), ExpireAt: now + 100}).Success())
+	assert.True(t, fsm.exec(iface.Command{Ins: iface.SET_STR, Key: "key2", Value: []byte("v"), ExpireAt: now - 1}).Success())
+
+	v, err := eng.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, now+100, v.(*values.Value).ExpireAt)
+	assert.False(t, eng.Exec(iface.GET_STR, utils.KeyBytes("key2")).Success())
+}
+
+func TestFSM_HashIndex(t *testing.T) {
+	peers, _ := newTestCluster(t, 2)
+	leader, follower := peers[0], peers[1]
+
+	res, err := leader.Apply(iface.Command{Ins: iface.SET_HASH, Key: "user", Field: "age", Value: []byte("18")})
+	assert.Nil(t, err)
+	assert.True(t, res.Success())
+
+	// 二级索引的定义通过日志复制 各副本分别为已有数据建立索引
+	res, err = leader.Apply(iface.Command{Ins: iface.CREATE_HASH_INDEX, Key: "age", Field: "age"})
+	assert.Nil(t, err)
+	assert.True(t, res.Success())
+
+	for _, peer := range peers {
+		indexer := peer.Engine().(engine.HashIndexer)
+		waitFor(t, func() bool {
+			indexes := indexer.HashIndexes()
+			return len(indexes) == 1 && indexes[0].Ready
+		})
+		keys, err := indexer.RangeHashIndex("age", []byte("10"), []byte("20"))
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("user")}, keys)
+	}
+
+	res, err = leader.Apply(iface.Command{Ins: iface.DROP_HASH_INDEX, Key: "age"})
+	assert.Nil(t, err)
+	assert.True(t, res.Success())
+	waitFor(t, func() bool {
+		return len(follower.Engine().(engine.HashIndexer).HashIndexes()) == 0
+	})
+}
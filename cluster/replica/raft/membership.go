@@ -0,0 +1,242 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/hashicorp/raft"
+)
+
+/// 成员管理 只能在领导者上执行
+/// 移除或降级投票节点前检查剩余的投票节点中可达的节点仍然构成多数派
+/// 节点是否可达由领导者的心跳结果判断 领导者需要先转移领导权才能被移除或降级
+
+// Member 集群成员
+type Member struct {
+	ID       string
+	Address  string
+	Suffrage string // Voter Nonvoter Staging
+	Leader   bool
+}
+
+// 记录心跳失败的节点 领导者变化后重新统计
+func (peer *Peer) observeHeartbeats() {
+	ch := make(chan raft.Observation, 16)
+	peer.raftNode.RegisterObserver(raft.NewObserver(ch, false, func(o *raft.Observation) bool {
+		switch o.Data.(type) {
+		case raft.FailedHeartbeatObservation, raft.ResumedHeartbeatObservation,
+			raft.PeerObservation, raft.LeaderObservation:
+			return true
+		}
+		return false
+	}))
+
+	go func() {
+		for o := range ch {
+			peer.unreachableMutex.Lock()
+			switch data := o.Data.(type) {
+			case raft.FailedHeartbeatObservation:
+				peer.unreachable[data.PeerID] = struct{}{}
+			case raft.ResumedHeartbeatObservation:
+				delete(peer.unreachable, data.PeerID)
+			case raft.PeerObservation:
+				if data.Removed {
+					delete(peer.unreachable, data.Peer.ID)
+				}
+			case raft.LeaderObservation:
+				peer.unreachable = make(map[raft.ServerID]struct{})
+			}
+			peer.unreachableMutex.Unlock()
+		}
+	}()
+}
+
+// Members 返回集群成员
+func (peer *Peer) Members() ([]Member, error) {
+	servers, err := peer.servers()
+	if err != nil {
+		return nil, err
+	}
+
+	leader := peer.LeaderAddr()
+	members := make([]Member, 0, len(servers))
+	for _, s := range servers {
+		members = append(members, Member{
+			ID:       string(s.ID),
+			Address:  string(s.Address),
+			Suffrage: s.Suffrage.String(),
+			Leader:   leader != "" && string(s.Address) == leader,
+		})
+	}
+	return members, nil
+}
+
+// AddNonvoter 添加不参与投票的节点 节点接收日志但不参与选举和提交
+func (peer *Peer) AddNonvoter(nodeId, serviceAddr, raftAddr string) error {
+	servers, err := peer.leaderServers()
+	if err != nil {
+		return err
+	}
+	if s, ok := findServer(servers, nodeId); ok && s.Suffrage == raft.Voter {
+		return errno.ErrServerIsVoter
+	}
+
+	f := peer.raftNode.AddNonvoter(raft.ServerID(nodeId), raft.ServerAddress(raftAddr), 0, raftTimeout)
+	if err = f.Error(); err != nil {
+		return leaderError(err)
+	}
+	if err = peer.SetMeta(nodeId, serviceAddr); err != nil {
+		return err
+	}
+
+	klog.Infof("node %s at %s added as nonvoter", nodeId, raftAddr)
+	return nil
+}
+
+// RemoveServer 将节点移出集群
+func (peer *Peer) RemoveServer(nodeId string) error {
+	servers, err := peer.leaderServers()
+	if err != nil {
+		return err
+	}
+	if err = peer.checkRemoval(servers, nodeId); err != nil {
+		return err
+	}
+
+	f := peer.raftNode.RemoveServer(raft.ServerID(nodeId), 0, raftTimeout)
+	if err = f.Error(); err != nil {
+		return leaderError(err)
+	}
+
+	// 数据服务地址和副本服务地址在一条日志中删除
+	res, err := peer.Apply(iface.Command{Batch: []iface.Command{
+		delMetaCommand(nodeId),
+		delMetaCommand(replicaKeyMark + nodeId),
+	}})
+	if err != nil {
+		return err
+	}
+	if err = res.Error(); err != nil {
+		return err
+	}
+
+	klog.Infof("node %s removed from cluster", nodeId)
+	return nil
+}
+
+// DemoteVoter 取消节点的投票权 节点继续接收日志
+func (peer *Peer) DemoteVoter(nodeId string) error {
+	servers, err := peer.leaderServers()
+	if err != nil {
+		return err
+	}
+	if s, ok := findServer(servers, nodeId); ok && s.Suffrage != raft.Voter {
+		return errno.ErrNotVoter
+	}
+	if err = peer.checkRemoval(servers, nodeId); err != nil {
+		return err
+	}
+
+	f := peer.raftNode.DemoteVoter(raft.ServerID(nodeId), 0, raftTimeout)
+	if err = f.Error(); err != nil {
+		return leaderError(err)
+	}
+
+	klog.Infof("node %s demoted to nonvoter", nodeId)
+	return nil
+}
+
+// TransferLeadership 转移领导权 nodeId为空时由raft选择日志最新的投票节点
+func (peer *Peer) TransferLeadership(nodeId string) error {
+	servers, err := peer.leaderServers()
+	if err != nil {
+		return err
+	}
+
+	var f raft.Future
+	if nodeId == "" {
+		f = peer.raftNode.LeadershipTransfer()
+	} else {
+		s, ok := findServer(servers, nodeId)
+		if !ok {
+			return errno.ErrServerNotFound
+		}
+		if s.Suffrage != raft.Voter {
+			return errno.ErrNotVoter
+		}
+		if s.ID == raft.ServerID(peer.id) {
+			return nil
+		}
+		f = peer.raftNode.LeadershipTransferToServer(s.ID, s.Address)
+	}
+
+	if err = f.Error(); err != nil {
+		return leaderError(err)
+	}
+	return nil
+}
+
+// 检查移除节点或取消投票权后集群仍然可用
+func (peer *Peer) checkRemoval(servers []raft.Server, nodeId string) error {
+	s, ok := findServer(servers, nodeId)
+	if !ok {
+		return errno.ErrServerNotFound
+	}
+	if s.ID == raft.ServerID(peer.id) {
+		return errno.ErrLeaderChange
+	}
+	if s.Suffrage != raft.Voter {
+		return nil
+	}
+
+	peer.unreachableMutex.Lock()
+	defer peer.unreachableMutex.Unlock()
+	if !hasQuorum(servers, s.ID, peer.unreachable) {
+		return errno.ErrQuorumLost
+	}
+	return nil
+}
+
+// 判断去掉节点removed后 剩余的投票节点中可达的节点是否构成多数派
+func hasQuorum(servers []raft.Server, removed raft.ServerID, unreachable map[raft.ServerID]struct{}) bool {
+	var voters, reachable int
+	for _, s := range servers {
+		if s.Suffrage != raft.Voter || s.ID == removed {
+			continue
+		}
+		voters++
+		if _, ok := unreachable[s.ID]; !ok {
+			reachable++
+		}
+	}
+	return voters > 0 && reachable > voters/2
+}
+
+// 返回集群配置 当前节点不是领导者时返回ErrNotLeader
+func (peer *Peer) leaderServers() ([]raft.Server, error) {
+	if !peer.IsLeader() {
+		return nil, errno.ErrNotLeader
+	}
+	return peer.servers()
+}
+
+func (peer *Peer) servers() ([]raft.Server, error) {
+	if peer.raftNode == nil {
+		return nil, errno.ErrNoLeader
+	}
+
+	f := peer.raftNode.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return nil, err
+	}
+	return f.Configuration().Servers, nil
+}
+
+func findServer(servers []raft.Server, nodeId string) (raft.Server, bool) {
+	for _, s := range servers {
+		if s.ID == raft.ServerID(nodeId) {
+			return s, true
+		}
+	}
+	return raft.Server{}, false
+}
@@ -0,0 +1,137 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// 启动n个投票节点 第一个节点为领导者
+func newTestCluster(t *testing.T, n int) ([]*Peer, []*raft.InmemTransport) {
+	peers := make([]*Peer, n)
+	transports := make([]*raft.InmemTransport, n)
+	for i := range peers {
+		peers[i], transports[i] = newTestPeer(t, "node"+strconv.Itoa(i+1))
+	}
+	for i := range transports {
+		for j := range transports {
+			if i != j {
+				transports[i].Connect(transports[j].LocalAddr(), transports[j])
+			}
+		}
+	}
+
+	leader := peers[0]
+	err := leader.raftNode.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: "node1", Address: transports[0].LocalAddr()}},
+	}).Error()
+	assert.Nil(t, err)
+	waitFor(t, leader.IsLeader)
+
+	for i := 1; i < n; i++ {
+		err = leader.raftNode.AddVoter(raft.ServerID(peers[i].id), transports[i].LocalAddr(), 0, 0).Error()
+		assert.Nil(t, err)
+	}
+	return peers, transports
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPeer_Membership(t *testing.T) {
+	peers, transports := newTestCluster(t, 3)
+	leader := peers[0]
+
+	// 只能在领导者上执行
+	assert.Equal(t, errno.ErrNotLeader, peers[1].RemoveServer("node3"))
+	assert.Equal(t, errno.ErrLeaderChange, leader.RemoveServer("node1"))
+	assert.Equal(t, errno.ErrServerNotFound, leader.RemoveServer("node9"))
+
+	learner, learnerTrans := newTestPeer(t, "node4")
+	transports[0].Connect(learnerTrans.LocalAddr(), learnerTrans)
+	learnerTrans.Connect(transports[0].LocalAddr(), transports[0])
+	assert.Equal(t, errno.ErrServerIsVoter, leader.AddNonvoter("node2", "", string(transports[1].LocalAddr())))
+	assert.Nil(t, leader.AddNonvoter("node4", "127.0.0.1:10084", string(learnerTrans.LocalAddr())))
+	assert.Nil(t, learner.WaitForAppliedIndex(leader.raftNode.LastIndex(), 5*time.Second))
+
+	// 节点地址保存在内部key中 不占用用户的键空间
+	addr, err := learner.GetMeta("node4")
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:10084", addr)
+	assert.False(t, learner.Engine().Exec(iface.GET_STR, utils.KeyBytes("node4")).Success())
+
+	assert.Equal(t, errno.ErrNotVoter, leader.DemoteVoter("node4"))
+	assert.Equal(t, errno.ErrNotVoter, leader.TransferLeadership("node4"))
+
+	assert.Nil(t, leader.DemoteVoter("node3"))
+	members, err := leader.Members()
+	assert.Nil(t, err)
+	suffrage := make(map[string]string)
+	for _, m := range members {
+		suffrage[m.ID] = m.Suffrage
+		assert.Equal(t, m.ID == "node1", m.Leader)
+	}
+	assert.Equal(t, map[string]string{"node1": "Voter", "node2": "Voter", "node3": "Nonvoter", "node4": "Nonvoter"}, suffrage)
+
+	// 移除节点时同时删除数据服务地址和副本服务地址
+	assert.Nil(t, leader.SetMeta(replicaKeyMark+"node4", "127.0.0.1:10094"))
+	assert.Nil(t, leader.RemoveServer("node4"))
+	_, err = leader.GetMeta("node4")
+	assert.NotNil(t, err)
+	_, err = leader.GetMeta(replicaKeyMark + "node4")
+	assert.NotNil(t, err)
+	assert.Nil(t, leader.RemoveServer("node3"))
+	members, err = leader.Members()
+	assert.Nil(t, err)
+	assert.Len(t, members, 2)
+
+	// 转移领导权后原领导者可以被移除
+	assert.Nil(t, leader.TransferLeadership("node2"))
+	waitFor(t, peers[1].IsLeader)
+	assert.Equal(t, errno.ErrNotLeader, leader.TransferLeadership(""))
+	assert.Nil(t, peers[1].RemoveServer("node1"))
+}
+
+func TestPeer_RemoveServerQuorum(t *testing.T) {
+	peers, transports := newTestCluster(t, 3)
+	leader := peers[0]
+
+	// 节点3不可达 移除节点2后剩余的投票节点无法构成多数派
+	transports[0].Disconnect(transports[2].LocalAddr())
+	waitFor(t, func() bool {
+		leader.unreachableMutex.Lock()
+		defer leader.unreachableMutex.Unlock()
+		_, ok := leader.unreachable["node3"]
+		return ok
+	})
+	assert.Equal(t, errno.ErrQuorumLost, leader.RemoveServer("node2"))
+	assert.Equal(t, errno.ErrQuorumLost, leader.DemoteVoter("node2"))
+	assert.Nil(t, leader.RemoveServer("node3"))
+}
+
+func TestHasQuorum(t *testing.T) {
+	servers := []raft.Server{
+		{ID: "a", Suffrage: raft.Voter},
+		{ID: "b", Suffrage: raft.Voter},
+		{ID: "c", Suffrage: raft.Voter},
+		{ID: "d", Suffrage: raft.Nonvoter},
+	}
+	none := map[raft.ServerID]struct{}{}
+	assert.True(t, hasQuorum(servers, "c", none))
+	assert.True(t, hasQuorum(servers, "d", map[raft.ServerID]struct{}{"c": {}}))
+	assert.False(t, hasQuorum(servers, "b", map[raft.ServerID]struct{}{"c": {}}))
+	assert.True(t, hasQuorum(servers, "c", map[raft.ServerID]struct{}{"c": {}}))
+	assert.False(t, hasQuorum(servers[:1], "a", none))
+}
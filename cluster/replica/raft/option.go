@@ -5,11 +5,22 @@ import "time"
 type Option struct {
 	RaftDir       string
 	RaftBind      string
+	APIAddr       string // 数据服务地址 加入集群时登记 用于转发写请求
+	ReplicaAddr   string // 副本服务地址 加入集群时登记 用于向领导者查询复制进度
 	MaxPool       int
-	SnapshotCount int
+	SnapshotCount int // 保留的快照数量
 	Timeout       time.Duration
-	Store         string
+	Store         string // 日志存储 BOLT 或 BASES 为空时使用BOLT
 	Single        bool
+	Caller        Caller // 不为空时raft消息通过副本服务转发 RaftBind为副本服务地址 否则使用独立端口的TCP传输层
+
+	// 以下参数为0时使用raft的默认值
+	SnapshotInterval  time.Duration // 检查是否需要生成快照的间隔
+	SnapshotThreshold uint64        // 距上次快照的日志数量达到该值时生成快照并压缩日志
+	TrailingLogs      uint64        // 压缩日志后保留的日志数量 供落后的跟随者追赶
+	HeartbeatTimeout  time.Duration // 跟随者超过该时间未收到领导者消息时发起选举
+	ElectionTimeout   time.Duration // 候选者超过该时间未赢得选举时重新选举
+	MaxAppendEntries  int           // 单次追加日志请求中的最大日志数量
 }
 
 var DefaultOption = Option{
@@ -12,6 +12,8 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,10 +21,15 @@ const (
 	retainSnapshotCount = 2
 	raftTimeout         = 10 * time.Second
 	leaderWaitDelay     = 100 * time.Millisecond
-	appliedWaitDelay    = 100 * time.Millisecond
 	openTimeout         = 60 * time.Second
 )
 
+// 节点元数据的key前缀 以0x00开头的key由存储引擎内部使用 不会与用户写入的key冲突
+const (
+	metaKeyMark    = "\x00meta:raft:"
+	replicaKeyMark = "replica:" // 节点ID -> 副本服务地址
+)
+
 // Peer Raft_节点
 type Peer struct {
 	id        string // 节点ID
@@ -30,32 +37,86 @@ type Peer struct {
 	store     iface.Engine // 存储引擎
 	dirPath   string       // 日志存储路径
 	address   string       // 通信地址
-	snapCount int          // 快照数目
+	apiAddr   string       // 数据服务地址 跟随者将写请求转发到领导者的此地址
+	replAddr  string       // 副本服务地址 其他节点向领导者的此地址查询复制进度
+	snapCount int          // 保留的快照数目
+	config    *raft.Config // 启动时使用的raft配置
 	maxPool   int
-	single    bool // 单节点
+	single    bool          // 单节点
+	logStore  string        // 日志存储 BOLT 或 BASES
+	transport *RPCTransport // 通过副本服务转发raft消息时的传输层
+
+	leaseReady   int32            // 领导者租约读是否已在当前任期确认
+	progress     *progressTracker // 领导者记录的跟随者复制进度
+	applied      *appliedIndex    // 状态机已应用的日志索引
+	restoreIndex uint64           // 最近一次打开的快照的索引 恢复快照后作为状态机的应用索引
+	logs         raft.LogStore    // raft日志存储 判断未应用的日志中是否有命令
+
+	unreachableMutex sync.Mutex
+	unreachable      map[raft.ServerID]struct{} // 领导者心跳失败的节点
 }
 
 type FSM Peer
 
-// NewPeer 创建节点
+// NewPeer 创建节点 raft参数不合法时返回错误
 func NewPeer(option Option, id string, eng iface.Engine) (*Peer, error) {
-	return &Peer{
+	config, err := newConfig(option, id)
+	if err != nil {
+		return nil, err
+	}
+	if option.SnapshotCount == 0 {
+		option.SnapshotCount = retainSnapshotCount
+	}
+
+	peer := &Peer{
 		id:        id,
 		store:     eng,
 		address:   option.RaftBind,
+		apiAddr:   option.APIAddr,
+		replAddr:  option.ReplicaAddr,
 		dirPath:   option.RaftDir,
 		snapCount: option.SnapshotCount,
+		config:    config,
 		maxPool:   option.MaxPool,
 		single:    option.Single,
-	}, nil
+		logStore:  option.Store,
+		progress:  newProgressTracker(),
+		applied:   newAppliedIndex(),
+
+		unreachable: make(map[raft.ServerID]struct{}),
+	}
+	// 副本服务启动后即可接收raft消息 传输层在创建节点时创建
+	if option.Caller != nil {
+		peer.transport = NewRPCTransport(option.RaftBind, option.Caller)
+	}
+	return peer, nil
 }
 
-func (peer *Peer) Apply(c iface.Command) error {
-	b, err := marshal(c)
+// Apply 提交命令 日志提交并应用到状态机后返回执行结果
+// 只能在领导者上调用 否则返回ErrNotLeader
+func (peer *Peer) Apply(c iface.Command) (iface.Result, error) {
+	if !peer.IsLeader() {
+		return nil, errno.ErrNotLeader
+	}
+
+	b, err := c.Encode()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	f := peer.raftNode.Apply(b, raftTimeout)
+	if err = f.Error(); err != nil {
+		if err == raft.ErrNotLeader {
+			return nil, errno.ErrNotLeader
+		}
+		return nil, err
+	}
+
+	res, ok := f.Response().(iface.Result)
+	if !ok {
+		return nil, fmt.Errorf("unexpected apply response: %v", f.Response())
 	}
-	return peer.raftNode.Apply(b, raftTimeout).Error()
+	return res, nil
 }
 
 // Engine 返回存储引擎
@@ -68,53 +129,49 @@ func (peer *Peer) ID() string {
 	return peer.id
 }
 
+// State 返回节点状态 节点未启动时为Shutdown
 func (peer *Peer) State() raft.RaftState {
+	if peer.raftNode == nil {
+		return raft.Shutdown
+	}
 	return peer.raftNode.State()
 }
 
-// Bootstrap 节点启动
-func (peer *Peer) Bootstrap() error {
-	localId := peer.id
+// IsLeader 判断当前节点是否为领导者
+func (peer *Peer) IsLeader() bool {
+	return peer.raftNode != nil && peer.raftNode.State() == raft.Leader
+}
 
-	config := raft.DefaultConfig()                     // 使用默认配置
-	config.LocalID = raft.ServerID(localId)            // 本地节点ID
-	raftPath := filepath.Join(peer.dirPath, "raft.db") // raft日志存储路径
-	config.LogOutput = os.Stdout
+// APIAddr 返回当前节点的数据服务地址
+func (peer *Peer) APIAddr() string {
+	return peer.apiAddr
+}
 
-	newNode := !utils.PathExists(raftPath) // 检查路径是否存在 如果不存在则说明是新节点
-	addr, err := net.ResolveTCPAddr("tcp", peer.address)
-	if err != nil {
-		return err
-	}
+// Bootstrap 节点启动
+func (peer *Peer) Bootstrap() error {
+	config := peer.config
 
-	transport, err := raft.NewTCPTransport(peer.address, addr, 3, 10*time.Second, os.Stderr)
+	transport, err := peer.newTransport()
 	if err != nil {
 		return err
 	}
 
 	// 创建文件快照
-	snapshots, err := raft.NewFileSnapshotStore(peer.dirPath, retainSnapshotCount, os.Stderr)
+	snapshots, err := raft.NewFileSnapshotStore(peer.dirPath, peer.snapCount, os.Stderr)
 	if err != nil {
 		return fmt.Errorf("file snapshot store: %s", err)
 	}
 
-	var logStore raft.LogStore // 日志存储
-	var stableStore raft.StableStore
-
-	boltDB, err := raftboltdb.NewBoltStore(raftPath) // 初始化数据访问接口
+	logStore, stableStore, newNode, err := peer.openLogStore()
 	if err != nil {
 		return fmt.Errorf("new raft: %s", err)
 	}
 
-	logStore = boltDB
-	stableStore = boltDB
-
 	// 创建状态机
-	ra, err := raft.NewRaft(config, (*FSM)(peer), logStore, stableStore, snapshots, transport)
-	if err != nil {
+	if err = peer.newRaft(config, logStore, stableStore, snapshots, transport); err != nil {
 		return fmt.Errorf("new raft: %s", err)
 	}
-	peer.raftNode = ra
+	ra := peer.raftNode
 
 	// 单节点启动
 	if peer.single && newNode {
@@ -137,6 +194,71 @@ func (peer *Peer) Bootstrap() error {
 	return nil
 }
 
+// Shutdown 停止raft节点 节点未启动时直接返回
+func (peer *Peer) Shutdown() error {
+	if peer.raftNode == nil {
+		return nil
+	}
+	return peer.raftNode.Shutdown().Error()
+}
+
+// 创建raft节点 包装传输层和快照存储以记录复制进度和恢复的快照索引
+func (peer *Peer) newRaft(config *raft.Config, logs raft.LogStore, stable raft.StableStore,
+	snapshots raft.SnapshotStore, trans raft.Transport) error {
+	peer.config = config
+	peer.logs = logs
+
+	ra, err := raft.NewRaft(config, (*FSM)(peer), logs, stable, peer.trackSnapshots(snapshots), peer.track(trans))
+	if err != nil {
+		return err
+	}
+	peer.raftNode = ra
+	peer.observeLeader()
+	peer.observeHeartbeats()
+	return nil
+}
+
+// 创建传输层 未通过副本服务转发时监听独立的raft端口
+func (peer *Peer) newTransport() (raft.Transport, error) {
+	if peer.transport != nil {
+		return peer.transport, nil
+	}
+
+	addr, err := net.ResolveTCPAddr("tcp", peer.address)
+	if err != nil {
+		return nil, err
+	}
+	return raft.NewTCPTransport(peer.address, addr, 3, 10*time.Second, os.Stderr)
+}
+
+// Transport 返回通过副本服务转发raft消息的传输层 使用TCP传输层时为空
+func (peer *Peer) Transport() *RPCTransport {
+	return peer.transport
+}
+
+// 打开日志存储和稳定存储 存储路径不存在时说明是新节点
+func (peer *Peer) openLogStore() (raft.LogStore, raft.StableStore, bool, error) {
+	switch strings.ToUpper(peer.logStore) {
+	case "", "BOLT":
+		path := filepath.Join(peer.dirPath, "raft.db")
+		newNode := !utils.PathExists(path)
+		boltDB, err := raftboltdb.NewBoltStore(path)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return boltDB, boltDB, newNode, nil
+	case "BASES":
+		path := filepath.Join(peer.dirPath, "log")
+		newNode := !utils.PathExists(path)
+		store, err := NewBaseStore(path)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return store, store, newNode, nil
+	}
+	return nil, nil, false, errno.ErrUnknownLogStore
+}
+
 func (peer *Peer) ReplicaList() string {
 	servers := peer.raftNode.GetConfiguration().Configuration().Servers
 	data, err := json.Marshal(servers)
@@ -148,6 +270,9 @@ func (peer *Peer) ReplicaList() string {
 
 // LeaderAddr 返回主节点地址
 func (peer *Peer) LeaderAddr() string {
+	if peer.raftNode == nil {
+		return ""
+	}
 	return string(peer.raftNode.Leader())
 }
 
@@ -192,21 +317,20 @@ func (peer *Peer) WaitForLeader(timeout time.Duration) (string, error) {
 	}
 }
 
-// WaitForAppliedIndex 阻塞直到一个日志项被应用
+// WaitForAppliedIndex 阻塞直到一个日志项被应用 状态机应用日志后唤醒等待者
 func (peer *Peer) WaitForAppliedIndex(index uint64, timeout time.Duration) error {
-	// 创建定时器
-	ticker := time.NewTicker(appliedWaitDelay)
-	defer ticker.Stop()
 	timer := time.NewTimer(timeout)
 	defer timer.Stop()
 
 	for {
+		// 先获取通知通道再检查 避免错过检查之后的应用
+		wait := peer.applied.notify()
+		if peer.isApplied(index) {
+			return nil
+		}
+
 		select {
-		case <-ticker.C:
-			// 日志更新结束
-			if peer.raftNode.AppliedIndex() >= index {
-				return nil
-			}
+		case <-wait:
 		case <-timer.C:
 			return fmt.Errorf("timeout expired")
 		}
@@ -236,57 +360,31 @@ func (peer *Peer) consistentRead() error {
 }
 
 func (peer *Peer) Set(key string, val []byte) error {
-	if peer.raftNode.State() != raft.Leader {
-		return raft.ErrNotLeader
-	}
-
-	// 创建SET命令
-	c := &iface.Command{
+	res, err := peer.Apply(iface.Command{
 		Ins:   iface.SET_STR,
 		Key:   key,
 		Value: val,
-	}
-
-	b, err := json.Marshal(c)
+	})
 	if err != nil {
 		return err
 	}
-
-	f := peer.raftNode.Apply(b, raftTimeout)
-	return f.Error()
+	return res.Error()
 }
 
 func (peer *Peer) Del(key string) error {
-	if peer.raftNode.State() != raft.Leader {
-		return raft.ErrNotLeader
-	}
-
-	// 创建DEL命令
-	c := &iface.Command{
+	res, err := peer.Apply(iface.Command{
 		Ins: iface.DEL,
 		Key: key,
-	}
-
-	b, err := c.Encode()
+	})
 	if err != nil {
 		return err
 	}
-
-	f := peer.raftNode.Apply(b, raftTimeout)
-	return f.Error()
+	return res.Error()
 }
 
 func (peer *Peer) Get(key string, level ConsistencyLevel) (string, error) {
-	if level != Stale {
-		if peer.raftNode.State() != raft.Leader {
-			return "", raft.ErrNotLeader
-		}
-	}
-
-	if level == Consistent {
-		if err := peer.consistentRead(); err != nil {
-			return "", err
-		}
+	if err := peer.ReadBarrier(level, 0); err != nil {
+		return "", err
 	}
 
 	keyBytes := utils.KeyBytes(key)
@@ -294,8 +392,8 @@ func (peer *Peer) Get(key string, level ConsistencyLevel) (string, error) {
 	return res.String(), res.Error()
 }
 
-// Join 节点加入集群
-func (peer *Peer) Join(nodeId, serviceAddr, raftAddr string) error {
+// Join 节点加入集群 serviceAddr为数据服务地址 replicaAddr为副本服务地址 为空时不登记
+func (peer *Peer) Join(nodeId, serviceAddr, raftAddr, replicaAddr string) error {
 	klog.Infof("received join request for remote node %s at %s", nodeId, raftAddr)
 
 	config := peer.raftNode.GetConfiguration()
@@ -327,8 +425,22 @@ func (peer *Peer) Join(nodeId, serviceAddr, raftAddr string) error {
 		return f.Error()
 	}
 
-	// 存储元数据
-	if err := peer.SetMeta(nodeId, serviceAddr); err != nil {
+	// 存储元数据 同时写入领导者自身的地址 供跟随者转发写请求和查询复制进度 全部元数据在一条日志中提交
+	batch := []iface.Command{metaCommand(nodeId, serviceAddr)}
+	if replicaAddr != "" {
+		batch = append(batch, metaCommand(replicaKeyMark+nodeId, replicaAddr))
+	}
+	if peer.apiAddr != "" {
+		batch = append(batch, metaCommand(peer.id, peer.apiAddr))
+	}
+	if peer.replAddr != "" {
+		batch = append(batch, metaCommand(replicaKeyMark+peer.id, peer.replAddr))
+	}
+	res, err := peer.Apply(iface.Command{Batch: batch})
+	if err != nil {
+		return err
+	}
+	if err = res.Error(); err != nil {
 		return err
 	}
 
@@ -336,21 +448,53 @@ func (peer *Peer) Join(nodeId, serviceAddr, raftAddr string) error {
 	return nil
 }
 
+func metaKey(key string) string {
+	return metaKeyMark + key
+}
+
+func metaCommand(key, value string) iface.Command {
+	return iface.Command{
+		Ins:   iface.SET_STR,
+		Key:   metaKey(key),
+		Value: utils.S2B(value),
+	}
+}
+
+func delMetaCommand(key string) iface.Command {
+	return iface.Command{
+		Ins: iface.DEL,
+		Key: metaKey(key),
+	}
+}
+
 // SetMeta 设置元数据
 func (peer *Peer) SetMeta(key, value string) error {
-	return peer.Set(key, utils.S2B(value))
+	res, err := peer.Apply(metaCommand(key, value))
+	if err != nil {
+		return err
+	}
+	return res.Error()
 }
 
 // DelMeta 删除元数据
 func (peer *Peer) DelMeta(key string) error {
-	return peer.Del(key)
+	res, err := peer.Apply(delMetaCommand(key))
+	if err != nil {
+		return err
+	}
+	return res.Error()
 }
 
 func (peer *Peer) GetMeta(key string) (string, error) {
-	return peer.Get(key, Stale)
+	return peer.Get(metaKey(key), Stale)
 }
 
+// LeaderAPIAddr 返回领导者的数据服务地址 当前节点是领导者时返回自身地址
 func (peer *Peer) LeaderAPIAddr() string {
+	if peer.IsLeader() && peer.apiAddr != "" {
+		return peer.apiAddr
+	}
+
 	id, err := peer.LeaderID()
 	if err != nil {
 		return ""
@@ -363,3 +507,22 @@ func (peer *Peer) LeaderAPIAddr() string {
 
 	return addr
 }
+
+// LeaderReplicaAddr 返回领导者的副本服务地址 当前节点是领导者时返回自身地址
+func (peer *Peer) LeaderReplicaAddr() string {
+	if peer.IsLeader() && peer.replAddr != "" {
+		return peer.replAddr
+	}
+
+	id, err := peer.LeaderID()
+	if err != nil || id == "" {
+		return ""
+	}
+
+	addr, err := peer.GetMeta(replicaKeyMark + id)
+	if err != nil {
+		return ""
+	}
+
+	return addr
+}
@@ -0,0 +1,208 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/// 读屏障 按一致性级别判断本地状态机能否提供读取
+/// 租约读: 领导者在租约内不会被替换 任期内第一次读取前执行一次屏障 之后等待已提交的日志应用即可读取
+///        多数投票节点在LeaderLeaseTimeout内与领导者通信过时租约有效 租约过期时退化为ReadIndex
+/// ReadIndex: 记录当前提交索引 通过一轮心跳确认领导者身份 等待该索引应用后读取
+/// 跟随者读: 与领导者最后一次联系的时间不超过给定值 等待已知的提交索引应用后读取
+
+// 观察领导者变化 领导者变化后租约需要重新确认 复制进度重新统计
+func (peer *Peer) observeLeader() {
+	ch := make(chan raft.Observation, 16)
+	peer.raftNode.RegisterObserver(raft.NewObserver(ch, false, func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.LeaderObservation)
+		return ok
+	}))
+
+	go func() {
+		for range ch {
+			atomic.StoreInt32(&peer.leaseReady, 0)
+			peer.progress.reset()
+		}
+	}()
+}
+
+// ReadBarrier 等待本地状态机满足一致性级别的要求
+// 领导者读取时当前节点不是领导者返回ErrNotLeader
+func (peer *Peer) ReadBarrier(level ConsistencyLevel, maxStaleness time.Duration) error {
+	if peer.raftNode == nil {
+		return errno.ErrNoLeader
+	}
+
+	switch level {
+	case Default:
+		return peer.leaseRead()
+	case Consistent:
+		return peer.readIndex()
+	case Stale:
+		return peer.staleRead(maxStaleness)
+	}
+	return errno.ErrInvalidConsistency
+}
+
+func (peer *Peer) leaseRead() error {
+	if !peer.IsLeader() {
+		return errno.ErrNotLeader
+	}
+	// 租约过期时领导者可能已被替换 需要通过心跳确认身份
+	if !peer.leaseValid() {
+		return peer.readIndex()
+	}
+
+	// 新的领导者可能还没有应用前任提交的日志
+	if atomic.LoadInt32(&peer.leaseReady) == 0 {
+		if err := peer.raftNode.Barrier(raftTimeout).Error(); err != nil {
+			return leaderError(err)
+		}
+		atomic.StoreInt32(&peer.leaseReady, 1)
+	}
+	return peer.WaitForAppliedIndex(peer.raftNode.CommitIndex(), raftTimeout)
+}
+
+// 领导者租约是否有效 包括领导者自身在内的多数投票节点在LeaderLeaseTimeout内成功通信过
+func (peer *Peer) leaseValid() bool {
+	servers, err := peer.servers()
+	if err != nil {
+		return false
+	}
+
+	now := time.Now()
+	var voters, contacted int
+	for _, s := range servers {
+		if s.Suffrage != raft.Voter {
+			continue
+		}
+		voters++
+		if s.ID == raft.ServerID(peer.id) {
+			contacted++
+			continue
+		}
+		if r, ok := peer.progress.get(s.ID); ok && now.Sub(r.contact) < peer.config.LeaderLeaseTimeout {
+			contacted++
+		}
+	}
+	return voters > 0 && contacted > voters/2
+}
+
+func (peer *Peer) readIndex() error {
+	if !peer.IsLeader() {
+		return errno.ErrNotLeader
+	}
+
+	index := peer.raftNode.CommitIndex()
+	if err := peer.consistentRead(); err != nil {
+		return leaderError(err)
+	}
+	return peer.WaitForAppliedIndex(index, raftTimeout)
+}
+
+func (peer *Peer) staleRead(maxStaleness time.Duration) error {
+	if !peer.IsLeader() && maxStaleness > 0 {
+		last := peer.raftNode.LastContact()
+		if last.IsZero() || time.Since(last) > maxStaleness {
+			return errno.ErrStaleRead
+		}
+	}
+
+	// 等待日志应用的时间不超过允许落后的时间
+	timeout := raftTimeout
+	if maxStaleness > 0 {
+		timeout = maxStaleness
+	}
+	if err := peer.WaitForAppliedIndex(peer.raftNode.CommitIndex(), timeout); err != nil {
+		return errno.ErrStaleRead
+	}
+	return nil
+}
+
+func leaderError(err error) error {
+	if err == raft.ErrNotLeader || err == raft.ErrLeadershipLost {
+		return errno.ErrNotLeader
+	}
+	return err
+}
+
+// 状态机已应用的日志索引 应用新的日志后唤醒等待者
+type appliedIndex struct {
+	mutex   sync.Mutex
+	index   uint64
+	changed chan struct{}
+}
+
+func newAppliedIndex() *appliedIndex {
+	return &appliedIndex{changed: make(chan struct{})}
+}
+
+func (a *appliedIndex) get() uint64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.index
+}
+
+// 返回在下一次应用日志时关闭的通道
+func (a *appliedIndex) notify() <-chan struct{} {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.changed
+}
+
+func (a *appliedIndex) set(index uint64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if index <= a.index {
+		return
+	}
+	a.index = index
+	close(a.changed)
+	a.changed = make(chan struct{})
+}
+
+// 日志是否已经应用到状态机
+// 空操作 屏障和配置变更日志不经过状态机 状态机的应用索引之后直到index都不是命令时同样视为已应用
+func (peer *Peer) isApplied(index uint64) bool {
+	applied := peer.applied.get()
+	if applied >= index {
+		return true
+	}
+	if peer.logs == nil {
+		return false
+	}
+
+	// 还没有收到的日志
+	last, err := peer.logs.LastIndex()
+	if err != nil || last < index {
+		return false
+	}
+	// 已被压缩的日志包含在快照中 状态机已经应用
+	first, err := peer.logs.FirstIndex()
+	if err != nil {
+		return false
+	}
+	if applied+1 < first {
+		applied = first - 1
+	}
+
+	var entry raft.Log
+	for i := applied + 1; i <= index; i++ {
+		if err = peer.logs.GetLog(i, &entry); err != nil {
+			if err == raft.ErrLogNotFound {
+				continue
+			}
+			return false
+		}
+		if entry.Type == raft.LogCommand {
+			return false
+		}
+	}
+	peer.applied.set(index)
+	return true
+}
@@ -0,0 +1,36 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeer_ReadBarrier(t *testing.T) {
+	peers, transports := newTestCluster(t, 3)
+	leader := peers[0]
+
+	// 领导者当选后的空操作和配置变更日志不经过状态机 同样视为已应用
+	assert.Nil(t, leader.WaitForAppliedIndex(leader.raftNode.CommitIndex(), time.Second))
+
+	assert.Nil(t, leader.Set("key", []byte("value")))
+	waitFor(t, leader.leaseValid)
+	assert.Nil(t, leader.ReadBarrier(Default, 0))
+	assert.Nil(t, leader.ReadBarrier(Consistent, 0))
+	assert.Equal(t, errno.ErrNotLeader, peers[1].ReadBarrier(Default, 0))
+
+	// 跟随者等待应用日志的通知后读取
+	assert.Nil(t, peers[1].WaitForAppliedIndex(leader.raftNode.LastIndex(), 5*time.Second))
+	assert.Nil(t, peers[1].ReadBarrier(Stale, time.Second))
+	val, err := peers[1].Get("key", Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, "value", val)
+
+	// 与多数派失联后租约过期 不能再提供租约读
+	transports[0].DisconnectAll()
+	waitFor(t, func() bool {
+		return !leader.leaseValid()
+	})
+	assert.NotNil(t, leader.ReadBarrier(Default, 0))
+}
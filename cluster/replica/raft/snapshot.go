@@ -1,34 +1,46 @@
 package raft
 
 import (
+	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/hashicorp/raft"
+	"io"
+	"sync/atomic"
 )
 
+// Snapshot 状态机快照 将存储引擎快照流式写入
 type Snapshot struct {
-	data []byte
+	snap iface.Snapshot
 }
 
 func (s *Snapshot) Persist(sink raft.SnapshotSink) error {
-	err := func() error {
-		b, e := marshal(s.data)
-		if e != nil {
-			return e
-		}
+	if _, err := s.snap.WriteTo(sink); err != nil {
+		_ = sink.Cancel()
+		return err
+	}
+	return sink.Close()
+}
 
-		if _, e = sink.Write(b); e != nil {
-			return e
-		}
+func (s *Snapshot) Release() {
+	s.snap.Release()
+}
 
-		return sink.Close()
-	}()
+// 记录打开的快照索引的快照存储 raft先打开快照再交给状态机恢复
+// 状态机恢复完成后以该索引作为应用索引
+type trackingSnapshotStore struct {
+	raft.SnapshotStore
+	peer *Peer
+}
 
+func (s *trackingSnapshotStore) Open(id string) (*raft.SnapshotMeta, io.ReadCloser, error) {
+	meta, rc, err := s.SnapshotStore.Open(id)
 	if err != nil {
-		_ = sink.Cancel()
-		return err
+		return nil, nil, err
 	}
-
-	return nil
+	atomic.StoreUint64(&s.peer.restoreIndex, meta.Index)
+	return meta, rc, nil
 }
 
-func (s *Snapshot) Release() {
+// 包装快照存储以记录恢复的快照索引
+func (peer *Peer) trackSnapshots(snapshots raft.SnapshotStore) raft.SnapshotStore {
+	return &trackingSnapshotStore{SnapshotStore: snapshots, peer: peer}
 }
@@ -0,0 +1,98 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// 使用内存传输和日志存储启动节点 快照写入文件
+func newTestPeer(t *testing.T, id string) (*Peer, *raft.InmemTransport) {
+	_, trans := raft.NewInmemTransport(raft.ServerAddress(id))
+	return newTestPeerWith(t, id, trans), trans
+}
+
+func newTestPeerWith(t *testing.T, id string, trans raft.Transport) *Peer {
+	eng, err := engine.NewBaseEngineWith(config.BaseStoreConfig{
+		Directory:    t.TempDir(),
+		DatafileSize: 1 << 20,
+	})
+	assert.Nil(t, err)
+	peer, err := NewPeer(Option{RaftDir: t.TempDir()}, id, eng)
+	assert.Nil(t, err)
+
+	conf := raft.DefaultConfig()
+	conf.LocalID = raft.ServerID(id)
+	conf.HeartbeatTimeout = 50 * time.Millisecond
+	conf.ElectionTimeout = 50 * time.Millisecond
+	conf.LeaderLeaseTimeout = 50 * time.Millisecond
+	conf.CommitTimeout = 5 * time.Millisecond
+	conf.SnapshotThreshold = 1 << 20 // 只手动生成快照
+	conf.TrailingLogs = 1
+	conf.LogOutput = io.Discard
+
+	snapshots, err := raft.NewFileSnapshotStore(peer.dirPath, retainSnapshotCount, io.Discard)
+	assert.Nil(t, err)
+	logs := raft.NewInmemStore()
+
+	assert.Nil(t, peer.newRaft(conf, logs, logs, snapshots, trans))
+	t.Cleanup(func() {
+		_ = peer.raftNode.Shutdown().Error()
+	})
+	return peer
+}
+
+func TestPeer_InstallSnapshot(t *testing.T) {
+	leader, leaderTrans := newTestPeer(t, "node1")
+	err := leader.raftNode.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: "node1", Address: leaderTrans.LocalAddr()}},
+	}).Error()
+	assert.Nil(t, err)
+	_, err = leader.WaitForLeader(5 * time.Second)
+	assert.Nil(t, err)
+	for !leader.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, leader.Set("key"+strconv.Itoa(i), []byte("value"+strconv.Itoa(i))))
+	}
+	assert.Nil(t, leader.Del("key0"))
+
+	// 生成快照后截断日志 新节点只能通过安装快照追上
+	assert.Nil(t, leader.raftNode.Snapshot().Error())
+	assert.Nil(t, leader.Set("after", []byte("snapshot")))
+
+	follower, followerTrans := newTestPeer(t, "node2")
+	leaderTrans.Connect(followerTrans.LocalAddr(), followerTrans)
+	followerTrans.Connect(leaderTrans.LocalAddr(), leaderTrans)
+
+	// 跟随者中的旧数据在安装快照后被替换
+	res := follower.Engine().Exec(iface.SET_STR, [][]byte{[]byte("stale"), []byte("value")})
+	assert.True(t, res.Success())
+
+	err = leader.raftNode.AddVoter("node2", followerTrans.LocalAddr(), 0, 0).Error()
+	assert.Nil(t, err)
+	assert.Nil(t, follower.WaitForAppliedIndex(leader.raftNode.LastIndex(), 5*time.Second))
+
+	assert.NotEqual(t, "0", follower.raftNode.Stats()["last_snapshot_index"])
+
+	get := func(key string) iface.Result {
+		return follower.Engine().Exec(iface.GET_STR, utils.KeyBytes(key))
+	}
+	for i := 1; i < 100; i++ {
+		res = get("key" + strconv.Itoa(i))
+		assert.True(t, res.Success())
+		assert.Equal(t, "value"+strconv.Itoa(i), res.String())
+	}
+	assert.False(t, get("key0").Success())
+	assert.False(t, get("stale").Success())
+	assert.Equal(t, "snapshot", get("after").String())
+}
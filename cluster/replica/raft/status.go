@@ -0,0 +1,253 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/// 节点状态和复制进度
+/// 节点自身的状态取自raft的统计信息 跟随者的复制进度由领导者在传输层记录
+/// 领导者每次向跟随者成功发送日志或心跳后 记录跟随者的最新日志索引和时间
+/// 跟随者的日志不落后于领导者已提交的日志时视为已追上 落后时长从最后一次追上时开始计算
+
+// Status 节点在复制组中的状态
+type Status struct {
+	ID            string
+	Address       string
+	State         string // Leader Follower Candidate Shutdown
+	Leader        string // 领导者的raft地址
+	Term          uint64
+	LastIndex     uint64
+	CommitIndex   uint64
+	AppliedIndex  uint64
+	SnapshotIndex uint64
+	LastContact   time.Duration // 距上次与领导者通信的时间 领导者为0 从未通信时为-1
+	Followers     []Progress    // 只有领导者记录跟随者的复制进度
+}
+
+// Progress 领导者观察到的跟随者复制进度
+type Progress struct {
+	ID          string
+	Address     string
+	Suffrage    string        // Voter Nonvoter Staging
+	MatchIndex  uint64        // 跟随者的最新日志索引
+	LagEntries  uint64        // 落后领导者的日志数
+	LagTime     time.Duration // 落后持续的时间 已追上时为0 从未成功复制时为-1
+	LastContact time.Duration // 距上次成功复制的时间 从未成功时为-1
+}
+
+// 跟随者的复制记录
+type replication struct {
+	matchIndex uint64
+	contact    time.Time // 最后一次成功复制的时间
+	caughtUp   time.Time // 最后一次追上已提交日志的时间
+}
+
+// 记录领导者向跟随者复制日志的结果
+type progressTracker struct {
+	mutex   sync.Mutex
+	records map[raft.ServerID]*replication
+}
+
+func newProgressTracker() *progressTracker {
+	return &progressTracker{records: make(map[raft.ServerID]*replication)}
+}
+
+func (p *progressTracker) record(id raft.ServerID, req *raft.AppendEntriesRequest, resp *raft.AppendEntriesResponse) {
+	if !resp.Success {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	r, ok := p.records[id]
+	if !ok {
+		r = &replication{caughtUp: now}
+		p.records[id] = r
+	}
+	r.matchIndex = resp.LastLog
+	r.contact = now
+	if resp.LastLog >= req.LeaderCommitIndex {
+		r.caughtUp = now
+	}
+}
+
+// 领导者变化后清空记录 之前任期的通信不能证明当前领导者的租约
+func (p *progressTracker) reset() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.records = make(map[raft.ServerID]*replication)
+}
+
+func (p *progressTracker) get(id raft.ServerID) (replication, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	r, ok := p.records[id]
+	if !ok {
+		return replication{}, false
+	}
+	return *r, true
+}
+
+// 记录复制进度的传输层 单次发送和流水线发送的日志都会记录
+type trackingTransport struct {
+	raft.Transport
+	progress *progressTracker
+}
+
+func (t *trackingTransport) AppendEntries(id raft.ServerID, target raft.ServerAddress,
+	args *raft.AppendEntriesRequest, resp *raft.AppendEntriesResponse) error {
+	if err := t.Transport.AppendEntries(id, target, args, resp); err != nil {
+		return err
+	}
+	t.progress.record(id, args, resp)
+	return nil
+}
+
+// AppendEntriesPipeline 包装流水线 在响应交给raft之前记录复制进度
+func (t *trackingTransport) AppendEntriesPipeline(id raft.ServerID, target raft.ServerAddress) (raft.AppendPipeline, error) {
+	pipeline, err := t.Transport.AppendEntriesPipeline(id, target)
+	if err != nil {
+		return nil, err
+	}
+	p := &trackingPipeline{
+		AppendPipeline: pipeline,
+		id:             id,
+		progress:       t.progress,
+		consumer:       make(chan raft.AppendFuture, cap(pipeline.Consumer())),
+		shutdownCh:     make(chan struct{}),
+	}
+	go p.forward()
+	return p, nil
+}
+
+// 记录复制进度的流水线 底层流水线的响应经过记录后转发给raft
+type trackingPipeline struct {
+	raft.AppendPipeline
+	id       raft.ServerID
+	progress *progressTracker
+
+	consumer     chan raft.AppendFuture
+	shutdownOnce sync.Once
+	shutdownCh   chan struct{}
+}
+
+func (p *trackingPipeline) forward() {
+	for {
+		select {
+		case future := <-p.AppendPipeline.Consumer():
+			if future.Error() == nil {
+				p.progress.record(p.id, future.Request(), future.Response())
+			}
+			select {
+			case p.consumer <- future:
+			case <-p.shutdownCh:
+				return
+			}
+		case <-p.shutdownCh:
+			return
+		}
+	}
+}
+
+func (p *trackingPipeline) Consumer() <-chan raft.AppendFuture {
+	return p.consumer
+}
+
+func (p *trackingPipeline) Close() error {
+	p.shutdownOnce.Do(func() {
+		close(p.shutdownCh)
+	})
+	return p.AppendPipeline.Close()
+}
+
+// Close 关闭底层的传输层
+func (t *trackingTransport) Close() error {
+	if c, ok := t.Transport.(raft.WithClose); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// 包装传输层以记录复制进度
+func (peer *Peer) track(trans raft.Transport) raft.Transport {
+	return &trackingTransport{Transport: trans, progress: peer.progress}
+}
+
+// Status 返回节点状态 节点是领导者时包含各跟随者的复制进度
+func (peer *Peer) Status() (Status, error) {
+	if peer.raftNode == nil {
+		return Status{}, errno.ErrNoLeader
+	}
+
+	stats := peer.raftNode.Stats()
+	status := Status{
+		ID:            peer.id,
+		Address:       peer.address,
+		State:         stats["state"],
+		Leader:        peer.LeaderAddr(),
+		Term:          parseStat(stats, "term"),
+		LastIndex:     parseStat(stats, "last_log_index"),
+		CommitIndex:   parseStat(stats, "commit_index"),
+		AppliedIndex:  parseStat(stats, "applied_index"),
+		SnapshotIndex: parseStat(stats, "last_snapshot_index"),
+		LastContact:   -1,
+	}
+	switch contact := stats["last_contact"]; contact {
+	case "never":
+	case "0":
+		status.LastContact = 0
+	default:
+		if d, err := time.ParseDuration(contact); err == nil {
+			status.LastContact = d
+		}
+	}
+
+	if status.State != raft.Leader.String() {
+		return status, nil
+	}
+
+	servers, err := peer.servers()
+	if err != nil {
+		return status, err
+	}
+	now := time.Now()
+	status.Followers = make([]Progress, 0, len(servers))
+	for _, s := range servers {
+		if s.ID == raft.ServerID(peer.id) {
+			continue
+		}
+		p := Progress{
+			ID:          string(s.ID),
+			Address:     string(s.Address),
+			Suffrage:    s.Suffrage.String(),
+			LagEntries:  status.LastIndex,
+			LagTime:     -1,
+			LastContact: -1,
+		}
+		if r, ok := peer.progress.get(s.ID); ok {
+			p.MatchIndex = r.matchIndex
+			p.LastContact = now.Sub(r.contact)
+			if r.matchIndex < status.LastIndex {
+				p.LagEntries = status.LastIndex - r.matchIndex
+				p.LagTime = now.Sub(r.caughtUp)
+			} else {
+				p.LagEntries, p.LagTime = 0, 0
+			}
+		}
+		status.Followers = append(status.Followers, p)
+	}
+	return status, nil
+}
+
+func parseStat(stats map[string]string, key string) uint64 {
+	v, _ := strconv.ParseUint(stats[key], 10, 64)
+	return v
+}
@@ -0,0 +1,130 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPeer_Status(t *testing.T) {
+	peer, err := NewPeer(Option{}, "node1", nil)
+	assert.Nil(t, err)
+	_, err = peer.Status()
+	assert.Equal(t, errno.ErrNoLeader, err)
+
+	peers, transports := newTestCluster(t, 3)
+	leader := peers[0]
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, leader.Set("key"+strconv.Itoa(i), []byte("value")))
+	}
+
+	// 跟随者追上领导者后没有延迟
+	caughtUp := func() bool {
+		status, err := leader.Status()
+		assert.Nil(t, err)
+		for _, p := range status.Followers {
+			if p.LagEntries != 0 || p.LastContact < 0 {
+				return false
+			}
+		}
+		return len(status.Followers) == 2
+	}
+	waitFor(t, caughtUp)
+
+	status, err := leader.Status()
+	assert.Nil(t, err)
+	assert.Equal(t, "Leader", status.State)
+	assert.Equal(t, time.Duration(0), status.LastContact)
+	assert.NotZero(t, status.Term)
+	assert.Equal(t, status.LastIndex, status.CommitIndex)
+	for _, p := range status.Followers {
+		assert.Equal(t, status.LastIndex, p.MatchIndex)
+		assert.Equal(t, time.Duration(0), p.LagTime)
+		assert.Equal(t, "Voter", p.Suffrage)
+	}
+
+	waitFor(t, func() bool {
+		s, err := peers[1].Status()
+		assert.Nil(t, err)
+		return s.AppliedIndex == status.LastIndex
+	})
+	status, err = peers[1].Status()
+	assert.Nil(t, err)
+	assert.Equal(t, "Follower", status.State)
+	assert.Equal(t, string(transports[0].LocalAddr()), status.Leader)
+	assert.GreaterOrEqual(t, status.LastContact, time.Duration(0))
+	assert.Nil(t, status.Followers)
+
+	// 断开的跟随者落后于领导者
+	transports[0].Disconnect(transports[2].LocalAddr())
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, leader.Set("key"+strconv.Itoa(i), []byte("value")))
+	}
+	waitFor(t, func() bool {
+		status, err = leader.Status()
+		assert.Nil(t, err)
+		for _, p := range status.Followers {
+			if p.ID == "node2" && p.LagEntries != 0 {
+				return false
+			}
+		}
+		return true
+	})
+	for _, p := range status.Followers {
+		if p.ID == "node3" {
+			assert.Equal(t, uint64(10), p.LagEntries)
+			assert.Greater(t, p.LagTime, time.Duration(0))
+			assert.Greater(t, p.LastContact, time.Duration(0))
+		}
+	}
+}
+
+func TestTrackingPipeline(t *testing.T) {
+	_, leader := raft.NewInmemTransport("")
+	_, follower := raft.NewInmemTransport("")
+	leader.Connect(follower.LocalAddr(), follower)
+
+	// 跟随者收到的日志全部成功
+	go func() {
+		for rpc := range follower.Consumer() {
+			req := rpc.Command.(*raft.AppendEntriesRequest)
+			rpc.Respond(&raft.AppendEntriesResponse{Success: true, LastLog: req.PrevLogEntry + uint64(len(req.Entries))}, nil)
+		}
+	}()
+
+	progress := newProgressTracker()
+	trans := &trackingTransport{Transport: leader, progress: progress}
+	pipeline, err := trans.AppendEntriesPipeline("node2", follower.LocalAddr())
+	assert.Nil(t, err)
+	defer pipeline.Close()
+
+	req := &raft.AppendEntriesRequest{PrevLogEntry: 4, LeaderCommitIndex: 5, Entries: []*raft.Log{{Index: 5}}}
+	_, err = pipeline.AppendEntries(req, new(raft.AppendEntriesResponse))
+	assert.Nil(t, err)
+
+	// 响应交给raft之前已经记录复制进度
+	future := <-pipeline.Consumer()
+	assert.Nil(t, future.Error())
+	r, ok := progress.get("node2")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(5), r.matchIndex)
+}
+
+func TestPeer_LeaderReplicaAddr(t *testing.T) {
+	peers, _ := newTestCluster(t, 3)
+	leader := peers[0]
+	leader.replAddr = "127.0.0.1:10041"
+	assert.Equal(t, "127.0.0.1:10041", leader.LeaderReplicaAddr())
+
+	// 跟随者从元数据中查找领导者的副本服务地址
+	assert.Equal(t, "", peers[1].LeaderReplicaAddr())
+	res, err := leader.Apply(metaCommand(replicaKeyMark+leader.id, leader.replAddr))
+	assert.Nil(t, err)
+	assert.True(t, res.Success())
+	waitFor(t, func() bool {
+		return peers[1].LeaderReplicaAddr() == "127.0.0.1:10041"
+	})
+}
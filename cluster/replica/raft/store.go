@@ -0,0 +1,342 @@
+package raft
+
+import (
+	"encoding/binary"
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"sync"
+	"time"
+)
+
+/// 基于bases存储引擎的raft日志存储和稳定存储
+/// 日志以 'l' + 大端序索引 为key 日志索引连续 范围删除时按首尾索引逐个删除 不遍历存储引擎的索引
+/// 稳定存储以 's' + key 为key 所有写入都通过WriteBatch提交并持久化
+/// 日志编码: 版本 | 任期 | 类型 | 追加时间 | 数据 | 扩展 整数使用varint编码 字节串以长度开头
+
+const (
+	logKeyPrefix    byte = 'l'
+	stableKeyPrefix byte = 's'
+	logVersion      byte = 1
+
+	logDataFileSize = 64 * 1024 * 1024
+)
+
+// BaseStore 日志存储和稳定存储
+type BaseStore struct {
+	base    *bases.Base
+	options bases.Options
+
+	mutex       sync.Mutex   // 保证写入和首尾索引的更新串行执行
+	baseMutex   sync.RWMutex // 合并后重新打开存储引擎时阻塞读取
+	mergeMutex  sync.Mutex   // 保证合并和重新打开串行执行
+	first, last uint64       // 日志为空时均为0
+}
+
+// NewBaseStore 在dir中打开存储
+func NewBaseStore(dir string) (*BaseStore, error) {
+	opts := bases.DefaultOptions
+	opts.DirPath = dir
+	opts.DataFileSize = logDataFileSize
+
+	b, err := bases.NewBaseWith(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &BaseStore{base: b, options: opts}
+	it := b.NewIterator(bases.IteratorOptions{Prefix: []byte{logKeyPrefix}})
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		index := binary.BigEndian.Uint64(it.Key()[1:])
+		if s.first == 0 {
+			s.first = index
+		}
+		s.last = index
+	}
+	return s, nil
+}
+
+// Close 关闭存储
+func (s *BaseStore) Close() error {
+	s.baseMutex.RLock()
+	defer s.baseMutex.RUnlock()
+	return s.base.Close()
+}
+
+// FirstIndex implements the raft.LogStore interface.
+func (s *BaseStore) FirstIndex() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.first, nil
+}
+
+// LastIndex implements the raft.LogStore interface.
+func (s *BaseStore) LastIndex() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.last, nil
+}
+
+// GetLog implements the raft.LogStore interface.
+func (s *BaseStore) GetLog(index uint64, log *raft.Log) error {
+	s.baseMutex.RLock()
+	val, err := s.base.Get(string(logKey(index)))
+	s.baseMutex.RUnlock()
+	if err == errno.ErrKeyNotFound {
+		return raft.ErrLogNotFound
+	}
+	if err != nil {
+		return err
+	}
+	log.Index = index
+	return decodeLog(val.Bytes(), log)
+}
+
+// StoreLog implements the raft.LogStore interface.
+func (s *BaseStore) StoreLog(log *raft.Log) error {
+	return s.StoreLogs([]*raft.Log{log})
+}
+
+// StoreLogs 在一个批次中写入全部日志
+func (s *BaseStore) StoreLogs(logs []*raft.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	wb := s.newWriteBatch(len(logs))
+	for _, log := range logs {
+		if err := wb.Put(logKey(log.Index), encodeLog(log)); err != nil {
+			return err
+		}
+	}
+	if err := wb.Commit(); err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		if s.first == 0 || log.Index < s.first {
+			s.first = log.Index
+		}
+		if log.Index > s.last {
+			s.last = log.Index
+		}
+	}
+	return nil
+}
+
+// DeleteRange 在一个批次中删除[min, max]中的日志
+// 日志压缩删除的是开头的日志 只删除首尾索引范围内的日志 删除后检查是否需要合并数据文件
+func (s *BaseStore) DeleteRange(min, max uint64) error {
+	if err := s.deleteRange(min, max); err != nil {
+		return err
+	}
+	return s.merge()
+}
+
+func (s *BaseStore) deleteRange(min, max uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	from, to := min, max
+	if from < s.first {
+		from = s.first
+	}
+	if to > s.last {
+		to = s.last
+	}
+	if s.last > 0 && from <= to {
+		wb := s.newWriteBatch(int(to - from + 1))
+		for index := from; index <= to; index++ {
+			if err := wb.Delete(logKey(index)); err != nil {
+				return err
+			}
+		}
+		if err := wb.Commit(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case min <= s.first && max >= s.last:
+		s.first, s.last = 0, 0
+	case min <= s.first:
+		if max >= s.first {
+			s.first = max + 1
+		}
+	case max >= s.last:
+		if min <= s.last {
+			s.last = min - 1
+		}
+	}
+	return nil
+}
+
+// 可回收空间占比达到DataFileMergeRatio时合并数据文件
+// 合并后的文件在打开存储引擎时才替换旧文件 因此合并完成后重新打开存储引擎
+func (s *BaseStore) merge() error {
+	s.mergeMutex.Lock()
+	defer s.mergeMutex.Unlock()
+
+	s.baseMutex.RLock()
+	err := s.base.Merge()
+	s.baseMutex.RUnlock()
+	if err == errno.ErrMergeRatioUnreached || err == errno.ErrMergeIsProgress {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.baseMutex.Lock()
+	defer s.baseMutex.Unlock()
+
+	if err = s.base.Close(); err != nil {
+		return err
+	}
+	s.base, err = bases.NewBaseWith(s.options)
+	return err
+}
+
+// Set implements the raft.StableStore interface.
+func (s *BaseStore) Set(key []byte, val []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	wb := s.newWriteBatch(1)
+	if err := wb.Put(stableKey(key), val); err != nil {
+		return err
+	}
+	return wb.Commit()
+}
+
+// Get implements the raft.StableStore interface.
+func (s *BaseStore) Get(key []byte) ([]byte, error) {
+	s.baseMutex.RLock()
+	val, err := s.base.Get(string(stableKey(key)))
+	s.baseMutex.RUnlock()
+	if err == errno.ErrKeyNotFound {
+		return nil, errno.ErrStableKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return val.Bytes(), nil
+}
+
+// SetUint64 implements the raft.StableStore interface.
+func (s *BaseStore) SetUint64(key []byte, val uint64) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], val)
+	return s.Set(key, buf[:])
+}
+
+// GetUint64 implements the raft.StableStore interface.
+func (s *BaseStore) GetUint64(key []byte) (uint64, error) {
+	val, err := s.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(val) != 8 {
+		return 0, errno.ErrInvalidRaftLog
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+// 批次提交时持久化 raft要求写入返回前数据已经落盘
+func (s *BaseStore) newWriteBatch(n int) *bases.WriteBatch {
+	return s.base.NewWriteBatchWith(bases.WriteBatchOptions{
+		MaxBatchNum: uint(n),
+		SyncWriters: true,
+	})
+}
+
+func logKey(index uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = logKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], index)
+	return key
+}
+
+func stableKey(key []byte) []byte {
+	return append([]byte{stableKeyPrefix}, key...)
+}
+
+func encodeLog(log *raft.Log) []byte {
+	buf := make([]byte, 0, 2+4*binary.MaxVarintLen64+len(log.Data)+len(log.Extensions))
+	var tmp [binary.MaxVarintLen64]byte
+	putUvarint := func(v uint64) {
+		buf = append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+	}
+
+	// 追加时间为零值时记为0
+	var appendedAt int64
+	if !log.AppendedAt.IsZero() {
+		appendedAt = log.AppendedAt.UnixNano()
+	}
+
+	buf = append(buf, logVersion)
+	putUvarint(log.Term)
+	buf = append(buf, byte(log.Type))
+	buf = append(buf, tmp[:binary.PutVarint(tmp[:], appendedAt)]...)
+	putUvarint(uint64(len(log.Data)))
+	buf = append(buf, log.Data...)
+	putUvarint(uint64(len(log.Extensions)))
+	buf = append(buf, log.Extensions...)
+	return buf
+}
+
+func decodeLog(b []byte, log *raft.Log) error {
+	if len(b) < 2 || b[0] != logVersion {
+		return errno.ErrInvalidRaftLog
+	}
+	b = b[1:]
+
+	term, n := binary.Uvarint(b)
+	if n <= 0 || len(b) <= n {
+		return errno.ErrInvalidRaftLog
+	}
+	log.Term = term
+	log.Type = raft.LogType(b[n])
+	b = b[n+1:]
+
+	appendedAt, n := binary.Varint(b)
+	if n <= 0 {
+		return errno.ErrInvalidRaftLog
+	}
+	log.AppendedAt = time.Time{}
+	if appendedAt != 0 {
+		log.AppendedAt = time.Unix(0, appendedAt)
+	}
+	b = b[n:]
+
+	var err error
+	if log.Data, b, err = decodeLogBytes(b); err != nil {
+		return err
+	}
+	if log.Extensions, b, err = decodeLogBytes(b); err != nil {
+		return err
+	}
+	if len(b) != 0 {
+		return errno.ErrInvalidRaftLog
+	}
+	return nil
+}
+
+// 读取以长度开头的字节串 返回字节串和剩余的数据
+func decodeLogBytes(b []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) < size {
+		return nil, nil, errno.ErrInvalidRaftLog
+	}
+	b = b[n:]
+	if size == 0 {
+		return nil, b, nil
+	}
+	return b[:size], b[size:], nil
+}
@@ -0,0 +1,187 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestLogs(first, last uint64) []*raft.Log {
+	logs := make([]*raft.Log, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		logs = append(logs, &raft.Log{
+			Index:      i,
+			Term:       i / 10,
+			Type:       raft.LogCommand,
+			Data:       []byte("data" + strconv.FormatUint(i, 10)),
+			AppendedAt: time.Unix(0, int64(i)),
+		})
+	}
+	return logs
+}
+
+func assertIndexes(t *testing.T, s *BaseStore, first, last uint64) {
+	idx, err := s.FirstIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, first, idx)
+	idx, err = s.LastIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, last, idx)
+}
+
+func TestBaseStore_Logs(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBaseStore(dir)
+	assert.Nil(t, err)
+	assertIndexes(t, s, 0, 0)
+
+	var log raft.Log
+	assert.Equal(t, raft.ErrLogNotFound, s.GetLog(1, &log))
+
+	assert.Nil(t, s.StoreLog(&raft.Log{Index: 1, Term: 1, Type: raft.LogConfiguration}))
+	assert.Nil(t, s.StoreLogs(newTestLogs(2, 100)))
+	assertIndexes(t, s, 1, 100)
+
+	assert.Nil(t, s.GetLog(1, &log))
+	assert.Equal(t, raft.Log{Index: 1, Term: 1, Type: raft.LogConfiguration}, log)
+	assert.Nil(t, s.GetLog(42, &log))
+	assert.Equal(t, *newTestLogs(42, 42)[0], log)
+
+	// 日志压缩删除开头的日志 冲突时删除末尾的日志
+	assert.Nil(t, s.DeleteRange(1, 50))
+	assertIndexes(t, s, 51, 100)
+	assert.Nil(t, s.DeleteRange(91, 100))
+	assertIndexes(t, s, 51, 90)
+	assert.Equal(t, raft.ErrLogNotFound, s.GetLog(50, &log))
+	assert.Equal(t, raft.ErrLogNotFound, s.GetLog(91, &log))
+	assert.Nil(t, s.StoreLogs(newTestLogs(91, 95)))
+	assertIndexes(t, s, 51, 95)
+
+	// 重启后从存储中恢复首尾索引
+	assert.Nil(t, s.Close())
+	s, err = NewBaseStore(dir)
+	assert.Nil(t, err)
+	assertIndexes(t, s, 51, 95)
+	assert.Nil(t, s.GetLog(95, &log))
+	assert.Equal(t, *newTestLogs(95, 95)[0], log)
+
+	assert.Nil(t, s.DeleteRange(0, 1000))
+	assertIndexes(t, s, 0, 0)
+	assert.Nil(t, s.Close())
+}
+
+func TestBaseStore_Stable(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBaseStore(dir)
+	assert.Nil(t, err)
+
+	// raft按错误信息判断key不存在
+	_, err = s.Get([]byte("CurrentTerm"))
+	assert.Equal(t, "not found", err.Error())
+	_, err = s.GetUint64([]byte("CurrentTerm"))
+	assert.Equal(t, errno.ErrStableKeyNotFound, err)
+
+	assert.Nil(t, s.SetUint64([]byte("CurrentTerm"), 7))
+	assert.Nil(t, s.Set([]byte("LastVoteCand"), []byte("node1")))
+	assert.Nil(t, s.StoreLogs(newTestLogs(1, 3)))
+
+	assert.Nil(t, s.Close())
+	s, err = NewBaseStore(dir)
+	assert.Nil(t, err)
+	term, err := s.GetUint64([]byte("CurrentTerm"))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), term)
+	val, err := s.Get([]byte("LastVoteCand"))
+	assert.Nil(t, err)
+	assert.Equal(t, "node1", string(val))
+	assertIndexes(t, s, 1, 3)
+	assert.Nil(t, s.Close())
+}
+
+func TestPeer_BaseStore(t *testing.T) {
+	peer, err := NewPeer(Option{RaftDir: t.TempDir(), Store: "unknown"}, "node1", nil)
+	assert.Nil(t, err)
+	_, _, _, err = peer.openLogStore()
+	assert.Equal(t, errno.ErrUnknownLogStore, err)
+
+	// 使用bases日志存储运行节点 生成快照后压缩日志
+	peer, _ = newTestPeer(t, "node1")
+	peer.logStore = "bases"
+	logs, stable, newNode, err := peer.openLogStore()
+	assert.Nil(t, err)
+	assert.True(t, newNode)
+	store := logs.(*BaseStore)
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	_ = peer.raftNode.Shutdown().Error()
+	conf := raft.DefaultConfig()
+	conf.LocalID = "node1"
+	conf.HeartbeatTimeout = 50 * time.Millisecond
+	conf.ElectionTimeout = 50 * time.Millisecond
+	conf.LeaderLeaseTimeout = 50 * time.Millisecond
+	conf.SnapshotThreshold = 1 << 20
+	conf.TrailingLogs = 10
+	conf.LogOutput = io.Discard
+	_, trans := raft.NewInmemTransport("node1")
+	assert.Nil(t, peer.newRaft(conf, logs, stable, raft.NewInmemSnapshotStore(), trans))
+	t.Cleanup(func() {
+		_ = peer.raftNode.Shutdown().Error()
+	})
+
+	err = peer.raftNode.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: "node1", Address: trans.LocalAddr()}},
+	}).Error()
+	assert.Nil(t, err)
+	waitFor(t, peer.IsLeader)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, peer.Set("key"+strconv.Itoa(i), []byte("value")))
+	}
+	assert.Nil(t, peer.raftNode.Snapshot().Error())
+
+	first, err := store.FirstIndex()
+	assert.Nil(t, err)
+	last, err := store.LastIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, peer.raftNode.LastIndex(), last)
+	assert.Equal(t, last-conf.TrailingLogs+1, first)
+	term, err := store.GetUint64([]byte("CurrentTerm"))
+	assert.Nil(t, err)
+	assert.NotZero(t, term)
+}
+
+func TestBaseStore_Merge(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBaseStore(dir)
+	assert.Nil(t, err)
+
+	// 每轮写入日志后压缩开头的日志 合并后重新打开 目录大小不随压缩次数增长
+	value := make([]byte, 1024)
+	var last uint64
+	for round := 0; round < 5; round++ {
+		logs := newTestLogs(last+1, last+1000)
+		for _, log := range logs {
+			log.Data = value
+		}
+		assert.Nil(t, s.StoreLogs(logs))
+		last += 1000
+		assert.Nil(t, s.DeleteRange(0, last-100))
+		assertIndexes(t, s, last-99, last)
+	}
+
+	size, err := utils.DirSize(dir)
+	assert.Nil(t, err)
+	assert.Less(t, size, int64(2*1000*len(value)))
+
+	var log raft.Log
+	assert.Nil(t, s.GetLog(last, &log))
+	assert.Equal(t, value, log.Data)
+	assert.Equal(t, raft.ErrLogNotFound, s.GetLog(last-100, &log))
+	assert.Nil(t, s.Close())
+}
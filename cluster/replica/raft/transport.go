@@ -0,0 +1,362 @@
+package raft
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/go-msgpack/v2/codec"
+	"github.com/hashicorp/raft"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/// 通过副本服务转发raft消息的传输层 raft不再需要单独的端口
+/// 消息使用msgpack编码 与raft的TCP传输层相同 由Caller发送到目标节点的副本服务
+/// 快照分块发送 接收方将分块写入管道交给raft读取 分块长时间未到达时中止安装
+
+const (
+	snapshotChunkSize   = 1 << 20
+	snapshotIdleTimeout = 30 * time.Second
+)
+
+// Caller 将raft消息发送到目标节点 返回编码后的响应 目标节点处理失败时返回其错误信息
+type Caller interface {
+	AppendEntries(target string, command []byte) ([]byte, error)
+	RequestVote(target string, command []byte) ([]byte, error)
+	TimeoutNow(target string, command []byte) ([]byte, error)
+	InstallSnapshot(target string, chunk *SnapshotChunk) ([]byte, error)
+}
+
+// SnapshotChunk 快照分块 第一个分块携带安装请求 最后一个分块返回安装结果
+type SnapshotChunk struct {
+	Session string // 同一次安装的分块使用相同的会话
+	Command []byte
+	Offset  int64
+	Data    []byte
+	Done    bool
+}
+
+// 接收中的快照
+type snapshotSession struct {
+	writer *io.PipeWriter
+	offset int64
+	timer  *time.Timer
+	done   chan struct{} // raft返回安装结果后关闭
+	resp   raft.RPCResponse
+}
+
+// RPCTransport 通过Caller收发raft消息的传输层
+type RPCTransport struct {
+	localAddr raft.ServerAddress
+	caller    Caller
+	consumeCh chan raft.RPC
+
+	heartbeatMutex sync.Mutex
+	heartbeatFn    func(raft.RPC)
+
+	snapshotMutex sync.Mutex
+	snapshots     map[string]*snapshotSession
+	sessionSeq    uint64
+
+	shutdownMutex sync.Mutex
+	shutdown      bool
+	shutdownCh    chan struct{}
+}
+
+// NewRPCTransport 创建传输层 localAddr为本节点副本服务的地址
+func NewRPCTransport(localAddr string, caller Caller) *RPCTransport {
+	return &RPCTransport{
+		localAddr:  raft.ServerAddress(localAddr),
+		caller:     caller,
+		consumeCh:  make(chan raft.RPC),
+		snapshots:  make(map[string]*snapshotSession),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Consumer implements the raft.Transport interface.
+func (t *RPCTransport) Consumer() <-chan raft.RPC {
+	return t.consumeCh
+}
+
+// LocalAddr implements the raft.Transport interface.
+func (t *RPCTransport) LocalAddr() raft.ServerAddress {
+	return t.localAddr
+}
+
+// AppendEntriesPipeline 不支持流水线 raft逐个发送追加日志请求
+func (t *RPCTransport) AppendEntriesPipeline(id raft.ServerID, target raft.ServerAddress) (raft.AppendPipeline, error) {
+	return nil, raft.ErrPipelineReplicationNotSupported
+}
+
+// AppendEntries implements the raft.Transport interface.
+func (t *RPCTransport) AppendEntries(id raft.ServerID, target raft.ServerAddress,
+	args *raft.AppendEntriesRequest, resp *raft.AppendEntriesResponse) error {
+	return t.call(t.caller.AppendEntries, target, args, resp)
+}
+
+// RequestVote implements the raft.Transport interface.
+func (t *RPCTransport) RequestVote(id raft.ServerID, target raft.ServerAddress,
+	args *raft.RequestVoteRequest, resp *raft.RequestVoteResponse) error {
+	return t.call(t.caller.RequestVote, target, args, resp)
+}
+
+// TimeoutNow implements the raft.Transport interface.
+func (t *RPCTransport) TimeoutNow(id raft.ServerID, target raft.ServerAddress,
+	args *raft.TimeoutNowRequest, resp *raft.TimeoutNowResponse) error {
+	return t.call(t.caller.TimeoutNow, target, args, resp)
+}
+
+// InstallSnapshot 分块发送快照 最后一个分块返回安装结果
+func (t *RPCTransport) InstallSnapshot(id raft.ServerID, target raft.ServerAddress,
+	args *raft.InstallSnapshotRequest, resp *raft.InstallSnapshotResponse, data io.Reader) error {
+	command, err := encodeMessage(args)
+	if err != nil {
+		return err
+	}
+
+	session := fmt.Sprintf("%s-%d-%d", t.localAddr, time.Now().UnixNano(), atomic.AddUint64(&t.sessionSeq, 1))
+	buf := make([]byte, snapshotChunkSize)
+	var offset int64
+	for {
+		n, err := io.ReadFull(data, buf)
+		done := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !done {
+			return err
+		}
+
+		chunk := &SnapshotChunk{Session: session, Offset: offset, Data: buf[:n], Done: done}
+		if offset == 0 {
+			chunk.Command = command
+		}
+		out, err := t.caller.InstallSnapshot(string(target), chunk)
+		if err != nil {
+			return err
+		}
+		if done {
+			return decodeMessage(out, resp)
+		}
+		offset += int64(n)
+	}
+}
+
+// EncodePeer implements the raft.Transport interface.
+func (t *RPCTransport) EncodePeer(id raft.ServerID, addr raft.ServerAddress) []byte {
+	return []byte(addr)
+}
+
+// DecodePeer implements the raft.Transport interface.
+func (t *RPCTransport) DecodePeer(buf []byte) raft.ServerAddress {
+	return raft.ServerAddress(buf)
+}
+
+// SetHeartbeatHandler implements the raft.Transport interface.
+func (t *RPCTransport) SetHeartbeatHandler(cb func(rpc raft.RPC)) {
+	t.heartbeatMutex.Lock()
+	defer t.heartbeatMutex.Unlock()
+	t.heartbeatFn = cb
+}
+
+// Close 关闭传输层 中止等待中的请求和接收中的快照
+func (t *RPCTransport) Close() error {
+	t.shutdownMutex.Lock()
+	defer t.shutdownMutex.Unlock()
+
+	if !t.shutdown {
+		close(t.shutdownCh)
+		t.shutdown = true
+	}
+	return nil
+}
+
+// HandleAppendEntries 处理其他节点发送的追加日志请求 心跳直接交给心跳处理函数
+func (t *RPCTransport) HandleAppendEntries(command []byte) ([]byte, error) {
+	var req raft.AppendEntriesRequest
+	if err := decodeMessage(command, &req); err != nil {
+		return nil, err
+	}
+
+	leaderAddr := req.RPCHeader.Addr
+	if len(leaderAddr) == 0 {
+		leaderAddr = req.Leader
+	}
+	if req.Term != 0 && leaderAddr != nil && req.PrevLogEntry == 0 && req.PrevLogTerm == 0 &&
+		len(req.Entries) == 0 && req.LeaderCommitIndex == 0 {
+		t.heartbeatMutex.Lock()
+		fn := t.heartbeatFn
+		t.heartbeatMutex.Unlock()
+		if fn != nil {
+			respCh := make(chan raft.RPCResponse, 1)
+			fn(raft.RPC{Command: &req, RespChan: respCh})
+			return t.response(respCh)
+		}
+	}
+	return t.handle(&req)
+}
+
+// HandleRequestVote 处理其他节点发送的投票请求
+func (t *RPCTransport) HandleRequestVote(command []byte) ([]byte, error) {
+	var req raft.RequestVoteRequest
+	if err := decodeMessage(command, &req); err != nil {
+		return nil, err
+	}
+	return t.handle(&req)
+}
+
+// HandleTimeoutNow 处理其他节点发送的立即选举请求
+func (t *RPCTransport) HandleTimeoutNow(command []byte) ([]byte, error) {
+	var req raft.TimeoutNowRequest
+	if err := decodeMessage(command, &req); err != nil {
+		return nil, err
+	}
+	return t.handle(&req)
+}
+
+// HandleInstallSnapshot 处理快照分块 分块必须按顺序到达 最后一个分块等待raft返回安装结果
+func (t *RPCTransport) HandleInstallSnapshot(chunk *SnapshotChunk) ([]byte, error) {
+	s, err := t.snapshotSession(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.writer.Write(chunk.Data); err == nil {
+		t.snapshotMutex.Lock()
+		s.offset += int64(len(chunk.Data))
+		t.snapshotMutex.Unlock()
+		if !chunk.Done {
+			s.timer.Reset(snapshotIdleTimeout)
+			return nil, nil
+		}
+		s.timer.Stop()
+		_ = s.writer.Close()
+	}
+
+	// raft已经返回结果或写入失败 等待安装结果
+	<-s.done
+	t.closeSnapshot(chunk.Session, nil)
+	if s.resp.Error != nil {
+		return nil, s.resp.Error
+	}
+	return encodeMessage(s.resp.Response)
+}
+
+// 返回分块所属的快照 第一个分块创建快照并交给raft
+func (t *RPCTransport) snapshotSession(chunk *SnapshotChunk) (*snapshotSession, error) {
+	t.snapshotMutex.Lock()
+	s, ok := t.snapshots[chunk.Session]
+	inOrder := ok && s.offset == chunk.Offset
+	t.snapshotMutex.Unlock()
+	if ok {
+		if !inOrder {
+			t.closeSnapshot(chunk.Session, errno.ErrSnapshotChunkOutOfOrder)
+			return nil, errno.ErrSnapshotChunkOutOfOrder
+		}
+		return s, nil
+	}
+	if chunk.Offset != 0 {
+		return nil, errno.ErrSnapshotChunkOutOfOrder
+	}
+
+	req := new(raft.InstallSnapshotRequest)
+	if err := decodeMessage(chunk.Command, req); err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	respCh := make(chan raft.RPCResponse, 1)
+	s = &snapshotSession{writer: writer, done: make(chan struct{})}
+	s.timer = time.AfterFunc(snapshotIdleTimeout, func() {
+		t.closeSnapshot(chunk.Session, errno.ErrSnapshotTimeout)
+	})
+
+	t.snapshotMutex.Lock()
+	t.snapshots[chunk.Session] = s
+	t.snapshotMutex.Unlock()
+
+	// raft可能不读取数据就返回结果 此时关闭管道使写入返回
+	go func() {
+		select {
+		case s.resp = <-respCh:
+		case <-t.shutdownCh:
+			s.resp = raft.RPCResponse{Error: raft.ErrTransportShutdown}
+		}
+		_ = reader.CloseWithError(io.ErrClosedPipe)
+		close(s.done)
+	}()
+
+	select {
+	case t.consumeCh <- raft.RPC{Command: req, Reader: reader, RespChan: respCh}:
+	case <-t.shutdownCh:
+		t.closeSnapshot(chunk.Session, raft.ErrTransportShutdown)
+		return nil, raft.ErrTransportShutdown
+	}
+	return s, nil
+}
+
+// 移除快照 err不为空时中止raft读取
+func (t *RPCTransport) closeSnapshot(session string, err error) {
+	t.snapshotMutex.Lock()
+	s, ok := t.snapshots[session]
+	delete(t.snapshots, session)
+	t.snapshotMutex.Unlock()
+
+	if ok {
+		s.timer.Stop()
+		if err != nil {
+			_ = s.writer.CloseWithError(err)
+		}
+	}
+}
+
+// 交给raft处理并等待响应
+func (t *RPCTransport) handle(command interface{}) ([]byte, error) {
+	respCh := make(chan raft.RPCResponse, 1)
+	select {
+	case t.consumeCh <- raft.RPC{Command: command, RespChan: respCh}:
+	case <-t.shutdownCh:
+		return nil, raft.ErrTransportShutdown
+	}
+	return t.response(respCh)
+}
+
+func (t *RPCTransport) response(respCh chan raft.RPCResponse) ([]byte, error) {
+	select {
+	case resp := <-respCh:
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		return encodeMessage(resp.Response)
+	case <-t.shutdownCh:
+		return nil, raft.ErrTransportShutdown
+	}
+}
+
+func (t *RPCTransport) call(fn func(string, []byte) ([]byte, error), target raft.ServerAddress,
+	args interface{}, resp interface{}) error {
+	command, err := encodeMessage(args)
+	if err != nil {
+		return err
+	}
+	out, err := fn(string(target), command)
+	if err != nil {
+		return err
+	}
+	return decodeMessage(out, resp)
+}
+
+func encodeMessage(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := codec.NewEncoder(&buf, &codec.MsgpackHandle{}).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeMessage(b []byte, v interface{}) error {
+	if err := codec.NewDecoderBytes(b, &codec.MsgpackHandle{}).Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", errno.ErrInvalidRaftMessage, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package raft
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 在内存中将消息交给目标节点的传输层处理
+type testCaller struct {
+	mutex      sync.Mutex
+	transports map[string]*RPCTransport
+	chunks     int
+}
+
+func (c *testCaller) target(addr string) (*RPCTransport, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	t, ok := c.transports[addr]
+	if !ok {
+		return nil, errno.ErrConnectionClosed
+	}
+	return t, nil
+}
+
+func (c *testCaller) AppendEntries(target string, command []byte) ([]byte, error) {
+	t, err := c.target(target)
+	if err != nil {
+		return nil, err
+	}
+	return t.HandleAppendEntries(command)
+}
+
+func (c *testCaller) RequestVote(target string, command []byte) ([]byte, error) {
+	t, err := c.target(target)
+	if err != nil {
+		return nil, err
+	}
+	return t.HandleRequestVote(command)
+}
+
+func (c *testCaller) TimeoutNow(target string, command []byte) ([]byte, error) {
+	t, err := c.target(target)
+	if err != nil {
+		return nil, err
+	}
+	return t.HandleTimeoutNow(command)
+}
+
+func (c *testCaller) InstallSnapshot(target string, chunk *SnapshotChunk) ([]byte, error) {
+	t, err := c.target(target)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	c.chunks++
+	c.mutex.Unlock()
+	return t.HandleInstallSnapshot(chunk)
+}
+
+func TestRPCTransport(t *testing.T) {
+	caller := &testCaller{transports: make(map[string]*RPCTransport)}
+	peers := make([]*Peer, 3)
+	for i := range peers {
+		id := "node" + strconv.Itoa(i+1)
+		trans := NewRPCTransport(id, caller)
+		caller.transports[id] = trans
+		peers[i] = newTestPeerWith(t, id, trans)
+	}
+
+	leader := peers[0]
+	err := leader.raftNode.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: "node1", Address: "node1"}},
+	}).Error()
+	assert.Nil(t, err)
+	waitFor(t, leader.IsLeader)
+	assert.Nil(t, leader.raftNode.AddVoter("node2", "node2", 0, 0).Error())
+
+	// 快照超过一个分块 新节点通过分块安装快照追上领导者
+	value := bytes.Repeat([]byte("v"), 32*1024)
+	for i := 0; i < 64; i++ {
+		assert.Nil(t, leader.Set("key"+strconv.Itoa(i), value))
+	}
+	assert.Nil(t, leader.raftNode.Snapshot().Error())
+	assert.Nil(t, leader.raftNode.AddVoter("node3", "node3", 0, 0).Error())
+	for _, peer := range peers[1:] {
+		assert.Nil(t, peer.WaitForAppliedIndex(leader.raftNode.LastIndex(), 5*time.Second))
+		res := peer.Engine().Exec(iface.GET_STR, utils.KeyBytes("key63"))
+		assert.True(t, res.Success())
+		assert.Equal(t, string(value), res.String())
+	}
+	caller.mutex.Lock()
+	assert.Greater(t, caller.chunks, 1)
+	caller.mutex.Unlock()
+
+	// 转移领导权使用投票和立即选举请求
+	assert.Nil(t, leader.TransferLeadership("node2"))
+	waitFor(t, peers[1].IsLeader)
+	assert.Nil(t, peers[1].Set("key", []byte("value")))
+
+	// 分块必须按顺序到达
+	_, err = caller.transports["node1"].HandleInstallSnapshot(&SnapshotChunk{Session: "s", Offset: 10})
+	assert.Equal(t, errno.ErrSnapshotChunkOutOfOrder, err)
+	_, err = caller.transports["node1"].HandleAppendEntries([]byte("invalid"))
+	assert.ErrorIs(t, err, errno.ErrInvalidRaftMessage)
+}
@@ -0,0 +1,79 @@
+package raft
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"os"
+	"time"
+)
+
+/// raft参数 由副本配置指定 未指定的参数使用raft的默认值
+/// 创建节点时校验 参数不合法时节点无法启动
+
+// Tuning 生效的raft参数
+type Tuning struct {
+	SnapshotInterval   time.Duration
+	SnapshotThreshold  uint64
+	TrailingLogs       uint64
+	HeartbeatTimeout   time.Duration
+	ElectionTimeout    time.Duration
+	LeaderLeaseTimeout time.Duration
+	MaxAppendEntries   int
+	RetainSnapshots    int
+}
+
+// 根据选项生成raft配置并校验
+func newConfig(option Option, id string) (*raft.Config, error) {
+	if option.SnapshotInterval < 0 || option.HeartbeatTimeout < 0 || option.ElectionTimeout < 0 ||
+		option.MaxAppendEntries < 0 || option.SnapshotCount < 0 {
+		return nil, errno.ErrInvalidRaftConfig
+	}
+
+	config := raft.DefaultConfig()
+	config.LocalID = raft.ServerID(id)
+	config.LogOutput = os.Stdout
+
+	if option.SnapshotInterval > 0 {
+		config.SnapshotInterval = option.SnapshotInterval
+	}
+	if option.SnapshotThreshold > 0 {
+		config.SnapshotThreshold = option.SnapshotThreshold
+	}
+	if option.TrailingLogs > 0 {
+		config.TrailingLogs = option.TrailingLogs
+	}
+	if option.HeartbeatTimeout > 0 {
+		config.HeartbeatTimeout = option.HeartbeatTimeout
+	}
+	if option.ElectionTimeout > 0 {
+		config.ElectionTimeout = option.ElectionTimeout
+	}
+	if option.MaxAppendEntries > 0 {
+		config.MaxAppendEntries = option.MaxAppendEntries
+	}
+
+	// 租约不能超过心跳超时
+	if config.LeaderLeaseTimeout > config.HeartbeatTimeout {
+		config.LeaderLeaseTimeout = config.HeartbeatTimeout
+	}
+
+	if err := raft.ValidateConfig(config); err != nil {
+		return nil, fmt.Errorf("%w: %v", errno.ErrInvalidRaftConfig, err)
+	}
+	return config, nil
+}
+
+// Tuning 返回生效的raft参数
+func (peer *Peer) Tuning() Tuning {
+	return Tuning{
+		SnapshotInterval:   peer.config.SnapshotInterval,
+		SnapshotThreshold:  peer.config.SnapshotThreshold,
+		TrailingLogs:       peer.config.TrailingLogs,
+		HeartbeatTimeout:   peer.config.HeartbeatTimeout,
+		ElectionTimeout:    peer.config.ElectionTimeout,
+		LeaderLeaseTimeout: peer.config.LeaderLeaseTimeout,
+		MaxAppendEntries:   peer.config.MaxAppendEntries,
+		RetainSnapshots:    peer.snapCount,
+	}
+}
@@ -0,0 +1,60 @@
+package raft
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewPeer_Tuning(t *testing.T) {
+	// 未指定的参数使用默认值
+	peer, err := NewPeer(Option{}, "node1", nil)
+	assert.Nil(t, err)
+	def := raft.DefaultConfig()
+	assert.Equal(t, Tuning{
+		SnapshotInterval:   def.SnapshotInterval,
+		SnapshotThreshold:  def.SnapshotThreshold,
+		TrailingLogs:       def.TrailingLogs,
+		HeartbeatTimeout:   def.HeartbeatTimeout,
+		ElectionTimeout:    def.ElectionTimeout,
+		LeaderLeaseTimeout: def.LeaderLeaseTimeout,
+		MaxAppendEntries:   def.MaxAppendEntries,
+		RetainSnapshots:    retainSnapshotCount,
+	}, peer.Tuning())
+	assert.Equal(t, raft.Shutdown, peer.State())
+
+	// 租约随心跳超时缩短
+	peer, err = NewPeer(Option{
+		SnapshotCount:     3,
+		SnapshotInterval:  time.Minute,
+		SnapshotThreshold: 1000,
+		TrailingLogs:      100,
+		HeartbeatTimeout:  200 * time.Millisecond,
+		ElectionTimeout:   400 * time.Millisecond,
+		MaxAppendEntries:  32,
+	}, "node1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, Tuning{
+		SnapshotInterval:   time.Minute,
+		SnapshotThreshold:  1000,
+		TrailingLogs:       100,
+		HeartbeatTimeout:   200 * time.Millisecond,
+		ElectionTimeout:    400 * time.Millisecond,
+		LeaderLeaseTimeout: 200 * time.Millisecond,
+		MaxAppendEntries:   32,
+		RetainSnapshots:    3,
+	}, peer.Tuning())
+
+	for _, option := range []Option{
+		{ElectionTimeout: 100 * time.Millisecond, HeartbeatTimeout: 200 * time.Millisecond},
+		{HeartbeatTimeout: time.Millisecond},
+		{MaxAppendEntries: 2048},
+		{MaxAppendEntries: -1},
+		{SnapshotCount: -1},
+	} {
+		_, err = NewPeer(option, "node1", nil)
+		assert.ErrorIs(t, err, errno.ErrInvalidRaftConfig)
+	}
+}
@@ -3,15 +3,15 @@ package replica
 import (
 	"context"
 	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/cluster/router"
 	"github.com/T4t4KAU/TikBase/pkg/config"
 	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/rpc/replica"
 	"github.com/T4t4KAU/TikBase/pkg/rpc/replica/replicaservice"
-	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
-	"net"
 	"time"
 )
 
@@ -20,33 +20,52 @@ const (
 )
 
 type Service struct {
-	peer    *raft.Peer
+	router  *router.Router
 	address string
 	config  *config.ReplicaConfig
+	clients *Clients // 到其他节点副本服务的客户端
 }
 
 func (s *Service) GetId(ctx context.Context, req *replica.GetIdReq) (r *replica.GetIdResp, err error) {
-	r.NodeId = s.peer.ID()
+	r = new(replica.GetIdResp)
+	r.NodeId = s.config.Id
 	return
 }
 
+// ReplicaList 返回分区0的副本列表 节点加入时同时加入全部分区
 func (s *Service) ReplicaList() string {
-	return s.peer.ReplicaList()
+	return s.router.Groups()[0].Peer.ReplicaList()
 }
 
-func NewService(peer *raft.Peer, addr string, config *config.ReplicaConfig) *Service {
+func NewService(rt *router.Router, addr string, config *config.ReplicaConfig, clients *Clients) *Service {
 	return &Service{
-		peer:    peer,
+		router:  rt,
 		address: addr,
 		config:  config,
+		clients: clients,
 	}
 }
 
+// 返回请求指定分区的节点
+func (s *Service) peer(regionId int32) (*raft.Peer, error) {
+	g, err := s.router.Group(int(regionId))
+	if err != nil {
+		return nil, err
+	}
+	return g.Peer, nil
+}
+
 // Join implements the ConsisServiceImpl interface.
 func (s *Service) Join(ctx context.Context, req *replica.JoinReq) (resp *replica.JoinResp, err error) {
 	resp = new(replica.JoinResp)
 
-	err = s.peer.Join(req.NodeId, req.ServiceAddr, req.RaftAddr)
+	peer, err := s.peer(req.GetRegionId())
+	if err == nil {
+		err = s.checkJoin(req)
+	}
+	if err == nil {
+		err = peer.Join(req.NodeId, req.ServiceAddr, req.RaftAddr, req.GetReplicaAddr())
+	}
 	if err != nil {
 		resp.Message = err.Error()
 		return resp, err
@@ -55,30 +74,218 @@ func (s *Service) Join(ctx context.Context, req *replica.JoinReq) (resp *replica
 	return resp, nil
 }
 
+// 检查加入的节点 分区数量必须与集群一致 否则同一个key在不同节点上路由到不同的分区
+// 监听raft_addr时 raft地址不能与其他节点在其他分区上的地址相同 同一分区中相同地址的旧节点在加入时被替换
+// 通过副本服务转发时各分区共用副本服务地址 不需要检查
+func (s *Service) checkJoin(req *replica.JoinReq) error {
+	if req.IsSetRegions() && int(req.GetRegions()) != len(s.router.Groups()) {
+		return errno.ErrRegionCountMismatch
+	}
+	if s.config.KitexTransport() {
+		return nil
+	}
+	for _, g := range s.router.Groups() {
+		if g.ID == int(req.GetRegionId()) {
+			continue
+		}
+		members, err := g.Peer.Members()
+		if err != nil {
+			continue
+		}
+		for _, m := range members {
+			if m.Address == req.RaftAddr && m.ID != req.NodeId {
+				return errno.ErrRegionAddrConflict
+			}
+		}
+	}
+	return nil
+}
+
 // LeaderAddr implements the ReplicaServiceImpl interface.
 func (s *Service) LeaderAddr(ctx context.Context, req *replica.LeaderAddrReq) (resp *replica.LeaderAddrResp, err error) {
 	resp = new(replica.LeaderAddrResp)
-	resp.Address = s.peer.LeaderAddr()
+
+	peer, err := s.peer(req.GetRegionId())
+	if err != nil {
+		return resp, err
+	}
+	resp.Address = peer.LeaderAddr()
 
 	return
 }
 
+// AddNonvoter implements the ReplicaServiceImpl interface.
+func (s *Service) AddNonvoter(ctx context.Context, req *replica.AddNonvoterReq) (resp *replica.AddNonvoterResp, err error) {
+	resp = new(replica.AddNonvoterResp)
+	resp.Success, resp.Message = s.admin(req.GetRegionId(), func(peer *raft.Peer) error {
+		return peer.AddNonvoter(req.NodeId, req.ServiceAddr, req.RaftAddr)
+	})
+	return resp, nil
+}
+
+// RemoveServer implements the ReplicaServiceImpl interface.
+func (s *Service) RemoveServer(ctx context.Context, req *replica.RemoveServerReq) (resp *replica.RemoveServerResp, err error) {
+	resp = new(replica.RemoveServerResp)
+	resp.Success, resp.Message = s.admin(req.GetRegionId(), func(peer *raft.Peer) error {
+		return peer.RemoveServer(req.NodeId)
+	})
+	return resp, nil
+}
+
+// DemoteVoter implements the ReplicaServiceImpl interface.
+func (s *Service) DemoteVoter(ctx context.Context, req *replica.DemoteVoterReq) (resp *replica.DemoteVoterResp, err error) {
+	resp = new(replica.DemoteVoterResp)
+	resp.Success, resp.Message = s.admin(req.GetRegionId(), func(peer *raft.Peer) error {
+		return peer.DemoteVoter(req.NodeId)
+	})
+	return resp, nil
+}
+
+// TransferLeadership implements the ReplicaServiceImpl interface.
+func (s *Service) TransferLeadership(ctx context.Context, req *replica.TransferLeadershipReq) (resp *replica.TransferLeadershipResp, err error) {
+	resp = new(replica.TransferLeadershipResp)
+	resp.Success, resp.Message = s.admin(req.GetRegionId(), func(peer *raft.Peer) error {
+		return peer.TransferLeadership(req.GetNodeId())
+	})
+	return resp, nil
+}
+
+// Members implements the ReplicaServiceImpl interface.
+func (s *Service) Members(ctx context.Context, req *replica.MembersReq) (resp *replica.MembersResp, err error) {
+	resp = &replica.MembersResp{Members: []*replica.Member{}}
+
+	var members []raft.Member
+	resp.Success, resp.Message = s.admin(req.GetRegionId(), func(peer *raft.Peer) (err error) {
+		members, err = peer.Members()
+		return err
+	})
+	for _, m := range members {
+		resp.Members = append(resp.Members, &replica.Member{
+			NodeId:   m.ID,
+			RaftAddr: m.Address,
+			Suffrage: m.Suffrage,
+			Leader:   m.Leader,
+		})
+	}
+	return resp, nil
+}
+
+// Status implements the ReplicaServiceImpl interface.
+// 返回节点在指定分区上的状态和生效的raft参数 节点是领导者时包含各跟随者的复制进度
+func (s *Service) Status(ctx context.Context, req *replica.StatusReq) (resp *replica.StatusResp, err error) {
+	resp = &replica.StatusResp{NodeId: s.config.Id, Tuning: &replica.RaftTuning{}}
+
+	peer, err := s.peer(req.GetRegionId())
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	resp.Success = true
+	resp.State = peer.State().String()
+	resp.Leader = peer.LeaderAddr()
+	if region, err := s.router.Status(int(req.GetRegionId())); err == nil {
+		statusResp(resp, region)
+	}
+
+	tuning := peer.Tuning()
+	resp.Tuning = &replica.RaftTuning{
+		SnapshotInterval:   tuning.SnapshotInterval.Milliseconds(),
+		SnapshotThreshold:  int64(tuning.SnapshotThreshold),
+		TrailingLogs:       int64(tuning.TrailingLogs),
+		HeartbeatTimeout:   tuning.HeartbeatTimeout.Milliseconds(),
+		ElectionTimeout:    tuning.ElectionTimeout.Milliseconds(),
+		LeaderLeaseTimeout: tuning.LeaderLeaseTimeout.Milliseconds(),
+		MaxAppendEntries:   int32(tuning.MaxAppendEntries),
+		RetainSnapshots:    int32(tuning.RetainSnapshots),
+	}
+	return resp, nil
+}
+
+// RaftAppendEntries implements the ReplicaServiceImpl interface.
+func (s *Service) RaftAppendEntries(ctx context.Context, req *replica.RaftReq) (resp *replica.RaftResp, err error) {
+	return s.raft(req.RegionId, func(t *raft.RPCTransport) ([]byte, error) {
+		return t.HandleAppendEntries(req.Command)
+	}), nil
+}
+
+// RaftRequestVote implements the ReplicaServiceImpl interface.
+func (s *Service) RaftRequestVote(ctx context.Context, req *replica.RaftReq) (resp *replica.RaftResp, err error) {
+	return s.raft(req.RegionId, func(t *raft.RPCTransport) ([]byte, error) {
+		return t.HandleRequestVote(req.Command)
+	}), nil
+}
+
+// RaftTimeoutNow implements the ReplicaServiceImpl interface.
+func (s *Service) RaftTimeoutNow(ctx context.Context, req *replica.RaftReq) (resp *replica.RaftResp, err error) {
+	return s.raft(req.RegionId, func(t *raft.RPCTransport) ([]byte, error) {
+		return t.HandleTimeoutNow(req.Command)
+	}), nil
+}
+
+// RaftInstallSnapshot implements the ReplicaServiceImpl interface.
+func (s *Service) RaftInstallSnapshot(ctx context.Context, req *replica.RaftSnapshotReq) (resp *replica.RaftResp, err error) {
+	return s.raft(req.RegionId, func(t *raft.RPCTransport) ([]byte, error) {
+		return t.HandleInstallSnapshot(&raft.SnapshotChunk{
+			Session: req.Session,
+			Command: req.Command,
+			Offset:  req.Offset,
+			Data:    req.Data,
+			Done:    req.Done,
+		})
+	}), nil
+}
+
+// 交给指定分区的传输层处理raft消息 处理失败时返回错误信息
+func (s *Service) raft(regionId int32, fn func(t *raft.RPCTransport) ([]byte, error)) *replica.RaftResp {
+	resp := new(replica.RaftResp)
+
+	peer, err := s.peer(regionId)
+	if err == nil && peer.Transport() == nil {
+		err = errno.ErrRaftNotForwarded
+	}
+	if err == nil {
+		resp.Response, err = fn(peer.Transport())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return resp
+}
+
+// 在指定分区上执行成员管理 失败时返回错误信息 不是领导者时附带领导者的raft地址
+func (s *Service) admin(regionId int32, fn func(peer *raft.Peer) error) (bool, string) {
+	peer, err := s.peer(regionId)
+	if err != nil {
+		return false, err.Error()
+	}
+	if err = fn(peer); err == nil {
+		return true, ""
+	}
+	if err == errno.ErrNotLeader {
+		if leader := peer.LeaderAddr(); leader != "" {
+			return false, err.Error() + ", leader is at " + leader
+		}
+	}
+	return false, err.Error()
+}
+
 // Start 启动服务
 func (s *Service) Start() error {
-	addr, err := net.ResolveTCPAddr("tcp", s.address)
+	options, err := serverOptions(s.address, s.config.TLS)
 	if err != nil {
 		return err
 	}
 
-	err = s.peer.Bootstrap()
-	if err != nil {
-		return err
+	for _, g := range s.router.Groups() {
+		if err = g.Peer.Bootstrap(); err != nil {
+			return err
+		}
 	}
 
-	srv := replicaservice.NewServer(s,
+	srv := replicaservice.NewServer(s, append(options,
 		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: s.Name()}),
-		server.WithServiceAddr(addr),
-	)
+	)...)
 
 	klog.Infof("start replica service at %s", s.address)
 
@@ -88,27 +295,39 @@ func (s *Service) Start() error {
 
 	time.Sleep(joinDealyTime)
 
+	// 依次加入每个分区的复制组
 	if s.config.JoinAddr != "" {
-		err = join(s.config.RaftAddr, s.config.ServiceAddr, s.peer.ID(), s.config.JoinAddr)
+		for _, g := range s.router.Groups() {
+			raftAddr, err := s.config.RegionRaftAddr(g.ID)
+			if err != nil {
+				return err
+			}
+			if err = s.join(raftAddr, g.Peer.APIAddr(), g.Peer.ID(), g.ID); err != nil {
+				return err
+			}
+		}
 	}
 
-	return err
-
+	return nil
 }
 
 func (s *Service) Name() string {
 	return consts.ReplicaServiceName
 }
 
-func join(raftAddr, serviceAddr, nodeId string, joinAddr string) error {
-	cli, err := replicaservice.NewClient(consts.ReplicaServiceName, client.WithHostPorts(joinAddr))
+func (s *Service) join(raftAddr, serviceAddr, nodeId string, regionId int) error {
+	cli, err := s.clients.Get(s.config.JoinAddr)
 	if err != nil {
 		return err
 	}
+	id, regions := int32(regionId), int32(len(s.router.Groups()))
 	_, err = cli.Join(context.Background(), &replica.JoinReq{
 		RaftAddr:    raftAddr,
 		ServiceAddr: serviceAddr,
 		NodeId:      nodeId,
+		RegionId:    &id,
+		Regions:     &regions,
+		ReplicaAddr: &s.address,
 	})
 
 	if err != nil {
@@ -0,0 +1,110 @@
+package replica
+
+import (
+	"context"
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/cluster/router"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/replica"
+	"time"
+)
+
+/// 分区状态 跟随者的复制进度只有领导者记录
+/// 本节点不是领导者时向领导者的副本服务查询 使每个分区的状态都来自其领导者
+
+// LeaderStatus 返回分区在领导者上的状态 无法查询领导者时返回本节点的状态
+func (s *Service) LeaderStatus(ctx context.Context, id int) (router.RegionStatus, error) {
+	local, err := s.router.Status(id)
+	if err != nil || local.Node.State == "Leader" {
+		return local, err
+	}
+
+	g, err := s.router.Group(id)
+	if err != nil {
+		return local, nil
+	}
+	addr := g.Peer.LeaderReplicaAddr()
+	if addr == "" && s.config.KitexTransport() {
+		addr = g.Peer.LeaderAddr() // 通过副本服务转发时raft地址就是副本服务地址
+	}
+	if addr == "" {
+		return local, nil
+	}
+
+	cli, err := s.clients.Get(addr)
+	if err != nil {
+		return local, nil
+	}
+	regionId := int32(id)
+	resp, err := cli.Status(ctx, &replica.StatusReq{RegionId: &regionId})
+	if err != nil || !resp.Success || resp.State != "Leader" {
+		return local, nil
+	}
+	return regionStatus(id, resp), nil
+}
+
+// 将分区状态写入响应 时间转换为毫秒
+func statusResp(resp *replica.StatusResp, region router.RegionStatus) {
+	status := region.Node
+	term, last, commit := int64(status.Term), int64(status.LastIndex), int64(status.CommitIndex)
+	applied, snapshot := int64(status.AppliedIndex), int64(status.SnapshotIndex)
+	contact, replicas := milliseconds(status.LastContact), int32(region.Replicas)
+
+	resp.RaftAddr = &status.Address
+	resp.Term, resp.LastIndex, resp.CommitIndex = &term, &last, &commit
+	resp.AppliedIndex, resp.SnapshotIndex = &applied, &snapshot
+	resp.LastContact, resp.Replicas = &contact, &replicas
+	for _, p := range status.Followers {
+		resp.Followers = append(resp.Followers, &replica.Progress{
+			NodeId:      p.ID,
+			RaftAddr:    p.Address,
+			Suffrage:    p.Suffrage,
+			MatchIndex:  int64(p.MatchIndex),
+			LagEntries:  int64(p.LagEntries),
+			LagTime:     milliseconds(p.LagTime),
+			LastContact: milliseconds(p.LastContact),
+		})
+	}
+}
+
+// 从响应中恢复分区状态
+func regionStatus(id int, resp *replica.StatusResp) router.RegionStatus {
+	status := raft.Status{
+		ID:            resp.NodeId,
+		Address:       resp.GetRaftAddr(),
+		State:         resp.State,
+		Leader:        resp.Leader,
+		Term:          uint64(resp.GetTerm()),
+		LastIndex:     uint64(resp.GetLastIndex()),
+		CommitIndex:   uint64(resp.GetCommitIndex()),
+		AppliedIndex:  uint64(resp.GetAppliedIndex()),
+		SnapshotIndex: uint64(resp.GetSnapshotIndex()),
+		LastContact:   duration(resp.GetLastContact()),
+	}
+	for _, p := range resp.Followers {
+		status.Followers = append(status.Followers, raft.Progress{
+			ID:          p.NodeId,
+			Address:     p.RaftAddr,
+			Suffrage:    p.Suffrage,
+			MatchIndex:  uint64(p.MatchIndex),
+			LagEntries:  uint64(p.LagEntries),
+			LagTime:     duration(p.LagTime),
+			LastContact: duration(p.LastContact),
+		})
+	}
+	return router.RegionStatus{ID: id, Replicas: int(resp.GetReplicas()), Node: status}
+}
+
+// 转换为毫秒 未知的时间保持为-1
+func milliseconds(d time.Duration) int64 {
+	if d < 0 {
+		return -1
+	}
+	return d.Milliseconds()
+}
+
+func duration(ms int64) time.Duration {
+	if ms < 0 {
+		return -1
+	}
+	return time.Duration(ms) * time.Millisecond
+}
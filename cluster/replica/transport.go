@@ -0,0 +1,165 @@
+package replica
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/consts"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/replica"
+	"github.com/T4t4KAU/TikBase/pkg/rpc/replica/replicaservice"
+	"github.com/T4t4KAU/TikBase/pkg/secure"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/remote/trans/gonet"
+	"github.com/cloudwego/kitex/server"
+	"net"
+	"sync"
+	"time"
+)
+
+/// raft消息通过副本服务转发 每个分区的复制组使用独立的Caller 共用到各节点的客户端
+/// 启用TLS时副本服务使用go net传输层监听TLS端口 客户端使用TLS拨号 双方互相验证证书
+
+const (
+	connectTimeout         = time.Second
+	raftRPCTimeout         = 10 * time.Second
+	snapshotInstallTimeout = 10 * time.Minute // 最后一个快照分块等待跟随者恢复快照
+)
+
+// Clients 到各节点副本服务的客户端
+type Clients struct {
+	options []client.Option
+	mutex   sync.Mutex
+	clients map[string]replicaservice.Client // 副本服务地址 -> 客户端
+}
+
+// NewClients 创建客户端集合 配置了TLS证书时使用双向TLS连接
+func NewClients(c config.TLSConfig) (*Clients, error) {
+	cfg, err := secure.ClientConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return &Clients{
+		options: ClientOptions(cfg),
+		clients: make(map[string]replicaservice.Client),
+	}, nil
+}
+
+// ClientOptions 返回连接副本服务的客户端选项 cfg为空时不加密
+func ClientOptions(cfg *tls.Config) []client.Option {
+	options := []client.Option{client.WithConnectTimeout(connectTimeout)}
+	if cfg != nil {
+		options = append(options,
+			client.WithDialer(&secure.Dialer{Config: cfg}),
+			client.WithTransHandlerFactory(gonet.NewCliTransHandlerFactory()),
+		)
+	}
+	return options
+}
+
+// Get 返回到指定地址的客户端
+func (c *Clients) Get(addr string) (replicaservice.Client, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cli, ok := c.clients[addr]; ok {
+		return cli, nil
+	}
+	options := append([]client.Option{client.WithHostPorts(addr)}, c.options...)
+	cli, err := replicaservice.NewClient(consts.ReplicaServiceName, options...)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[addr] = cli
+	return cli, nil
+}
+
+// Caller 返回转发指定分区raft消息的Caller
+func (c *Clients) Caller(regionId int) raft.Caller {
+	return &caller{clients: c, regionId: int32(regionId)}
+}
+
+type caller struct {
+	clients  *Clients
+	regionId int32
+}
+
+func (c *caller) AppendEntries(target string, command []byte) ([]byte, error) {
+	return c.call(target, func(ctx context.Context, cli replicaservice.Client) (*replica.RaftResp, error) {
+		return cli.RaftAppendEntries(ctx, &replica.RaftReq{RegionId: c.regionId, Command: command},
+			callopt.WithRPCTimeout(raftRPCTimeout))
+	})
+}
+
+func (c *caller) RequestVote(target string, command []byte) ([]byte, error) {
+	return c.call(target, func(ctx context.Context, cli replicaservice.Client) (*replica.RaftResp, error) {
+		return cli.RaftRequestVote(ctx, &replica.RaftReq{RegionId: c.regionId, Command: command},
+			callopt.WithRPCTimeout(raftRPCTimeout))
+	})
+}
+
+func (c *caller) TimeoutNow(target string, command []byte) ([]byte, error) {
+	return c.call(target, func(ctx context.Context, cli replicaservice.Client) (*replica.RaftResp, error) {
+		return cli.RaftTimeoutNow(ctx, &replica.RaftReq{RegionId: c.regionId, Command: command},
+			callopt.WithRPCTimeout(raftRPCTimeout))
+	})
+}
+
+func (c *caller) InstallSnapshot(target string, chunk *raft.SnapshotChunk) ([]byte, error) {
+	timeout := raftRPCTimeout
+	if chunk.Done {
+		timeout = snapshotInstallTimeout
+	}
+	return c.call(target, func(ctx context.Context, cli replicaservice.Client) (*replica.RaftResp, error) {
+		return cli.RaftInstallSnapshot(ctx, &replica.RaftSnapshotReq{
+			RegionId: c.regionId,
+			Session:  chunk.Session,
+			Command:  chunk.Command,
+			Offset:   chunk.Offset,
+			Data:     chunk.Data,
+			Done:     chunk.Done,
+		}, callopt.WithRPCTimeout(timeout))
+	})
+}
+
+func (c *caller) call(target string, fn func(context.Context, replicaservice.Client) (*replica.RaftResp, error)) ([]byte, error) {
+	cli, err := c.clients.Get(target)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fn(context.Background(), cli)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return resp.Response, nil
+}
+
+// 副本服务的监听选项 配置了TLS证书时监听TLS端口并要求客户端证书
+func serverOptions(addr string, c config.TLSConfig) ([]server.Option, error) {
+	cfg, err := secure.ServerConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		return []server.Option{server.WithServiceAddr(tcpAddr)}, nil
+	}
+
+	ln, err := tls.Listen("tcp", addr, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return []server.Option{
+		server.WithListener(ln),
+		server.WithTransServerFactory(gonet.NewTransServerFactory()),
+		server.WithTransHandlerFactory(gonet.NewSvrTransHandlerFactory()),
+	}, nil
+}
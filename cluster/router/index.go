@@ -0,0 +1,137 @@
+package router
+
+import (
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+)
+
+/// 配额和二级索引由各分区的存储引擎分别维护
+/// 查询时汇总全部分区的结果 修改时通过各分区的raft提交
+
+// NamespaceUsage 汇总各分区中命名空间的使用情况 配额限制在每个分区内分别生效
+func (r *Router) NamespaceUsage() []quota.Usage {
+	var usages []quota.Usage
+	index := make(map[string]int)
+	for _, g := range r.groups {
+		reporter, ok := g.Engine.(quota.Reporter)
+		if !ok {
+			continue
+		}
+		for _, usage := range reporter.NamespaceUsage() {
+			i, ok := index[usage.Prefix]
+			if !ok {
+				index[usage.Prefix] = len(usages)
+				usages = append(usages, usage)
+				continue
+			}
+			usages[i].Bytes += usage.Bytes
+			usages[i].Keys += usage.Keys
+		}
+	}
+	return usages
+}
+
+// CreateHashIndex 在全部分区上创建二级索引 各副本应用日志时建立索引
+// 当前节点不是某个分区的领导者时返回ErrNotLeader 已经创建的分区在重试时跳过
+func (r *Router) CreateHashIndex(name, field string) error {
+	return r.applyIndex(iface.Command{Ins: iface.CREATE_HASH_INDEX, Key: name, Field: field}, errno.ErrHashIndexExists,
+		func(indexes []bases.HashIndex) bool {
+			for _, idx := range indexes {
+				if idx.Name == name && idx.Field == field {
+					return true
+				}
+			}
+			return false
+		})
+}
+
+// DropHashIndex 删除全部分区上的二级索引 已经删除的分区在重试时跳过
+func (r *Router) DropHashIndex(name string) error {
+	return r.applyIndex(iface.Command{Ins: iface.DROP_HASH_INDEX, Key: name}, errno.ErrHashIndexNotFound,
+		func(indexes []bases.HashIndex) bool {
+			for _, idx := range indexes {
+				if idx.Name == name {
+					return false
+				}
+			}
+			return true
+		})
+}
+
+// 通过各分区的raft提交二级索引的修改 done判断分区是否已经完成修改
+// 全部分区都已完成时返回exist
+func (r *Router) applyIndex(c iface.Command, exist error, done func([]bases.HashIndex) bool) error {
+	pending := make([]*Group, 0, len(r.groups))
+	for _, g := range r.groups {
+		indexer, ok := g.Engine.(engine.HashIndexer)
+		if !ok {
+			return errno.ErrHashIndexNotSupported
+		}
+		if !done(indexer.HashIndexes()) {
+			pending = append(pending, g)
+		}
+	}
+	if len(pending) == 0 {
+		return exist
+	}
+
+	for _, g := range pending {
+		res, err := g.Peer.Apply(c)
+		if err != nil {
+			return err
+		}
+		if !res.Success() {
+			return res.Error()
+		}
+	}
+	return nil
+}
+
+// HashIndexes 返回二级索引 全部分区都建立完成后索引才就绪
+func (r *Router) HashIndexes() []bases.HashIndex {
+	var indexes []bases.HashIndex
+	index := make(map[string]int)
+	_ = r.eachIndexer(func(indexer engine.HashIndexer) error {
+		for _, idx := range indexer.HashIndexes() {
+			i, ok := index[idx.Name]
+			if !ok {
+				index[idx.Name] = len(indexes)
+				indexes = append(indexes, idx)
+				continue
+			}
+			indexes[i].Ready = indexes[i].Ready && idx.Ready
+		}
+		return nil
+	})
+	return indexes
+}
+
+// RangeHashIndex 合并各分区的查询结果
+func (r *Router) RangeHashIndex(name string, min, max []byte) ([][]byte, error) {
+	var keys [][]byte
+	err := r.eachIndexer(func(indexer engine.HashIndexer) error {
+		res, err := indexer.RangeHashIndex(name, min, max)
+		keys = append(keys, res...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *Router) eachIndexer(fn func(indexer engine.HashIndexer) error) error {
+	for _, g := range r.groups {
+		indexer, ok := g.Engine.(engine.HashIndexer)
+		if !ok {
+			return errno.ErrHashIndexNotSupported
+		}
+		if err := fn(indexer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
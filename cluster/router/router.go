@@ -0,0 +1,145 @@
+package router
+
+import (
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"time"
+)
+
+/// 分区路由 键空间按key的哈希值划分为固定数量的分区
+/// 每个分区由独立的raft复制组复制 复制组的成员分布在多个节点上
+/// 所有节点使用相同的分区数量 因此同一个key在任意节点上都路由到相同的分区
+
+// Group 分区的复制组
+type Group struct {
+	ID     int
+	Peer   *raft.Peer
+	Engine iface.Engine
+}
+
+// Region 分区到复制组的元数据
+type Region struct {
+	ID      int           `json:"id"`
+	Leader  string        `json:"leader"` // 领导者的raft地址
+	Members []raft.Member `json:"members"`
+}
+
+// Router 分区路由
+type Router struct {
+	groups []*Group
+}
+
+// New 创建分区路由 复制组按分区编号排列
+func New(groups []*Group) (*Router, error) {
+	if len(groups) == 0 {
+		return nil, errno.ErrInvalidRegionCount
+	}
+	return &Router{groups: groups}, nil
+}
+
+// Route 返回逻辑数据库中的key所在的复制组
+func (r *Router) Route(db, key string) *Group {
+	return r.Locate(append(iface.DBPrefix(db), key...))
+}
+
+// Locate 返回存储引擎中的key所在的复制组
+func (r *Router) Locate(key []byte) *Group {
+	return r.groups[crc32.ChecksumIEEE(key)%uint32(len(r.groups))]
+}
+
+// Group 返回指定编号的复制组
+func (r *Router) Group(id int) (*Group, error) {
+	if id < 0 || id >= len(r.groups) {
+		return nil, errno.ErrRegionNotFound
+	}
+	return r.groups[id], nil
+}
+
+// Groups 返回全部复制组
+func (r *Router) Groups() []*Group {
+	return r.groups
+}
+
+// Regions 返回分区元数据 复制组未启动时成员为空
+func (r *Router) Regions() []Region {
+	regions := make([]Region, 0, len(r.groups))
+	for _, g := range r.groups {
+		region := Region{ID: g.ID, Leader: g.Peer.LeaderAddr()}
+		if members, err := g.Peer.Members(); err == nil {
+			region.Members = members
+		}
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// Exec 将指令交给key所在分区的存储引擎执行 清空和统计逻辑数据库时访问全部分区
+// 清空逻辑数据库通过各分区的raft提交 当前节点不是某个分区的领导者时返回ErrNotLeader 由调用方转发给领导者
+func (r *Router) Exec(ins iface.INS, args [][]byte) iface.Result {
+	switch ins {
+	case iface.FLUSH_DB:
+		prefix, err := engine.ParseDBArgs(args)
+		if err != nil {
+			return engine.NewBaseErrResult(err)
+		}
+		db, _, _ := iface.SplitDB(prefix)
+		var res iface.Result
+		for _, g := range r.groups {
+			if res, err = g.Peer.Apply(iface.Command{Ins: ins, DB: db}); err != nil {
+				return engine.NewBaseErrResult(err)
+			}
+			if !res.Success() {
+				return res
+			}
+		}
+		return res
+	case iface.DB_SIZE:
+		var size int
+		for _, g := range r.groups {
+			res := g.Engine.Exec(ins, args)
+			if !res.Success() {
+				return res
+			}
+			n, err := strconv.Atoi(res.String())
+			if err != nil {
+				return engine.NewBaseErrResult(err)
+			}
+			size += n
+		}
+		return engine.NewBaseResult(true, []byte(strconv.Itoa(size)), nil)
+	}
+
+	if ins == iface.ECHO || len(args) == 0 {
+		return r.groups[0].Engine.Exec(ins, args)
+	}
+	return r.Locate(args[0]).Engine.Exec(ins, args)
+}
+
+// Snapshot 快照由各分区的复制组分别生成
+func (r *Router) Snapshot() (iface.Snapshot, error) {
+	return nil, errno.ErrSnapshotNotSupported
+}
+
+func (r *Router) Restore(rd io.Reader) error {
+	return errno.ErrSnapshotNotSupported
+}
+
+// ReadBarrier 等待全部分区满足一致性级别的要求 用于统计逻辑数据库等访问全部分区的读请求
+func (r *Router) ReadBarrier(level iface.ConsistencyLevel, maxStaleness time.Duration) error {
+	for _, g := range r.groups {
+		if err := g.Peer.ReadBarrier(level, maxStaleness); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KeyReadBarrier 只等待key所在的分区满足一致性级别的要求
+func (r *Router) KeyReadBarrier(key []byte, level iface.ConsistencyLevel, maxStaleness time.Duration) error {
+	return r.Locate(key).Peer.ReadBarrier(level, maxStaleness)
+}
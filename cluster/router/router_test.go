@@ -0,0 +1,140 @@
+package router
+
+import (
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestRouter(t *testing.T, n int) *Router {
+	groups := make([]*Group, n)
+	for i := range groups {
+		eng, err := engine.NewBaseEngineWith(config.BaseStoreConfig{
+			Directory:    t.TempDir(),
+			DatafileSize: 1 << 20,
+		})
+		assert.Nil(t, err)
+
+		// 单节点复制组 清空逻辑数据库等通过raft提交的指令需要领导者
+		peer, err := raft.NewPeer(raft.Option{
+			RaftDir:          t.TempDir(),
+			RaftBind:         "127.0.0.1:0",
+			Single:           true,
+			HeartbeatTimeout: 50 * time.Millisecond,
+			ElectionTimeout:  50 * time.Millisecond,
+		}, "node1", eng)
+		assert.Nil(t, err)
+		assert.Nil(t, peer.Bootstrap())
+		t.Cleanup(func() {
+			_ = peer.Shutdown()
+		})
+		for !peer.IsLeader() {
+			time.Sleep(10 * time.Millisecond)
+		}
+		groups[i] = &Group{ID: i, Peer: peer, Engine: eng}
+	}
+	rt, err := New(groups)
+	assert.Nil(t, err)
+	return rt
+}
+
+func TestRouter_Exec(t *testing.T) {
+	_, err := New(nil)
+	assert.Equal(t, errno.ErrInvalidRegionCount, err)
+
+	rt := newTestRouter(t, 4)
+	_, err = rt.Group(4)
+	assert.Equal(t, errno.ErrRegionNotFound, err)
+
+	db := engine.Select(rt, "1")
+	for i := 0; i < 100; i++ {
+		key := "key" + strconv.Itoa(i)
+		assert.True(t, db.Exec(iface.SET_STR, [][]byte{[]byte(key), []byte("v")}).Success())
+
+		// 数据只写入key所在的分区
+		g := rt.Route("1", key)
+		for _, other := range rt.Groups() {
+			res := engine.Select(other.Engine, "1").Exec(iface.GET_STR, utils.KeyBytes(key))
+			assert.Equal(t, other == g, res.Success())
+		}
+	}
+
+	// 数据分布到多个分区
+	for _, g := range rt.Groups() {
+		size, err := engine.Select(g.Engine, "1").Size()
+		assert.Nil(t, err)
+		assert.Greater(t, size, 0)
+	}
+
+	size, err := db.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 100, size)
+
+	assert.True(t, engine.Select(rt, "2").Exec(iface.SET_STR, [][]byte{[]byte("key0"), []byte("v")}).Success())
+	assert.Nil(t, db.FlushDB())
+	size, err = db.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, size)
+	size, err = engine.Select(rt, "2").Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, size)
+
+	// 不是领导者的节点不清空本地数据 由调用方转发给领导者
+	follower, err := raft.NewPeer(raft.Option{RaftDir: t.TempDir()}, "node2", rt.groups[0].Engine)
+	assert.Nil(t, err)
+	rt.groups[0].Peer = follower
+	assert.Equal(t, errno.ErrNotLeader, engine.Select(rt, "2").FlushDB())
+}
+
+func TestRouter_HashIndex(t *testing.T) {
+	rt := newTestRouter(t, 2)
+
+	// 二级索引的修改通过各分区的raft提交
+	assert.Nil(t, rt.CreateHashIndex("age", "age"))
+	assert.Equal(t, errno.ErrHashIndexExists, rt.CreateHashIndex("age", "age"))
+	for _, g := range rt.Groups() {
+		assert.Equal(t, 1, len(g.Engine.(engine.HashIndexer).HashIndexes()))
+	}
+
+	follower, err := raft.NewPeer(raft.Option{RaftDir: t.TempDir()}, "node2", rt.groups[1].Engine)
+	assert.Nil(t, err)
+	leader := rt.groups[1].Peer
+	rt.groups[1].Peer = follower
+	assert.Equal(t, errno.ErrNotLeader, rt.DropHashIndex("age"))
+	assert.Equal(t, 1, len(rt.groups[1].Engine.(engine.HashIndexer).HashIndexes()))
+
+	rt.groups[1].Peer = leader
+	assert.Nil(t, rt.DropHashIndex("age"))
+	assert.Equal(t, 0, len(rt.HashIndexes()))
+}
+
+func TestSummarize(t *testing.T) {
+	regions := []RegionStatus{
+		{ID: 0, Replicas: 3, Node: raft.Status{State: "Leader", Leader: "node1", Followers: []raft.Progress{
+			{ID: "node2"},
+			{ID: "node3", LagEntries: 5, LagTime: time.Second},
+		}}},
+		{ID: 1, Replicas: 3, Node: raft.Status{State: "Follower", Leader: "node2"}},
+		{ID: 2, Replicas: 3, Node: raft.Status{State: "Leader", Leader: "node1", Followers: []raft.Progress{
+			{ID: "node2", LagEntries: 8, LagTime: -1},
+			{ID: "node3"},
+		}}},
+		{ID: 3, Replicas: 2, Node: raft.Status{State: "Candidate"}},
+	}
+	assert.Equal(t, ClusterStatus{
+		Regions:       4,
+		Leaders:       2,
+		Leaderless:    1,
+		Replicas:      11,
+		Lagging:       2,
+		MaxLagEntries: 8,
+		MaxLagTime:    time.Second,
+	}, Summarize(regions))
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"github.com/T4t4KAU/TikBase/cluster/replica/raft"
+	"time"
+)
+
+/// 分区状态 跟随者的复制进度只有领导者记录
+/// 汇总集群状态时每个分区使用其领导者上的状态 每个复制组只计算一次
+
+// RegionStatus 分区复制组在一个节点上的状态
+type RegionStatus struct {
+	ID       int
+	Replicas int         // 复制组成员数
+	Node     raft.Status // 节点的状态 节点是领导者时包含跟随者的复制进度
+}
+
+// ClusterStatus 全部分区的汇总
+type ClusterStatus struct {
+	Regions       int
+	Leaders       int // 状态来自领导者的分区数
+	Leaderless    int // 没有领导者的分区数
+	Replicas      int // 全部复制组的成员数
+	Lagging       int // 落后领导者的跟随者数
+	MaxLagEntries uint64
+	MaxLagTime    time.Duration
+}
+
+// Status 返回指定分区在本节点上的状态
+func (r *Router) Status(id int) (RegionStatus, error) {
+	g, err := r.Group(id)
+	if err != nil {
+		return RegionStatus{}, err
+	}
+
+	status, err := g.Peer.Status()
+	if err != nil {
+		return RegionStatus{}, err
+	}
+	members, err := g.Peer.Members()
+	if err != nil {
+		return RegionStatus{}, err
+	}
+	return RegionStatus{ID: g.ID, Replicas: len(members), Node: status}, nil
+}
+
+// Statuses 返回全部分区在本节点上的状态
+func (r *Router) Statuses() ([]RegionStatus, error) {
+	regions := make([]RegionStatus, 0, len(r.groups))
+	for _, g := range r.groups {
+		status, err := r.Status(g.ID)
+		if err != nil {
+			return nil, err
+		}
+		regions = append(regions, status)
+	}
+	return regions, nil
+}
+
+// Summarize 汇总分区状态 每个分区应使用其领导者上的状态 从未成功复制的跟随者计为落后
+// 无法获得领导者状态的分区不计入落后的跟随者
+func Summarize(regions []RegionStatus) ClusterStatus {
+	cs := ClusterStatus{Regions: len(regions)}
+	for _, region := range regions {
+		cs.Replicas += region.Replicas
+		if region.Node.Leader == "" {
+			cs.Leaderless++
+		}
+		if region.Node.State != "Leader" {
+			continue
+		}
+		cs.Leaders++
+		for _, p := range region.Node.Followers {
+			if p.LagEntries == 0 {
+				continue
+			}
+			cs.Lagging++
+			if p.LagEntries > cs.MaxLagEntries {
+				cs.MaxLagEntries = p.LagEntries
+			}
+			if p.LagTime > cs.MaxLagTime {
+				cs.MaxLagTime = p.LagTime
+			}
+		}
+	}
+	return cs
+}
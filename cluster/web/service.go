@@ -4,19 +4,26 @@ import (
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/consts"
 	"github.com/T4t4KAU/TikBase/pkg/net/http"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
 )
 
 // Service Web服务
 type Service struct {
-	Address string       // 服务地址
-	eng     iface.Engine // 存储引擎
+	Address string              // 服务地址
+	eng     iface.Engine        // 存储引擎
+	queue   *queue.MessageQueue // 消息队列
+	barrier iface.ReadBarrier   // 读屏障
+	applier iface.Applier       // 写请求通过复制状态机提交
 }
 
 // NewService 创建Web服务
-func NewService(addr string, eng iface.Engine) *Service {
+func NewService(addr string, eng iface.Engine, mq *queue.MessageQueue, barrier iface.ReadBarrier, applier iface.Applier) *Service {
 	return &Service{
 		Address: addr,
 		eng:     eng,
+		queue:   mq,
+		barrier: barrier,
+		applier: applier,
 	}
 }
 
@@ -26,5 +33,8 @@ func (s *Service) Name() string {
 
 // Start 启动服务
 func (s *Service) Start() error {
-	return http.StartServer(s.Address, s.eng)
+	srv := http.NewServer(s.eng, s.queue)
+	srv.SetReadBarrier(s.barrier)
+	srv.SetApplier(s.applier)
+	return srv.Run(s.Address)
 }
@@ -8,11 +8,13 @@ import (
 	"github.com/T4t4KAU/TikBase/pkg/config"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"time"
 )
 
 type BaseEngine struct {
 	*bases.Base
 	execFunc map[iface.INS]ExecFunc
+	notifier iface.Notifier // 键空间事件通知
 }
 
 type BaseResult struct {
@@ -108,9 +110,24 @@ func NewBaseEngineWith(config config.BaseStoreConfig) (*BaseEngine, error) {
 		DataFileSize:       int64(config.DatafileSize),
 		SyncWrites:         config.SyncWrites,
 		IndexType:          bases.NewIndexerType(config.Indexer),
+		IndexShards:        config.IndexShards,
 		BytesPerSync:       uint(config.BytesPerSync),
 		MMapAtStartup:      config.MmapAtStartup,
+		IOType:             bases.NewIOType(config.IOType),
 		DataFileMergeRatio: float32(config.DatafileMergeRatio),
+		ChangeCapture:      config.ChangeCapture,
+		Quotas:             toQuotas(config.Namespaces),
+		CheckpointInterval: time.Duration(config.Checkpoint) * time.Second,
+		LoadConcurrency:    config.LoadWorkers,
+		BlockCacheSize:     int64(config.BlockCacheSize),
+		BloomFalsePositive: config.BloomFalsePositive,
+	}
+	if option.LoadConcurrency <= 0 {
+		option.LoadConcurrency = bases.DefaultOptions.LoadConcurrency
+	}
+	option.ChangeRetention = bases.DefaultOptions.ChangeRetention
+	if config.ChangeRetention > 0 {
+		option.ChangeRetention = time.Duration(config.ChangeRetention) * time.Second
 	}
 
 	base, err := bases.NewBaseWith(option)
@@ -129,11 +146,18 @@ func NewBaseEngineWith(config config.BaseStoreConfig) (*BaseEngine, error) {
 
 func (eng *BaseEngine) Exec(ins iface.INS, args [][]byte) iface.Result {
 	if fn, ok := eng.execFunc[ins]; ok {
-		return fn(args)
+		res := fn(args)
+		notify(eng.notifier, ins, args, res)
+		return res
 	}
 	return NewUnknownBaseResult()
 }
 
+// SetNotifier 设置键空间事件通知器
+func (eng *BaseEngine) SetNotifier(n iface.Notifier) {
+	eng.notifier = n
+}
+
 func (eng *BaseEngine) registerExecFunc(ins iface.INS, fn ExecFunc) {
 	eng.execFunc[ins] = fn
 }
@@ -150,6 +174,12 @@ func (eng *BaseEngine) initExecFunc() {
 	eng.registerExecFunc(iface.ADD_SET, eng.ExecSetAdd)
 	eng.registerExecFunc(iface.REM_SET, eng.ExecSetRem)
 	eng.registerExecFunc(iface.IS_MEMBER_SET, eng.ExecSetIsMember)
+	eng.registerExecFunc(iface.ADD_ZSET, eng.ExecZSetAdd)
+	eng.registerExecFunc(iface.REM_ZSET, eng.ExecZSetRem)
+	eng.registerExecFunc(iface.FLUSH_DB, eng.ExecFlushDB)
+	eng.registerExecFunc(iface.DB_SIZE, eng.ExecDBSize)
+	eng.registerExecFunc(iface.CREATE_HASH_INDEX, execCreateHashIndex(eng))
+	eng.registerExecFunc(iface.DROP_HASH_INDEX, execDropHashIndex(eng))
 }
 
 func (eng *BaseEngine) ExecStrSet(args [][]byte) iface.Result {
@@ -294,6 +324,16 @@ func (eng *BaseEngine) ExecZSetAdd(args [][]byte) iface.Result {
 	return NewBaseErrResult(err)
 }
 
-func (eng *BaseEngine) RecoverFromBytes(data []byte) error {
-	return eng.Base.RecoverFromBytes(data)
+func (eng *BaseEngine) ExecZSetRem(args [][]byte) iface.Result {
+	key, member, err := ParseZSetRemArgs(args)
+	if err != nil {
+		return NewBaseErrResult(err)
+	}
+	_, err = eng.ZRem(key, member)
+	return NewBaseErrResult(err)
+}
+
+// Snapshot 生成快照 写出时按位置读取数据 不阻塞之后的写入
+func (eng *BaseEngine) Snapshot() (iface.Snapshot, error) {
+	return eng.Base.NewSnapshot(), nil
 }
@@ -1,18 +1,22 @@
 package bases
 
 import (
-	"bytes"
-	"encoding/gob"
 	"errors"
 	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/quota"
 	"github.com/T4t4KAU/TikBase/engine/values"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/dates/artree"
+	"github.com/T4t4KAU/TikBase/pkg/dates/bptree"
 	"github.com/T4t4KAU/TikBase/pkg/dates/btree"
+	"github.com/T4t4KAU/TikBase/pkg/dates/shard"
+	"github.com/T4t4KAU/TikBase/pkg/dates/slist"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/fio"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/gofrs/flock"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -20,37 +24,64 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
 	SeqNoKey     = "seq.no"
 	fileLockName = "flock"
+	keyLockCount = 256 // key分段锁数量
 )
 
-type Item struct {
-	Key   []byte
-	Type  iface.Type
-	Value []byte
+// NewIndexer 根据类型初始化索引
+func NewIndexer(typ IndexerType, dirPath string, sync bool) iface.Indexer {
+	return NewShardedIndexer(typ, 1, dirPath, sync)
 }
 
-// NewIndexer 根据类型初始化索引
-func NewIndexer(typ IndexerType) iface.Indexer {
+// NewShardedIndexer 初始化分片索引 分片数不大于1时不分片
+// 持久化索引保存在单个文件中 不支持分片
+func NewShardedIndexer(typ IndexerType, shards int, dirPath string, sync bool) iface.Indexer {
+	if shards > 1 && typ != BPT {
+		return shard.New(shards, func() iface.Indexer {
+			return newIndexer(typ, dirPath, sync)
+		})
+	}
+	return newIndexer(typ, dirPath, sync)
+}
+
+func newIndexer(typ IndexerType, dirPath string, sync bool) iface.Indexer {
 	switch typ {
 	case BT:
 		return btree.New()
 	case ART:
 		return artree.New()
+	case SL:
+		return slist.New()
+	case BPT:
+		return bptree.New(dirPath, sync)
 	default:
 		panic("unsupported index type")
 	}
 }
 
+// 关闭索引 持久化索引需要关闭索引文件
+func (b *Base) closeIndex() error {
+	if closer, ok := b.index.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // Base 存储引擎
 type Base struct {
-	index           iface.Indexer // 索引 保存key和日志的映射
-	mutex           sync.RWMutex
-	activeFile      *data.File            // 活跃文件
-	olderFiles      map[uint32]*data.File // 旧文件
+	index           iface.Indexer            // 索引 保存key和日志的映射
+	mutex           sync.RWMutex             // 写入持有读锁 合并 快照和检查点等要求索引与数据文件一致的操作持有写锁
+	appendMutex     sync.Mutex               // 追加日志时持有 保证日志记录连续写入
+	fileMutex       sync.RWMutex             // 保护活跃文件和旧文件表 读取数据时只持有该锁
+	keyLocks        [keyLockCount]sync.Mutex // 按key分段 保证同一个key的写入与索引更新顺序一致
+	activeFile      *data.File               // 活跃文件
+	olderFiles      map[uint32]*data.File    // 旧文件
 	options         Options
 	fileIds         []int        // 加载索引时使用
 	fileLock        *flock.Flock // 文件锁
@@ -58,6 +89,18 @@ type Base struct {
 	merging         bool         // 标记是否正在merge
 	bytesWrite      uint         // 累积写入字节数
 	reclaimableSize int64        // 可回收磁盘空间容量
+	changeMutex     sync.Mutex
+	changed         chan struct{}  // 有新写入时关闭 用于唤醒变更读取者
+	quotas          *quota.Manager // 命名空间配额
+	checkpointMutex sync.Mutex
+	bgWait          sync.WaitGroup // 等待后台任务退出
+	closeCh         chan struct{}
+	closeOnce       sync.Once     // 重复关闭时直接返回
+	loadTime        time.Duration // 启动时加载索引耗时
+	hashMutex       sync.Mutex    // 保证哈希写入与二级索引更新串行
+	hashIndexes     map[string]*HashIndex
+	blockCache      *blockCache // 日志记录缓存 为nil时不缓存
+	blooms          *fileBlooms // 数据文件布隆过滤器 为nil时不使用
 }
 
 func New() (*Base, error) {
@@ -95,67 +138,125 @@ func Open(options Options) (*Base, error) {
 	base := &Base{
 		options:    options,
 		olderFiles: make(map[uint32]*data.File),
-		index:      NewIndexer(options.IndexType), // 创建内存索引结构
+		fileLock:   fileLock,
+		quotas:     quota.New(options.Quotas...),
+		closeCh:    make(chan struct{}),
+	}
+	if options.BlockCacheSize > 0 {
+		base.blockCache = newBlockCache(options.BlockCacheSize)
 	}
 
 	// 如果存在合并后的目录 加载该目录中的文件数据
+	_, err = os.Stat(base.getMergePath())
+	merged := err == nil
 	if err = base.LoadMergeFiles(); err != nil {
 		return nil, err
 	}
 
+	// 删除旧版本写错位置的序列号文件
+	if err = base.removeLegacySeqNoFile(); err != nil {
+		return nil, err
+	}
+
 	// 加载数据文件
 	if err = base.LoadDataFiles(); err != nil {
 		return nil, err
 	}
 
-	// 从Hint文件加载索引
-	if err = base.LoadIndexFromHintFile(); err != nil {
+	// 创建索引结构 持久化索引要在合并文件移动之后打开
+	base.index = NewShardedIndexer(options.IndexType, options.IndexShards, options.DirPath, options.SyncWrites)
+	start := time.Now()
+	marker, err := base.takeIndexMarker(merged)
+	if err != nil {
 		return nil, err
 	}
+	if marker == nil {
+		// 内存索引从最新的检查点恢复
+		if marker, err = base.loadCheckpoint(merged); err != nil {
+			return nil, err
+		}
+	}
 
-	// 从数据文件加载索引
-	if err = base.LoadIndexFromDataFiles(); err != nil {
+	if marker != nil {
+		// 索引已经覆盖标记之前的日志 只回放标记之后的日志
+		base.seqNo = marker.SeqNo
+		base.reclaimableSize = marker.ReclaimableSize
+		if err = base.loadIndexFromDataFiles(marker.Fid, marker.Offset); err != nil {
+			return nil, err
+		}
+	} else {
+		// 从Hint文件加载索引
+		if err = base.LoadIndexFromHintFile(); err != nil {
+			return nil, err
+		}
+
+		// 从数据文件加载索引
+		if err = base.LoadIndexFromDataFiles(); err != nil {
+			return nil, err
+		}
+	}
+
+	base.loadTime = time.Since(start)
+	klog.Infof("load index of %s in %v", options.DirPath, base.loadTime)
+
+	// 统计命名空间使用量
+	base.rebuildQuotaUsage()
+
+	// 异常退出时活跃文件尾部可能是预分配的空间 从实际的写偏移继续写入
+	if base.activeFile != nil {
+		if err = base.activeFile.Truncate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = base.loadBlooms(); err != nil {
 		return nil, err
 	}
 
-	if base.options.MMapAtStartup {
+	// 可写MMap启动后无需切换IO类型
+	if base.options.MMapAtStartup && base.options.IOType != fio.WritableMemoryMap {
 		if err = base.resetDataFileIoType(); err != nil {
 			return nil, err
 		}
 	}
 
+	if base.options.CheckpointInterval > 0 {
+		base.bgWait.Add(1)
+		go base.autoCheckpoint()
+	}
+
+	// 加载哈希字段二级索引
+	base.loadHashIndexes()
+
 	return base, nil
 }
 
 // Get 读取数据
 func (b *Base) Get(key string) (iface.Value, error) {
-	b.mutex.RLock()
-	defer b.mutex.RUnlock()
-
 	if len(key) == 0 {
 		return nil, errno.ErrKeyIsEmpty
 	}
 	keyBytes := utils.S2B(key)
 
-	// 从索引中获取键的位置
-	pos := b.index.Get(keyBytes)
-	if pos == nil {
+	// 布隆过滤器判断key不存在时 无需访问索引
+	if b.blooms != nil && !b.blooms.mayContain(keyBytes) {
 		return nil, errno.ErrKeyNotFound
 	}
 
-	var dataFile *data.File
-	if b.activeFile.FileId == pos.Fid {
-		dataFile = b.activeFile
-	} else {
-		dataFile = b.olderFiles[pos.Fid]
+	// 从索引中获取键的位置 索引自身保证并发安全 无需持有全局锁
+	pos := b.index.Get(keyBytes)
+	if pos == nil {
+		return nil, errno.ErrKeyNotFound
 	}
 
+	// 数据文件只追加 合并后的文件在重启时才替换 读取旧位置仍然有效
+	dataFile := b.dataFile(pos.Fid)
 	if dataFile == nil {
 		return nil, errno.ErrDataFileNotFound
 	}
 
 	// 读取日志记录
-	rec, _, err := dataFile.ReadLogRecord(pos.Offset)
+	rec, err := b.readLogRecord(dataFile, pos)
 	if err != nil {
 		return nil, err
 	}
@@ -173,24 +274,7 @@ func (b *Base) Set(key string, value iface.Value) error {
 		return errno.ErrKeyIsEmpty
 	}
 
-	keyBytes := utils.S2B(key)
-	rec := data.LogRecord{
-		Key:   LogRecordKeyWithSeqNo(keyBytes, nonTransactionSeqNo),
-		Value: value.Bytes(),
-		Type:  data.LogRecordNormal,
-	}
-
-	// 追加写入到当前活跃文件中
-	pos, err := b.AppendLogRecordWithLock(&rec)
-	if err != nil {
-		return err
-	}
-
-	// 更新索引
-	if oldPos := b.index.Put(keyBytes, pos); oldPos != nil {
-		b.reclaimableSize += int64(oldPos.Size)
-	}
-	return nil
+	return b.writeWithLock(utils.S2B(key), value.Bytes(), data.LogRecordNormal)
 }
 
 // Del 删除键值对
@@ -199,38 +283,121 @@ func (b *Base) Del(key string) error {
 		return errno.ErrKeyIsEmpty
 	}
 
-	keyBytes := utils.S2B(key)
+	// 删除哈希时同时删除其二级索引条目
+	if deleted, err := b.delHash(key); deleted || err != nil {
+		return err
+	}
+
+	// 追加日志记录 标记墓碑值
+	return b.writeWithLock(utils.S2B(key), nil, data.LogRecordDeleted)
+}
+
+// 写入一次非事务修改并更新索引
+// 写入前检查命名空间配额 开启变更捕获时以单条记录的事务写入 为其分配序列号和提交时间
+// 持有key的分段锁和全局读锁 只在追加日志时持有追加锁
+func (b *Base) writeWithLock(key, value []byte, typ data.LogRecordType) error {
+	lock := b.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
 
 	// 从索引中检查key是否存在
-	if pos := b.index.Get(keyBytes); pos == nil {
+	oldPos := b.index.Get(key)
+	if typ == data.LogRecordDeleted && oldPos == nil {
 		return nil
 	}
 
-	// 构造LogRecord 标记墓碑值
+	var seqNo uint64 = nonTransactionSeqNo
+	if b.options.ChangeCapture {
+		seqNo = atomic.AddUint64(&b.seqNo, 1)
+	}
 	rec := &data.LogRecord{
-		Key:  LogRecordKeyWithSeqNo(keyBytes, nonTransactionSeqNo),
-		Type: data.LogRecordDeleted,
+		Key:   LogRecordKeyWithSeqNo(key, seqNo),
+		Value: value,
+		Type:  typ,
+	}
+
+	change := newQuotaChange(key, rec, oldPos)
+	if err := b.quotas.Admit(change); err != nil {
+		return err
 	}
-	pos, err := b.AppendLogRecordWithLock(rec)
+
+	b.appendMutex.Lock()
+	pos, err := b.AppendLogRecord(rec)
+	if err == nil && seqNo != nonTransactionSeqNo {
+		_, err = b.AppendLogRecord(newTxnFinishedRecord(seqNo))
+	}
+	b.appendMutex.Unlock()
 	if err != nil {
+		b.quotas.Revert(change)
 		return err
 	}
-	b.reclaimableSize += int64(pos.Size)
+
+	if typ == data.LogRecordNormal {
+		// 更新索引
+		if oldPos = b.index.Put(key, pos); oldPos != nil {
+			atomic.AddInt64(&b.reclaimableSize, int64(oldPos.Size))
+		}
+		return nil
+	}
 
 	// 从内存索引中将对应key删除
-	oldPos, ok := b.index.Delete(keyBytes)
+	atomic.AddInt64(&b.reclaimableSize, int64(pos.Size))
+	oldPos, ok := b.index.Delete(key)
 	if !ok {
 		return errno.ErrIndexUpdateFailed
 	}
 	if oldPos != nil {
-		b.reclaimableSize += int64(oldPos.Size)
+		atomic.AddInt64(&b.reclaimableSize, int64(oldPos.Size))
 	}
 
 	return nil
 }
 
+// 返回key所在的分段锁
+func (b *Base) keyLock(key []byte) *sync.Mutex {
+	return &b.keyLocks[crc32.ChecksumIEEE(key)%keyLockCount]
+}
+
+// 按key的哈希值对分段锁加锁 返回解锁函数
+// 多个key按分段编号从小到大加锁 避免事务之间死锁
+func (b *Base) lockKeys(keys ...[]byte) func() {
+	var locked [keyLockCount]bool
+	ids := make([]int, 0, len(keys))
+	for _, key := range keys {
+		id := int(crc32.ChecksumIEEE(key) % keyLockCount)
+		if !locked[id] {
+			locked[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		b.keyLocks[id].Lock()
+	}
+	return func() {
+		for i := len(ids) - 1; i >= 0; i-- {
+			b.keyLocks[ids[i]].Unlock()
+		}
+	}
+}
+
+// 返回文件ID对应的数据文件 不存在时返回nil
+// 只持有文件表的读锁 读取数据时不需要全局锁
+func (b *Base) dataFile(fid uint32) *data.File {
+	b.fileMutex.RLock()
+	defer b.fileMutex.RUnlock()
+
+	if b.activeFile != nil && b.activeFile.FileId == fid {
+		return b.activeFile
+	}
+	return b.olderFiles[fid]
+}
+
 // 设置当前活跃文件
-// 访问此方法前要持有互斥锁
+// 访问此方法前要持有追加锁或全局写锁
 func (b *Base) setActiveDataFile() error {
 	var initFileId uint32 = 0
 
@@ -239,16 +406,19 @@ func (b *Base) setActiveDataFile() error {
 	}
 
 	// 打开数据文件
-	dataFile, err := data.OpenDataFile(b.options.DirPath, initFileId, fio.StandardFIO)
+	dataFile, err := data.OpenDataFile(b.options.DirPath, initFileId, b.options.IOType, b.options.DataFileSize)
 	if err != nil {
 		return err
 	}
 
+	b.fileMutex.Lock()
 	b.activeFile = dataFile
+	b.fileMutex.Unlock()
 	return nil
 }
 
 // AppendLogRecord 追加日志记录
+// 访问此方法前要持有追加锁或全局写锁
 func (b *Base) AppendLogRecord(rec *data.LogRecord) (*data.LogRecordPos, error) {
 	// 判断当前活跃数据文件是否存在 如果为空则初始化数据文件
 	if b.activeFile == nil {
@@ -265,8 +435,16 @@ func (b *Base) AppendLogRecord(rec *data.LogRecord) (*data.LogRecordPos, error)
 		if err := b.activeFile.Sync(); err != nil {
 			return nil, err
 		}
+		if err := b.activeFile.Truncate(); err != nil {
+			return nil, err
+		}
+		if err := b.sealBloom(b.activeFile.FileId); err != nil {
+			return nil, err
+		}
 
+		b.fileMutex.Lock()
 		b.olderFiles[b.activeFile.FileId] = b.activeFile
+		b.fileMutex.Unlock()
 		if err := b.setActiveDataFile(); err != nil {
 			return nil, err
 		}
@@ -276,6 +454,7 @@ func (b *Base) AppendLogRecord(rec *data.LogRecord) (*data.LogRecordPos, error)
 	if err := b.activeFile.Write(encRecord); err != nil {
 		return nil, err
 	}
+	b.addBloomKey(rec.Key)
 
 	var needSync = b.options.SyncWrites
 
@@ -290,7 +469,9 @@ func (b *Base) AppendLogRecord(rec *data.LogRecord) (*data.LogRecordPos, error)
 		}
 	}
 
-	return &data.LogRecordPos{Fid: b.activeFile.FileId, Offset: writeOff}, nil
+	b.signalChange()
+
+	return &data.LogRecordPos{Fid: b.activeFile.FileId, Offset: writeOff, Size: uint32(size)}, nil
 }
 
 // LoadDataFiles 加载数据文件
@@ -322,12 +503,12 @@ func (b *Base) LoadDataFiles() error {
 	// 遍历每个文件ID 打开对应数据文件
 	// 建立文件映射 并设置活跃文件
 	for i, fid := range fileIds {
-		ioType := fio.StandardFIO
-		if b.options.MMapAtStartup {
+		ioType := b.options.IOType
+		if b.options.MMapAtStartup && ioType != fio.WritableMemoryMap {
 			ioType = fio.MemoryMap
 		}
 
-		dataFile, err := data.OpenDataFile(b.options.DirPath, uint32(fid), ioType)
+		dataFile, err := data.OpenDataFile(b.options.DirPath, uint32(fid), ioType, b.options.DataFileSize)
 		if err != nil {
 			return err
 		}
@@ -346,6 +527,11 @@ func (b *Base) LoadDataFiles() error {
 // LoadIndexFromDataFiles 从数据文件中加载索引
 // 遍历文件中所有记录 更新到内存索引中
 func (b *Base) LoadIndexFromDataFiles() error {
+	return b.loadIndexFromDataFiles(0, 0)
+}
+
+// 从指定位置开始加载索引 之前的记录已经在索引中
+func (b *Base) loadIndexFromDataFiles(startFid uint32, startOffset int64) error {
 	if len(b.fileIds) == 0 {
 		return nil
 	}
@@ -362,6 +548,23 @@ func (b *Base) LoadIndexFromDataFiles() error {
 		nonMergeFileId = fid
 	}
 
+	// 并发加载时先收集需要读取的文件
+	if b.options.LoadConcurrency > 1 && len(b.fileIds) > 1 {
+		files := make([]*data.File, 0, len(b.fileIds))
+		for _, fid := range b.fileIds {
+			var fileId = uint32(fid)
+			if (hasMerge && fileId < nonMergeFileId) || fileId < startFid {
+				continue
+			}
+			if fileId == b.activeFile.FileId {
+				files = append(files, b.activeFile)
+			} else {
+				files = append(files, b.olderFiles[fileId])
+			}
+		}
+		return b.loadIndexParallel(files, startFid, startOffset)
+	}
+
 	// 更新索引信息
 	updateIndex := func(key []byte, typ data.LogRecordType, pos *data.LogRecordPos) {
 		var oldPos *data.LogRecordPos
@@ -376,7 +579,7 @@ func (b *Base) LoadIndexFromDataFiles() error {
 	}
 
 	// 当前序列号
-	var currentSeqNo uint64 = nonTransactionSeqNo
+	var currentSeqNo = b.seqNo
 
 	// 暂存事务数据 事务ID -> 列表
 	txRecords := make(map[uint64][]*data.TxRecord)
@@ -389,6 +592,10 @@ func (b *Base) LoadIndexFromDataFiles() error {
 		if hasMerge && fileId < nonMergeFileId {
 			continue
 		}
+		// 已经在索引中的文件不再加载
+		if fileId < startFid {
+			continue
+		}
 
 		var dataFile *data.File
 		if fileId == b.activeFile.FileId {
@@ -398,6 +605,9 @@ func (b *Base) LoadIndexFromDataFiles() error {
 		}
 
 		var offset int64 = 0
+		if fileId == startFid {
+			offset = startOffset
+		}
 		for {
 			// 读取日志记录
 			rec, size, err := dataFile.ReadLogRecord(offset)
@@ -409,7 +619,7 @@ func (b *Base) LoadIndexFromDataFiles() error {
 			}
 
 			// 构造索引信息
-			pos := &data.LogRecordPos{Fid: fileId, Offset: offset}
+			pos := &data.LogRecordPos{Fid: fileId, Offset: offset, Size: uint32(size)}
 
 			// 解析key 获取事务序列号
 			realKey, seqNo := parseLogRecordKey(rec.Key)
@@ -462,64 +672,15 @@ func (b *Base) Backup(dir string) error {
 	return utils.CopyDir(b.options.DirPath, dir, []string{fileLockName})
 }
 
-func (b *Base) Snapshot() ([]byte, error) {
-	it := b.index.Iterator(false)
-	items := make([]*Item, b.index.Size())
-
-	for it.Rewind(); it.Valid(); it.Next() {
-		value, err := b.getValueByPosition(it.Value())
-		if err != nil {
-			return nil, err
-		}
-		item := &Item{it.Key(), 0, value}
-		items = append(items, item)
-	}
-
-	shot := make([]byte, 0)
-	buffer := bytes.NewBuffer(shot)
-	err := gob.NewEncoder(buffer).Encode(items)
-	if err != nil {
-		return buffer.Bytes(), err
-	}
-	return buffer.Bytes(), nil
-}
-
-func (b *Base) RecoverFromBytes(data []byte) error {
-	items := make([]*Item, 0)
-
-	buffer := bytes.NewBuffer(data)
-	err := gob.NewDecoder(buffer).Decode(&items)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range items {
-		val := values.New(item.Value, 0, item.Type)
-		err = b.Set(utils.B2S(item.Key), &val)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // 通过位置信息获取值
 func (b *Base) getValueByPosition(pos *data.LogRecordPos) ([]byte, error) {
-	var dataFile *data.File
-
-	if b.activeFile.FileId == pos.Fid {
-		dataFile = b.activeFile
-	} else {
-		dataFile = b.olderFiles[pos.Fid]
-	}
-
+	dataFile := b.dataFile(pos.Fid)
 	if dataFile == nil {
 		return nil, errno.ErrDataFileNotFound
 	}
 
 	// 读取指定偏移处的日志记录
-	rec, _, err := dataFile.ReadLogRecord(pos.Offset)
+	rec, err := b.readLogRecord(dataFile, pos)
 	if err != nil {
 		return nil, err
 	}
@@ -532,19 +693,46 @@ func (b *Base) getValueByPosition(pos *data.LogRecordPos) ([]byte, error) {
 	return rec.Value, nil
 }
 
+// 读取位置处的日志记录 开启缓存时优先从缓存读取
+func (b *Base) readLogRecord(dataFile *data.File, pos *data.LogRecordPos) (*data.LogRecord, error) {
+	if b.blockCache == nil {
+		rec, _, err := dataFile.ReadLogRecord(pos.Offset)
+		return rec, err
+	}
+
+	if rec := b.blockCache.get(pos.Fid, pos.Offset); rec != nil {
+		return rec, nil
+	}
+	rec, _, err := dataFile.ReadLogRecord(pos.Offset)
+	if err != nil {
+		return nil, err
+	}
+	b.blockCache.put(pos.Fid, pos.Offset, rec)
+	return rec, nil
+}
+
 func (b *Base) Sync() error {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	b.appendMutex.Lock()
+	defer b.appendMutex.Unlock()
+
 	if b.activeFile == nil {
 		return nil
 	}
-
-	b.mutex.Lock()
-	defer b.mutex.Unlock()
-
 	return b.activeFile.Sync()
 }
 
-// Close 关闭数据库
+// Close 关闭数据库 只有第一次调用生效
 func (b *Base) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		err = b.close()
+	})
+	return err
+}
+
+func (b *Base) close() error {
 	defer func() {
 		// 解锁文件锁
 		if err := b.fileLock.Unlock(); err != nil {
@@ -552,8 +740,17 @@ func (b *Base) Close() error {
 		}
 	}()
 
+	// 停止定期检查点 关闭前写入最后一个检查点
+	close(b.closeCh)
+	b.bgWait.Wait()
+	if b.options.CheckpointInterval > 0 {
+		if err := b.Checkpoint(); err != nil {
+			return err
+		}
+	}
+
 	if b.activeFile == nil {
-		return nil
+		return b.closeIndex()
 	}
 
 	b.mutex.Lock()
@@ -579,6 +776,11 @@ func (b *Base) Close() error {
 		return err
 	}
 
+	// 记录持久化索引已经覆盖的位置
+	if err = b.saveIndexMarker(); err != nil {
+		return err
+	}
+
 	// 关闭当前活跃文件
 	if err = b.activeFile.Close(); err != nil {
 		return err
@@ -591,12 +793,13 @@ func (b *Base) Close() error {
 		}
 	}
 
-	return nil
+	return b.closeIndex()
 }
 
 // ListKeys 获取所有Key
 func (b *Base) ListKeys() [][]byte {
 	it := b.index.Iterator(false)
+	defer it.Close()
 	keys := make([][]byte, b.index.Size())
 
 	var idx int
@@ -613,6 +816,7 @@ func (b *Base) Fold(fn func(key []byte, value []byte) bool) error {
 	defer b.mutex.Unlock()
 
 	it := b.index.Iterator(false)
+	defer it.Close()
 	for it.Rewind(); it.Valid(); it.Next() {
 		val, err := b.getValueByPosition(it.Value())
 		if err != nil {
@@ -628,8 +832,10 @@ func (b *Base) Fold(fn func(key []byte, value []byte) bool) error {
 
 // AppendLogRecordWithLock 带锁追加日志
 func (b *Base) AppendLogRecordWithLock(rec *data.LogRecord) (*data.LogRecordPos, error) {
-	b.mutex.Lock()
-	defer b.mutex.Unlock()
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	b.appendMutex.Lock()
+	defer b.appendMutex.Unlock()
 
 	return b.AppendLogRecord(rec)
 }
@@ -640,11 +846,11 @@ func (b *Base) resetDataFileIoType() error {
 		return nil
 	}
 
-	if err := b.activeFile.SetIOManager(b.options.DirPath, fio.StandardFIO); err != nil {
+	if err := b.activeFile.SetIOManager(b.options.DirPath, b.options.IOType); err != nil {
 		return err
 	}
 	for _, dataFile := range b.olderFiles {
-		if err := dataFile.SetIOManager(b.options.DirPath, fio.StandardFIO); err != nil {
+		if err := dataFile.SetIOManager(b.options.DirPath, b.options.IOType); err != nil {
 			return err
 		}
 	}
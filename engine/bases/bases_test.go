@@ -2,14 +2,17 @@ package bases
 
 import (
 	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
 	"github.com/T4t4KAU/TikBase/engine/values"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/fio"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"github.com/boltdb/bolt"
 	"github.com/stretchr/testify/assert"
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 )
@@ -37,6 +40,17 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, b)
 }
 
+func TestBase_CloseTwice(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+
+	assert.Nil(t, b.Close())
+	assert.Nil(t, b.Close())
+}
+
 func TestBase_Set(t *testing.T) {
 	opts := DefaultOptions
 	dir, _ := os.MkdirTemp("", "test")
@@ -266,6 +280,35 @@ func TestBase_SRem(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestBase_ZRem(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	base, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer base.Close()
+
+	_, err = base.ZAdd("test_zset", 1.5, []byte("member1"))
+	assert.Nil(t, err)
+	_, err = base.ZAdd("test_zset", 2.5, []byte("member2"))
+	assert.Nil(t, err)
+
+	ok, err := base.ZRem("test_zset", []byte("member1"))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	_, err = base.ZScore("test_zset", []byte("member1"))
+	assert.ErrorIs(t, err, errno.ErrKeyNotFound)
+	_, err = base.ZRem("test_zset", []byte("member1"))
+	assert.ErrorIs(t, err, errno.ErrZSetMemberNotFound)
+
+	score, err := base.ZScore("test_zset", []byte("member2"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, score)
+
+	// 分数key一起删除 只剩下member2的两条数据和元数据
+	assert.Equal(t, 3, base.index.Size())
+}
+
 func TestBase_LPush(t *testing.T) {
 	opts := DefaultOptions
 	opts.DirPath = "../../temp"
@@ -383,3 +426,107 @@ func BenchmarkBolt_Set(b *testing.B) {
 		}
 	}
 }
+
+func TestBase_LegacySeqNoFile(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		v := values.New([]byte("value"), 0, iface.STRING)
+		assert.Nil(t, b.Set("key"+strconv.Itoa(i), &v))
+	}
+	assert.Nil(t, b.Close())
+
+	// 旧版本将序列号写入merge-finished文件
+	file, err := data.OpenMergeFinishedFile(opts.DirPath)
+	assert.Nil(t, err)
+	enc, _ := data.EncodeLogRecord(&data.LogRecord{Key: []byte(SeqNoKey), Value: []byte("100")})
+	assert.Nil(t, file.Write(enc))
+	assert.Nil(t, file.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer destroyDB(b)
+	for i := 0; i < 10; i++ {
+		v, err := b.Get("key" + strconv.Itoa(i))
+		assert.Nil(t, err)
+		assert.Equal(t, "value", v.String())
+	}
+	_, err = os.Stat(filepath.Join(opts.DirPath, data.MergeFinishedFileName))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestBase_IOType(t *testing.T) {
+	for _, ioType := range []fio.FileIOType{fio.DirectFIO, fio.AsyncFIO, fio.UringFIO} {
+		opts := DefaultOptions
+		opts.DirPath = t.TempDir()
+		opts.DataFileSize = 16 * 1024
+		opts.IOType = ioType
+
+		b, err := NewBaseWith(opts)
+		assert.Nil(t, err)
+		for i := 0; i < 2000; i++ {
+			v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+			assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i%500), &v))
+		}
+		assert.Greater(t, len(b.olderFiles), 0)
+		assert.Nil(t, b.Merge())
+		assert.Nil(t, b.Close())
+
+		// 合并后重新打开 数据文件仍使用指定的IO类型
+		b, err = NewBaseWith(opts)
+		assert.Nil(t, err)
+		for i := 1500; i < 2000; i++ {
+			v, err := b.Get(fmt.Sprintf("key-%d", i%500))
+			assert.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d", i), v.String())
+		}
+		_, ok := b.activeFile.IOManager.(*fio.DirectIO)
+		if ioType != fio.DirectFIO {
+			_, ok = b.activeFile.IOManager.(*fio.AsyncIO)
+		}
+		assert.True(t, ok)
+		assert.Nil(t, b.Close())
+	}
+}
+
+func TestBase_WritableMMap(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.DataFileSize = 16 * 1024
+	opts.IOType = fio.WritableMemoryMap
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for i := 0; i < 1000; i++ {
+		v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i), &v))
+	}
+	assert.Greater(t, len(b.olderFiles), 0)
+	activeFid := b.activeFile.FileId
+	assert.Nil(t, b.Close())
+
+	// 模拟异常退出 活跃文件尾部保留预分配的空间
+	name := data.GetDataFileName(opts.DirPath, activeFid)
+	assert.Nil(t, os.Truncate(name, opts.DataFileSize))
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	_, ok := b.activeFile.IOManager.(*fio.WritableMMap)
+	assert.True(t, ok)
+	for i := 1000; i < 1100; i++ {
+		v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i), &v))
+	}
+	assert.Nil(t, b.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	for i := 0; i < 1100; i++ {
+		v, err := b.Get(fmt.Sprintf("key-%d", i))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), v.String())
+	}
+}
@@ -3,6 +3,7 @@ package bases
 import (
 	"encoding/binary"
 	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/quota"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"sync"
@@ -21,6 +22,12 @@ type WriteBatch struct {
 
 	// 暂存用户写入数据
 	pending map[string]*data.LogRecord
+
+	// 复杂数据类型的成员所属的key 配额按所属的key计算
+	owners map[string]string
+
+	// 复杂数据类型的元信息 不受value大小配额限制
+	metas map[string]struct{}
 }
 
 func (b *Base) NewWriteBatch() *WriteBatch {
@@ -33,6 +40,8 @@ func (b *Base) NewWriteBatchWith(options WriteBatchOptions) *WriteBatch {
 		options: options,
 		base:    b,
 		pending: make(map[string]*data.LogRecord),
+		owners:  make(map[string]string),
+		metas:   make(map[string]struct{}),
 	}
 }
 
@@ -75,6 +84,39 @@ func (wb *WriteBatch) Delete(key []byte) error {
 	return nil
 }
 
+// 事务中写入复杂数据类型的元信息
+func (wb *WriteBatch) putMeta(key string, meta []byte) error {
+	if err := wb.Put(utils.S2B(key), meta); err != nil {
+		return err
+	}
+	wb.mutex.Lock()
+	wb.metas[key] = struct{}{}
+	wb.mutex.Unlock()
+	return nil
+}
+
+// 事务中写入复杂数据类型的成员
+func (wb *WriteBatch) putMember(owner string, key, value []byte) error {
+	if err := wb.Put(key, value); err != nil {
+		return err
+	}
+	wb.mutex.Lock()
+	wb.owners[string(key)] = owner
+	wb.mutex.Unlock()
+	return nil
+}
+
+// 事务中删除复杂数据类型的成员
+func (wb *WriteBatch) deleteMember(owner string, key []byte) error {
+	if err := wb.Delete(key); err != nil {
+		return err
+	}
+	wb.mutex.Lock()
+	wb.owners[string(key)] = owner
+	wb.mutex.Unlock()
+	return nil
+}
+
 // Commit 事务提交 将暂存的数据写到数据文件 更新内存索引
 func (wb *WriteBatch) Commit() error {
 	wb.mutex.Lock()
@@ -89,45 +131,53 @@ func (wb *WriteBatch) Commit() error {
 		return errno.ErrExceedMaxBatchNum
 	}
 
-	// 存储引擎 加锁保证事务串行化
-	wb.base.mutex.Lock()
-	defer wb.base.mutex.Unlock()
+	// 对事务中的key加分段锁 与其他写入按key串行 只在追加日志时持有追加锁
+	keys := make([][]byte, 0, len(wb.pending))
+	for _, rec := range wb.pending {
+		keys = append(keys, rec.Key)
+	}
+	unlock := wb.base.lockKeys(keys...)
+	defer unlock()
+	wb.base.mutex.RLock()
+	defer wb.base.mutex.RUnlock()
 
 	// 获取当前最新的事务序列号
 	seqNo := atomic.AddUint64(&wb.base.seqNo, 1)
 
-	// 基于pending表 追加日志
-	positions := make(map[string]*data.LogRecordPos)
+	records := make([]*data.LogRecord, 0, len(wb.pending))
+	recordKeys := make([]string, 0, len(wb.pending))
+	changes := make([]quota.Change, 0, len(wb.pending))
 	for _, rec := range wb.pending {
-		pos, err := wb.base.AppendLogRecord(&data.LogRecord{
+		encRec := &data.LogRecord{
 			Key:   LogRecordKeyWithSeqNo(rec.Key, seqNo), // 标记序列号
 			Value: rec.Value,
 			Type:  rec.Type,
-		})
-		if err != nil {
-			return err
 		}
-
-		// 记录位置信息 key -> pos
-		positions[utils.B2S(rec.Key)] = pos
-	}
-
-	// 标识事务完成
-	finishedRecord := &data.LogRecord{
-		Key:  LogRecordKeyWithSeqNo(txnFinKey, seqNo),
-		Type: data.LogRecordTxnFinished,
+		oldPos := wb.base.index.Get(rec.Key)
+		if rec.Type == data.LogRecordDeleted && oldPos == nil {
+			continue
+		}
+		change := newQuotaChange(rec.Key, encRec, oldPos)
+		if owner, ok := wb.owners[string(rec.Key)]; ok {
+			change.Key, change.Member = utils.S2B(owner), true
+		}
+		if _, ok := wb.metas[string(rec.Key)]; ok {
+			change.ValueSize = 0
+		}
+		records = append(records, encRec)
+		recordKeys = append(recordKeys, string(rec.Key))
+		changes = append(changes, change)
 	}
 
-	// 追加事务完成标记 标识结束
-	if _, err := wb.base.AppendLogRecord(finishedRecord); err != nil {
+	// 整个事务作为一个整体检查配额
+	if err := wb.base.quotas.Admit(changes...); err != nil {
 		return err
 	}
 
-	// 根据配置决定是否持久化
-	if wb.options.SyncWriters && wb.base.activeFile != nil {
-		if err := wb.base.activeFile.Sync(); err != nil {
-			return err
-		}
+	positions, err := wb.appendRecords(records, recordKeys, seqNo)
+	if err != nil {
+		wb.base.quotas.Revert(changes...)
+		return err
 	}
 
 	// 持久化已完成 二次遍历待提交日志 更新索引
@@ -135,23 +185,61 @@ func (wb *WriteBatch) Commit() error {
 		key := utils.B2S(rec.Key)
 		pos := positions[key] // 获取位置
 
-		// 在索引中更新数据
+		// 在索引中更新数据 被覆盖的旧数据可以回收
 		if rec.Type == data.LogRecordNormal {
-			wb.base.index.Put(rec.Key, pos)
+			if oldPos := wb.base.index.Put(rec.Key, pos); oldPos != nil {
+				atomic.AddInt64(&wb.base.reclaimableSize, int64(oldPos.Size))
+			}
 		}
 
-		// 在索引中删除数据
-		if rec.Type == data.LogRecordDeleted {
-			wb.base.index.Delete(rec.Key)
+		// 在索引中删除数据 墓碑值和旧数据都可以回收
+		if rec.Type == data.LogRecordDeleted && pos != nil {
+			atomic.AddInt64(&wb.base.reclaimableSize, int64(pos.Size))
+			if oldPos, ok := wb.base.index.Delete(rec.Key); ok && oldPos != nil {
+				atomic.AddInt64(&wb.base.reclaimableSize, int64(oldPos.Size))
+			}
 		}
 	}
 
 	// 重置pending表
 	wb.pending = make(map[string]*data.LogRecord)
+	wb.owners = make(map[string]string)
+	wb.metas = make(map[string]struct{})
 
 	return nil
 }
 
+// 持有追加锁 连续追加事务中的日志和完成标记 返回各key的位置信息
+func (wb *WriteBatch) appendRecords(records []*data.LogRecord, keys []string, seqNo uint64) (map[string]*data.LogRecordPos, error) {
+	wb.base.appendMutex.Lock()
+	defer wb.base.appendMutex.Unlock()
+
+	// 基于pending表 追加日志
+	positions := make(map[string]*data.LogRecordPos)
+	for i, rec := range records {
+		pos, err := wb.base.AppendLogRecord(rec)
+		if err != nil {
+			return nil, err
+		}
+
+		// 记录位置信息 key -> pos
+		positions[keys[i]] = pos
+	}
+
+	// 追加事务完成标记 标识结束 同时记录提交时间
+	if _, err := wb.base.AppendLogRecord(newTxnFinishedRecord(seqNo)); err != nil {
+		return nil, err
+	}
+
+	// 根据配置决定是否持久化
+	if wb.options.SyncWriters && wb.base.activeFile != nil {
+		if err := wb.base.activeFile.Sync(); err != nil {
+			return nil, err
+		}
+	}
+	return positions, nil
+}
+
 // LogRecordKeyWithSeqNo 将Key和事务号编码
 func LogRecordKeyWithSeqNo(key []byte, seqNo uint64) []byte {
 	seq := make([]byte, binary.MaxVarintLen64)
@@ -0,0 +1,132 @@
+package bases
+
+import (
+	"container/list"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"sync"
+	"sync/atomic"
+)
+
+/// 日志记录缓存 以记录在数据文件中的位置为key 缓存解码后的记录类型和值
+/// 分为多个分片 每个分片独立加锁 按LRU淘汰 总大小不超过给定的字节数
+
+const (
+	blockCacheShards    = 16
+	blockCacheEntrySize = 64 // 每条缓存额外占用的空间估算
+)
+
+type blockCacheKey struct {
+	fid    uint32
+	offset int64
+}
+
+type blockCacheEntry struct {
+	key  blockCacheKey
+	rec  *data.LogRecord
+	size int64
+}
+
+type blockCacheShard struct {
+	mutex    sync.Mutex
+	items    map[blockCacheKey]*list.Element
+	lru      *list.List
+	size     int64
+	capacity int64
+}
+
+type blockCache struct {
+	shards []*blockCacheShard
+	hits   uint64
+	misses uint64
+}
+
+func newBlockCache(capacity int64) *blockCache {
+	c := &blockCache{shards: make([]*blockCacheShard, blockCacheShards)}
+	for i := range c.shards {
+		c.shards[i] = &blockCacheShard{
+			items:    make(map[blockCacheKey]*list.Element),
+			lru:      list.New(),
+			capacity: capacity / blockCacheShards,
+		}
+	}
+	return c
+}
+
+func (c *blockCache) shardOf(key blockCacheKey) *blockCacheShard {
+	h := uint64(key.fid)*0x9E3779B97F4A7C15 ^ uint64(key.offset)
+	h ^= h >> 29
+	return c.shards[h%blockCacheShards]
+}
+
+// 获取缓存的记录 返回记录的副本
+func (c *blockCache) get(fid uint32, offset int64) *data.LogRecord {
+	key := blockCacheKey{fid: fid, offset: offset}
+	s := c.shardOf(key)
+
+	s.mutex.Lock()
+	elem, ok := s.items[key]
+	if !ok {
+		s.mutex.Unlock()
+		atomic.AddUint64(&c.misses, 1)
+		return nil
+	}
+	s.lru.MoveToFront(elem)
+	rec := elem.Value.(*blockCacheEntry).rec
+	s.mutex.Unlock()
+
+	atomic.AddUint64(&c.hits, 1)
+	return &data.LogRecord{
+		Value: append([]byte(nil), rec.Value...),
+		Type:  rec.Type,
+	}
+}
+
+// 缓存记录 超过容量时淘汰最久未访问的记录
+func (c *blockCache) put(fid uint32, offset int64, rec *data.LogRecord) {
+	key := blockCacheKey{fid: fid, offset: offset}
+	size := int64(len(rec.Value)) + blockCacheEntrySize
+	s := c.shardOf(key)
+	if size > s.capacity {
+		return
+	}
+	rec = &data.LogRecord{
+		Value: append([]byte(nil), rec.Value...),
+		Type:  rec.Type,
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.items[key]; ok {
+		return
+	}
+	s.items[key] = s.lru.PushFront(&blockCacheEntry{key: key, rec: rec, size: size})
+	s.size += size
+
+	for s.size > s.capacity {
+		s.removeElement(s.lru.Back())
+	}
+}
+
+func (s *blockCacheShard) removeElement(elem *list.Element) {
+	entry := s.lru.Remove(elem).(*blockCacheEntry)
+	delete(s.items, entry.key)
+	s.size -= entry.size
+}
+
+// 删除文件ID小于fid的全部缓存
+func (c *blockCache) removeBefore(fid uint32) {
+	for _, s := range c.shards {
+		s.mutex.Lock()
+		for key, elem := range s.items {
+			if key.fid < fid {
+				s.removeElement(elem)
+			}
+		}
+		s.mutex.Unlock()
+	}
+}
+
+func (c *blockCache) stats() (hits, misses uint64) {
+	return atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+}
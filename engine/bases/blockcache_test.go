@@ -0,0 +1,80 @@
+package bases
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBlockCache(t *testing.T) {
+	c := newBlockCache(blockCacheShards * (blockCacheEntrySize + 10) * 2)
+
+	// 同一分片最多容纳两条记录
+	var keys []blockCacheKey
+	shard := c.shardOf(blockCacheKey{fid: 0, offset: 0})
+	for off := int64(0); len(keys) < 3; off++ {
+		key := blockCacheKey{fid: 0, offset: off}
+		if c.shardOf(key) == shard {
+			keys = append(keys, key)
+		}
+	}
+
+	rec := &data.LogRecord{Value: []byte("0123456789"), Type: data.LogRecordNormal}
+	c.put(keys[0].fid, keys[0].offset, rec)
+	c.put(keys[1].fid, keys[1].offset, rec)
+
+	// 缓存的是副本 修改原记录不影响缓存
+	rec.Value[0] = 'x'
+	got := c.get(keys[0].fid, keys[0].offset)
+	assert.NotNil(t, got)
+	assert.Equal(t, "0123456789", string(got.Value))
+
+	// keys[1]最久未访问 被淘汰
+	c.put(keys[2].fid, keys[2].offset, rec)
+	assert.Nil(t, c.get(keys[1].fid, keys[1].offset))
+	assert.NotNil(t, c.get(keys[2].fid, keys[2].offset))
+
+	hits, misses := c.stats()
+	assert.Equal(t, uint64(2), hits)
+	assert.Equal(t, uint64(1), misses)
+
+	c.removeBefore(1)
+	assert.Nil(t, c.get(keys[0].fid, keys[0].offset))
+}
+
+func TestBase_BlockCache(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.DataFileSize = 4 * 1024
+	opts.DataFileMergeRatio = 0
+	opts.BlockCacheSize = 1 << 20
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+
+	for i := 0; i < 200; i++ {
+		v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i%100), &v))
+	}
+
+	for round := 0; round < 2; round++ {
+		for i := 100; i < 200; i++ {
+			v, err := b.Get(fmt.Sprintf("key-%d", i%100))
+			assert.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d", i), v.String())
+		}
+	}
+	st := b.Status()
+	assert.Equal(t, uint64(100), st.CacheMisses)
+	assert.Equal(t, uint64(100), st.CacheHits)
+
+	// 合并后旧文件中的缓存失效
+	assert.Nil(t, b.Merge())
+	_, err = b.Get("key-0")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(101), b.Status().CacheMisses)
+}
@@ -0,0 +1,192 @@
+package bases
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/pkg/filter"
+	"github.com/T4t4KAU/TikBase/pkg/fio"
+	"github.com/spaolacci/murmur3"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+)
+
+/// 数据文件布隆过滤器 每个数据文件一个 记录文件中出现过的key
+/// 写满的文件生成位图保存在数据目录中 活跃文件保存key的哈希值 写满时生成位图
+/// 读取时所有过滤器都不包含key则直接返回 不访问索引和数据文件
+/// 只用于BPT索引 内存索引的查询本身足够快 过滤器使用自己的锁 读取时不竞争全局锁
+
+var errBloomCorrupted = errors.New("bloom filter file is corrupted")
+
+type fileBlooms struct {
+	mutex        sync.RWMutex
+	rate         float64
+	sealed       map[uint32][]byte   // 已写满文件的位图
+	active       *filter.BloomFilter // 活跃文件的过滤器
+	activeHashes map[uint32]struct{} // 活跃文件中key的哈希值
+}
+
+func (fb *fileBlooms) resetActive() {
+	fb.active, _ = filter.NewBloomFilterWithRate(fb.rate)
+	fb.activeHashes = make(map[uint32]struct{})
+}
+
+func (fb *fileBlooms) add(key []byte) {
+	fb.mutex.Lock()
+	defer fb.mutex.Unlock()
+
+	fb.active.Add(key)
+	fb.activeHashes[murmur3.Sum32(key)] = struct{}{}
+}
+
+// 活跃文件写满 保存位图并重置活跃文件的过滤器
+func (fb *fileBlooms) seal(fid uint32, bitmap []byte) {
+	fb.mutex.Lock()
+	defer fb.mutex.Unlock()
+
+	fb.sealed[fid] = bitmap
+	fb.resetActive()
+}
+
+func (fb *fileBlooms) activeBitmap() []byte {
+	fb.mutex.RLock()
+	defer fb.mutex.RUnlock()
+
+	return fb.active.Hash()
+}
+
+// 判断key是否可能存在于某个数据文件中
+func (fb *fileBlooms) mayContain(key []byte) bool {
+	fb.mutex.RLock()
+	defer fb.mutex.RUnlock()
+
+	if _, ok := fb.activeHashes[murmur3.Sum32(key)]; ok {
+		return true
+	}
+	for _, bitmap := range fb.sealed {
+		if fb.active.Exist(bitmap, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// 写入位图文件 末尾附加校验和
+func writeBloomFile(dirPath string, fid uint32, bitmap []byte) error {
+	buf := make([]byte, len(bitmap)+4)
+	copy(buf, bitmap)
+	binary.LittleEndian.PutUint32(buf[len(bitmap):], crc32.ChecksumIEEE(bitmap))
+
+	fileName := data.GetBloomFileName(dirPath, fid)
+	tmpName := fileName + ".tmp"
+	if err := os.WriteFile(tmpName, buf, fio.DataFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fileName)
+}
+
+func readBloomFile(dirPath string, fid uint32) ([]byte, error) {
+	buf, err := os.ReadFile(data.GetBloomFileName(dirPath, fid))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < 6 {
+		return nil, errBloomCorrupted
+	}
+	bitmap := buf[:len(buf)-4]
+	if binary.LittleEndian.Uint32(buf[len(bitmap):]) != crc32.ChecksumIEEE(bitmap) {
+		return nil, errBloomCorrupted
+	}
+	return bitmap, nil
+}
+
+// 读取数据文件中的全部key 加入过滤器
+func scanBloomKeys(dataFile *data.File, f *filter.BloomFilter, hashes map[uint32]struct{}) error {
+	var offset int64 = 0
+	for {
+		rec, size, err := dataFile.ReadLogRecord(offset)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		realKey, _ := parseLogRecordKey(rec.Key)
+		f.Add(realKey)
+		if hashes != nil {
+			hashes[murmur3.Sum32(realKey)] = struct{}{}
+		}
+		offset += size
+	}
+}
+
+// 启动时加载过滤器 没有位图文件的旧文件重新扫描生成
+func (b *Base) loadBlooms() error {
+	if b.options.BloomFalsePositive <= 0 || b.options.IndexType != BPT {
+		return nil
+	}
+
+	fb := &fileBlooms{
+		rate:   b.options.BloomFalsePositive,
+		sealed: make(map[uint32][]byte),
+	}
+	fb.resetActive()
+
+	for fid, dataFile := range b.olderFiles {
+		bitmap, err := readBloomFile(b.options.DirPath, fid)
+		if err != nil {
+			f, _ := filter.NewBloomFilterWithRate(fb.rate)
+			if err = scanBloomKeys(dataFile, f, nil); err != nil {
+				return err
+			}
+			bitmap = f.Hash()
+			if err = writeBloomFile(b.options.DirPath, fid, bitmap); err != nil {
+				return err
+			}
+		}
+		fb.sealed[fid] = bitmap
+	}
+
+	if b.activeFile != nil {
+		if err := scanBloomKeys(b.activeFile, fb.active, fb.activeHashes); err != nil {
+			return err
+		}
+	}
+
+	b.blooms = fb
+	return nil
+}
+
+// 记录写入活跃文件的key
+// 访问此方法前要持有互斥锁
+func (b *Base) addBloomKey(key []byte) {
+	if b.blooms == nil {
+		return
+	}
+	realKey, _ := parseLogRecordKey(key)
+	b.blooms.add(realKey)
+}
+
+// 活跃文件写满 生成位图并保存
+// 访问此方法前要持有互斥锁
+func (b *Base) sealBloom(fid uint32) error {
+	if b.blooms == nil {
+		return nil
+	}
+	bitmap := b.blooms.activeBitmap()
+	if err := writeBloomFile(b.options.DirPath, fid, bitmap); err != nil {
+		return err
+	}
+	b.blooms.seal(fid, bitmap)
+	return nil
+}
+
+// 保存活跃文件的位图 用于合并完成时
+func (b *Base) saveActiveBloom() error {
+	if b.blooms == nil || b.activeFile == nil {
+		return nil
+	}
+	return writeBloomFile(b.options.DirPath, b.activeFile.FileId, b.blooms.activeBitmap())
+}
@@ -0,0 +1,92 @@
+package bases
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestBase_Bloom(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.IndexType = BPT
+	opts.DataFileSize = 4 * 1024
+	opts.DataFileMergeRatio = 0
+	opts.BloomFalsePositive = 0.01
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for i := 0; i < 500; i++ {
+		v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i%250), &v))
+	}
+	assert.Greater(t, len(b.olderFiles), 1)
+	assert.Equal(t, len(b.olderFiles), len(b.blooms.sealed))
+
+	check := func(b *Base) {
+		for i := 250; i < 500; i++ {
+			v, err := b.Get(fmt.Sprintf("key-%d", i%250))
+			assert.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("value-%d", i), v.String())
+		}
+
+		// 不存在的key大多被过滤器排除
+		var positives int
+		for i := 0; i < 1000; i++ {
+			key := fmt.Sprintf("missing-%d", i)
+			_, err := b.Get(key)
+			assert.Equal(t, errno.ErrKeyNotFound, err)
+			if b.blooms.mayContain([]byte(key)) {
+				positives++
+			}
+		}
+		assert.Less(t, positives, 100)
+	}
+	check(b)
+	assert.Nil(t, b.Close())
+
+	// 重启时加载位图文件 缺失的位图重新生成
+	assert.Nil(t, os.Remove(data.GetBloomFileName(opts.DirPath, 0)))
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	check(b)
+	_, err = os.Stat(data.GetBloomFileName(opts.DirPath, 0))
+	assert.Nil(t, err)
+
+	// 合并后重启 过滤器与合并后的文件对应
+	assert.Nil(t, b.Merge())
+	assert.Nil(t, b.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	check(b)
+	for fid := range b.olderFiles {
+		_, err = os.Stat(data.GetBloomFileName(opts.DirPath, fid))
+		assert.Nil(t, err)
+	}
+}
+
+// 内存索引不使用过滤器
+func TestBase_BloomMemoryIndex(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.BloomFalsePositive = 0.01
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	assert.Nil(t, b.blooms)
+
+	v := values.New([]byte("value"), 0, iface.STRING)
+	assert.Nil(t, b.Set("key", &v))
+	_, err = b.Get("key")
+	assert.Nil(t, err)
+}
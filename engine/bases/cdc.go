@@ -0,0 +1,371 @@
+package bases
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+/// 变更数据捕获 按写入顺序读取数据文件 输出已提交的修改
+
+const changeOffsetSuffix = ".cdc-offset"
+
+type ChangeOp byte
+
+const (
+	ChangePut    ChangeOp = iota // 写入
+	ChangeDelete                 // 删除
+)
+
+func (op ChangeOp) String() string {
+	if op == ChangeDelete {
+		return "del"
+	}
+	return "put"
+}
+
+// ChangePosition 数据文件中的位置
+type ChangePosition struct {
+	Fid    uint32
+	Offset int64
+}
+
+// ChangeEvent 变更事件
+type ChangeEvent struct {
+	Key       []byte // 用户写入的key 不含逻辑数据库前缀
+	DB        string // key所在的逻辑数据库 存储引擎内部使用的key为空
+	Value     []byte
+	Op        ChangeOp
+	SeqNo     uint64         // 事务序列号 非事务写入为0
+	Timestamp int64          // 提交时间 纳秒 非事务写入为0
+	Pos       ChangePosition // 日志记录的位置
+	Next      ChangePosition // 所在批次结束后的位置 消费者从此处恢复
+}
+
+// 构造事务完成记录 记录值为提交时间
+func newTxnFinishedRecord(seqNo uint64) *data.LogRecord {
+	ts := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts, uint64(time.Now().UnixNano()))
+
+	return &data.LogRecord{
+		Key:   LogRecordKeyWithSeqNo(txnFinKey, seqNo),
+		Value: ts,
+		Type:  data.LogRecordTxnFinished,
+	}
+}
+
+// 解析事务完成记录中的提交时间
+func parseTxnTimestamp(value []byte) int64 {
+	if len(value) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(value))
+}
+
+// 返回在下一次写入时关闭的通道
+func (b *Base) changeNotify() <-chan struct{} {
+	b.changeMutex.Lock()
+	defer b.changeMutex.Unlock()
+
+	if b.changed == nil {
+		b.changed = make(chan struct{})
+	}
+	return b.changed
+}
+
+// 唤醒等待写入的读取者
+func (b *Base) signalChange() {
+	b.changeMutex.Lock()
+	defer b.changeMutex.Unlock()
+
+	if b.changed != nil {
+		close(b.changed)
+		b.changed = nil
+	}
+}
+
+// 获取大于fid的最小文件ID
+// 访问此方法前要持有追加锁或全局写锁
+func (b *Base) nextFileId(fid uint32) (uint32, bool) {
+	next, found := uint32(0), false
+	if b.activeFile != nil && b.activeFile.FileId > fid {
+		next, found = b.activeFile.FileId, true
+	}
+	for id := range b.olderFiles {
+		if id > fid && (!found || id < next) {
+			next, found = id, true
+		}
+	}
+	return next, found
+}
+
+// ChangeReader 变更读取器 从指定位置开始按顺序读取变更事件
+type ChangeReader struct {
+	base      *Base
+	pos       ChangePosition            // 下一条待读取的记录
+	txRecords map[uint64][]*ChangeEvent // 尚未提交的事务
+	events    []*ChangeEvent            // 已读取未返回的事件
+}
+
+// NewChangeReader 从指定位置创建变更读取器
+// 位置应为某一批次的边界 即数据文件起始处或事件的Next
+func (b *Base) NewChangeReader(from ChangePosition) *ChangeReader {
+	return &ChangeReader{
+		base:      b,
+		pos:       from,
+		txRecords: make(map[uint64][]*ChangeEvent),
+	}
+}
+
+// Position 返回读取器当前位置
+func (r *ChangeReader) Position() ChangePosition {
+	return r.pos
+}
+
+// Read 读取至多max个变更事件 没有新事件时阻塞等待直到ctx结束
+func (r *ChangeReader) Read(ctx context.Context, max int) ([]*ChangeEvent, error) {
+	if max <= 0 {
+		max = 1
+	}
+
+	for {
+		// 先获取通知通道再读取 避免错过读取之后的写入
+		wait := r.base.changeNotify()
+		if err := r.scan(max); err != nil {
+			return nil, err
+		}
+
+		if len(r.events) > 0 {
+			n := max
+			if n > len(r.events) {
+				n = len(r.events)
+			}
+			events := r.events[:n]
+			r.events = r.events[n:]
+			return events, nil
+		}
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// 读取日志记录直到凑齐max个事件或读到末尾
+func (r *ChangeReader) scan(max int) error {
+	b := r.base
+	// 持有追加锁 不会读到写入了一半的日志记录
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	b.appendMutex.Lock()
+	defer b.appendMutex.Unlock()
+
+	for len(r.events) < max {
+		var dataFile *data.File
+		if b.activeFile != nil && b.activeFile.FileId == r.pos.Fid {
+			dataFile = b.activeFile
+		} else {
+			dataFile = b.olderFiles[r.pos.Fid]
+		}
+
+		if dataFile == nil {
+			// 文件已被合并 无法从文件中间继续读取
+			if r.pos.Offset > 0 {
+				return errno.ErrChangeLogTruncated
+			}
+			next, ok := b.nextFileId(r.pos.Fid)
+			if !ok {
+				return nil
+			}
+			r.pos = ChangePosition{Fid: next}
+			continue
+		}
+
+		rec, size, err := dataFile.ReadLogRecord(r.pos.Offset)
+		if err != nil {
+			if err != io.EOF {
+				return err
+			}
+			// 活跃文件读取完毕 等待新的写入
+			if dataFile == b.activeFile {
+				return nil
+			}
+			next, ok := b.nextFileId(r.pos.Fid)
+			if !ok {
+				return nil
+			}
+			r.pos = ChangePosition{Fid: next}
+			continue
+		}
+
+		pos := r.pos
+		r.pos.Offset += size
+		r.apply(rec, pos)
+	}
+
+	return nil
+}
+
+// 处理一条日志记录 事务中的记录在读到完成标记后才输出
+func (r *ChangeReader) apply(rec *data.LogRecord, pos ChangePosition) {
+	realKey, seqNo := parseLogRecordKey(rec.Key)
+
+	if rec.Type == data.LogRecordTxnFinished {
+		ts := parseTxnTimestamp(rec.Value)
+		for _, event := range r.txRecords[seqNo] {
+			event.Timestamp = ts
+			event.Next = r.pos
+			r.events = append(r.events, event)
+		}
+		delete(r.txRecords, seqNo)
+		return
+	}
+
+	event := &ChangeEvent{
+		Key:   realKey,
+		Value: rec.Value,
+		Op:    ChangePut,
+		SeqNo: seqNo,
+		Pos:   pos,
+		Next:  r.pos,
+	}
+	if rec.Type == data.LogRecordDeleted {
+		event.Op = ChangeDelete
+		event.Value = nil
+	}
+	if db, key, ok := iface.SplitDB(realKey); ok {
+		event.DB, event.Key = db, key
+	}
+
+	if seqNo == nonTransactionSeqNo {
+		r.events = append(r.events, event)
+	} else {
+		r.txRecords[seqNo] = append(r.txRecords[seqNo], event)
+	}
+}
+
+// 消费者位置文件
+func (b *Base) changeOffsetFile(consumer string) (string, error) {
+	if consumer == "" || strings.ContainsAny(consumer, `/\.`) {
+		return "", errno.ErrInvalidConsumerName
+	}
+	return filepath.Join(b.options.DirPath, consumer+changeOffsetSuffix), nil
+}
+
+// CommitChangeOffset 持久化消费者位置 下次从该位置继续读取
+func (b *Base) CommitChangeOffset(consumer string, pos ChangePosition) error {
+	fileName, err := b.changeOffsetFile(consumer)
+	if err != nil {
+		return err
+	}
+	return writeChangeOffset(fileName, pos)
+}
+
+// ChangeOffset 读取消费者位置 没有提交过时返回起始位置
+func (b *Base) ChangeOffset(consumer string) (ChangePosition, error) {
+	fileName, err := b.changeOffsetFile(consumer)
+	if err != nil {
+		return ChangePosition{}, err
+	}
+	return readChangeOffset(fileName)
+}
+
+// 先写临时文件再重命名 保证位置文件完整
+func writeChangeOffset(fileName string, pos ChangePosition) error {
+	tmpName := fileName + ".tmp"
+	buf := data.EncodeLogRecordPos(&data.LogRecordPos{Fid: pos.Fid, Offset: pos.Offset})
+
+	f, err := os.OpenFile(tmpName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(buf); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, fileName)
+}
+
+func readChangeOffset(fileName string) (ChangePosition, error) {
+	buf, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return ChangePosition{}, nil
+	}
+	if err != nil {
+		return ChangePosition{}, err
+	}
+
+	pos := data.DecodeLogRecordPos(buf)
+	return ChangePosition{Fid: pos.Fid, Offset: pos.Offset}, nil
+}
+
+// 合并前检查消费者位置 合并会删除旧数据文件
+// 已经读完全部数据的消费者 将其位置移动到合并后的新文件
+// 超过保留时间未提交位置的消费者视为已放弃 删除其位置 之后从合并后的数据重新读取
+// 访问此方法前要持有互斥锁
+func (b *Base) advanceChangeOffsets(nonMergeFileId uint32) error {
+	entries, err := os.ReadDir(b.options.DirPath)
+	if err != nil {
+		return err
+	}
+
+	end := ChangePosition{Fid: b.activeFile.FileId, Offset: b.activeFile.WriteOff}
+
+	var caughtUp, expired []string
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), changeOffsetSuffix) {
+			continue
+		}
+		fileName := filepath.Join(b.options.DirPath, entry.Name())
+		pos, err := readChangeOffset(fileName)
+		if err != nil {
+			return err
+		}
+		if pos.Fid >= nonMergeFileId {
+			continue
+		}
+		if pos == end {
+			caughtUp = append(caughtUp, fileName)
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if b.options.ChangeRetention <= 0 || time.Since(info.ModTime()) < b.options.ChangeRetention {
+			return errno.ErrChangeConsumerLagging
+		}
+		expired = append(expired, fileName)
+	}
+
+	for _, fileName := range caughtUp {
+		if err = writeChangeOffset(fileName, ChangePosition{Fid: nonMergeFileId}); err != nil {
+			return err
+		}
+	}
+	for _, fileName := range expired {
+		if err = os.Remove(fileName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
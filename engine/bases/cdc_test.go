@@ -0,0 +1,152 @@
+package bases
+
+import (
+	"context"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+func openChangeCaptureDB(t *testing.T, dir string) *Base {
+	opts := DefaultOptions
+	opts.DirPath = dir
+	opts.MMapAtStartup = false
+	opts.ChangeCapture = true
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	return b
+}
+
+func TestChangeReader_Read(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "cdc")
+	b := openChangeCaptureDB(t, dir)
+	defer destroyDB(b)
+
+	v := values.New([]byte("v1"), 0, iface.STRING)
+	assert.Nil(t, b.Set("k1", &v))
+	assert.Nil(t, b.Del("k1"))
+
+	wb := b.NewWriteBatch()
+	assert.Nil(t, wb.Put([]byte("k2"), []byte("v2")))
+	assert.Nil(t, wb.Commit())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	r := b.NewChangeReader(ChangePosition{})
+	events, err := r.Read(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(events))
+
+	assert.Equal(t, []byte("k1"), events[0].Key)
+	assert.Equal(t, ChangePut, events[0].Op)
+	assert.Equal(t, []byte("v1"), events[0].Value)
+	assert.Equal(t, ChangeDelete, events[1].Op)
+	assert.Equal(t, []byte("k2"), events[2].Key)
+
+	for i, event := range events {
+		assert.Equal(t, uint64(i+1), event.SeqNo)
+		assert.NotZero(t, event.Timestamp)
+	}
+	assert.Equal(t, r.Position(), events[2].Next)
+
+	// 没有新数据时阻塞 直到有新的写入
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		v := values.New([]byte("v3"), 0, iface.STRING)
+		_ = b.Set("k3", &v)
+	}()
+	events, err = r.Read(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, []byte("k3"), events[0].Key)
+
+	// 从指定位置重放
+	events, err = b.NewChangeReader(events[0].Pos).Read(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("k3"), events[0].Key)
+
+	// 输出的key不含逻辑数据库前缀
+	assert.Nil(t, b.Set(iface.DBKey("1", "k4"), &v))
+	events, err = r.Read(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("k4"), events[0].Key)
+	assert.Equal(t, "1", events[0].DB)
+
+	// 读取超时
+	timeout, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	_, err = r.Read(timeout, 10)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestBase_ChangeOffset(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "cdc")
+	b := openChangeCaptureDB(t, dir)
+
+	pos, err := b.ChangeOffset("analytics")
+	assert.Nil(t, err)
+	assert.Equal(t, ChangePosition{}, pos)
+
+	_, err = b.ChangeOffset("../analytics")
+	assert.Equal(t, errno.ErrInvalidConsumerName, err)
+
+	for _, key := range []string{"k1", "k2"} {
+		v := values.New([]byte(key), 0, iface.STRING)
+		assert.Nil(t, b.Set(key, &v))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	events, err := b.NewChangeReader(pos).Read(ctx, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, b.CommitChangeOffset("analytics", events[0].Next))
+	assert.Nil(t, b.Close())
+
+	// 重启后从已提交的位置继续读取
+	b = openChangeCaptureDB(t, dir)
+	defer destroyDB(b)
+
+	pos, err = b.ChangeOffset("analytics")
+	assert.Nil(t, err)
+	assert.Equal(t, events[0].Next, pos)
+
+	events, err = b.NewChangeReader(pos).Read(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, []byte("k2"), events[0].Key)
+	assert.Equal(t, uint64(2), events[0].SeqNo)
+}
+
+func TestBase_ChangeRetention(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "cdc")
+	b := openChangeCaptureDB(t, dir)
+	defer destroyDB(b)
+	b.options.DataFileMergeRatio = 0.1
+
+	// 反复覆盖同一个key 产生可回收的空间
+	for i := 0; i < 100; i++ {
+		v := values.New([]byte("value"), 0, iface.STRING)
+		assert.Nil(t, b.Set("key", &v))
+	}
+	assert.Nil(t, b.CommitChangeOffset("abandoned", ChangePosition{}))
+	assert.Equal(t, errno.ErrChangeConsumerLagging, b.Merge())
+
+	// 超过保留时间未提交的消费者不再阻止合并
+	fileName, err := b.changeOffsetFile("abandoned")
+	assert.Nil(t, err)
+	old := time.Now().Add(-b.options.ChangeRetention - time.Minute)
+	assert.Nil(t, os.Chtimes(fileName, old, old))
+	assert.Nil(t, b.Merge())
+
+	_, err = os.Stat(fileName)
+	assert.True(t, os.IsNotExist(err))
+	pos, err := b.ChangeOffset("abandoned")
+	assert.Nil(t, err)
+	assert.Equal(t, ChangePosition{}, pos)
+}
@@ -0,0 +1,268 @@
+package bases
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/pkg/dates/bptree"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+/// 索引检查点 将内存索引和对应的日志位置一起写入文件
+/// 启动时加载最新的有效检查点 只回放检查点之后的日志记录
+
+const (
+	checkpointFileSuffix = ".ckpt"
+	checkpointRetained   = 2 // 保留的检查点数量
+)
+
+var (
+	checkpointMagic = []byte("TKCP")
+
+	errCheckpointCorrupted = errors.New("index checkpoint is corrupted")
+)
+
+// 检查点文件名包含日志位置 文件名越大检查点越新
+func checkpointFileName(dirPath string, fid uint32, offset int64) string {
+	return filepath.Join(dirPath, fmt.Sprintf("%09d-%019d%s", fid, offset, checkpointFileSuffix))
+}
+
+// 返回目录中的检查点文件 从新到旧排列
+func listCheckpoints(dirPath string) ([]string, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), checkpointFileSuffix) {
+			names = append(names, filepath.Join(dirPath, entry.Name()))
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names, nil
+}
+
+// 删除检查点 保留最新的retain个
+func removeCheckpoints(dirPath string, retain int) error {
+	names, err := listCheckpoints(dirPath)
+	if err != nil {
+		return err
+	}
+	for i := retain; i < len(names); i++ {
+		if err = os.Remove(names[i]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checkpoint 将当前索引写入检查点文件
+// 持有锁时只创建索引快照 序列化在锁外完成 不阻塞写入
+func (b *Base) Checkpoint() error {
+	if _, ok := b.index.(*bptree.BPTree); ok {
+		return nil
+	}
+
+	b.checkpointMutex.Lock()
+	defer b.checkpointMutex.Unlock()
+
+	b.mutex.Lock()
+	if b.activeFile == nil {
+		b.mutex.Unlock()
+		return nil
+	}
+	marker := &indexMarker{
+		Fid:             b.activeFile.FileId,
+		Offset:          b.activeFile.WriteOff,
+		SeqNo:           b.seqNo,
+		ReclaimableSize: b.reclaimableSize,
+	}
+	count := b.index.Size()
+	it := b.index.Iterator(false)
+	b.mutex.Unlock()
+	defer it.Close()
+
+	fileName := checkpointFileName(b.options.DirPath, marker.Fid, marker.Offset)
+	if _, err := os.Stat(fileName); err == nil {
+		return nil
+	}
+
+	// 先写入临时文件 完成后重命名 保证检查点原子可见
+	tmpName := fileName + ".tmp"
+	file, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(tmpName)
+	}()
+
+	crc := crc32.NewIEEE()
+	w := bufio.NewWriter(io.MultiWriter(file, crc))
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	writeUvarint := func(v uint64) {
+		n := binary.PutUvarint(buf, v)
+		_, _ = w.Write(buf[:n])
+	}
+	writeBytes := func(p []byte) {
+		writeUvarint(uint64(len(p)))
+		_, _ = w.Write(p)
+	}
+
+	_, _ = w.Write(checkpointMagic)
+	writeBytes(encodeIndexMarker(marker))
+	writeUvarint(uint64(count))
+	for it.Rewind(); it.Valid(); it.Next() {
+		writeBytes(it.Key())
+		writeBytes(data.EncodeLogRecordPos(it.Value()))
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint32(buf, crc.Sum32())
+	if _, err = file.Write(buf[:4]); err != nil {
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpName, fileName); err != nil {
+		return err
+	}
+
+	return removeCheckpoints(b.options.DirPath, checkpointRetained)
+}
+
+// 读取检查点文件 将索引写入当前索引结构
+func (b *Base) readCheckpoint(fileName string) (*indexMarker, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := stat.Size() - 4
+	if size < int64(len(checkpointMagic)) {
+		return nil, errCheckpointCorrupted
+	}
+
+	crc := crc32.NewIEEE()
+	r := bufio.NewReader(io.TeeReader(io.LimitReader(file, size), crc))
+	readBytes := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if n > uint64(size) {
+			return nil, errCheckpointCorrupted
+		}
+		p := make([]byte, n)
+		_, err = io.ReadFull(r, p)
+		return p, err
+	}
+
+	magic := make([]byte, len(checkpointMagic))
+	if _, err = io.ReadFull(r, magic); err != nil || string(magic) != string(checkpointMagic) {
+		return nil, errCheckpointCorrupted
+	}
+	buf, err := readBytes()
+	if err != nil {
+		return nil, errCheckpointCorrupted
+	}
+	marker, ok := decodeIndexMarker(buf)
+	if !ok {
+		return nil, errCheckpointCorrupted
+	}
+
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errCheckpointCorrupted
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := readBytes()
+		if err != nil {
+			return nil, errCheckpointCorrupted
+		}
+		pos, err := readBytes()
+		if err != nil {
+			return nil, errCheckpointCorrupted
+		}
+		b.index.Put(key, data.DecodeLogRecordPos(pos))
+	}
+
+	// 检查文件是否完整
+	if _, err = r.ReadByte(); err != io.EOF {
+		return nil, errCheckpointCorrupted
+	}
+	sum := make([]byte, 4)
+	if _, err = file.ReadAt(sum, size); err != nil {
+		return nil, errCheckpointCorrupted
+	}
+	if binary.LittleEndian.Uint32(sum) != crc.Sum32() {
+		return nil, errCheckpointCorrupted
+	}
+
+	return marker, nil
+}
+
+// 加载最新的有效检查点 无效的检查点被跳过
+// merged表示启动时加载了合并文件 此时检查点中的位置已经失效
+func (b *Base) loadCheckpoint(merged bool) (*indexMarker, error) {
+	if _, ok := b.index.(*bptree.BPTree); ok {
+		return nil, nil
+	}
+	if merged {
+		return nil, removeCheckpoints(b.options.DirPath, 0)
+	}
+
+	names, err := listCheckpoints(b.options.DirPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		marker, err := b.readCheckpoint(name)
+		if err == nil && b.checkIndexMarker(marker) {
+			return marker, nil
+		}
+		// 检查点不可用 重新创建索引
+		b.index = NewShardedIndexer(b.options.IndexType, b.options.IndexShards, b.options.DirPath, b.options.SyncWrites)
+	}
+	return nil, nil
+}
+
+// 定期写入检查点
+func (b *Base) autoCheckpoint() {
+	defer b.bgWait.Done()
+
+	ticker := time.NewTicker(b.options.CheckpointInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = b.Checkpoint()
+		case <-b.closeCh:
+			return
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package bases
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestBase_Checkpoint(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for _, key := range []string{"k1", "k2", "k3"} {
+		v := values.New([]byte("v-"+key), 0, iface.STRING)
+		assert.Nil(t, b.Set(key, &v))
+	}
+	assert.Nil(t, b.Checkpoint())
+
+	// 检查点之后的写入在启动时回放
+	v := values.New([]byte("v-k4"), 0, iface.STRING)
+	assert.Nil(t, b.Set("k4", &v))
+	assert.Nil(t, b.Del("k2"))
+	assert.Nil(t, b.Close())
+
+	// 破坏检查点之前的日志记录 启动时不再读取这些记录
+	file, err := os.OpenFile(data.GetDataFileName(opts.DirPath, 0), os.O_RDWR, 0644)
+	assert.Nil(t, err)
+	_, err = file.WriteAt([]byte{0, 0, 0, 0}, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, file.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, uint(3), b.Status().KeyCount())
+	val, err := b.Get("k4")
+	assert.Nil(t, err)
+	assert.Equal(t, "v-k4", val.String())
+	_, err = b.Get("k2")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+	assert.Nil(t, b.Close())
+}
+
+func TestBase_CheckpointOnClose(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.CheckpointInterval = time.Hour
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		v := values.New([]byte("value"), 0, iface.STRING)
+		assert.Nil(t, b.Set("key"+string(rune('a'+i)), &v))
+		assert.Nil(t, b.Checkpoint())
+	}
+	assert.Nil(t, b.Close())
+
+	// 只保留最新的检查点
+	names, err := listCheckpoints(opts.DirPath)
+	assert.Nil(t, err)
+	assert.Equal(t, checkpointRetained, len(names))
+
+	// 最新的检查点损坏时使用较旧的检查点
+	assert.Nil(t, os.WriteFile(checkpointFileName(opts.DirPath, 0, 1<<40), []byte("broken"), 0644))
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	assert.Equal(t, uint(3), b.Status().KeyCount())
+	val, err := b.Get("keyc")
+	assert.Nil(t, err)
+	assert.Equal(t, "value", val.String())
+}
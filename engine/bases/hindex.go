@@ -0,0 +1,407 @@
+package bases
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"sort"
+	"strings"
+	"time"
+)
+
+/// 哈希字段二级索引 索引定义和索引条目都以内部key保存在存储引擎中
+/// 索引条目 = 条目前缀 + 保序编码的字段值 + 哈希key 按字段值有序 支持范围查询
+
+const (
+	hashIndexDefMark   = "\x00meta:hidx:" // 索引定义
+	hashIndexEntryMark = "\x00hidx:"      // 索引条目
+)
+
+// HashIndex 哈希字段二级索引定义
+type HashIndex struct {
+	Name  string // 索引名称
+	Field string // 建立索引的哈希字段
+	Ready bool   // 已有数据是否已经建立索引
+}
+
+func encodeHashIndex(idx *HashIndex) []byte {
+	var ready byte
+	if idx.Ready {
+		ready = 1
+	}
+	return append([]byte{ready}, idx.Field...)
+}
+
+func decodeHashIndex(name string, buf []byte) *HashIndex {
+	if len(buf) == 0 {
+		return nil
+	}
+	return &HashIndex{Name: name, Field: string(buf[1:]), Ready: buf[0] == 1}
+}
+
+func hashIndexDefKey(name string) []byte {
+	return []byte(hashIndexDefMark + name)
+}
+
+func hashIndexEntryPrefix(name string) []byte {
+	return []byte(hashIndexEntryMark + name + "\x00")
+}
+
+// 保序编码字段值 0x00转义为0x00 0xFF 以0x00 0x01结尾
+// 编码后的字节序与原值的字节序一致 且可以确定值的结束位置
+func encodeIndexValue(dst, value []byte) []byte {
+	for _, c := range value {
+		dst = append(dst, c)
+		if c == 0x00 {
+			dst = append(dst, 0xFF)
+		}
+	}
+	return append(dst, 0x00, 0x01)
+}
+
+func decodeIndexValue(buf []byte) (value []byte, n int, ok bool) {
+	value = make([]byte, 0, len(buf))
+	for i := 0; i < len(buf); i++ {
+		if buf[i] != 0x00 {
+			value = append(value, buf[i])
+			continue
+		}
+		if i+1 >= len(buf) {
+			return nil, 0, false
+		}
+		if buf[i+1] == 0x01 {
+			return value, i + 2, true
+		}
+		value = append(value, 0x00)
+		i++
+	}
+	return nil, 0, false
+}
+
+func hashIndexEntryKey(name string, value []byte, key string) []byte {
+	entry := encodeIndexValue(hashIndexEntryPrefix(name), value)
+	return append(entry, key...)
+}
+
+// 解析索引条目 返回字段值和哈希key
+func decodeHashIndexEntry(prefix, entry []byte) ([]byte, []byte, bool) {
+	if !bytes.HasPrefix(entry, prefix) {
+		return nil, nil, false
+	}
+	value, n, ok := decodeIndexValue(entry[len(prefix):])
+	if !ok {
+		return nil, nil, false
+	}
+	return value, entry[len(prefix)+n:], true
+}
+
+// 判断是否为二级索引使用的内部key
+func isHashIndexKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(hashIndexDefMark)) || bytes.HasPrefix(key, []byte(hashIndexEntryMark))
+}
+
+// 启动时加载索引定义 未完成构建的索引在后台继续构建
+func (b *Base) loadHashIndexes() {
+	b.hashIndexes = make(map[string]*HashIndex)
+
+	prefix := []byte(hashIndexDefMark)
+	it := b.NewIterator(IteratorOptions{Prefix: prefix})
+	defer it.Close()
+	for it.Seek(prefix); it.Valid() && bytes.HasPrefix(it.Key(), prefix); it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			continue
+		}
+		name := string(it.Key()[len(prefix):])
+		if idx := decodeHashIndex(name, value); idx != nil {
+			b.hashIndexes[name] = idx
+		}
+	}
+
+	for _, idx := range b.hashIndexes {
+		if !idx.Ready {
+			b.bgWait.Add(1)
+			go b.buildHashIndex(*idx)
+		}
+	}
+}
+
+// CreateHashIndex 在哈希字段上创建二级索引 已有数据在后台建立索引
+func (b *Base) CreateHashIndex(name, field string) error {
+	if name == "" || field == "" || strings.ContainsRune(name, 0) {
+		return errno.ErrInvalidHashIndex
+	}
+
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	if _, ok := b.hashIndexes[name]; ok {
+		return errno.ErrHashIndexExists
+	}
+
+	idx := &HashIndex{Name: name, Field: field}
+	if err := b.writeWithLock(hashIndexDefKey(name), encodeHashIndex(idx), data.LogRecordNormal); err != nil {
+		return err
+	}
+	b.hashIndexes[name] = idx
+
+	// 创建之后的写入由HSet和HDel维护 之前的数据在后台补充
+	b.bgWait.Add(1)
+	go b.buildHashIndex(*idx)
+
+	return nil
+}
+
+// DropHashIndex 删除二级索引及其全部条目
+func (b *Base) DropHashIndex(name string) error {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	if _, ok := b.hashIndexes[name]; !ok {
+		return errno.ErrHashIndexNotFound
+	}
+	if err := b.writeWithLock(hashIndexDefKey(name), nil, data.LogRecordDeleted); err != nil {
+		return err
+	}
+	delete(b.hashIndexes, name)
+
+	// 先收集全部条目再删除 迭代期间不写入
+	prefix := hashIndexEntryPrefix(name)
+	entries := make([][]byte, 0)
+	it := b.NewIterator(IteratorOptions{Prefix: prefix})
+	for it.Seek(prefix); it.Valid() && bytes.HasPrefix(it.Key(), prefix); it.Next() {
+		entries = append(entries, append([]byte{}, it.Key()...))
+	}
+	it.Close()
+
+	wb := b.NewWriteBatch()
+	var n uint
+	for _, entry := range entries {
+		_ = wb.Delete(entry)
+		if n++; n >= wb.options.MaxBatchNum {
+			if err := wb.Commit(); err != nil {
+				return err
+			}
+			n = 0
+		}
+	}
+	return wb.Commit()
+}
+
+// HashIndexes 返回全部二级索引定义
+func (b *Base) HashIndexes() []HashIndex {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	indexes := make([]HashIndex, 0, len(b.hashIndexes))
+	for _, idx := range b.hashIndexes {
+		indexes = append(indexes, *idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Name < indexes[j].Name
+	})
+	return indexes
+}
+
+// LookupHashIndex 查找字段值等于value的哈希key
+func (b *Base) LookupHashIndex(name string, value []byte) ([][]byte, error) {
+	return b.RangeHashIndex(name, value, value)
+}
+
+// RangeHashIndex 查找字段值在[min, max]之间的哈希key 结果按字段值排序
+// min或max为nil时表示不限制
+func (b *Base) RangeHashIndex(name string, min, max []byte) ([][]byte, error) {
+	b.hashMutex.Lock()
+	idx, ok := b.hashIndexes[name]
+	b.hashMutex.Unlock()
+
+	if !ok {
+		return nil, errno.ErrHashIndexNotFound
+	}
+	if !idx.Ready {
+		return nil, errno.ErrHashIndexNotReady
+	}
+	field := []byte(idx.Field)
+
+	prefix := hashIndexEntryPrefix(name)
+	it := b.NewIterator(IteratorOptions{Prefix: prefix})
+
+	start := prefix
+	if min != nil {
+		start = encodeIndexValue(append([]byte{}, prefix...), min)
+	}
+
+	keys := make([][]byte, 0)
+	stale := make([][]byte, 0)
+	for it.Seek(start); it.Valid() && bytes.HasPrefix(it.Key(), prefix); it.Next() {
+		value, key, ok := decodeHashIndexEntry(prefix, it.Key())
+		if !ok {
+			continue
+		}
+		if max != nil && bytes.Compare(value, max) > 0 {
+			break
+		}
+
+		// 过期的哈希在重新写入之前条目不会清除 需要检查当前值
+		if !b.hashFieldEquals(key, field, value) {
+			stale = append(stale, append([]byte{}, it.Key()...))
+			continue
+		}
+		keys = append(keys, key)
+	}
+	it.Close()
+
+	b.removeStaleEntries(prefix, field, stale)
+	return keys, nil
+}
+
+// 判断哈希字段的当前值是否与索引条目一致
+func (b *Base) hashFieldEquals(key, field, value []byte) bool {
+	v, err := b.HGet(utils.B2S(key), field)
+	return err == nil && v != nil && bytes.Equal(v.Bytes(), value)
+}
+
+// 删除查询时发现的过期条目 持有hashMutex后再次检查 避免删除并发写入的条目
+func (b *Base) removeStaleEntries(prefix, field []byte, stale [][]byte) {
+	if len(stale) == 0 {
+		return
+	}
+
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	wb := b.NewWriteBatch()
+	for _, entry := range stale {
+		value, key, ok := decodeHashIndexEntry(prefix, entry)
+		if ok && !b.hashFieldEquals(key, field, value) {
+			_ = wb.Delete(entry)
+		}
+	}
+	_ = wb.Commit()
+}
+
+// 在事务中删除哈希全部字段的索引条目 meta为哈希删除或过期前的元信息
+// 访问此方法前要持有hashMutex
+func (b *Base) deleteHashIndexEntries(wb *WriteBatch, key string, meta *values.Meta) {
+	for _, idx := range b.hashIndexes {
+		v, err := b.Get(values.NewHashInternalKey(key, meta.Version, []byte(idx.Field)).String())
+		if err != nil {
+			continue
+		}
+		_ = wb.Delete(hashIndexEntryKey(idx.Name, v.Bytes(), key))
+	}
+}
+
+// 返回key保存的哈希元信息 不是哈希时返回nil
+func (b *Base) hashMeta(key string) *values.Meta {
+	val, err := b.Get(key)
+	if err != nil {
+		return nil
+	}
+	meta, ok := values.ParseMeta(val.Bytes())
+	if !ok || meta.DataType != iface.HASH {
+		return nil
+	}
+	return meta
+}
+
+// 删除key 如果是建立了二级索引的哈希 在同一个事务中删除索引条目
+func (b *Base) delHash(key string) (bool, error) {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	if len(b.hashIndexes) == 0 {
+		return false, nil
+	}
+	meta := b.hashMeta(key)
+	if meta == nil {
+		return false, nil
+	}
+
+	wb := b.NewWriteBatch()
+	b.deleteHashIndexEntries(wb, key, meta)
+	_ = wb.Delete(utils.S2B(key))
+	return true, wb.Commit()
+}
+
+// 在事务中更新字段对应的索引条目 value为nil表示删除字段
+// 访问此方法前要持有hashMutex
+func (b *Base) updateHashIndexes(wb *WriteBatch, key string, field, oldValue, value []byte) {
+	for _, idx := range b.hashIndexes {
+		if idx.Field != string(field) {
+			continue
+		}
+		if oldValue != nil && (value == nil || !bytes.Equal(oldValue, value)) {
+			_ = wb.Delete(hashIndexEntryKey(idx.Name, oldValue, key))
+		}
+		if value != nil {
+			_ = wb.Put(hashIndexEntryKey(idx.Name, value, key), []byte{})
+		}
+	}
+}
+
+// 为已有的哈希数据建立索引 完成后标记索引可用
+func (b *Base) buildHashIndex(idx HashIndex) {
+	defer b.bgWait.Done()
+
+	field := []byte(idx.Field)
+
+	// 先收集全部key再写入 持久化索引的迭代器持有只读事务 不能在迭代期间写入
+	keys := make([]string, 0)
+	it := b.index.Iterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		if key := it.Key(); !isHashIndexKey(key) {
+			keys = append(keys, string(key))
+		}
+	}
+	it.Close()
+
+	for _, key := range keys {
+		select {
+		case <-b.closeCh:
+			// 下次启动时继续构建
+			return
+		default:
+		}
+		b.indexHashField(idx.Name, key, field)
+	}
+
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	// 构建期间索引可能已被删除
+	current, ok := b.hashIndexes[idx.Name]
+	if !ok || current.Field != idx.Field {
+		return
+	}
+	ready := &HashIndex{Name: idx.Name, Field: idx.Field, Ready: true}
+	if err := b.writeWithLock(hashIndexDefKey(idx.Name), encodeHashIndex(ready), data.LogRecordNormal); err == nil {
+		b.hashIndexes[idx.Name] = ready
+	}
+}
+
+// 如果key是包含指定字段的哈希 写入索引条目
+func (b *Base) indexHashField(name string, key string, field []byte) {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
+	if _, ok := b.hashIndexes[name]; !ok {
+		return
+	}
+
+	meta := b.hashMeta(key)
+	if meta == nil || meta.Size == 0 || (meta.Expire != 0 && meta.Expire <= time.Now().UnixNano()) {
+		return
+	}
+
+	v, err := b.Get(values.NewHashInternalKey(key, meta.Version, field).String())
+	if err != nil {
+		return
+	}
+	wb := b.NewWriteBatch()
+	_ = wb.Put(hashIndexEntryKey(name, v.Bytes(), key), []byte{})
+	_ = wb.Commit()
+}
@@ -0,0 +1,176 @@
+package bases
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func waitHashIndexReady(t *testing.T, b *Base, name string) {
+	assert.Eventually(t, func() bool {
+		for _, idx := range b.HashIndexes() {
+			if idx.Name == name {
+				return idx.Ready
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+}
+
+func toStrings(keys [][]byte) []string {
+	res := make([]string, len(keys))
+	for i, key := range keys {
+		res[i] = string(key)
+	}
+	return res
+}
+
+func TestEncodeIndexValue(t *testing.T) {
+	values := [][]byte{{}, {0x00}, {0x00, 0x00}, {0x00, 0x01}, []byte("a"), []byte("a\x00b"), []byte("ab")}
+	for i := 1; i < len(values); i++ {
+		// 编码后保持原有顺序
+		prev := encodeIndexValue(nil, values[i-1])
+		curr := encodeIndexValue(nil, values[i])
+		assert.Less(t, string(prev), string(curr))
+
+		value, n, ok := decodeIndexValue(append(curr, "key"...))
+		assert.True(t, ok)
+		assert.Equal(t, values[i], value)
+		assert.Equal(t, len(curr), n)
+	}
+}
+
+func countIndexEntries(b *Base, name string) int {
+	prefix := hashIndexEntryPrefix(name)
+	n := 0
+	for _, key := range b.ListKeys() {
+		if bytes.HasPrefix(key, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// 将哈希的过期时间改为已过期
+func expireHash(t *testing.T, b *Base, key string) {
+	meta := b.hashMeta(key)
+	assert.NotNil(t, meta)
+	meta.Expire = time.Now().Add(-time.Second).UnixNano()
+	val := meta.Value()
+	assert.Nil(t, b.Set(key, &val))
+}
+
+func TestBase_HashIndex(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+
+	users := map[string]string{"user:1": "DE", "user:2": "FR", "user:3": "DE", "user:4": "US"}
+	for key, country := range users {
+		_, err = b.HSet(key, []byte("country"), []byte(country))
+		assert.Nil(t, err)
+		_, err = b.HSet(key, []byte("name"), []byte(key))
+		assert.Nil(t, err)
+	}
+
+	// 在已有数据上创建索引 后台构建完成后可以查询
+	assert.Nil(t, b.CreateHashIndex("by_country", "country"))
+	assert.Equal(t, errno.ErrHashIndexExists, b.CreateHashIndex("by_country", "country"))
+	assert.Equal(t, errno.ErrInvalidHashIndex, b.CreateHashIndex("", "country"))
+	waitHashIndexReady(t, b, "by_country")
+
+	keys, err := b.LookupHashIndex("by_country", []byte("DE"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:1", "user:3"}, toStrings(keys))
+
+	// 写入时同步维护索引
+	_, err = b.HSet("user:2", []byte("country"), []byte("DE"))
+	assert.Nil(t, err)
+	_, err = b.HSet("user:1", []byte("country"), []byte("US"))
+	assert.Nil(t, err)
+	_, err = b.HDel("user:3", []byte("country"))
+	assert.Nil(t, err)
+	_, err = b.HSet("user:5", []byte("country"), []byte("CN"))
+	assert.Nil(t, err)
+
+	keys, err = b.LookupHashIndex("by_country", []byte("DE"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:2"}, toStrings(keys))
+
+	// 范围查询按字段值排序
+	keys, err = b.RangeHashIndex("by_country", []byte("CN"), []byte("DE"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:5", "user:2"}, toStrings(keys))
+	keys, err = b.RangeHashIndex("by_country", []byte("E"), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:1", "user:4"}, toStrings(keys))
+
+	// 整个哈希删除后同时删除索引条目
+	assert.Equal(t, 4, countIndexEntries(b, "by_country"))
+	assert.Nil(t, b.Del("user:4"))
+	assert.Equal(t, 3, countIndexEntries(b, "by_country"))
+	keys, err = b.LookupHashIndex("by_country", []byte("US"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:1"}, toStrings(keys))
+
+	// 过期的哈希重新写入时删除旧条目
+	expireHash(t, b, "user:2")
+	_, err = b.HSet("user:2", []byte("country"), []byte("FR"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, countIndexEntries(b, "by_country"))
+
+	// 查询时发现的过期条目被清除
+	expireHash(t, b, "user:1")
+	keys, err = b.LookupHashIndex("by_country", []byte("US"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+	assert.Equal(t, 2, countIndexEntries(b, "by_country"))
+	assert.Nil(t, b.Close())
+
+	// 索引定义在重启后仍然存在
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	assert.Equal(t, []HashIndex{{Name: "by_country", Field: "country", Ready: true}}, b.HashIndexes())
+	keys, err = b.LookupHashIndex("by_country", []byte("CN"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:5"}, toStrings(keys))
+
+	assert.Nil(t, b.DropHashIndex("by_country"))
+	_, err = b.LookupHashIndex("by_country", []byte("CN"))
+	assert.Equal(t, errno.ErrHashIndexNotFound, err)
+	for _, key := range b.ListKeys() {
+		assert.False(t, isHashIndexKey(key))
+	}
+}
+
+// 持久化索引的迭代器持有只读事务 构建时不能在迭代期间写入
+func TestBase_HashIndexPersistent(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.IndexType = BPT
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+
+	for _, key := range []string{"user:1", "user:2"} {
+		_, err = b.HSet(key, []byte("country"), []byte("DE"))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, b.CreateHashIndex("by_country", "country"))
+	waitHashIndexReady(t, b, "by_country")
+
+	keys, err := b.LookupHashIndex("by_country", []byte("DE"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:1", "user:2"}, toStrings(keys))
+
+	assert.Nil(t, b.DropHashIndex("by_country"))
+	assert.Equal(t, 0, countIndexEntries(b, "by_country"))
+}
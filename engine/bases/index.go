@@ -0,0 +1,123 @@
+package bases
+
+import (
+	"encoding/binary"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/pkg/dates/bptree"
+)
+
+/// 持久化索引 正常关闭时记录索引已经覆盖的日志位置
+/// 重启时如果标记有效 只回放标记之后的日志记录 无需重建索引
+
+var indexMarkerKey = []byte("index.marker")
+
+// 索引标记 记录持久化索引对应的日志位置和引擎状态
+type indexMarker struct {
+	Fid             uint32 // 活跃文件ID
+	Offset          int64  // 活跃文件写偏移
+	SeqNo           uint64 // 事务序列号
+	ReclaimableSize int64  // 可回收空间
+}
+
+func encodeIndexMarker(m *indexMarker) []byte {
+	buf := make([]byte, binary.MaxVarintLen32+binary.MaxVarintLen64*3)
+	var index = 0
+	index += binary.PutUvarint(buf[index:], uint64(m.Fid))
+	index += binary.PutVarint(buf[index:], m.Offset)
+	index += binary.PutUvarint(buf[index:], m.SeqNo)
+	index += binary.PutVarint(buf[index:], m.ReclaimableSize)
+	return buf[:index]
+}
+
+func decodeIndexMarker(buf []byte) (*indexMarker, bool) {
+	var index = 0
+	fid, n := binary.Uvarint(buf[index:])
+	if n <= 0 {
+		return nil, false
+	}
+	index += n
+	offset, n := binary.Varint(buf[index:])
+	if n <= 0 {
+		return nil, false
+	}
+	index += n
+	seqNo, n := binary.Uvarint(buf[index:])
+	if n <= 0 {
+		return nil, false
+	}
+	index += n
+	reclaimableSize, n := binary.Varint(buf[index:])
+	if n <= 0 {
+		return nil, false
+	}
+
+	return &indexMarker{
+		Fid:             uint32(fid),
+		Offset:          offset,
+		SeqNo:           seqNo,
+		ReclaimableSize: reclaimableSize,
+	}, true
+}
+
+// 读取并删除索引标记 标记无效时清空持久化索引
+// 标记被删除后如果进程异常退出 下次启动会完整重建索引
+// merged表示启动时加载了合并文件 此时索引中的位置已经失效
+func (b *Base) takeIndexMarker(merged bool) (*indexMarker, error) {
+	tree, ok := b.index.(*bptree.BPTree)
+	if !ok {
+		return nil, nil
+	}
+
+	marker, ok := decodeIndexMarker(tree.GetMeta(indexMarkerKey))
+	if ok && (merged || !b.checkIndexMarker(marker)) {
+		marker, ok = nil, false
+	}
+	if !ok {
+		return nil, tree.Clear()
+	}
+
+	if err := tree.DeleteMeta(indexMarkerKey); err != nil {
+		return nil, err
+	}
+	return marker, tree.Sync()
+}
+
+// 检查标记位置在数据文件中是否存在
+func (b *Base) checkIndexMarker(marker *indexMarker) bool {
+	if b.activeFile == nil || marker.Fid > b.activeFile.FileId {
+		return false
+	}
+
+	var dataFile *data.File
+	if marker.Fid == b.activeFile.FileId {
+		dataFile = b.activeFile
+	} else {
+		dataFile = b.olderFiles[marker.Fid]
+	}
+	if dataFile == nil {
+		return false
+	}
+
+	size, err := dataFile.IOManager.Size()
+	return err == nil && marker.Offset <= size
+}
+
+// 关闭前写入索引标记
+// 访问此方法前要持有互斥锁
+func (b *Base) saveIndexMarker() error {
+	tree, ok := b.index.(*bptree.BPTree)
+	if !ok || b.activeFile == nil {
+		return nil
+	}
+
+	marker := &indexMarker{
+		Fid:             b.activeFile.FileId,
+		Offset:          b.activeFile.WriteOff,
+		SeqNo:           b.seqNo,
+		ReclaimableSize: b.reclaimableSize,
+	}
+	if err := tree.PutMeta(indexMarkerKey, encodeIndexMarker(marker)); err != nil {
+		return err
+	}
+	return tree.Sync()
+}
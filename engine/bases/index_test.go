@@ -0,0 +1,296 @@
+package bases
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/dates/bptree"
+	"github.com/T4t4KAU/TikBase/pkg/dates/shard"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestNewIndexerType(t *testing.T) {
+	assert.Equal(t, SL, NewIndexerType("SL"))
+	assert.Equal(t, BPT, NewIndexerType("bpt"))
+	assert.Equal(t, ART, NewIndexerType("unknown"))
+}
+
+func TestBase_Indexers(t *testing.T) {
+	for _, typ := range []IndexerType{BT, ART, SL, BPT} {
+		dir, _ := os.MkdirTemp("", "index")
+		opts := DefaultOptions
+		opts.DirPath = dir
+		opts.MMapAtStartup = false
+		opts.IndexType = typ
+
+		b, err := NewBaseWith(opts)
+		assert.Nil(t, err)
+
+		for _, key := range []string{"k3", "k1", "k2"} {
+			v := values.New([]byte("v-"+key), 0, iface.STRING)
+			assert.Nil(t, b.Set(key, &v))
+		}
+		assert.Nil(t, b.Del("k2"))
+
+		wb := b.NewWriteBatch()
+		assert.Nil(t, wb.Put([]byte("k4"), []byte("v-k4")))
+		assert.Nil(t, wb.Commit())
+
+		it := b.NewIterator(IteratorOptions{Reverse: true})
+		keys := make([]string, 0)
+		for it.Rewind(); it.Valid(); it.Next() {
+			keys = append(keys, string(it.Key()))
+		}
+		it.Close()
+		assert.Equal(t, []string{"k4", "k3", "k1"}, keys, "indexer %d", typ)
+		assert.Nil(t, b.Close())
+
+		// 重启后索引与数据一致
+		b, err = NewBaseWith(opts)
+		assert.Nil(t, err)
+		assert.Equal(t, uint(3), b.Status().KeyCount())
+		v, err := b.Get("k4")
+		assert.Nil(t, err)
+		assert.Equal(t, "v-k4", v.String())
+		_, err = b.Get("k2")
+		assert.Equal(t, errno.ErrKeyNotFound, err)
+		destroyDB(b)
+	}
+}
+
+func TestBase_PersistentIndex(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.IndexType = BPT
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for _, key := range []string{"k1", "k2", "k3"} {
+		v := values.New([]byte("v-"+key), 0, iface.STRING)
+		assert.Nil(t, b.Set(key, &v))
+	}
+	assert.Nil(t, b.Close())
+
+	// 正常关闭后写入标记 修改索引文件后重启 索引直接复用不会重建
+	tree := bptree.New(opts.DirPath, false)
+	assert.NotNil(t, tree.GetMeta(indexMarkerKey))
+	tree.Delete([]byte("k1"))
+	assert.Nil(t, tree.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	_, err = b.Get("k1")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+
+	// 标记之后写入的数据在重启时回放
+	v := values.New([]byte("v-k4"), 0, iface.STRING)
+	assert.Nil(t, b.Set("k4", &v))
+	assert.Nil(t, b.Del("k2"))
+	assert.Nil(t, b.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, uint(2), b.Status().KeyCount())
+	val, err := b.Get("k4")
+	assert.Nil(t, err)
+	assert.Equal(t, "v-k4", val.String())
+	assert.Nil(t, b.Close())
+
+	// 标记丢失时视为异常退出 从数据文件重建索引
+	tree = bptree.New(opts.DirPath, false)
+	assert.Nil(t, tree.DeleteMeta(indexMarkerKey))
+	assert.Nil(t, tree.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	val, err = b.Get("k1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v-k1", val.String())
+	assert.Equal(t, uint(3), b.Status().KeyCount())
+}
+
+func TestBase_ShardedIndex(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.IndexShards = 8
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	assert.IsType(t, &shard.Index{}, b.index)
+
+	for i := 0; i < 100; i++ {
+		v := values.New([]byte(fmt.Sprintf("v%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%03d", i), &v))
+	}
+	assert.Nil(t, b.Del("key-000"))
+
+	// 跨分片的前缀迭代仍然有序
+	it := b.NewIterator(IteratorOptions{Prefix: []byte("key-09")})
+	keys := make([]string, 0)
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"key-090", "key-091", "key-092", "key-093", "key-094",
+		"key-095", "key-096", "key-097", "key-098", "key-099"}, keys)
+	assert.Nil(t, b.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+	assert.Equal(t, uint(99), b.Status().KeyCount())
+	v, err := b.Get("key-042")
+	assert.Nil(t, err)
+	assert.Equal(t, "v42", v.String())
+}
+
+// 读取不持有全局锁 各类索引需要自身保证并发读写安全 使用-race运行
+func TestBase_ConcurrentGetSet(t *testing.T) {
+	for _, typ := range []IndexerType{BT, ART, SL, BPT} {
+		for _, shards := range []int{0, 4} {
+			opts := DefaultOptions
+			opts.DirPath = t.TempDir()
+			opts.MMapAtStartup = false
+			opts.IndexType = typ
+			opts.IndexShards = shards
+
+			b, err := NewBaseWith(opts)
+			assert.Nil(t, err)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(2)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < 200; j++ {
+						v := values.New([]byte("value"), 0, iface.STRING)
+						assert.Nil(t, b.Set(fmt.Sprintf("key-%d", j), &v))
+					}
+				}(i)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < 200; j++ {
+						_, _ = b.Get(fmt.Sprintf("key-%d", j))
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			assert.Equal(t, uint(200), b.Status().KeyCount(), "indexer %d shards %d", typ, shards)
+			assert.Nil(t, b.Close())
+		}
+	}
+}
+
+func TestBase_ConcurrentReadWrite(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.IndexShards = 4
+	opts.DataFileSize = 64 * 1024 // 写入期间切换活跃文件
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer b.Close()
+
+	v := values.New([]byte("value"), 0, iface.STRING)
+	assert.Nil(t, b.Set("key", &v))
+
+	// 追加日志期间读取不被阻塞
+	b.appendMutex.Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		val, err := b.Get("key")
+		assert.Nil(t, err)
+		assert.Equal(t, "value", val.String())
+	}()
+	<-done
+	b.appendMutex.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				key := fmt.Sprintf("key%d", j%50)
+				if i%2 == 0 {
+					assert.Nil(t, b.Set(key, &v))
+					continue
+				}
+				wb := b.NewWriteBatch()
+				assert.Nil(t, wb.Put([]byte(key), []byte("value")))
+				assert.Nil(t, wb.Put([]byte(fmt.Sprintf("batch%d", j%50)), []byte("value")))
+				assert.Nil(t, wb.Commit())
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				if val, err := b.Get(fmt.Sprintf("key%d", j%50)); err == nil {
+					assert.Equal(t, "value", val.String())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint(101), b.Status().KeyCount())
+}
+
+// 与BenchmarkBase_Set/Get对比 分别使用单个索引和分片索引并发读写
+func benchmarkIndexShards(b *testing.B, shards int, read bool) {
+	opts := DefaultOptions
+	opts.DirPath = b.TempDir()
+	opts.IndexShards = shards
+	base, err := NewBaseWith(opts)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer base.Close()
+
+	const keyNum = 100000
+	if read {
+		for i := 0; i < keyNum; i++ {
+			v := values.New([]byte("value"), 0, iface.STRING)
+			_ = base.Set(fmt.Sprintf("key%d", i), &v)
+		}
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			key := fmt.Sprintf("key%d", i%keyNum)
+			if read {
+				_, _ = base.Get(key)
+			} else {
+				v := values.New([]byte("value"), 0, iface.STRING)
+				_ = base.Set(key, &v)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkBase_ParallelSet(b *testing.B) {
+	benchmarkIndexShards(b, 1, false)
+}
+
+func BenchmarkBase_ShardedSet(b *testing.B) {
+	benchmarkIndexShards(b, 16, false)
+}
+
+func BenchmarkBase_ParallelGet(b *testing.B) {
+	benchmarkIndexShards(b, 1, true)
+}
+
+func BenchmarkBase_ShardedGet(b *testing.B) {
+	benchmarkIndexShards(b, 16, true)
+}
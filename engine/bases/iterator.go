@@ -34,9 +34,6 @@ func (it *Iterator) Next() {
 
 func (it *Iterator) Value() ([]byte, error) {
 	pos := it.iterator.Value()
-	it.base.mutex.RLock()
-	defer it.base.mutex.RUnlock()
-
 	return it.base.getValueByPosition(pos)
 }
 
@@ -0,0 +1,175 @@
+package bases
+
+import (
+	"context"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	conc "github.com/T4t4KAU/TikBase/pkg/conc/pool"
+	"io"
+)
+
+/// 并行加载索引 多个数据文件同时读取到各自的局部索引
+/// 再按文件ID顺序合并到索引中 跨文件的事务在合并时处理
+
+// 局部索引中的一条记录
+type fileIndexEntry struct {
+	typ   data.LogRecordType
+	pos   *data.LogRecordPos
+	order int64 // 生效位置 事务中的记录在完成标记处生效
+}
+
+// 事务完成标记
+type txnFinished struct {
+	seqNo  uint64
+	offset int64
+}
+
+// 单个数据文件的局部索引 只保留每个key在文件中的最后一次操作
+type fileIndex struct {
+	entries         map[string]*fileIndexEntry
+	txRecords       map[uint64][]*data.TxRecord // 文件中未完成的事务
+	finished        []txnFinished               // 文件中所有事务完成标记
+	seqNo           uint64
+	reclaimableSize int64 // 文件内被覆盖的记录大小
+	offset          int64 // 文件读取结束的位置
+	err             error
+	done            chan struct{}
+}
+
+// 记录一次操作 生效位置较早的操作被覆盖
+func (fi *fileIndex) apply(key []byte, typ data.LogRecordType, pos *data.LogRecordPos, order int64) {
+	if e, ok := fi.entries[string(key)]; ok {
+		if e.order >= order {
+			if typ != data.LogRecordDeleted {
+				fi.reclaimableSize += int64(pos.Size)
+			}
+			return
+		}
+		if e.typ != data.LogRecordDeleted {
+			fi.reclaimableSize += int64(e.pos.Size)
+		}
+	}
+	fi.entries[string(key)] = &fileIndexEntry{typ: typ, pos: pos, order: order}
+}
+
+// 读取数据文件中从offset开始的记录
+func (fi *fileIndex) load(dataFile *data.File, offset int64) {
+	defer close(fi.done)
+
+	for {
+		rec, size, err := dataFile.ReadLogRecord(offset)
+		if err != nil {
+			if err != io.EOF {
+				fi.err = err
+			}
+			break
+		}
+
+		pos := &data.LogRecordPos{Fid: dataFile.FileId, Offset: offset, Size: uint32(size)}
+
+		realKey, seqNo := parseLogRecordKey(rec.Key)
+		if seqNo == nonTransactionSeqNo {
+			fi.apply(realKey, rec.Type, pos, offset)
+		} else if rec.Type == data.LogRecordTxnFinished {
+			for _, txRecord := range fi.txRecords[seqNo] {
+				fi.apply(txRecord.Record.Key, txRecord.Record.Type, txRecord.Pos, offset)
+			}
+			delete(fi.txRecords, seqNo)
+			fi.finished = append(fi.finished, txnFinished{seqNo: seqNo, offset: offset})
+		} else {
+			rec.Key = realKey
+			fi.txRecords[seqNo] = append(fi.txRecords[seqNo], &data.TxRecord{
+				Record: rec,
+				Pos:    pos,
+			})
+		}
+
+		if seqNo > fi.seqNo {
+			fi.seqNo = seqNo
+		}
+		offset += size
+	}
+	fi.offset = offset
+}
+
+// 并行读取数据文件 按文件ID顺序合并局部索引
+func (b *Base) loadIndexParallel(files []*data.File, startFid uint32, startOffset int64) error {
+	pool := conc.NewPool("index-loader", int32(b.options.LoadConcurrency))
+
+	indexes := make([]*fileIndex, len(files))
+	for i, dataFile := range files {
+		var offset int64 = 0
+		if dataFile.FileId == startFid {
+			offset = startOffset
+		}
+
+		fi := &fileIndex{
+			entries:   make(map[string]*fileIndexEntry),
+			txRecords: make(map[uint64][]*data.TxRecord),
+			done:      make(chan struct{}),
+		}
+		indexes[i] = fi
+
+		dataFile := dataFile
+		pool.Run(context.Background(), func() {
+			fi.load(dataFile, offset)
+		})
+	}
+
+	// 尚未完成的跨文件事务
+	carried := make(map[uint64][]*data.TxRecord)
+	var currentSeqNo = b.seqNo
+	var err error
+
+	for i, fi := range indexes {
+		<-fi.done
+		if err != nil {
+			continue
+		}
+		if fi.err != nil {
+			err = fi.err
+			continue
+		}
+
+		// 事务在之前的文件中开始 在当前文件中完成
+		for _, fin := range fi.finished {
+			for _, txRecord := range carried[fin.seqNo] {
+				fi.apply(txRecord.Record.Key, txRecord.Record.Type, txRecord.Pos, fin.offset)
+			}
+			delete(carried, fin.seqNo)
+		}
+		for seqNo, records := range fi.txRecords {
+			carried[seqNo] = append(carried[seqNo], records...)
+		}
+
+		for key, e := range fi.entries {
+			var oldPos *data.LogRecordPos
+			if e.typ == data.LogRecordDeleted {
+				oldPos, _ = b.index.Delete([]byte(key))
+			} else {
+				oldPos = b.index.Put([]byte(key), e.pos)
+			}
+			if oldPos != nil {
+				b.reclaimableSize += int64(oldPos.Size)
+			}
+		}
+		b.reclaimableSize += fi.reclaimableSize
+
+		if fi.seqNo > currentSeqNo {
+			currentSeqNo = fi.seqNo
+		}
+
+		// 如果当前为活跃文件 更新该文件写偏移
+		if i == len(indexes)-1 {
+			b.activeFile.WriteOff = fi.offset
+		}
+
+		// 合并后释放局部索引
+		indexes[i] = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	b.seqNo = currentSeqNo
+	return nil
+}
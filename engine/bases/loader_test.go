@@ -0,0 +1,58 @@
+package bases
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBase_LoadIndexParallel(t *testing.T) {
+	opts := DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	opts.DataFileSize = 512 // 使事务跨越多个数据文件
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	for i := 0; i < 200; i++ {
+		v := values.New([]byte(fmt.Sprintf("value-%d", i)), 0, iface.STRING)
+		assert.Nil(t, b.Set(fmt.Sprintf("key-%d", i%50), &v))
+		if i%7 == 0 {
+			assert.Nil(t, b.Del(fmt.Sprintf("key-%d", (i+3)%50)))
+		}
+		if i%20 == 0 {
+			wb := b.NewWriteBatch()
+			for j := 0; j < 10; j++ {
+				assert.Nil(t, wb.Put([]byte(fmt.Sprintf("key-%d", (i+j)%50)), []byte(fmt.Sprintf("batch-%d", i))))
+			}
+			assert.Nil(t, wb.Delete([]byte(fmt.Sprintf("key-%d", (i+11)%50))))
+			assert.Nil(t, wb.Commit())
+		}
+	}
+	assert.Nil(t, b.Close())
+
+	load := func(concurrency int) (map[string]string, *Status, uint64) {
+		opts.LoadConcurrency = concurrency
+		b, err := NewBaseWith(opts)
+		assert.Nil(t, err)
+		defer b.Close()
+
+		kvs := make(map[string]string)
+		assert.Nil(t, b.Fold(func(key []byte, value []byte) bool {
+			kvs[string(key)] = string(value)
+			return true
+		}))
+		return kvs, b.Status(), b.seqNo
+	}
+
+	// 并发加载与顺序加载的结果一致
+	kvs, st, seqNo := load(1)
+	assert.Greater(t, st.DataFileNum, uint(10))
+	pkvs, pst, pseqNo := load(8)
+	assert.Equal(t, kvs, pkvs)
+	assert.Equal(t, st.ReclaimableSize, pst.ReclaimableSize)
+	assert.Equal(t, seqNo, pseqNo)
+	assert.Greater(t, int64(pst.LoadTime), int64(0))
+}
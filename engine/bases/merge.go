@@ -38,11 +38,21 @@ func (b *Base) Merge() error {
 		b.merging = false
 	}()
 
+	// 持久化当前活跃文件 直接IO缓冲的数据写入后才能统计目录大小
+	if err := b.activeFile.Sync(); err != nil {
+		b.mutex.Unlock()
+		return err
+	}
+
 	totalSize, err := utils.DirSize(b.options.DirPath)
 	if err != nil {
 		b.mutex.Unlock()
 		return err
 	}
+	// 活跃文件预分配的空间不计入
+	if stat, err := os.Stat(data.GetDataFileName(b.options.DirPath, b.activeFile.FileId)); err == nil && stat.Size() > b.activeFile.WriteOff {
+		totalSize -= stat.Size() - b.activeFile.WriteOff
+	}
 
 	if float32(b.reclaimableSize)/float32(totalSize) < b.options.DataFileMergeRatio {
 		b.mutex.Unlock()
@@ -60,14 +70,21 @@ func (b *Base) Merge() error {
 		return errno.ErrNotEnoughDiskForMerge
 	}
 
-	// 持久化当前活跃文件
-	if err = b.activeFile.Sync(); err != nil {
+	nonMergeFileId := b.activeFile.FileId + 1 // merge后新文件ID
+
+	// 合并会删除旧文件 不能丢失消费者尚未读取的变更
+	if err = b.advanceChangeOffsets(nonMergeFileId); err != nil {
 		b.mutex.Unlock()
 		return err
 	}
 
-	nonMergeFileId := b.activeFile.FileId + 1        // merge后新文件ID
+	if err = b.sealBloom(b.activeFile.FileId); err != nil {
+		b.mutex.Unlock()
+		return err
+	}
+	b.fileMutex.Lock()
 	b.olderFiles[b.activeFile.FileId] = b.activeFile // 归入旧文件
+	b.fileMutex.Unlock()
 
 	// 打开并设置新的活跃文件
 	if err = b.setActiveDataFile(); err != nil {
@@ -100,6 +117,9 @@ func (b *Base) Merge() error {
 
 	mergeOptions := b.options
 	mergeOptions.SyncWrites = false
+	// 合并时不使用临时数据库的索引 避免在合并目录中创建持久化索引
+	mergeOptions.IndexType = BT
+	mergeOptions.BlockCacheSize = 0
 
 	// 指定merge目录
 	mergeOptions.DirPath = mergePath
@@ -107,12 +127,15 @@ func (b *Base) Merge() error {
 	if err != nil {
 		return err
 	}
+	// 关闭临时数据库 释放合并目录中的文件锁
+	defer mergeDB.Close()
 
 	// 打开Hint文件 文件存储索引
 	hintFile, err := data.OpenHintFile(mergeDB.options.DirPath)
 	if err != nil {
 		return err
 	}
+	defer hintFile.Close()
 
 	// 遍历待merge文件
 	for _, dataFile := range mergeFiles {
@@ -159,6 +182,11 @@ func (b *Base) Merge() error {
 		return err
 	}
 
+	// 合并后最后一个文件的过滤器 其他文件的过滤器在写满时已经保存
+	if err = mergeDB.saveActiveBloom(); err != nil {
+		return err
+	}
+
 	// 标识文件 表示merge已经完成
 	mergeFinFile, err := data.OpenMergeFinishedFile(mergeDB.options.DirPath)
 	if err != nil {
@@ -181,6 +209,11 @@ func (b *Base) Merge() error {
 		return err
 	}
 
+	// 合并的文件在重启后被替换 其中的记录位置不再有效
+	if b.blockCache != nil {
+		b.blockCache.removeBefore(nonMergeFileId)
+	}
+
 	return nil
 }
 
@@ -245,6 +278,29 @@ func (b *Base) getNonMergeFileId(dirPath string) (uint32, error) {
 	return uint32(nonMergeFileId), nil
 }
 
+// 旧版本关闭时将序列号写入merge-finished文件 会被误认为合并完成的标识
+// 文件中第一条记录是序列号时删除该文件 序列号在加载数据文件时恢复
+func (b *Base) removeLegacySeqNoFile() error {
+	fileName := filepath.Join(b.options.DirPath, data.MergeFinishedFileName)
+	if _, err := os.Stat(fileName); err != nil {
+		return nil
+	}
+
+	file, err := data.OpenMergeFinishedFile(b.options.DirPath)
+	if err != nil {
+		return err
+	}
+	rec, _, err := file.ReadLogRecord(0)
+	_ = file.Close()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if err == nil && utils.B2S(rec.Key) != SeqNoKey {
+		return nil
+	}
+	return os.Remove(fileName)
+}
+
 // LoadMergeFiles 加载合并文件
 func (b *Base) LoadMergeFiles() error {
 	// 如果合并目录存在则加载
@@ -296,10 +352,16 @@ func (b *Base) LoadMergeFiles() error {
 				return err
 			}
 		}
+		if err = os.Remove(data.GetBloomFileName(b.options.DirPath, fileId)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
 	}
 
-	// 将已经合并过的文件移动到新路径
+	// 将已经合并过的文件移动到新路径 临时数据库的文件锁和序列号文件不移动
 	for _, fileName := range fileNames {
+		if fileName == fileLockName || fileName == data.SeqNoFileName {
+			continue
+		}
 		srcPath := filepath.Join(mergePath, fileName)
 		destPath := filepath.Join(b.options.DirPath, fileName)
 		if err = os.Rename(srcPath, destPath); err != nil {
@@ -1,19 +1,26 @@
 package bases
 
 import (
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/pkg/fio"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 )
 
 const (
 	BT  IndexerType = iota + 1
 	ART             // 自适应基数树
 	SL              // 跳表
+	BPT             // 持久化B+树 索引保存在数据目录中
 )
   
 var nameIndexers = map[string]IndexerType{
 	"BT":  BT,  // B+树
 	"ART": ART, // 自适应基数树
 	"SL":  SL,  // 跳表
+	"BPT": BPT, // 持久化B+树
 }
 
 type Options struct {
@@ -29,14 +36,46 @@ type Options struct {
 	// 索引类型
 	IndexType IndexerType
 
+	// 索引分片数 大于1时按key哈希分片 各分片独立加锁
+	IndexShards int
+
 	// 每多少字节进行持久化
 	BytesPerSync uint
 
 	// 启动时是否使用MMap
 	MMapAtStartup bool
 
+	// 数据文件的IO类型 启动时使用MMap的 加载完成后切换为该类型
+	// 可写MMap按DataFileSize预分配文件 启动时不切换IO类型
+	// 直接IO在用户态缓冲未写满的块 最多256KB 未开启SyncWrites时进程崩溃会丢失这部分已确认的写入
+	// 标准IO写入页缓存 只在机器掉电时丢失
+	IOType fio.FileIOType
+
 	// 阈值
 	DataFileMergeRatio float32
+
+	// 是否开启变更捕获 开启后每次写入都会分配序列号和提交时间
+	ChangeCapture bool
+
+	// 消费者位置的保留时间 超过该时间未提交位置的消费者不再阻止合并
+	// 合并时删除其位置 之后从头读取 为0时永久保留
+	ChangeRetention time.Duration
+
+	// 命名空间配额 写入前检查
+	Quotas []quota.Quota
+
+	// 索引检查点间隔 为0时不写入检查点
+	CheckpointInterval time.Duration
+
+	// 启动时并发读取数据文件的数量 不大于1时顺序加载
+	LoadConcurrency int
+
+	// 日志记录缓存的字节数 为0时不缓存
+	BlockCacheSize int64
+
+	// 数据文件布隆过滤器的误判率 为0时不使用
+	// 只对索引不完全在内存中的BPT索引生效 不存在的key无需访问磁盘上的索引
+	BloomFalsePositive float64
 }
 
 type IndexerType = int8
@@ -48,6 +87,8 @@ var DefaultOptions = Options{
 	IndexType:          ART,
 	MMapAtStartup:      true,
 	DataFileMergeRatio: 0.5,
+	LoadConcurrency:    runtime.NumCPU(),
+	ChangeRetention:    24 * time.Hour,
 }
 
 type IteratorOptions struct {
@@ -70,8 +111,23 @@ var DefaultWriteBatchOptions = WriteBatchOptions{
 	SyncWriters: true,
 }
 
+var nameIOTypes = map[string]fio.FileIOType{
+	"STANDARD": fio.StandardFIO,       // 标准文件IO
+	"DIRECT":   fio.DirectFIO,         // 直接IO
+	"ASYNC":    fio.AsyncFIO,          // 直接IO写入 批量异步读取
+	"URING":    fio.UringFIO,          // 直接IO写入 读请求提交到io_uring
+	"MMAP":     fio.WritableMemoryMap, // 可写MMap
+}
+
+func NewIOType(name string) fio.FileIOType {
+	if res, ok := nameIOTypes[strings.ToUpper(name)]; ok {
+		return res
+	}
+	return fio.StandardFIO
+}
+
 func NewIndexerType(name string) IndexerType {
-	if res, ok := nameIndexers[name]; ok {
+	if res, ok := nameIndexers[strings.ToUpper(name)]; ok {
 		return res
 	}
 	return ART
@@ -0,0 +1,131 @@
+package bases
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+)
+
+/// 命名空间配额 占用空间按日志记录在磁盘上的大小计算
+
+// 构造一次写入对命名空间的影响
+func newQuotaChange(key []byte, rec *data.LogRecord, oldPos *data.LogRecordPos) quota.Change {
+	change := quota.Change{
+		Key:       key,
+		ValueSize: int64(len(rec.Value)),
+		Exist:     oldPos != nil,
+		Delete:    rec.Type == data.LogRecordDeleted,
+	}
+	if oldPos != nil {
+		change.OldSize = int64(oldPos.Size)
+	}
+	if !change.Delete {
+		change.NewSize = data.LogRecordSize(rec)
+	}
+	return change
+}
+
+// 根据索引重新统计命名空间使用量
+// 索引按key有序遍历 复杂数据类型的成员key以所属的key开头 排在所属的key之后
+// 遍历时只保留是当前key前缀的key 每个这样的key最多读取一次元信息 不再按成员key的每个前缀读取磁盘
+// 有序集合按分数排序的成员key带有前缀 不与所属的key相邻 遍历结束后按已找到的所属key统计
+func (b *Base) rebuildQuotaUsage() {
+	if b.quotas == nil {
+		return
+	}
+	b.quotas.Reset()
+
+	var (
+		stack  []*ownerCandidate    // 是当前key前缀的key 越靠后越长
+		owners = map[string]int64{} // 有成员的key -> 版本号
+		scores []*ownerCandidate    // 有序集合按分数排序的成员key
+	)
+
+	it := b.index.Iterator(false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := append([]byte(nil), it.Key()...)
+		size := int64(it.Value().Size)
+		if bytes.HasPrefix(key, scoreKeyPrefix) {
+			scores = append(scores, &ownerCandidate{key: key, size: size})
+			continue
+		}
+
+		for len(stack) > 0 && !bytes.HasPrefix(key, stack[len(stack)-1].key) {
+			stack = stack[:len(stack)-1]
+		}
+		// 复杂数据类型的成员计入所属的key
+		if owner := b.ownerInStack(stack, key); owner != nil {
+			owners[string(owner.key)] = owner.meta.Version
+			b.quotas.Account(owner.key, size, true)
+		} else {
+			b.quotas.Account(key, size, false)
+		}
+		stack = append(stack, &ownerCandidate{key: key})
+	}
+
+	for _, s := range scores {
+		if owner, ok := scoreOwner(owners, s.key); ok {
+			b.quotas.Account(owner, s.size, true)
+			continue
+		}
+		b.quotas.Account(s.key, s.size, false)
+	}
+}
+
+var scoreKeyPrefix = []byte(values.ScoreKeyPrefix)
+
+// 统计使用量时可能是成员所属的key 元信息在第一次用到时读取
+type ownerCandidate struct {
+	key    []byte
+	size   int64
+	loaded bool
+	meta   *values.Meta // 不是复杂数据类型的元信息时为空
+}
+
+// 从最长的前缀开始查找key所属的复杂数据类型
+func (b *Base) ownerInStack(stack []*ownerCandidate, key []byte) *ownerCandidate {
+	for i := len(stack) - 1; i >= 0; i-- {
+		c := stack[i]
+		if len(key) < len(c.key)+8 {
+			continue
+		}
+		if !c.loaded {
+			c.loaded = true
+			if val, err := b.Get(utils.B2S(c.key)); err == nil {
+				if meta, ok := values.ParseMeta(val.Bytes()); ok {
+					c.meta = meta
+				}
+			}
+		}
+		if c.meta == nil {
+			continue
+		}
+		if version, _ := values.MemberVersion(key, len(c.key)); version == c.meta.Version {
+			return c
+		}
+	}
+	return nil
+}
+
+// 按分数排序的成员key去掉前缀后 在已找到的所属key中查找
+func scoreOwner(owners map[string]int64, key []byte) ([]byte, bool) {
+	rest := key[len(scoreKeyPrefix):]
+	for i := len(rest) - 8; i > 0; i-- {
+		version, ok := owners[utils.B2S(rest[:i])]
+		if !ok {
+			continue
+		}
+		if v, _ := values.MemberVersion(rest, i); v == version {
+			return rest[:i], true
+		}
+	}
+	return nil, false
+}
+
+// NamespaceUsage 返回各命名空间的配额和使用量
+func (b *Base) NamespaceUsage() []quota.Usage {
+	return b.quotas.Usage()
+}
@@ -0,0 +1,123 @@
+package bases
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func openQuotaDB(t *testing.T, dir string) *Base {
+	opts := DefaultOptions
+	opts.DirPath = dir
+	opts.MMapAtStartup = false
+	opts.Quotas = []quota.Quota{{Prefix: "tenant:", MaxKeys: 2, MaxValueSize: 8}}
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	return b
+}
+
+func TestBase_Quota(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "quota")
+	b := openQuotaDB(t, dir)
+
+	set := func(key, value string) error {
+		v := values.New([]byte(value), 0, iface.STRING)
+		return b.Set(key, &v)
+	}
+
+	assert.Nil(t, set("tenant:1", "v1"))
+	assert.Equal(t, errno.ErrExceedValueSizeQuota, set("tenant:2", "too large value"))
+	assert.Nil(t, set("tenant:2", "v2"))
+	assert.Equal(t, errno.ErrExceedKeysQuota, set("tenant:3", "v3"))
+	assert.Nil(t, set("other", "not limited by quota"))
+
+	// 超出配额的写入不会落盘
+	_, err := b.Get("tenant:3")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+
+	// 事务整体检查配额
+	wb := b.NewWriteBatch()
+	assert.Nil(t, wb.Delete([]byte("tenant:1")))
+	assert.Nil(t, wb.Put([]byte("tenant:3"), []byte("v3")))
+	assert.Nil(t, wb.Commit())
+
+	wb = b.NewWriteBatch()
+	assert.Nil(t, wb.Put([]byte("tenant:4"), []byte("v4")))
+	assert.Equal(t, errno.ErrExceedKeysQuota, wb.Commit())
+
+	usage := b.Status().Namespaces
+	assert.Equal(t, 1, len(usage))
+	assert.Equal(t, int64(2), usage[0].Keys)
+	assert.Greater(t, usage[0].Bytes, int64(0))
+	assert.Nil(t, b.Close())
+
+	// 重启后恢复使用量
+	b = openQuotaDB(t, dir)
+	defer destroyDB(b)
+	assert.Equal(t, usage, b.NamespaceUsage())
+
+	assert.Nil(t, b.Del("tenant:2"))
+	assert.Nil(t, set("tenant:4", "v4"))
+}
+
+func TestBase_QuotaLogicalKeys(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "quota")
+	b := openQuotaDB(t, dir)
+
+	// 复杂数据类型的成员不计入key数量
+	for _, field := range []string{"f1", "f2", "f3"} {
+		_, err := b.HSet("tenant:h", []byte(field), []byte("v"))
+		assert.Nil(t, err)
+	}
+	_, err := b.SAdd("tenant:s", []byte("m1"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), b.NamespaceUsage()[0].Keys)
+
+	// 非默认数据库中的key同样按前缀匹配
+	v := values.New([]byte("v"), 0, iface.STRING)
+	assert.Equal(t, errno.ErrExceedKeysQuota, b.Set(iface.DBKey("1", "tenant:1"), &v))
+	assert.Nil(t, b.Set(iface.DBKey("1", "other"), &v))
+
+	usage := b.NamespaceUsage()
+	assert.Nil(t, b.Close())
+
+	// 重启后成员计入所属的key
+	b = openQuotaDB(t, dir)
+	defer destroyDB(b)
+	assert.Equal(t, usage, b.NamespaceUsage())
+}
+
+func TestBase_QuotaRebuild(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "quota")
+	opts := DefaultOptions
+	opts.DirPath = dir
+	opts.MMapAtStartup = false
+	opts.Quotas = []quota.Quota{{Prefix: "tenant:"}}
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+
+	// 所属的key是另一个key的前缀 有序集合按分数排序的成员key不与所属的key相邻
+	_, err = b.HSet("tenant:a", []byte("f1"), []byte("v"))
+	assert.Nil(t, err)
+	v := values.New([]byte("v"), 0, iface.STRING)
+	assert.Nil(t, b.Set("tenant:a1", &v))
+	for _, member := range []string{"m1", "m2"} {
+		_, err = b.ZAdd("tenant:z", 1, []byte(member))
+		assert.Nil(t, err)
+	}
+	_, err = b.RPush("tenant:l", []byte("e1"))
+	assert.Nil(t, err)
+
+	usage := b.NamespaceUsage()
+	assert.Equal(t, int64(4), usage[0].Keys)
+	assert.Nil(t, b.Close())
+
+	b, err = NewBaseWith(opts)
+	assert.Nil(t, err)
+	defer destroyDB(b)
+	assert.Equal(t, usage, b.NamespaceUsage())
+}
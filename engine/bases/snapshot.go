@@ -0,0 +1,320 @@
+package bases
+
+import (
+	"bufio"
+	"encoding/binary"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"hash"
+	"hash/crc32"
+	"io"
+	"sync/atomic"
+)
+
+/// 快照 记录生成时刻索引中所有key的位置 写出时按位置读取日志记录
+/// 数据文件只追加 旧位置在写出期间保持有效 因此不阻塞之后的写入
+/// 格式: 魔数 | 版本 | (key | value)... | 0 | CRC32 字节串以varint长度开头
+
+const (
+	snapshotMagic   byte = 0xCD
+	snapshotVersion byte = 1
+)
+
+// Snapshot 数据快照
+type Snapshot struct {
+	base      *Base
+	keys      [][]byte
+	positions []*data.LogRecordPos
+}
+
+// NewSnapshot 生成快照 只保存key和位置 值在写出时读取
+// 持有全局写锁 快照中不包含只追加了日志而未更新索引的写入
+func (b *Base) NewSnapshot() *Snapshot {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	size := b.index.Size()
+	snap := &Snapshot{
+		base:      b,
+		keys:      make([][]byte, 0, size),
+		positions: make([]*data.LogRecordPos, 0, size),
+	}
+
+	it := b.index.Iterator(false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		snap.keys = append(snap.keys, key)
+		snap.positions = append(snap.positions, it.Value())
+	}
+	return snap
+}
+
+// WriteTo 将快照写入w
+func (s *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	sw := &snapshotWriter{w: bufio.NewWriter(w), crc: crc32.NewIEEE()}
+	sw.write([]byte{snapshotMagic, snapshotVersion})
+
+	for i, pos := range s.positions {
+		value, err := s.value(pos)
+		if err != nil {
+			return sw.n, err
+		}
+		sw.bytes(s.keys[i])
+		sw.bytes(value)
+		if sw.err != nil {
+			return sw.n, sw.err
+		}
+	}
+
+	return sw.finish()
+}
+
+// Release 释放快照
+func (s *Snapshot) Release() {
+	s.keys = nil
+	s.positions = nil
+}
+
+func (s *Snapshot) value(pos *data.LogRecordPos) ([]byte, error) {
+	return s.base.getValueByPosition(pos)
+}
+
+// Restore 从快照恢复数据 替换当前全部数据
+// 快照中的数据和旧数据的删除作为一个事务写入 完整读取并校验快照后才提交
+// 读取失败时当前数据不变 提交前重启时未完成的事务被丢弃
+func (b *Base) Restore(r io.Reader) error {
+	if err := b.restore(r); err != nil {
+		return err
+	}
+
+	// 二级索引定义随数据一起恢复
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+	b.loadHashIndexes()
+
+	return nil
+}
+
+func (b *Base) restore(r io.Reader) error {
+	sr := &snapshotReader{r: bufio.NewReader(r), crc: crc32.NewIEEE(), max: uint64(b.options.DataFileSize)}
+	if err := sr.header(); err != nil {
+		return err
+	}
+
+	seqNo := atomic.AddUint64(&b.seqNo, 1)
+	positions := make(map[string]*data.LogRecordPos)
+	var written int64 // 事务未提交时写入的数据均可回收
+
+	err := b.appendSnapshot(sr, seqNo, positions, &written)
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err == nil {
+		err = b.commitRestore(seqNo, positions)
+	}
+	if err != nil {
+		b.reclaimableSize += written
+		return err
+	}
+
+	b.rebuildQuotaUsage()
+	return nil
+}
+
+// 追加快照中的数据 提交前不更新索引 读取仍然返回旧数据
+func (b *Base) appendSnapshot(sr *snapshotReader, seqNo uint64, positions map[string]*data.LogRecordPos, written *int64) error {
+	for {
+		key, value, err := sr.entry()
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return sr.verify()
+		}
+
+		pos, err := b.AppendLogRecordWithLock(&data.LogRecord{
+			Key:   LogRecordKeyWithSeqNo(key, seqNo),
+			Value: value,
+		})
+		if err != nil {
+			return err
+		}
+		*written += int64(pos.Size)
+		positions[utils.B2S(key)] = pos
+	}
+}
+
+// 删除快照中不存在的key 追加事务完成记录后更新索引
+// 访问此方法前要持有互斥锁
+func (b *Base) commitRestore(seqNo uint64, positions map[string]*data.LogRecordPos) error {
+	var stale [][]byte
+	it := b.index.Iterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		if _, ok := positions[utils.B2S(it.Key())]; !ok {
+			key := make([]byte, len(it.Key()))
+			copy(key, it.Key())
+			stale = append(stale, key)
+		}
+	}
+	it.Close()
+
+	for _, key := range stale {
+		pos, err := b.AppendLogRecord(&data.LogRecord{
+			Key:  LogRecordKeyWithSeqNo(key, seqNo),
+			Type: data.LogRecordDeleted,
+		})
+		if err != nil {
+			return err
+		}
+		b.reclaimableSize += int64(pos.Size)
+	}
+
+	if _, err := b.AppendLogRecord(newTxnFinishedRecord(seqNo)); err != nil {
+		return err
+	}
+	if err := b.activeFile.Sync(); err != nil {
+		return err
+	}
+
+	// 事务已经持久化 更新索引
+	for key, pos := range positions {
+		if oldPos := b.index.Put([]byte(key), pos); oldPos != nil {
+			b.reclaimableSize += int64(oldPos.Size)
+		}
+	}
+	for _, key := range stale {
+		if oldPos, ok := b.index.Delete(key); ok && oldPos != nil {
+			b.reclaimableSize += int64(oldPos.Size)
+		}
+	}
+	return nil
+}
+
+type snapshotWriter struct {
+	w   *bufio.Writer
+	crc hash.Hash32
+	n   int64
+	err error
+}
+
+func (sw *snapshotWriter) write(p []byte) {
+	if sw.err != nil {
+		return
+	}
+	_, _ = sw.crc.Write(p)
+	n, err := sw.w.Write(p)
+	sw.n += int64(n)
+	sw.err = err
+}
+
+func (sw *snapshotWriter) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	sw.write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func (sw *snapshotWriter) bytes(b []byte) {
+	sw.uvarint(uint64(len(b)))
+	sw.write(b)
+}
+
+// 写入结束标记和校验和
+func (sw *snapshotWriter) finish() (int64, error) {
+	sw.uvarint(0)
+	if sw.err != nil {
+		return sw.n, sw.err
+	}
+
+	n, err := sw.w.Write(sw.crc.Sum(nil))
+	sw.n += int64(n)
+	if err != nil {
+		return sw.n, err
+	}
+	return sw.n, sw.w.Flush()
+}
+
+type snapshotReader struct {
+	r   *bufio.Reader
+	crc hash.Hash32
+	max uint64 // 单个字节串的长度上限
+}
+
+func (sr *snapshotReader) ReadByte() (byte, error) {
+	c, err := sr.r.ReadByte()
+	if err != nil {
+		return 0, snapshotError(err)
+	}
+	_, _ = sr.crc.Write([]byte{c})
+	return c, nil
+}
+
+func (sr *snapshotReader) header() error {
+	magic, err := sr.ReadByte()
+	if err != nil {
+		return err
+	}
+	if magic != snapshotMagic {
+		return errno.ErrInvalidSnapshot
+	}
+	version, err := sr.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != snapshotVersion {
+		return errno.ErrSnapshotVersion
+	}
+	return nil
+}
+
+func (sr *snapshotReader) bytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(sr)
+	if err != nil {
+		return nil, snapshotError(err)
+	}
+	if n > sr.max {
+		return nil, errno.ErrInvalidSnapshot
+	}
+
+	b := make([]byte, n)
+	if _, err = io.ReadFull(sr.r, b); err != nil {
+		return nil, snapshotError(err)
+	}
+	_, _ = sr.crc.Write(b)
+	return b, nil
+}
+
+// 读取一个键值对 读到结束标记时返回的key为nil
+func (sr *snapshotReader) entry() ([]byte, []byte, error) {
+	key, err := sr.bytes()
+	if err != nil || len(key) == 0 {
+		return nil, nil, err
+	}
+	value, err := sr.bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+// 校验结束标记之后的校验和
+func (sr *snapshotReader) verify() error {
+	sum := sr.crc.Sum32()
+	var buf [4]byte
+	if _, err := io.ReadFull(sr.r, buf[:]); err != nil {
+		return snapshotError(err)
+	}
+	if binary.BigEndian.Uint32(buf[:]) != sum {
+		return errno.ErrInvalidSnapshot
+	}
+	return nil
+}
+
+func snapshotError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errno.ErrInvalidSnapshot
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package bases
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newSnapshotTestBase(t *testing.T, dir string) *Base {
+	opts := DefaultOptions
+	opts.DirPath = dir
+	opts.MMapAtStartup = false
+
+	b, err := NewBaseWith(opts)
+	assert.Nil(t, err)
+	return b
+}
+
+func setSnapshotTestKeys(t *testing.T, b *Base, keys ...string) {
+	for _, key := range keys {
+		v := values.New([]byte("v-"+key), 0, iface.STRING)
+		assert.Nil(t, b.Set(key, &v))
+	}
+}
+
+func TestBase_SnapshotRestore(t *testing.T) {
+	src := newSnapshotTestBase(t, t.TempDir())
+	setSnapshotTestKeys(t, src, "k1", "k2", "k3")
+	assert.Nil(t, src.Del("k2"))
+
+	// 快照只包含生成时刻的数据
+	snap := src.NewSnapshot()
+	setSnapshotTestKeys(t, src, "k4")
+	assert.Nil(t, src.Del("k1"))
+
+	var buf bytes.Buffer
+	_, err := snap.WriteTo(&buf)
+	assert.Nil(t, err)
+	snap.Release()
+	assert.Nil(t, src.Close())
+
+	dir := t.TempDir()
+	dst := newSnapshotTestBase(t, dir)
+	setSnapshotTestKeys(t, dst, "k1", "k5")
+	v := values.New([]byte("old"), 0, iface.STRING)
+	assert.Nil(t, dst.Set("k3", &v))
+
+	// 截断或损坏的快照不改变当前数据
+	data := buf.Bytes()
+	for _, bad := range [][]byte{data[:len(data)-1], data[:len(data)/2], append([]byte{0}, data[1:]...)} {
+		assert.Equal(t, errno.ErrInvalidSnapshot, dst.Restore(bytes.NewReader(bad)))
+		assert.Equal(t, uint(3), dst.Status().KeyCount())
+		val, err := dst.Get("k3")
+		assert.Nil(t, err)
+		assert.Equal(t, "old", val.String())
+	}
+
+	assert.Nil(t, dst.Restore(bytes.NewReader(data)))
+	check := func(b *Base) {
+		assert.Equal(t, uint(2), b.Status().KeyCount())
+		for _, key := range []string{"k1", "k3"} {
+			val, err := b.Get(key)
+			assert.Nil(t, err)
+			assert.Equal(t, "v-"+key, val.String())
+		}
+		for _, key := range []string{"k2", "k4", "k5"} {
+			_, err := b.Get(key)
+			assert.Equal(t, errno.ErrKeyNotFound, err)
+		}
+	}
+	check(dst)
+
+	// 重启后从数据文件恢复相同的状态
+	assert.Nil(t, dst.Close())
+	dst = newSnapshotTestBase(t, dir)
+	check(dst)
+	assert.Nil(t, dst.Close())
+}
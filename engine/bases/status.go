@@ -1,13 +1,22 @@
 package bases
 
-import "github.com/T4t4KAU/TikBase/pkg/utils"
+import (
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"sync/atomic"
+	"time"
+)
 
 // Status 状态信息
 type Status struct {
-	keyNum          uint  // key的数量
-	DataFileNum     uint  // 数据文件个数
-	ReclaimableSize int64 // 数据可回收的空间 字节为单位
-	DiskSize        int64 // 所占磁盘空间大小
+	keyNum          uint          // key的数量
+	DataFileNum     uint          // 数据文件个数
+	ReclaimableSize int64         // 数据可回收的空间 字节为单位
+	DiskSize        int64         // 所占磁盘空间大小
+	Namespaces      []quota.Usage // 命名空间配额和使用量
+	LoadTime        time.Duration // 启动时加载索引耗时
+	CacheHits       uint64        // 日志记录缓存命中次数
+	CacheMisses     uint64        // 日志记录缓存未命中次数
 }
 
 func (st *Status) KeyCount() uint {
@@ -16,22 +25,31 @@ func (st *Status) KeyCount() uint {
 
 // Status 返回数据库统计信息
 func (b *Base) Status() *Status {
-	b.mutex.RLock()
-	defer b.mutex.RUnlock()
-
+	b.fileMutex.RLock()
 	var dataFilesNum = uint(len(b.olderFiles))
 	if b.activeFile != nil {
 		dataFilesNum++
 	}
+	b.fileMutex.RUnlock()
+
 	dirSize, err := utils.DirSize(b.options.DirPath)
 	if err != nil {
 		panic(err)
 	}
 
+	var hits, misses uint64
+	if b.blockCache != nil {
+		hits, misses = b.blockCache.stats()
+	}
+
 	return &Status{
 		keyNum:          uint(b.index.Size()),
 		DataFileNum:     dataFilesNum,
-		ReclaimableSize: b.reclaimableSize,
+		ReclaimableSize: atomic.LoadInt64(&b.reclaimableSize),
 		DiskSize:        dirSize,
+		Namespaces:      b.quotas.Usage(),
+		LoadTime:        b.loadTime,
+		CacheHits:       hits,
+		CacheMisses:     misses,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"strings"
 	"time"
 )
 
@@ -43,29 +44,67 @@ func (b *Base) FindMeta(key string, dataType iface.Type) (*values.Meta, error) {
 	return meta, nil
 }
 
+// MemberOwner 判断key是否为复杂数据类型的成员 是则返回所属的key
+// 成员的key以所属的key和版本号开头 所属的key保存的元信息中版本号一致
+func (b *Base) MemberOwner(key []byte) ([]byte, bool) {
+	// 有序集合按分数排序的成员key带有前缀
+	rest := key
+	if strings.HasPrefix(utils.B2S(key), values.ScoreKeyPrefix) {
+		rest = key[len(values.ScoreKeyPrefix):]
+	}
+
+	for i := len(rest) - 8; i > 0; i-- {
+		version, _ := values.MemberVersion(rest, i)
+		val, err := b.Get(utils.B2S(rest[:i]))
+		if err != nil {
+			continue
+		}
+		if meta, ok := values.ParseMeta(val.Bytes()); ok && meta.Version == version {
+			return rest[:i], true
+		}
+	}
+	return nil, false
+}
+
 // HSet HashSet操作
 func (b *Base) HSet(key string, field, value []byte) (bool, error) {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
 	meta, err := b.FindMeta(key, iface.HASH) // 查找元信息
 	if err != nil {
 		return false, err
 	}
 
+	// 哈希已过期时重新创建 旧版本字段的索引条目一并删除
+	wb := b.NewWriteBatch()
+	if len(b.hashIndexes) > 0 {
+		if old := b.hashMeta(key); old != nil && old.Version != meta.Version {
+			b.deleteHashIndexEntries(wb, key, old)
+		}
+	}
+
 	encKey := values.NewHashInternalKey(key, meta.Version, field).Encode()
 
 	var exist = true
-	if _, err = b.Get(utils.B2S(encKey)); errors.Is(err, errno.ErrKeyNotFound) {
+	var oldValue []byte
+	if old, err := b.Get(utils.B2S(encKey)); errors.Is(err, errno.ErrKeyNotFound) {
 		exist = false
+	} else if err == nil {
+		oldValue = old.Bytes()
 	}
 
 	// 创建结构元信息和添加元素放在一个事务中操作
-	wb := b.NewWriteBatch()
 	if !exist {
 		// 不存在则追加
 		meta.Size++
-		_ = wb.Put(utils.S2B(key), meta.Encode())
+		_ = wb.putMeta(key, meta.Encode())
 	}
 
-	_ = wb.Put(encKey, value)
+	_ = wb.putMember(key, encKey, value)
+
+	// 二级索引条目与数据在同一个事务中更新
+	b.updateHashIndexes(wb, key, field, oldValue, value)
 	if err = wb.Commit(); err != nil {
 		return false, err
 	}
@@ -88,6 +127,9 @@ func (b *Base) HGet(key string, field []byte) (iface.Value, error) {
 
 // HDel HashDelete操作
 func (b *Base) HDel(key string, field []byte) (bool, error) {
+	b.hashMutex.Lock()
+	defer b.hashMutex.Unlock()
+
 	meta, err := b.FindMeta(key, iface.HASH)
 	if err != nil {
 		return false, err
@@ -99,16 +141,20 @@ func (b *Base) HDel(key string, field []byte) (bool, error) {
 	encKey := values.NewHashInternalKey(key, meta.Version, field).Encode()
 
 	var exist = true
-	if _, err = b.Get(utils.B2S(encKey)); errors.Is(err, errno.ErrKeyNotFound) {
+	var oldValue []byte
+	if old, err := b.Get(utils.B2S(encKey)); errors.Is(err, errno.ErrKeyNotFound) {
 		exist = false
+	} else if err == nil {
+		oldValue = old.Bytes()
 	}
 
 	if exist {
 		// 不存在则更新数据
 		wb := b.NewWriteBatch()
 		meta.Size--
-		_ = wb.Put(encKey, meta.Encode()) // 修改元信息
-		_ = wb.Delete(encKey)
+		_ = wb.putMeta(key, meta.Encode()) // 修改元信息
+		_ = wb.deleteMember(key, encKey)
+		b.updateHashIndexes(wb, key, field, oldValue, nil)
 		if err = wb.Commit(); err != nil {
 			return false, err
 		}
@@ -129,8 +175,8 @@ func (b *Base) SAdd(key string, member []byte) (bool, error) {
 	if _, err = b.Get(utils.B2S(encKey)); errors.Is(err, errno.ErrKeyNotFound) {
 		wb := b.NewWriteBatch()
 		meta.Size++
-		_ = wb.Put(utils.S2B(key), meta.Encode())
-		_ = wb.Put(encKey, nil)
+		_ = wb.putMeta(key, meta.Encode())
+		_ = wb.putMember(key, encKey, nil)
 		if err = wb.Commit(); err != nil {
 			return false, err
 		}
@@ -177,8 +223,8 @@ func (b *Base) SRem(key string, member []byte) (bool, error) {
 
 	wb := b.NewWriteBatch()
 	meta.Size--
-	_ = wb.Put(utils.S2B(key), meta.Encode())
-	_ = wb.Delete(setKey)
+	_ = wb.putMeta(key, meta.Encode())
+	_ = wb.deleteMember(key, setKey)
 	if err = wb.Commit(); err != nil {
 		return false, err
 	}
@@ -206,8 +252,8 @@ func (b *Base) pushInner(key string, member []byte, isLeft bool) (uint32, error)
 		meta.Tail++
 	}
 
-	_ = wb.Put(utils.S2B(key), meta.Encode())
-	_ = wb.Put(listKey.Encode(), member)
+	_ = wb.putMeta(key, meta.Encode())
+	_ = wb.putMember(key, listKey.Encode(), member)
 
 	// 事务提交
 	if err = wb.Commit(); err != nil {
@@ -244,8 +290,10 @@ func (b *Base) popInner(key string, isLeft bool) (iface.Value, error) {
 		meta.Tail--
 	}
 
-	val := meta.Value()
-	if err = b.Set(key, &val); err != nil {
+	wb := b.NewWriteBatch()
+	_ = wb.putMeta(key, meta.Encode())
+	_ = wb.deleteMember(key, listKey.Encode())
+	if err = wb.Commit(); err != nil {
 		return nil, err
 	}
 	return elem, nil
@@ -295,16 +343,16 @@ func (b *Base) ZAdd(key string, score float64, member []byte) (bool, error) {
 	wb := b.NewWriteBatch()
 	if !exist {
 		meta.Size++
-		_ = wb.Put(utils.S2B(key), meta.Encode())
+		_ = wb.putMeta(key, meta.Encode())
 	}
 
 	if exist {
 		oldKey := values.NewZSetInternalKey(key, meta.Version, member, val.Score())
-		_ = wb.Delete(oldKey.EncodeWithScore())
+		_ = wb.deleteMember(key, oldKey.EncodeWithScore())
 	}
 
-	_ = wb.Put(zsetKey.EncodeWithMember(), utils.F642B(score))
-	_ = wb.Put(zsetKey.EncodeWithScore(), nil)
+	_ = wb.putMember(key, zsetKey.EncodeWithMember(), utils.F642B(score))
+	_ = wb.putMember(key, zsetKey.EncodeWithScore(), nil)
 	if err = wb.Commit(); err != nil {
 		return false, err
 	}
@@ -312,6 +360,37 @@ func (b *Base) ZAdd(key string, score float64, member []byte) (bool, error) {
 	return !exist, nil
 }
 
+func (b *Base) ZRem(key string, member []byte) (bool, error) {
+	meta, err := b.FindMeta(key, iface.ZSET)
+	if err != nil {
+		return false, err
+	}
+	if meta.Size == 0 {
+		return false, errno.ErrZSetDataIsEmpty
+	}
+
+	zsetKey := values.NewZSetInternalKey(key, meta.Version, member, 0)
+	val, err := b.Get(utils.B2S(zsetKey.EncodeWithMember()))
+	if errors.Is(err, errno.ErrKeyNotFound) {
+		return false, errno.ErrZSetMemberNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+	zsetKey = values.NewZSetInternalKey(key, meta.Version, member, val.Score())
+
+	// 成员和分数两条数据一起删除
+	wb := b.NewWriteBatch()
+	meta.Size--
+	_ = wb.putMeta(key, meta.Encode())
+	_ = wb.deleteMember(key, zsetKey.EncodeWithMember())
+	_ = wb.deleteMember(key, zsetKey.EncodeWithScore())
+	if err = wb.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (b *Base) ZScore(key string, member []byte) (float64, error) {
 	meta, err := b.FindMeta(key, iface.ZSET)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 type CacheEngine struct {
 	*caches.Cache
 	execFunc map[iface.INS]ExecFunc
+	notifier iface.Notifier // 键空间事件通知
 }
 
 func NewCacheEngine() (*CacheEngine, error) {
@@ -39,6 +40,8 @@ func NewCacheEngineWith(config config.CacheStoreConfig) (*CacheEngine, error) {
 		MapSizeOfSegment: int(config.MapSizeOfSegment),
 		SegmentSize:      int(config.SegmentSize),
 		CasSleepTime:     int(config.CasSleepTime),
+		Quotas:           toQuotas(config.Namespaces),
+		EvictWhenFull:    config.EvictWhenFull,
 	}
 
 	cache, err := caches.NewCacheWith(option)
@@ -114,11 +117,19 @@ func NewCacheResult(succ bool, data []byte, err error) *CacheResult {
 
 func (eng *CacheEngine) Exec(ins iface.INS, args [][]byte) iface.Result {
 	if fn, ok := eng.execFunc[ins]; ok {
-		return fn(args)
+		res := fn(args)
+		notify(eng.notifier, ins, args, res)
+		return res
 	}
 	return NewUnknownCacheResult()
 }
 
+// SetNotifier 设置键空间事件通知器 缓存内部过期的数据也会通过它发布
+func (eng *CacheEngine) SetNotifier(n iface.Notifier) {
+	eng.notifier = n
+	eng.Cache.SetNotifier(n)
+}
+
 func (eng *CacheEngine) registerExecFunc(ins iface.INS, fn ExecFunc) {
 	eng.execFunc[ins] = fn
 }
@@ -128,6 +139,9 @@ func (eng *CacheEngine) initExecFunc() {
 	eng.registerExecFunc(iface.SET_STR, eng.ExecStrSet)
 	eng.registerExecFunc(iface.DEL, eng.ExecDelKey)
 	eng.registerExecFunc(iface.EXPIRE, eng.ExecExpire)
+	eng.registerExecFunc(iface.EXPIRE_AT, eng.ExecExpireAt)
+	eng.registerExecFunc(iface.FLUSH_DB, eng.ExecFlushDB)
+	eng.registerExecFunc(iface.DB_SIZE, eng.ExecDBSize)
 }
 
 func (eng *CacheEngine) ExecStrSet(args [][]byte) iface.Result {
@@ -169,10 +183,16 @@ func (eng *CacheEngine) ExecExpire(args [][]byte) iface.Result {
 	return NewCacheErrorResult(err)
 }
 
-func (eng *CacheEngine) Snapshot() ([]byte, error) {
-	return eng.Cache.SnapShot()
+func (eng *CacheEngine) ExecExpireAt(args [][]byte) iface.Result {
+	key, at, err := ParseExpireKeyArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+	err = eng.ExpireAt(key, at)
+	return NewCacheErrorResult(err)
 }
 
-func (eng *CacheEngine) RecoverFromBytes(data []byte) error {
-	return eng.Cache.RecoverFromBytes(data)
+// Snapshot 缓存数据在内存中 生成快照时只复制哈希表 写出时再编码
+func (eng *CacheEngine) Snapshot() (iface.Snapshot, error) {
+	return eng.Cache.NewSnapshot(), nil
 }
@@ -1,9 +1,13 @@
 package caches
 
 import (
-	"bytes"
 	"encoding/gob"
+	"errors"
+	"github.com/T4t4KAU/TikBase/engine/quota"
 	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"io"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +19,9 @@ type Cache struct {
 	segments    []segment // 存储segment实例
 	options     *Options  // 缓存配置
 	dumping     int32     // 标识当前缓存是否处于持久化状态 处于持久化状态则所有更新操作自旋
+	notifier    iface.Notifier
+	quotas      *quota.Manager // 命名空间配额
+	mutex       sync.RWMutex   // 保护segments等字段 恢复快照时持有写锁替换
 }
 
 // New 返回默认配置的缓存对象
@@ -24,15 +31,37 @@ func New() (*Cache, error) {
 
 // NewCacheWith 返回一个指定配置的缓存对象
 func NewCacheWith(options Options) (*Cache, error) {
+	quotas := quota.New(options.Quotas...)
 	if cache, ok := recoverFromDumpFile(options.DumpFile); ok {
+		cache.setQuotas(quotas)
 		return cache, nil
 	}
-	return &Cache{
+	cache := &Cache{
 		segmentSize: options.SegmentSize,
 		segments:    newSegments(options), // 初始化所有segment
 		options:     &options,
 		dumping:     0,
-	}, nil
+	}
+	cache.setQuotas(quotas)
+	return cache, nil
+}
+
+// 设置命名空间配额 并根据已有数据统计使用量
+func (c *Cache) setQuotas(quotas *quota.Manager) {
+	c.quotas = quotas
+	c.quotas.Reset()
+	for i := range c.segments {
+		seg := &c.segments[i]
+		seg.quotas = quotas
+		for key, v := range seg.Data {
+			quotas.Account(utils.S2B(key), entrySize(key, v.Data), false)
+		}
+	}
+}
+
+// NamespaceUsage 返回各命名空间的配额和使用量
+func (c *Cache) NamespaceUsage() []quota.Usage {
+	return c.quotas.Usage()
 }
 
 // 创建segment
@@ -62,8 +91,15 @@ func (c *Cache) segmentOf(key string) *segment {
 
 // Get 返回指定value 未找到则返回false
 func (c *Cache) Get(key string) (iface.Value, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
-	return c.segmentOf(key).get(key)
+	v, err := c.segmentOf(key).get(key)
+	if v != nil && errors.Is(err, errno.ErrKeyNotFound) {
+		// 访问时发现数据已过期
+		c.notify(iface.EventExpired, key)
+	}
+	return v, err
 }
 
 func (c *Cache) Set(key string, value []byte, ttl int64) error {
@@ -72,22 +108,31 @@ func (c *Cache) Set(key string, value []byte, ttl int64) error {
 
 // Del 从缓存中删除指定键值对
 func (c *Cache) Del(key string) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
 	return c.segmentOf(key).delete(key)
 }
 
 // Expire 设置超时时间
 func (c *Cache) Expire(key string, ttl int64) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
-	val, err := c.segmentOf(key).get(key)
-	if err != nil {
-		return err
-	}
-	val.TTL = ttl
-	return nil
+	return c.segmentOf(key).expire(key, ttl)
+}
+
+// ExpireAt 设置过期的绝对时间 单位秒 时间已过时数据立即失效
+func (c *Cache) ExpireAt(key string, at int64) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	c.waitForDumping()
+	return c.segmentOf(key).expireAt(key, at)
 }
 
 func (c *Cache) Keys() [][]byte {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
 
 	keys := make([][]byte, 0)
@@ -101,6 +146,8 @@ func (c *Cache) Keys() [][]byte {
 
 // Exist 检查键是否存在
 func (c *Cache) Exist(key string) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
 	_, err := c.segmentOf(key).get(key)
 	return err
@@ -108,12 +155,21 @@ func (c *Cache) Exist(key string) error {
 
 // SetWithTTL 添加到指定的数据到缓存中 设置相应有效期
 func (c *Cache) SetWithTTL(key string, value []byte, ttl int64, typ iface.Type) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
-	return c.segmentOf(key).set(key, value, ttl, typ)
+	evicted, err := c.segmentOf(key).set(key, value, ttl, typ)
+	for _, k := range evicted {
+		c.notify(iface.EventEvicted, k)
+	}
+	return err
 }
 
 // Status 返回缓存当前状态
 func (c *Cache) Status() Status {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
 	result := NewStatus()
 	for _, seg := range c.segments {
 		status := seg.status()
@@ -121,27 +177,51 @@ func (c *Cache) Status() Status {
 		result.KeySize += status.KeySize
 		result.ValueSize += status.ValueSize
 	}
+	result.Namespaces = c.quotas.Usage()
 	return result
 }
 
+// SetNotifier 设置事件通知器 数据过期或被淘汰时发布事件
+func (c *Cache) SetNotifier(n iface.Notifier) {
+	c.notifier = n
+}
+
+func (c *Cache) notify(typ iface.EventType, key string) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier.Notify(iface.Event{
+		Type: typ,
+		Key:  key,
+		Time: time.Now().UnixNano(),
+	})
+}
+
 // 清理缓存中过期数据
 func (c *Cache) gc() {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
 	c.waitForDumping()
 	wg := &sync.WaitGroup{}
-	for _, seg := range c.segments {
+	for i := range c.segments {
 		wg.Add(1)
-		go func(s segment) {
+		go func(s *segment) {
 			defer wg.Done()
-			s.gc()
-		}(seg)
+			for _, key := range s.gc() {
+				c.notify(iface.EventExpired, key)
+			}
+		}(&c.segments[i])
 	}
 	wg.Wait()
 }
 
 // AutoGC 开启异步协程定时清理过期数据
 func (c *Cache) AutoGC() {
+	c.mutex.RLock()
+	d := time.Duration(c.options.GcDuration) * time.Minute
+	c.mutex.RUnlock()
 	go func() {
-		ticker := time.NewTicker(time.Duration(c.options.GcDuration) * time.Minute)
+		ticker := time.NewTicker(d)
 		for range ticker.C {
 			c.gc()
 		}
@@ -150,6 +230,9 @@ func (c *Cache) AutoGC() {
 
 // 将缓存数据持久化到文件中
 func (c *Cache) dump() error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
 	// 设置持久化标识为1
 	atomic.StoreInt32(&c.dumping, 1)
 	defer atomic.StoreInt32(&c.dumping, 0)
@@ -158,8 +241,10 @@ func (c *Cache) dump() error {
 
 // AutoDump 开启异步协程定时持久化缓存数据
 func (c *Cache) AutoDump() {
+	c.mutex.RLock()
+	d := time.Duration(c.options.DumpDuration) * time.Minute
+	c.mutex.RUnlock()
 	go func() {
-		d := time.Duration(c.options.DumpDuration) * time.Minute
 		ticker := time.NewTicker(d)
 		for range ticker.C {
 			_ = c.dump()
@@ -175,23 +260,27 @@ func (c *Cache) waitForDumping() {
 	}
 }
 
-func (c *Cache) RecoverFromBytes(data []byte) error {
+// Restore 从快照恢复缓存 替换当前全部数据
+func (c *Cache) Restore(r io.Reader) error {
 	d := newEmptyDump()
-	buffer := bytes.NewBuffer(data)
-	if err := gob.NewDecoder(buffer).Decode(d); err != nil {
-		return nil
+	if err := gob.NewDecoder(r).Decode(d); err != nil {
+		return err
 	}
 
-	// 初始化对象
-	for _, seg := range *d.Segments {
-		seg.options = *d.Options
-		seg.mutex = &sync.RWMutex{}
+	// 初始化对象 完整构建后再替换
+	segments := *d.Segments
+	for i := range segments {
+		segments[i].options = *d.Options
+		segments[i].mutex = &sync.RWMutex{}
 	}
 
+	// 持有写锁替换 等待进行中的读写完成
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	c.segmentSize = d.SegmentSize
-	c.segments = *d.Segments
+	c.segments = segments
 	c.options = d.Options
-	c.dumping = 0
+	c.setQuotas(c.quotas)
 
 	return nil
 }
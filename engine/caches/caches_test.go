@@ -1,8 +1,15 @@
 package caches
 
 import (
+	"bytes"
 	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -27,7 +34,60 @@ func TestCache_Expire(t *testing.T) {
 	assert.Nil(t, err)
 	time.Sleep(time.Second)
 	res, _ = c.Get("key")
-	println(res.Alive())
+	assert.False(t, res.Alive())
+}
+
+func TestCache_ExpireAt(t *testing.T) {
+	c, _ := New()
+	assert.Nil(t, c.Set("key1", []byte("value"), 0))
+	assert.Nil(t, c.Set("key2", []byte("value"), 0))
+
+	// 按绝对时间过期 读取或访问不会推迟过期时间
+	now := time.Now().Unix()
+	assert.Nil(t, c.ExpireAt("key1", now+100))
+	assert.Nil(t, c.ExpireAt("key2", now-1))
+	res, err := c.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, now+100, res.(*values.Value).ExpireAt)
+	_, err = c.Get("key2")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+	assert.Equal(t, errno.ErrKeyNotFound, c.ExpireAt("key3", now+100))
+
+	// 按相对时间设置时不再使用绝对时间
+	assert.Nil(t, c.Expire("key1", 1000))
+	res, _ = c.Get("key1")
+	assert.Equal(t, int64(0), res.(*values.Value).ExpireAt)
+}
+
+func TestCache_Restore(t *testing.T) {
+	c, _ := New()
+	assert.Nil(t, c.Set("key", []byte("snapshot"), 0))
+	snap := c.NewSnapshot()
+
+	// 快照生成之后的写入不影响写出的数据
+	assert.Nil(t, c.Set("key", []byte("value"), 0))
+	var buf bytes.Buffer
+	n, err := snap.WriteTo(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	snap.Release()
+
+	// 恢复与读写并发执行
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			key := "key" + strconv.Itoa(i)
+			_ = c.Set(key, []byte("value"), 0)
+			_, _ = c.Get(key)
+		}
+	}()
+	assert.Nil(t, c.Restore(&buf))
+	<-done
+
+	res, err := c.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("snapshot"), res.Bytes())
 }
 
 func BenchmarkCache_Set(b *testing.B) {
@@ -78,3 +138,66 @@ func BenchmarkCache_Delete(b *testing.B) {
 		assert.Nil(b, err)
 	}
 }
+
+func TestCache_Quota(t *testing.T) {
+	options := DefaultOptions()
+	options.DumpFile = filepath.Join(t.TempDir(), "cache.dump")
+	options.Quotas = []quota.Quota{{Prefix: "tenant:", MaxBytes: 20}}
+	c, err := NewCacheWith(options)
+	assert.Nil(t, err)
+
+	assert.Nil(t, c.Set("tenant:1", []byte("v1"), 0))
+	assert.Equal(t, errno.ErrExceedBytesQuota, c.Set("tenant:2", []byte("large value"), 0))
+	assert.Nil(t, c.Set("other", []byte("large value"), 0))
+
+	// 删除后释放空间
+	assert.Nil(t, c.Del("tenant:1"))
+	assert.Nil(t, c.Set("tenant:2", []byte("large"), 0))
+
+	usage := c.Status().Namespaces
+	assert.Equal(t, 1, len(usage))
+	assert.Equal(t, int64(13), usage[0].Bytes)
+	assert.Equal(t, int64(1), usage[0].Keys)
+}
+
+type evictNotifier struct {
+	events []iface.Event
+}
+
+func (n *evictNotifier) Notify(event iface.Event) {
+	n.events = append(n.events, event)
+}
+
+func TestCache_EvictWhenFull(t *testing.T) {
+	options := DefaultOptions()
+	options.DumpFile = filepath.Join(t.TempDir(), "cache.dump")
+	options.SegmentSize = 1
+	options.MaxEntrySize = 1
+	value := make([]byte, 400*1024)
+
+	// 默认拒绝写入
+	c, err := NewCacheWith(options)
+	assert.Nil(t, err)
+	assert.Nil(t, c.Set("key1", value, 0))
+	assert.Nil(t, c.Set("key2", value, 0))
+	assert.Equal(t, errno.ErrExceedCapacity, c.Set("key3", value, 0))
+
+	// 写满时淘汰数据并发布事件
+	options.EvictWhenFull = true
+	c, err = NewCacheWith(options)
+	assert.Nil(t, err)
+	n := &evictNotifier{}
+	c.SetNotifier(n)
+	assert.Nil(t, c.Set("key1", value, 0))
+	assert.Nil(t, c.Set("key2", value, 0))
+	assert.Nil(t, c.Set("key3", value, 0))
+	assert.Equal(t, 2, c.Status().Count)
+	assert.Equal(t, 1, len(n.events))
+	assert.Equal(t, iface.EventEvicted, n.events[0].Type)
+	assert.NotEqual(t, "key3", n.events[0].Key)
+	assert.Nil(t, c.Exist("key3"))
+
+	// 单个键值对超过容量时拒绝写入 不淘汰数据
+	assert.Equal(t, errno.ErrExceedCapacity, c.Set("key4", make([]byte, 2*1024*1024), 0))
+	assert.Equal(t, 2, c.Status().Count)
+}
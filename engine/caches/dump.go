@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"io"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -32,12 +33,61 @@ func newDump(c *Cache) *dump {
 
 // 生成内存快照
 func (c *Cache) SnapShot() ([]byte, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
 	// 设置持久化标识为1
 	atomic.StoreInt32(&c.dumping, 1)
 	defer atomic.StoreInt32(&c.dumping, 0)
 	return newDump(c).snapshot()
 }
 
+// Snapshot 缓存快照 保存生成时刻各segment中的数据 写出时才进行编码
+type Snapshot struct {
+	d *dump
+}
+
+// NewSnapshot 生成快照 只复制各segment的哈希表 不复制数据本身
+// 数据写入时整体替换 不会修改已有的字节切片 因此写出期间不阻塞之后的写入
+func (c *Cache) NewSnapshot() *Snapshot {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	segments := make([]segment, len(c.segments))
+	for i := range c.segments {
+		segments[i] = c.segments[i].clone()
+	}
+	return &Snapshot{d: &dump{
+		SegmentSize: c.segmentSize,
+		Segments:    &segments,
+		Options:     c.options,
+	}}
+}
+
+// WriteTo 将快照编码后直接写入w
+func (s *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{w: w}
+	err := gob.NewEncoder(cw).Encode(s.d)
+	return cw.n, err
+}
+
+// Release 释放快照
+func (s *Snapshot) Release() {
+	s.d = nil
+}
+
+// 统计写入字节数
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
 func (d *dump) snapshot() ([]byte, error) {
 	data := make([]byte, 0)
 	buffer := bytes.NewBuffer(data)
@@ -84,9 +134,10 @@ func (d *dump) from(dumpFile string) (*Cache, error) {
 	}
 
 	// 初始化对象
-	for _, seg := range *d.Segments {
-		seg.options = *d.Options
-		seg.mutex = &sync.RWMutex{}
+	segments := *d.Segments
+	for i := range segments {
+		segments[i].options = *d.Options
+		segments[i].mutex = &sync.RWMutex{}
 	}
 	return &Cache{
 		segmentSize: d.SegmentSize,
@@ -1,10 +1,13 @@
 package caches
 
 import (
+	"github.com/T4t4KAU/TikBase/engine/quota"
 	"github.com/T4t4KAU/TikBase/engine/values"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
 	"sync"
+	"time"
 )
 
 // 数据块
@@ -13,6 +16,7 @@ type segment struct {
 	Status  Status                  // 状态信息
 	options Options                 // 配置信息
 	mutex   *sync.RWMutex           // 读写锁
+	quotas  *quota.Manager          // 命名空间配额 所有segment共享
 }
 
 // 返回一个使用options初始化过的segment实例
@@ -25,6 +29,22 @@ func newSegment(options Options) segment {
 	}
 }
 
+// 复制哈希表和状态信息 用于生成快照
+func (seg *segment) clone() segment {
+	seg.mutex.RLock()
+	defer seg.mutex.RUnlock()
+
+	data := make(map[string]values.Value, len(seg.Data))
+	for key, v := range seg.Data {
+		data[key] = v
+	}
+	return segment{
+		Data:    data,
+		Status:  seg.Status,
+		options: seg.options,
+	}
+}
+
 // 返回指定key数据
 func (seg *segment) get(key string) (*values.Value, error) {
 	// 对当前segment加读锁
@@ -32,10 +52,10 @@ func (seg *segment) get(key string) (*values.Value, error) {
 
 	// 获取从表中数据
 	v, ok := seg.Data[key]
+	seg.mutex.RUnlock()
 	if !ok {
 		return nil, errno.ErrKeyNotFound
 	}
-	seg.mutex.RUnlock()
 
 	// 数据过期
 	if !v.Alive() {
@@ -45,31 +65,84 @@ func (seg *segment) get(key string) (*values.Value, error) {
 	return &v, nil
 }
 
-// 将一个数据添加进segment
-func (seg *segment) set(key string, data []byte, ttl int64, typ iface.Type) error {
+// 将一个数据添加进segment 返回为腾出空间而淘汰的key
+func (seg *segment) set(key string, data []byte, ttl int64, typ iface.Type) ([]string, error) {
 	// 对当前segment进行加锁
 	seg.mutex.Lock()
 	defer seg.mutex.Unlock()
 
 	// 检查是否以及存在
-	if v, ok := seg.Data[key]; ok {
-		seg.Status.subEntry(key, v.Data)
+	ov, exist := seg.Data[key]
+	if exist {
+		seg.Status.subEntry(key, ov.Data)
 	}
 
 	// 检查数据是否超出容量
+	var evicted []string
+	if !seg.checkEntryCapacity(key, data) && seg.options.EvictWhenFull && seg.fitsEmpty(key, data) {
+		evicted = seg.evict(key, data)
+	}
 	if !seg.checkEntryCapacity(key, data) {
-		if ov, ok := seg.Data[key]; ok {
+		if exist {
 			seg.Status.addEntry(key, ov.Data)
 		}
 
 		// 超出单segment存储上限
-		return errno.ErrExceedCapacity
+		return evicted, errno.ErrExceedCapacity
+	}
+
+	// 检查命名空间配额
+	change := quota.Change{
+		Key:       utils.S2B(key),
+		ValueSize: int64(len(data)),
+		NewSize:   entrySize(key, data),
+		Exist:     exist,
+	}
+	if exist {
+		change.OldSize = entrySize(key, ov.Data)
+	}
+	if err := seg.quotas.Admit(change); err != nil {
+		if exist {
+			seg.Status.addEntry(key, ov.Data)
+		}
+		return evicted, err
 	}
 
 	// 修改状态消息
 	seg.Status.addEntry(key, data)
 	seg.Data[key] = values.New(data, ttl, typ)
-	return nil
+	return evicted, nil
+}
+
+// 淘汰数据直到能够容纳新的键值对 优先淘汰过期数据 其次淘汰最早写入或访问的数据
+func (seg *segment) evict(newKey string, newValue []byte) []string {
+	evicted := make([]string, 0)
+	for !seg.checkEntryCapacity(newKey, newValue) {
+		victim, found := "", false
+		var oldest int64
+		for k, v := range seg.Data {
+			if k == newKey {
+				continue
+			}
+			if !v.Alive() {
+				victim, found = k, true
+				break
+			}
+			if !found || v.Created < oldest {
+				victim, oldest, found = k, v.Created, true
+			}
+		}
+		if !found {
+			break
+		}
+
+		v := seg.Data[victim]
+		seg.Status.subEntry(victim, v.Data)
+		seg.quotas.Release(utils.S2B(victim), entrySize(victim, v.Data))
+		delete(seg.Data, victim)
+		evicted = append(evicted, victim)
+	}
+	return evicted
 }
 
 // 从segment中删除指定key
@@ -79,6 +152,7 @@ func (seg *segment) delete(key string) error {
 	defer seg.mutex.Unlock()
 	if v, ok := seg.Data[key]; ok {
 		seg.Status.subEntry(key, v.Data)
+		seg.quotas.Release(utils.S2B(key), entrySize(key, v.Data))
 		delete(seg.Data, key)
 		return nil
 	} else {
@@ -86,6 +160,36 @@ func (seg *segment) delete(key string) error {
 	}
 }
 
+// 修改指定key的存活时间 从当前时间开始计算
+func (seg *segment) expire(key string, ttl int64) error {
+	seg.mutex.Lock()
+	defer seg.mutex.Unlock()
+
+	v, ok := seg.Data[key]
+	if !ok || !v.Alive() {
+		return errno.ErrKeyNotFound
+	}
+	v.TTL = ttl
+	v.Created = time.Now().Unix()
+	v.ExpireAt = 0
+	seg.Data[key] = v
+	return nil
+}
+
+// 修改指定key过期的绝对时间 不依赖当前时间
+func (seg *segment) expireAt(key string, at int64) error {
+	seg.mutex.Lock()
+	defer seg.mutex.Unlock()
+
+	v, ok := seg.Data[key]
+	if !ok || !v.Alive() {
+		return errno.ErrKeyNotFound
+	}
+	v.ExpireAt = at
+	seg.Data[key] = v
+	return nil
+}
+
 // 返回该segment状态
 func (seg *segment) status() Status {
 	seg.mutex.RLock()
@@ -93,28 +197,43 @@ func (seg *segment) status() Status {
 	return seg.Status
 }
 
+// 键值对占用空间 用于配额统计
+func entrySize(key string, value []byte) int64 {
+	return int64(len(key)) + int64(len(value))
+}
+
 // 判断segment数据容量是否已经到了设定的上限
 func (seg *segment) checkEntryCapacity(newKey string, newValue []byte) bool {
-	return seg.Status.entrySize()+int64(len(newKey))+int64(len(newValue)) <=
-		int64((seg.options.MaxEntrySize*1024*1024)/seg.options.SegmentSize)
+	return seg.Status.entrySize()+int64(len(newKey))+int64(len(newValue)) <= seg.capacity()
+}
+
+// 判断键值对能否放入空的segment 放不下时不需要淘汰数据
+func (seg *segment) fitsEmpty(newKey string, newValue []byte) bool {
+	return int64(len(newKey))+int64(len(newValue)) <= seg.capacity()
+}
+
+func (seg *segment) capacity() int64 {
+	return int64((seg.options.MaxEntrySize * 1024 * 1024) / seg.options.SegmentSize)
 }
 
-// 清理segment中过期数据
-func (seg *segment) gc() {
+// 清理segment中过期数据 返回被清理的key
+func (seg *segment) gc() []string {
 	// 对GC的segment加锁
 	seg.mutex.Lock()
 	defer seg.mutex.Unlock()
-	count := 0
+	expired := make([]string, 0)
 
 	// 遍历segment中数据
 	for k, v := range seg.Data {
 		if !v.Alive() {
 			seg.Status.subEntry(k, v.Data)
+			seg.quotas.Release(utils.S2B(k), entrySize(k, v.Data))
 			delete(seg.Data, k)
-			count++
-			if count >= seg.options.MaxGcCount {
+			expired = append(expired, k)
+			if len(expired) >= seg.options.MaxGcCount {
 				break
 			}
 		}
 	}
+	return expired
 }
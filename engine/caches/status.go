@@ -1,10 +1,13 @@
 package caches
 
+import "github.com/T4t4KAU/TikBase/engine/quota"
+
 // Status 缓存状态
 type Status struct {
-	Count     int   // 记录缓存数据个数
-	KeySize   int64 // 记录key占用空间大小
-	ValueSize int64 // 记录value占用空间大小
+	Count      int           // 记录缓存数据个数
+	KeySize    int64         // 记录key占用空间大小
+	ValueSize  int64         // 记录value占用空间大小
+	Namespaces []quota.Usage // 命名空间配额和使用量 只在缓存整体状态中返回
 }
 
 // NewStatus 返回一个缓存信息对象指针
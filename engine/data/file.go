@@ -15,8 +15,10 @@ var (
 
 const (
 	FileNameSuffix        = ".data"
+	BloomFileSuffix       = ".bloom"
 	HintFileName          = "hint-index"
 	MergeFinishedFileName = "merge-finished"
+	SeqNoFileName         = "seq-no"
 )
 
 // File 文件管理结构
@@ -27,10 +29,11 @@ type File struct {
 }
 
 // OpenDataFile 打开新的数据文件
-func OpenDataFile(dirPath string, fid uint32, ioType fio.FileIOType) (*File, error) {
+// prealloc为写入时预分配的文件大小 只对可写MMap有效
+func OpenDataFile(dirPath string, fid uint32, ioType fio.FileIOType, prealloc int64) (*File, error) {
 	// 拼接文件路径
 	name := filepath.Join(dirPath, fmt.Sprintf("%09d", fid)+FileNameSuffix)
-	iom, err := fio.NewIOManager(name, ioType) // 初始化文件IO管理器
+	iom, err := fio.NewIOManagerWithSize(name, ioType, prealloc) // 初始化文件IO管理器
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +60,7 @@ func OpenMergeFinishedFile(dirPath string) (*File, error) {
 
 // OpenSeqNoFile 储存事务序列号的文件
 func OpenSeqNoFile(dirPath string) (*File, error) {
-	fileName := filepath.Join(dirPath, MergeFinishedFileName)
+	fileName := filepath.Join(dirPath, SeqNoFileName)
 	return newDataFile(fileName, 0, fio.StandardFIO)
 }
 
@@ -121,6 +124,11 @@ func GetDataFileName(dirPath string, fileId uint32) string {
 	return filepath.Join(dirPath, fmt.Sprintf("%09d", fileId)+FileNameSuffix)
 }
 
+// GetBloomFileName 数据文件对应的布隆过滤器文件
+func GetBloomFileName(dirPath string, fileId uint32) string {
+	return filepath.Join(dirPath, fmt.Sprintf("%09d", fileId)+BloomFileSuffix)
+}
+
 func newDataFile(fileName string, fileId uint32, ioType fio.FileIOType) (*File, error) {
 	iom, err := fio.NewIOManager(fileName, ioType)
 	if err != nil {
@@ -167,6 +175,14 @@ func (f *File) readNBytes(n int64, offset int64) ([]byte, error) {
 	return b, err
 }
 
+// Truncate 将文件截断到写偏移 丢弃预分配的空间
+func (f *File) Truncate() error {
+	if t, ok := f.IOManager.(fio.Truncater); ok {
+		return t.Truncate(f.WriteOff)
+	}
+	return nil
+}
+
 // SetIOManager 设置新的IO管理器
 func (f *File) SetIOManager(dirPath string, ioType fio.FileIOType) error {
 	if err := f.IOManager.Close(); err != nil {
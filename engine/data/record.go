@@ -93,6 +93,15 @@ func EncodeLogRecord(rec *LogRecord) ([]byte, int64) {
 	return encBytes, int64(size)
 }
 
+// LogRecordSize 返回日志记录编码后的大小
+func LogRecordSize(rec *LogRecord) int64 {
+	buf := make([]byte, binary.MaxVarintLen64)
+	size := 5
+	size += binary.PutVarint(buf, int64(len(rec.Key)))
+	size += binary.PutVarint(buf, int64(len(rec.Value)))
+	return int64(size + len(rec.Key) + len(rec.Value))
+}
+
 func getLogRecordCRC(rec *LogRecord, header []byte) uint32 {
 	if rec == nil {
 		return 0
@@ -107,11 +116,12 @@ func getLogRecordCRC(rec *LogRecord, header []byte) uint32 {
 
 // EncodeLogRecordPos 对位置信息进行编码
 func EncodeLogRecordPos(pos *LogRecordPos) []byte {
-	buf := make([]byte, binary.MaxVarintLen32+binary.MaxVarintLen64)
+	buf := make([]byte, binary.MaxVarintLen32*2+binary.MaxVarintLen64)
 
 	var index = 0
 	index += binary.PutVarint(buf[index:], int64(pos.Fid))
 	index += binary.PutVarint(buf[index:], pos.Offset)
+	index += binary.PutVarint(buf[index:], int64(pos.Size))
 	return buf[:index]
 }
 
@@ -120,6 +130,13 @@ func DecodeLogRecordPos(buf []byte) *LogRecordPos {
 	var index = 0
 	fileId, n := binary.Varint(buf[index:])
 	index += n
-	offset, _ := binary.Varint(buf[index:])
-	return &LogRecordPos{Fid: uint32(fileId), Offset: offset}
+	offset, n := binary.Varint(buf[index:])
+	index += n
+
+	// 旧版本的位置信息不包含大小
+	var size int64
+	if index < len(buf) {
+		size, _ = binary.Varint(buf[index:])
+	}
+	return &LogRecordPos{Fid: uint32(fileId), Offset: offset, Size: uint32(size)}
 }
@@ -0,0 +1,189 @@
+package engine
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"strconv"
+)
+
+/// 逻辑数据库 在key前加上数据库前缀 多个数据库共享同一个存储引擎
+
+// DB 逻辑数据库视图
+type DB struct {
+	iface.Engine
+	name   string
+	prefix []byte
+}
+
+// Select 返回存储引擎中指定的逻辑数据库
+func Select(eng iface.Engine, db string) *DB {
+	if d, ok := eng.(*DB); ok {
+		eng = d.Engine
+	}
+	return &DB{
+		Engine: eng,
+		name:   iface.NormalizeDB(db),
+		prefix: iface.DBPrefix(db),
+	}
+}
+
+// Name 返回数据库名称
+func (db *DB) Name() string {
+	return db.name
+}
+
+// Exec 为指令中的key加上数据库前缀后交给存储引擎执行
+func (db *DB) Exec(ins iface.INS, args [][]byte) iface.Result {
+	switch ins {
+	case iface.ECHO:
+		return db.Engine.Exec(ins, args)
+	case iface.FLUSH_DB, iface.DB_SIZE:
+		return db.Engine.Exec(ins, [][]byte{db.prefix})
+	}
+
+	if len(db.prefix) == 0 || len(args) == 0 {
+		return db.Engine.Exec(ins, args)
+	}
+
+	dbArgs := make([][]byte, len(args))
+	copy(dbArgs, args)
+	dbArgs[0] = append(append(make([]byte, 0, len(db.prefix)+len(args[0])), db.prefix...), args[0]...)
+
+	return db.Engine.Exec(ins, dbArgs)
+}
+
+// FlushDB 清空逻辑数据库
+func (db *DB) FlushDB() error {
+	return db.Exec(iface.FLUSH_DB, nil).Error()
+}
+
+// Size 返回逻辑数据库中key的数量
+func (db *DB) Size() (int, error) {
+	res := db.Exec(iface.DB_SIZE, nil)
+	if !res.Success() {
+		return 0, res.Error()
+	}
+	return strconv.Atoi(res.String())
+}
+
+// 返回逻辑数据库中的全部key 前缀为空时为默认数据库
+func baseDBKeys(base *bases.Base, prefix []byte) [][]byte {
+	if len(prefix) > 0 {
+		it := base.NewIterator(bases.IteratorOptions{Prefix: prefix})
+		defer it.Close()
+
+		keys := make([][]byte, 0)
+		for it.Rewind(); it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		return keys
+	}
+
+	keys := make([][]byte, 0)
+	for _, key := range base.ListKeys() {
+		if iface.InDB(prefix, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (eng *BaseEngine) ExecFlushDB(args [][]byte) iface.Result {
+	prefix, err := ParseDBArgs(args)
+	if err != nil {
+		return NewBaseErrResult(err)
+	}
+
+	// 分批在事务中删除 每批不超过事务的数量上限
+	options := bases.DefaultWriteBatchOptions
+	wb := eng.NewWriteBatchWith(options)
+	var pending uint
+	for _, key := range baseDBKeys(eng.Base, prefix) {
+		if err = wb.Delete(key); err != nil {
+			return NewBaseErrResult(err)
+		}
+		if pending++; pending == options.MaxBatchNum {
+			if err = wb.Commit(); err != nil {
+				return NewBaseErrResult(err)
+			}
+			pending = 0
+		}
+	}
+	return NewBaseErrResult(wb.Commit())
+}
+
+func (eng *BaseEngine) ExecDBSize(args [][]byte) iface.Result {
+	prefix, err := ParseDBArgs(args)
+	if err != nil {
+		return NewBaseErrResult(err)
+	}
+
+	// 只统计用户写入的key 复杂数据类型的成员不计入
+	size := 0
+	for _, key := range baseDBKeys(eng.Base, prefix) {
+		if _, ok := eng.MemberOwner(key); !ok {
+			size++
+		}
+	}
+	return NewBaseResult(true, []byte(strconv.Itoa(size)), nil)
+}
+
+// 返回缓存中属于逻辑数据库的key
+func (eng *CacheEngine) dbKeys(prefix []byte) [][]byte {
+	keys := make([][]byte, 0)
+	for _, key := range eng.Keys() {
+		if iface.InDB(prefix, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (eng *CacheEngine) ExecFlushDB(args [][]byte) iface.Result {
+	prefix, err := ParseDBArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+
+	for _, key := range eng.dbKeys(prefix) {
+		_ = eng.Del(utils.B2S(key))
+	}
+	return NewSuccCacheResult()
+}
+
+func (eng *CacheEngine) ExecDBSize(args [][]byte) iface.Result {
+	prefix, err := ParseDBArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+
+	size := len(eng.dbKeys(prefix))
+	return NewCacheResult(true, []byte(strconv.Itoa(size)), nil)
+}
+
+// ExecFlushDB 先将待刷盘数据写入磁盘层 再同时清空两层中的数据
+func (eng *TieredEngine) ExecFlushDB(args [][]byte) iface.Result {
+	prefix, err := ParseDBArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+	if err = eng.Flush(); err != nil {
+		return NewCacheErrorResult(err)
+	}
+
+	for _, key := range eng.cache.Keys() {
+		if iface.InDB(prefix, key) {
+			_ = eng.cache.Del(utils.B2S(key))
+		}
+	}
+	return eng.base.ExecFlushDB(args)
+}
+
+// ExecDBSize 磁盘层保存全部数据 以磁盘层为准
+func (eng *TieredEngine) ExecDBSize(args [][]byte) iface.Result {
+	if err := eng.Flush(); err != nil {
+		return NewCacheErrorResult(err)
+	}
+	return eng.base.ExecDBSize(args)
+}
@@ -22,6 +22,8 @@ func NewEngine(name string) (iface.Engine, error) {
 		return NewCacheEngine()
 	case "base":
 		return NewBaseEngine()
+	case "tiered":
+		return NewTieredEngine()
 	default:
 		return nil, errors.New("invalid engine")
 	}
@@ -211,3 +213,24 @@ func MakeZSetAddArgs(key string, score float64, element []byte) [][]byte {
 		element,
 	}
 }
+
+func ParseZSetRemArgs(args [][]byte) (string, []byte, error) {
+	if len(args) < 2 {
+		return "", nil, errno.ErrParseArgsError
+	}
+	return utils.B2S(args[0]), args[1], nil
+}
+
+func MakeZSetRemArgs(key string, element []byte) [][]byte {
+	return [][]byte{
+		utils.S2B(key),
+		element,
+	}
+}
+
+func ParseDBArgs(args [][]byte) ([]byte, error) {
+	if len(args) < 1 {
+		return nil, errno.ErrParseArgsError
+	}
+	return args[0], nil
+}
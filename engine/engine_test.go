@@ -1,8 +1,13 @@
 package engine
 
 import (
+	"bytes"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/engine/caches"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/stretchr/testify/assert"
@@ -75,3 +80,282 @@ func TestBaseEngine_ExecSetAdd(t *testing.T) {
 	assert.Equal(t, errno.ErrSetMemberNotFound, res.Error())
 	assert.False(t, res.Success())
 }
+
+type recordNotifier struct {
+	events []iface.Event
+}
+
+func (n *recordNotifier) Notify(event iface.Event) {
+	n.events = append(n.events, event)
+}
+
+func TestCacheEngine_Notify(t *testing.T) {
+	e, err := NewCacheEngine()
+	assert.Nil(t, err)
+
+	n := &recordNotifier{}
+	e.SetNotifier(n)
+
+	res := e.Exec(iface.SET_STR, [][]byte{[]byte("key1"), []byte("value1")})
+	assert.True(t, res.Success())
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.True(t, res.Success())
+	res = e.Exec(iface.DEL, [][]byte{[]byte("key1")})
+	assert.True(t, res.Success())
+	res = e.Exec(iface.DEL, [][]byte{[]byte("key1")})
+	assert.False(t, res.Success())
+
+	assert.Equal(t, 2, len(n.events))
+	assert.Equal(t, iface.EventSet, n.events[0].Type)
+	assert.Equal(t, iface.EventDel, n.events[1].Type)
+	assert.Equal(t, "key1", n.events[1].Key)
+}
+
+func newTestTieredEngine(t *testing.T, options TieredOptions) *TieredEngine {
+	opts := bases.DefaultOptions
+	opts.DirPath = t.TempDir()
+	opts.MMapAtStartup = false
+	b, err := bases.NewBaseWith(opts)
+	assert.Nil(t, err)
+
+	base := &BaseEngine{
+		Base:     b,
+		execFunc: make(map[iface.INS]ExecFunc),
+	}
+	base.initExecFunc()
+
+	cacheOpts := caches.DefaultOptions()
+	cacheOpts.DumpFile = ""
+	c, err := caches.NewCacheWith(cacheOpts)
+	assert.Nil(t, err)
+
+	return newTieredEngine(c, base, options)
+}
+
+func TestTieredEngine_WriteThrough(t *testing.T) {
+	e := newTestTieredEngine(t, DefaultTieredOptions)
+	defer e.Close()
+
+	res := e.Exec(iface.SET_STR, [][]byte{[]byte("key1"), []byte("value1")})
+	assert.True(t, res.Success())
+
+	// 写穿 磁盘中立即可见
+	v, err := e.base.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), v.Bytes())
+
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.Equal(t, []byte("value1"), res.Data())
+
+	// 缓存中不存在时从磁盘读取并提升
+	assert.Nil(t, e.cache.Del("key1"))
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.Equal(t, []byte("value1"), res.Data())
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.Equal(t, []byte("value1"), res.Data())
+
+	res = e.Exec(iface.DEL, [][]byte{[]byte("key1")})
+	assert.True(t, res.Success())
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.False(t, res.Success())
+
+	st := e.Status()
+	assert.Equal(t, uint64(2), st.Hits)
+	assert.Equal(t, uint64(2), st.Misses)
+	assert.Equal(t, uint64(1), st.Promotions)
+	assert.Equal(t, 0.5, st.HitRate())
+}
+
+func TestTieredEngine_WriteBehind(t *testing.T) {
+	options := DefaultTieredOptions
+	options.WriteMode = WriteBehind
+	options.FlushInterval = time.Hour
+	e := newTestTieredEngine(t, options)
+	defer e.Close()
+
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key1"), []byte("value1")})
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key2"), []byte("value2")})
+
+	// 写回 刷盘之前磁盘中不存在
+	_, err := e.base.Get("key1")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+	assert.Equal(t, 2, e.Status().Pending)
+
+	// 缓存降级后仍能读到待刷盘数据
+	assert.Nil(t, e.cache.Del("key1"))
+	res := e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.Equal(t, []byte("value1"), res.Data())
+
+	e.Exec(iface.DEL, [][]byte{[]byte("key2")})
+	res = e.Exec(iface.GET_STR, [][]byte{[]byte("key2")})
+	assert.False(t, res.Success())
+
+	assert.Nil(t, e.Flush())
+	assert.Equal(t, 0, e.Status().Pending)
+	assert.Equal(t, uint64(1), e.Status().Flushes)
+
+	v, err := e.base.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), v.Bytes())
+	_, err = e.base.Get("key2")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+}
+
+func TestTieredEngine_PassThrough(t *testing.T) {
+	options := DefaultTieredOptions
+	options.WriteMode = WriteBehind
+	options.FlushInterval = time.Hour
+	e := newTestTieredEngine(t, options)
+	defer e.Close()
+
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key1"), []byte("value1")})
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key2"), []byte("value2")})
+	res := e.Exec(iface.SET_HASH, [][]byte{[]byte("hash"), []byte("field"), []byte("value")})
+	assert.True(t, res.Success())
+
+	// 修改数据的指令只将该key的待刷盘数据写入磁盘 不刷写其他key
+	e.Exec(iface.DEL_HASH, [][]byte{[]byte("key1"), []byte("field")})
+	assert.Equal(t, 1, e.Status().Pending)
+	assert.Equal(t, uint64(0), e.Status().Flushes)
+	v, err := e.base.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), v.Bytes())
+	_, err = e.base.Get("key2")
+	assert.Equal(t, errno.ErrKeyNotFound, err)
+
+	// 只读指令不刷盘
+	res = e.Exec(iface.GET_HASH, [][]byte{[]byte("hash"), []byte("field")})
+	assert.Equal(t, []byte("value"), res.Data())
+	assert.Equal(t, 1, e.Status().Pending)
+	assert.Equal(t, uint64(0), e.Status().Flushes)
+}
+
+func TestTieredEngine_Restore(t *testing.T) {
+	options := DefaultTieredOptions
+	options.WriteMode = WriteBehind
+	options.FlushInterval = time.Millisecond
+	e := newTestTieredEngine(t, options)
+	defer e.Close()
+
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key"), []byte("snapshot")})
+	snap, err := e.Snapshot()
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	_, err = snap.WriteTo(&buf)
+	assert.Nil(t, err)
+	snap.Release()
+
+	// 恢复与写入和后台刷盘并发执行
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			e.Exec(iface.SET_STR, [][]byte{[]byte("key" + strconv.Itoa(i)), []byte("value")})
+		}
+	}()
+	assert.Nil(t, e.Restore(&buf))
+	<-done
+
+	res := e.Exec(iface.GET_STR, [][]byte{[]byte("key")})
+	assert.Equal(t, []byte("snapshot"), res.Data())
+}
+
+func TestTieredEngine_Demotion(t *testing.T) {
+	options := DefaultTieredOptions
+	options.HotTTL = 1
+	e := newTestTieredEngine(t, options)
+	defer e.Close()
+
+	n := &recordNotifier{}
+	e.SetNotifier(n)
+
+	e.Exec(iface.SET_STR, [][]byte{[]byte("key1"), []byte("value1")})
+	time.Sleep(1100 * time.Millisecond)
+
+	// 缓存中的数据过期降级 从磁盘重新加载
+	res := e.Exec(iface.GET_STR, [][]byte{[]byte("key1")})
+	assert.Equal(t, []byte("value1"), res.Data())
+
+	st := e.Status()
+	assert.Equal(t, uint64(1), st.Demotions)
+	assert.Equal(t, uint64(1), st.Promotions)
+
+	assert.Equal(t, 2, len(n.events))
+	assert.Equal(t, iface.EventEvicted, n.events[1].Type)
+}
+
+func testSelectDB(t *testing.T, eng iface.Engine) {
+	db0, db1 := Select(eng, ""), Select(eng, "1")
+	assert.Equal(t, iface.DefaultDB, db0.Name())
+
+	assert.True(t, db0.Exec(iface.SET_STR, [][]byte{[]byte("key"), []byte("v0")}).Success())
+	assert.True(t, db1.Exec(iface.SET_STR, [][]byte{[]byte("key"), []byte("v1")}).Success())
+	assert.True(t, db1.Exec(iface.SET_STR, [][]byte{[]byte("key2"), []byte("v2")}).Success())
+
+	// 不同数据库的键空间相互隔离
+	assert.Equal(t, "v0", db0.Exec(iface.GET_STR, [][]byte{[]byte("key")}).String())
+	assert.Equal(t, "v1", Select(eng, "01").Exec(iface.GET_STR, [][]byte{[]byte("key")}).String())
+
+	size, err := db0.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, size)
+	size, err = db1.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, size)
+
+	assert.Nil(t, db1.FlushDB())
+	size, _ = db1.Size()
+	assert.Equal(t, 0, size)
+	assert.False(t, db1.Exec(iface.GET_STR, [][]byte{[]byte("key")}).Success())
+	assert.Equal(t, "v0", db0.Exec(iface.GET_STR, [][]byte{[]byte("key")}).String())
+}
+
+func TestSelect(t *testing.T) {
+	cacheOpts := caches.DefaultOptions()
+	cacheOpts.DumpFile = ""
+	c, err := caches.NewCacheWith(cacheOpts)
+	assert.Nil(t, err)
+	ce := &CacheEngine{Cache: c, execFunc: make(map[iface.INS]ExecFunc)}
+	ce.initExecFunc()
+	testSelectDB(t, ce)
+
+	te := newTestTieredEngine(t, DefaultTieredOptions)
+	defer te.Close()
+	testSelectDB(t, te)
+	testSelectDB(t, te.base)
+}
+
+// 复制的命令在指定的逻辑数据库中执行
+func TestSelect_Command(t *testing.T) {
+	te := newTestTieredEngine(t, DefaultTieredOptions)
+	defer te.Close()
+	ce := te.base
+
+	apply := func(c iface.Command) iface.Result {
+		return Select(ce, c.DB).Exec(c.Ins, c.Args())
+	}
+
+	assert.True(t, apply(iface.Command{Ins: iface.SET_STR, Key: "key", Value: []byte("v1"), DB: "1"}).Success())
+	assert.Equal(t, "v1", Select(ce, "1").Exec(iface.GET_STR, MakeStrGetArgs("key")).String())
+	assert.False(t, ce.Exec(iface.GET_STR, MakeStrGetArgs("key")).Success())
+
+	assert.True(t, apply(iface.Command{Ins: iface.SET_HASH, Key: "hash", Field: "f", Value: []byte("v"), DB: "1"}).Success())
+	res := Select(ce, "1").Exec(iface.GET_HASH, MakeHashGetArgs("hash", []byte("f")))
+	assert.True(t, res.Success())
+	assert.Equal(t, "v", res.String())
+
+	assert.True(t, apply(iface.Command{Ins: iface.DEL, Key: "key", DB: "1"}).Success())
+	assert.False(t, Select(ce, "1").Exec(iface.GET_STR, MakeStrGetArgs("key")).Success())
+
+	assert.True(t, apply(iface.Command{Ins: iface.SET_STR, Key: "key", Value: []byte("v2"), DB: "1"}).Success())
+	// 复杂数据类型的成员不计入key数量
+	size, err := Select(ce, "1").Size()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, size)
+
+	assert.True(t, apply(iface.Command{Ins: iface.FLUSH_DB, DB: "1"}).Success())
+	assert.False(t, Select(ce, "1").Exec(iface.GET_STR, MakeStrGetArgs("key")).Success())
+	size, _ = Select(ce, "1").Size()
+	assert.Equal(t, 0, size)
+	assert.Equal(t, 0, len(baseDBKeys(ce.Base, iface.DBPrefix("1"))))
+}
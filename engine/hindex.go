@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+)
+
+// HashIndexer 支持哈希字段二级索引的存储引擎
+type HashIndexer interface {
+	CreateHashIndex(name, field string) error
+	DropHashIndex(name string) error
+	HashIndexes() []bases.HashIndex
+	RangeHashIndex(name string, min, max []byte) ([][]byte, error)
+}
+
+// 创建和删除二级索引也作为指令执行 集群模式下通过raft复制到各副本
+// 每个副本应用日志时写入索引定义 并在本地补充已有数据的索引条目
+
+func execCreateHashIndex(indexer HashIndexer) ExecFunc {
+	return func(args [][]byte) iface.Result {
+		if len(args) < 2 {
+			return NewBaseErrResult(errno.ErrInvalidHashIndex)
+		}
+		return NewBaseErrResult(indexer.CreateHashIndex(utils.B2S(args[0]), utils.B2S(args[1])))
+	}
+}
+
+func execDropHashIndex(indexer HashIndexer) ExecFunc {
+	return func(args [][]byte) iface.Result {
+		if len(args) < 1 {
+			return NewBaseErrResult(errno.ErrHashIndexNotFound)
+		}
+		return NewBaseErrResult(indexer.DropHashIndex(utils.B2S(args[0])))
+	}
+}
+
+// 哈希操作直接由磁盘层执行 二级索引也由磁盘层维护
+
+func (eng *TieredEngine) CreateHashIndex(name, field string) error {
+	return eng.base.CreateHashIndex(name, field)
+}
+
+func (eng *TieredEngine) DropHashIndex(name string) error {
+	return eng.base.DropHashIndex(name)
+}
+
+func (eng *TieredEngine) HashIndexes() []bases.HashIndex {
+	return eng.base.HashIndexes()
+}
+
+func (eng *TieredEngine) RangeHashIndex(name string, min, max []byte) ([][]byte, error) {
+	return eng.base.RangeHashIndex(name, min, max)
+}
@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"time"
+)
+
+// 指令与键空间事件的对应关系 只有修改数据的指令会产生事件
+var insEvents = map[iface.INS]iface.EventType{
+	iface.SET_STR:         iface.EventSet,
+	iface.DEL:             iface.EventDel,
+	iface.EXPIRE:          iface.EventExpire,
+	iface.EXPIRE_AT:       iface.EventExpire,
+	iface.SET_HASH:        iface.EventHSet,
+	iface.DEL_HASH:        iface.EventHDel,
+	iface.LEFT_PUSH_LIST:  iface.EventLPush,
+	iface.RIGHT_PUSH_LIST: iface.EventRPush,
+	iface.LEFT_POP_LIST:   iface.EventLPop,
+	iface.RIGHT_POP_LIST:  iface.EventRPop,
+	iface.ADD_SET:         iface.EventSAdd,
+	iface.REM_SET:         iface.EventSRem,
+	iface.ADD_ZSET:        iface.EventZAdd,
+	iface.REM_ZSET:        iface.EventZRem,
+}
+
+// 指令执行成功后发布键空间事件
+func notify(n iface.Notifier, ins iface.INS, args [][]byte, res iface.Result) {
+	if n == nil || len(args) == 0 || !res.Success() || res.Error() != nil {
+		return
+	}
+
+	typ, ok := insEvents[ins]
+	if !ok {
+		return
+	}
+
+	n.Notify(iface.Event{
+		Type: typ,
+		Key:  string(args[0]),
+		Time: time.Now().UnixNano(),
+	})
+}
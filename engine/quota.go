@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/quota"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+)
+
+// 将命名空间配置转换为配额
+func toQuotas(namespaces []config.NamespaceConfig) []quota.Quota {
+	quotas := make([]quota.Quota, 0, len(namespaces))
+	for _, ns := range namespaces {
+		quotas = append(quotas, quota.Quota{
+			Prefix:       ns.Prefix,
+			MaxBytes:     ns.MaxBytes,
+			MaxKeys:      ns.MaxKeys,
+			MaxValueSize: ns.MaxValueSize,
+		})
+	}
+	return quotas
+}
+
+// NamespaceUsage 返回磁盘层的命名空间配额和使用量
+// 写回模式下数据在刷盘时才计入
+func (eng *TieredEngine) NamespaceUsage() []quota.Usage {
+	return eng.base.NamespaceUsage()
+}
@@ -0,0 +1,236 @@
+package quota
+
+import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/// 命名空间配额 按key前缀划分命名空间 限制占用空间、key数量和单个value大小
+/// 前缀匹配用户写入的key 不区分逻辑数据库 存储引擎内部使用的key不计入任何命名空间
+
+// Quota 命名空间配额 值为0表示不限制
+type Quota struct {
+	Prefix       string // key前缀
+	MaxBytes     int64  // 占用空间上限
+	MaxKeys      int64  // key数量上限
+	MaxValueSize int64  // 单个value大小上限
+}
+
+// Usage 命名空间使用情况
+type Usage struct {
+	Quota
+	Bytes int64 // 已占用空间
+	Keys  int64 // key数量
+}
+
+// Reporter 可以报告命名空间使用情况的存储引擎
+type Reporter interface {
+	NamespaceUsage() []Usage
+}
+
+// Change 一次写入对命名空间的影响
+type Change struct {
+	Key       []byte
+	ValueSize int64 // 写入的value大小
+	OldSize   int64 // 覆盖或删除的旧数据占用空间
+	NewSize   int64 // 新数据占用空间 删除时为0
+	Exist     bool  // key是否已经存在
+	Delete    bool  // 是否为删除操作
+	Member    bool  // 是否为复杂数据类型的成员 Key为所属的key 只计入占用空间
+}
+
+type namespace struct {
+	quota Quota
+	bytes int64
+	keys  int64
+}
+
+// Manager 配额管理器 为空时不做任何限制
+type Manager struct {
+	mutex  sync.Mutex
+	spaces []*namespace // 按前缀长度降序排列 优先匹配最长前缀
+}
+
+func New(quotas ...Quota) *Manager {
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	m := &Manager{}
+	for _, q := range quotas {
+		m.spaces = append(m.spaces, &namespace{quota: q})
+	}
+	sort.SliceStable(m.spaces, func(i, j int) bool {
+		return len(m.spaces[i].quota.Prefix) > len(m.spaces[j].quota.Prefix)
+	})
+
+	return m
+}
+
+// 查找key所属的命名空间
+func (m *Manager) namespaceOf(key []byte) *namespace {
+	key, ok := iface.UserKey(key)
+	if !ok {
+		return nil
+	}
+	for _, ns := range m.spaces {
+		if strings.HasPrefix(string(key), ns.quota.Prefix) {
+			return ns
+		}
+	}
+	return nil
+}
+
+// 命名空间的变化量
+type delta struct {
+	bytes int64
+	keys  int64
+}
+
+func (c *Change) delta() delta {
+	d := delta{bytes: c.NewSize - c.OldSize}
+	if c.Delete {
+		d.bytes = -c.OldSize
+		if c.Exist {
+			d.keys = -1
+		}
+	} else if !c.Exist {
+		d.keys = 1
+	}
+	if c.Member {
+		d.keys = 0
+	}
+	return d
+}
+
+// Admit 检查一组写入是否超出配额 全部通过后才计入使用量
+func (m *Manager) Admit(changes ...Change) error {
+	if m == nil {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	deltas := make(map[*namespace]delta)
+	for i := range changes {
+		ns := m.namespaceOf(changes[i].Key)
+		if ns == nil {
+			continue
+		}
+		if !changes[i].Delete && ns.quota.MaxValueSize > 0 && changes[i].ValueSize > ns.quota.MaxValueSize {
+			return errno.ErrExceedValueSizeQuota
+		}
+
+		d := changes[i].delta()
+		sum := deltas[ns]
+		sum.bytes += d.bytes
+		sum.keys += d.keys
+		deltas[ns] = sum
+	}
+
+	// 只限制增长 删除和缩小总是允许
+	for ns, d := range deltas {
+		if d.bytes > 0 && ns.quota.MaxBytes > 0 && ns.bytes+d.bytes > ns.quota.MaxBytes {
+			return errno.ErrExceedBytesQuota
+		}
+		if d.keys > 0 && ns.quota.MaxKeys > 0 && ns.keys+d.keys > ns.quota.MaxKeys {
+			return errno.ErrExceedKeysQuota
+		}
+	}
+
+	for ns, d := range deltas {
+		ns.bytes += d.bytes
+		ns.keys += d.keys
+	}
+
+	return nil
+}
+
+// Revert 写入失败时撤销已计入的使用量
+func (m *Manager) Revert(changes ...Change) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i := range changes {
+		if ns := m.namespaceOf(changes[i].Key); ns != nil {
+			d := changes[i].delta()
+			ns.bytes -= d.bytes
+			ns.keys -= d.keys
+		}
+	}
+}
+
+// Account 直接计入已存在的数据 用于启动时恢复使用量
+// 复杂数据类型的成员传入所属的key 只计入占用空间
+func (m *Manager) Account(key []byte, size int64, member bool) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if ns := m.namespaceOf(key); ns != nil {
+		ns.bytes += size
+		if !member {
+			ns.keys++
+		}
+	}
+}
+
+// Release 直接扣除被删除或淘汰的数据
+func (m *Manager) Release(key []byte, size int64) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if ns := m.namespaceOf(key); ns != nil {
+		ns.bytes -= size
+		ns.keys--
+	}
+}
+
+// Reset 清空所有命名空间的使用量
+func (m *Manager) Reset() {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, ns := range m.spaces {
+		ns.bytes, ns.keys = 0, 0
+	}
+}
+
+// Usage 返回所有命名空间的使用情况
+func (m *Manager) Usage() []Usage {
+	if m == nil {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	usage := make([]Usage, 0, len(m.spaces))
+	for _, ns := range m.spaces {
+		usage = append(usage, Usage{
+			Quota: ns.quota,
+			Bytes: ns.bytes,
+			Keys:  ns.keys,
+		})
+	}
+	return usage
+}
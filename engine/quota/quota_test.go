@@ -0,0 +1,77 @@
+package quota
+
+import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestManager_Admit(t *testing.T) {
+	m := New(
+		Quota{Prefix: "user:", MaxBytes: 100, MaxKeys: 2, MaxValueSize: 10},
+		Quota{Prefix: "user:vip:", MaxKeys: 1},
+	)
+
+	put := func(key string, size int64) Change {
+		return Change{Key: []byte(key), ValueSize: size, NewSize: size}
+	}
+
+	assert.Nil(t, m.Admit(put("user:1", 10)))
+	assert.Equal(t, errno.ErrExceedValueSizeQuota, m.Admit(put("user:2", 11)))
+	assert.Nil(t, m.Admit(put("user:2", 10)))
+	assert.Equal(t, errno.ErrExceedKeysQuota, m.Admit(put("user:3", 1)))
+
+	// 最长前缀优先匹配
+	assert.Nil(t, m.Admit(put("user:vip:1", 1)))
+	assert.Equal(t, errno.ErrExceedKeysQuota, m.Admit(put("user:vip:2", 1)))
+
+	// 不属于任何命名空间的key不受限制
+	assert.Nil(t, m.Admit(put("order:1", 1000)))
+
+	// 删除总是允许 并释放使用量
+	assert.Nil(t, m.Admit(Change{Key: []byte("user:1"), OldSize: 10, Exist: true, Delete: true}))
+	assert.Nil(t, m.Admit(put("user:3", 10)))
+
+	// 覆盖写入只计算增量
+	over := Change{Key: []byte("user:3"), ValueSize: 10, OldSize: 10, NewSize: 95, Exist: true}
+	assert.Equal(t, errno.ErrExceedBytesQuota, m.Admit(over))
+
+	usage := m.Usage()
+	assert.Equal(t, "user:vip:", usage[0].Prefix)
+	assert.Equal(t, int64(1), usage[0].Keys)
+	assert.Equal(t, int64(20), usage[1].Bytes)
+	assert.Equal(t, int64(2), usage[1].Keys)
+
+	// 一组写入中任意一个超出配额则全部拒绝
+	assert.Equal(t, errno.ErrExceedKeysQuota, m.Admit(put("user:4", 1), put("order:2", 1)))
+	assert.Equal(t, int64(2), m.Usage()[1].Keys)
+
+	m.Revert(put("user:3", 10))
+	assert.Equal(t, int64(10), m.Usage()[1].Bytes)
+}
+
+func TestManager_LogicalKey(t *testing.T) {
+	m := New(Quota{Prefix: "user:", MaxKeys: 1})
+
+	// 去掉逻辑数据库前缀后匹配
+	key := []byte(iface.DBKey("1", "user:1"))
+	assert.Nil(t, m.Admit(Change{Key: key, NewSize: 10}))
+	assert.Equal(t, int64(1), m.Usage()[0].Keys)
+
+	// 成员只计入占用空间
+	assert.Nil(t, m.Admit(Change{Key: key, NewSize: 10, Member: true}))
+	assert.Equal(t, int64(1), m.Usage()[0].Keys)
+	assert.Equal(t, int64(20), m.Usage()[0].Bytes)
+
+	// 内部使用的key不属于任何命名空间
+	assert.Nil(t, m.Admit(Change{Key: []byte("\x00hidx:user:2"), NewSize: 10}))
+	assert.Equal(t, int64(1), m.Usage()[0].Keys)
+}
+
+func TestManager_Nil(t *testing.T) {
+	m := New()
+	assert.Nil(t, m)
+	assert.Nil(t, m.Admit(Change{Key: []byte("k"), NewSize: 1}))
+	assert.Nil(t, m.Usage())
+}
@@ -0,0 +1,518 @@
+package engine
+
+import (
+	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/engine/caches"
+	"github.com/T4t4KAU/TikBase/engine/values"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"hash/fnv"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/// 分层存储引擎 caches作为热数据层 bases作为持久层
+
+const (
+	WriteThrough = iota // 写穿 同时写入缓存和磁盘
+	WriteBehind         // 写回 先写缓存 定期批量刷入磁盘
+)
+
+const tieredLockCount = 64
+
+type TieredOptions struct {
+	WriteMode     int           // 写入策略
+	FlushInterval time.Duration // 写回模式下的刷盘间隔
+	MaxPending    int           // 待刷盘数据达到该数量时立即刷盘
+	HotTTL        int64         // 数据在缓存层的存活时间(秒) 超时后降级到磁盘
+}
+
+var DefaultTieredOptions = TieredOptions{
+	WriteMode:     WriteThrough,
+	FlushInterval: time.Second,
+	MaxPending:    1024,
+	HotTTL:        60,
+}
+
+// 待刷盘的写入
+type pendingWrite struct {
+	value   []byte
+	deleted bool
+}
+
+// TieredStatus 分层引擎统计信息
+type TieredStatus struct {
+	Hits       uint64 // 缓存层命中次数
+	Misses     uint64 // 缓存层未命中次数
+	Promotions uint64 // 从磁盘提升到缓存的次数
+	Demotions  uint64 // 从缓存降级的次数
+	Flushes    uint64 // 写回刷盘次数
+	Pending    int    // 待刷盘数据个数
+	Cache      caches.Status
+}
+
+// HitRate 缓存命中率
+func (st *TieredStatus) HitRate() float64 {
+	total := st.Hits + st.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(st.Hits) / float64(total)
+}
+
+type TieredEngine struct {
+	cache    *caches.Cache
+	base     *BaseEngine
+	options  TieredOptions
+	execFunc map[iface.INS]ExecFunc
+	notifier iface.Notifier // 键空间事件通知
+
+	locks        [tieredLockCount]sync.Mutex // 按key分段加锁 保证缓存和磁盘一致
+	pendingMutex sync.Mutex
+	pending      map[string]*pendingWrite // 待刷盘数据
+	flushing     map[string]*pendingWrite // 正在刷盘的数据
+	flushMutex   sync.Mutex
+	flushCh      chan struct{}
+	closeCh      chan struct{}
+
+	hits       uint64
+	misses     uint64
+	promotions uint64
+	demotions  uint64
+	flushes    uint64
+}
+
+func NewTieredEngine() (*TieredEngine, error) {
+	options := caches.DefaultOptions()
+	options.DumpFile = ""
+	options.EvictWhenFull = true
+	cache, err := caches.NewCacheWith(options)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := NewBaseEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	return newTieredEngine(cache, base, DefaultTieredOptions), nil
+}
+
+func NewTieredEngineWith(config config.TieredStoreConfig) (*TieredEngine, error) {
+	// 数据以磁盘为准 缓存层不需要持久化 写满时淘汰数据
+	config.Cache.DumpFile = ""
+	config.Cache.EvictWhenFull = true
+	cacheEng, err := NewCacheEngineWith(config.Cache)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := NewBaseEngineWith(config.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	options := DefaultTieredOptions
+	if config.WriteMode == "behind" {
+		options.WriteMode = WriteBehind
+	}
+	if config.FlushInterval > 0 {
+		options.FlushInterval = time.Duration(config.FlushInterval) * time.Millisecond
+	}
+	if config.MaxPending > 0 {
+		options.MaxPending = config.MaxPending
+	}
+	if config.HotTTL > 0 {
+		options.HotTTL = int64(config.HotTTL)
+	}
+
+	eng := newTieredEngine(cacheEng.Cache, base, options)
+	eng.cache.AutoGC()
+
+	return eng, nil
+}
+
+func newTieredEngine(cache *caches.Cache, base *BaseEngine, options TieredOptions) *TieredEngine {
+	eng := &TieredEngine{
+		cache:    cache,
+		base:     base,
+		options:  options,
+		execFunc: make(map[iface.INS]ExecFunc),
+		pending:  make(map[string]*pendingWrite),
+		flushCh:  make(chan struct{}, 1),
+		closeCh:  make(chan struct{}),
+	}
+
+	// 缓存层数据过期即降级
+	cache.SetNotifier(&demotionNotifier{eng: eng})
+	eng.initExecFunc()
+
+	if options.WriteMode == WriteBehind {
+		go eng.autoFlush()
+	}
+
+	return eng
+}
+
+func (eng *TieredEngine) Exec(ins iface.INS, args [][]byte) iface.Result {
+	fn, ok := eng.execFunc[ins]
+	if !ok {
+		fn = eng.execPassThrough(ins)
+	}
+
+	res := fn(args)
+	notify(eng.notifier, ins, args, res)
+	return res
+}
+
+// SetNotifier 设置键空间事件通知器 数据降级时发布evicted事件
+func (eng *TieredEngine) SetNotifier(n iface.Notifier) {
+	eng.notifier = n
+}
+
+func (eng *TieredEngine) registerExecFunc(ins iface.INS, fn ExecFunc) {
+	eng.execFunc[ins] = fn
+}
+
+func (eng *TieredEngine) initExecFunc() {
+	eng.registerExecFunc(iface.GET_STR, eng.ExecStrGet)
+	eng.registerExecFunc(iface.SET_STR, eng.ExecStrSet)
+	eng.registerExecFunc(iface.DEL, eng.ExecDelKey)
+	eng.registerExecFunc(iface.FLUSH_DB, eng.ExecFlushDB)
+	eng.registerExecFunc(iface.DB_SIZE, eng.ExecDBSize)
+	eng.registerExecFunc(iface.CREATE_HASH_INDEX, execCreateHashIndex(eng))
+	eng.registerExecFunc(iface.DROP_HASH_INDEX, execDropHashIndex(eng))
+}
+
+// 缓存层只保存字符串 其余指令直接交给磁盘层执行
+// 修改数据的指令先将该key的待刷盘数据写入磁盘并使缓存失效 只读指令直接读取磁盘层
+func (eng *TieredEngine) execPassThrough(ins iface.INS) ExecFunc {
+	return func(args [][]byte) iface.Result {
+		if len(args) > 0 && !ins.ReadOnly() {
+			key := utils.B2S(args[0])
+			lock := eng.lockOf(key)
+			lock.Lock()
+			defer lock.Unlock()
+
+			// 保证与待刷盘数据的写入顺序
+			if err := eng.flushKey(key); err != nil {
+				return NewCacheErrorResult(err)
+			}
+			_ = eng.cache.Del(key)
+		}
+		return eng.base.Exec(ins, args)
+	}
+}
+
+func (eng *TieredEngine) ExecStrGet(args [][]byte) iface.Result {
+	key, err := ParseStrGetArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+
+	if res, ok := eng.lookup(key); ok {
+		atomic.AddUint64(&eng.hits, 1)
+		return res
+	}
+	atomic.AddUint64(&eng.misses, 1)
+
+	lock := eng.lockOf(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// 其他协程可能已经完成提升
+	if res, ok := eng.lookup(key); ok {
+		return res
+	}
+
+	// 读穿 从磁盘读取并提升到缓存
+	val, err := eng.base.Get(key)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+	data := val.Bytes()
+	if eng.cache.Set(key, data, eng.options.HotTTL) == nil {
+		atomic.AddUint64(&eng.promotions, 1)
+	}
+
+	return NewCacheResult(true, data, nil)
+}
+
+func (eng *TieredEngine) ExecStrSet(args [][]byte) iface.Result {
+	key, val, err := ParseStrSetArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+	if len(key) == 0 {
+		return NewCacheErrorResult(errno.ErrKeyIsEmpty)
+	}
+	data := utils.S2B(val)
+
+	lock := eng.lockOf(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if eng.options.WriteMode == WriteBehind {
+		eng.addPending(key, &pendingWrite{value: utils.Copy(data)})
+	} else {
+		v := values.New(data, 0, iface.STRING)
+		if err = eng.base.Set(key, &v); err != nil {
+			return NewCacheErrorResult(err)
+		}
+	}
+
+	// 缓存写入失败时删除旧值 避免读到过期数据
+	if err = eng.cache.Set(key, data, eng.options.HotTTL); err != nil {
+		_ = eng.cache.Del(key)
+	}
+
+	return NewSuccCacheResult()
+}
+
+func (eng *TieredEngine) ExecDelKey(args [][]byte) iface.Result {
+	key, err := ParseDelKeyArgs(args)
+	if err != nil {
+		return NewCacheErrorResult(err)
+	}
+	if len(key) == 0 {
+		return NewCacheErrorResult(errno.ErrKeyIsEmpty)
+	}
+
+	lock := eng.lockOf(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if eng.options.WriteMode == WriteBehind {
+		eng.addPending(key, &pendingWrite{deleted: true})
+	} else if err = eng.base.Del(key); err != nil {
+		return NewCacheErrorResult(err)
+	}
+	_ = eng.cache.Del(key)
+
+	return NewSuccCacheResult()
+}
+
+// 依次从待刷盘数据和缓存中查找 返回false表示需要读取磁盘
+func (eng *TieredEngine) lookup(key string) (iface.Result, bool) {
+	eng.pendingMutex.Lock()
+	w, ok := eng.pending[key]
+	if !ok {
+		w, ok = eng.flushing[key]
+	}
+	eng.pendingMutex.Unlock()
+
+	if ok {
+		if w.deleted {
+			// 已删除但尚未刷盘
+			return NewCacheErrorResult(errno.ErrKeyNotFound), true
+		}
+		return NewCacheResult(true, w.value, nil), true
+	}
+
+	val, err := eng.cache.Get(key)
+	if err != nil {
+		return nil, false
+	}
+	return NewCacheResult(true, val.Bytes(), nil), true
+}
+
+func (eng *TieredEngine) addPending(key string, w *pendingWrite) {
+	eng.pendingMutex.Lock()
+	eng.pending[key] = w
+	full := len(eng.pending) >= eng.options.MaxPending
+	eng.pendingMutex.Unlock()
+
+	if full {
+		select {
+		case eng.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (eng *TieredEngine) lockOf(key string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write(utils.S2B(key))
+	return &eng.locks[h.Sum32()%tieredLockCount]
+}
+
+// Flush 将待刷盘数据批量写入磁盘
+func (eng *TieredEngine) Flush() error {
+	eng.flushMutex.Lock()
+	defer eng.flushMutex.Unlock()
+
+	eng.pendingMutex.Lock()
+	pending := eng.pending
+	eng.pending = make(map[string]*pendingWrite)
+	eng.flushing = pending
+	eng.pendingMutex.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	if err := eng.writePending(pending); err != nil {
+		return eng.restorePending(pending, err)
+	}
+
+	eng.pendingMutex.Lock()
+	eng.flushing = nil
+	eng.pendingMutex.Unlock()
+	atomic.AddUint64(&eng.flushes, 1)
+
+	return nil
+}
+
+// 只将key的待刷盘数据写入磁盘 调用方持有key的锁
+// 等待正在进行的刷盘完成 保证该key之前的写入已经落盘
+func (eng *TieredEngine) flushKey(key string) error {
+	eng.flushMutex.Lock()
+	defer eng.flushMutex.Unlock()
+
+	eng.pendingMutex.Lock()
+	w, ok := eng.pending[key]
+	delete(eng.pending, key)
+	eng.pendingMutex.Unlock()
+
+	if !ok {
+		return nil
+	}
+	pending := map[string]*pendingWrite{key: w}
+	if err := eng.writePending(pending); err != nil {
+		return eng.restorePending(pending, err)
+	}
+	return nil
+}
+
+// 在一个事务中写入待刷盘数据
+func (eng *TieredEngine) writePending(pending map[string]*pendingWrite) error {
+	wb := eng.base.NewWriteBatchWith(bases.WriteBatchOptions{
+		MaxBatchNum: uint(len(pending)),
+		SyncWriters: bases.DefaultWriteBatchOptions.SyncWriters,
+	})
+	for key, w := range pending {
+		var err error
+		if w.deleted {
+			err = wb.Delete(utils.S2B(key))
+		} else {
+			err = wb.Put(utils.S2B(key), w.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return wb.Commit()
+}
+
+// 刷盘失败 将未被覆盖的数据放回待刷盘集合
+func (eng *TieredEngine) restorePending(pending map[string]*pendingWrite, err error) error {
+	eng.pendingMutex.Lock()
+	defer eng.pendingMutex.Unlock()
+
+	for key, w := range pending {
+		if _, ok := eng.pending[key]; !ok {
+			eng.pending[key] = w
+		}
+	}
+	eng.flushing = nil
+
+	return err
+}
+
+// 写回模式下定期刷盘
+func (eng *TieredEngine) autoFlush() {
+	ticker := time.NewTicker(eng.options.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-eng.flushCh:
+		case <-eng.closeCh:
+			return
+		}
+		_ = eng.Flush()
+	}
+}
+
+// Status 返回分层引擎统计信息
+func (eng *TieredEngine) Status() *TieredStatus {
+	eng.pendingMutex.Lock()
+	pending := len(eng.pending)
+	eng.pendingMutex.Unlock()
+
+	return &TieredStatus{
+		Hits:       atomic.LoadUint64(&eng.hits),
+		Misses:     atomic.LoadUint64(&eng.misses),
+		Promotions: atomic.LoadUint64(&eng.promotions),
+		Demotions:  atomic.LoadUint64(&eng.demotions),
+		Flushes:    atomic.LoadUint64(&eng.flushes),
+		Pending:    pending,
+		Cache:      eng.cache.Status(),
+	}
+}
+
+// Close 刷盘并关闭磁盘层
+func (eng *TieredEngine) Close() error {
+	if eng.options.WriteMode == WriteBehind {
+		close(eng.closeCh)
+	}
+	if err := eng.Flush(); err != nil {
+		return err
+	}
+	return eng.base.Close()
+}
+
+// Snapshot 将缓存层待写入的数据刷盘后生成磁盘层快照
+func (eng *TieredEngine) Snapshot() (iface.Snapshot, error) {
+	if err := eng.Flush(); err != nil {
+		return nil, err
+	}
+	return eng.base.Snapshot()
+}
+
+// Restore 从磁盘层快照恢复
+// 恢复期间持有全部key的锁和刷盘锁 进行中的写入和刷盘完成后才替换数据 之后的写入等待恢复完成
+func (eng *TieredEngine) Restore(r io.Reader) error {
+	for i := range eng.locks {
+		eng.locks[i].Lock()
+		defer eng.locks[i].Unlock()
+	}
+	eng.flushMutex.Lock()
+	defer eng.flushMutex.Unlock()
+
+	eng.pendingMutex.Lock()
+	eng.pending = make(map[string]*pendingWrite)
+	eng.pendingMutex.Unlock()
+
+	if err := eng.base.Restore(r); err != nil {
+		return err
+	}
+
+	// 清空缓存层 之后的读取从磁盘重新加载
+	for _, key := range eng.cache.Keys() {
+		_ = eng.cache.Del(utils.B2S(key))
+	}
+	return nil
+}
+
+// 缓存层的过期和淘汰事件即数据降级
+type demotionNotifier struct {
+	eng *TieredEngine
+}
+
+func (n *demotionNotifier) Notify(event iface.Event) {
+	if event.Type != iface.EventExpired && event.Type != iface.EventEvicted {
+		return
+	}
+	atomic.AddUint64(&n.eng.demotions, 1)
+
+	if n.eng.notifier != nil {
+		event.Type = iface.EventEvicted
+		n.eng.notifier.Notify(event)
+	}
+}
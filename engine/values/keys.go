@@ -10,7 +10,7 @@ const (
 	MaxMetadataSize   = 1 + binary.MaxVarintLen64*2 + binary.MaxVarintLen32
 	ExtraListMetaSize = binary.MaxVarintLen64 * 2
 	InitialListFlag   = math.MaxUint64 / 2
-	scoreKeyPrefix    = "!score"
+	ScoreKeyPrefix    = "!score" // 有序集合按分数排序的成员key前缀
 )
 
 // HashInternalKey 用于标识一个HASH结构
@@ -158,11 +158,11 @@ func (zk *ZSetInternalKey) EncodeWithMember() []byte {
 
 func (zk *ZSetInternalKey) EncodeWithScore() []byte {
 	scoreBytes := utils.F642B(zk.score)
-	b := make([]byte, len(zk.key)+len(scoreKeyPrefix)+len(scoreBytes)+len(zk.member)+8+4)
+	b := make([]byte, len(zk.key)+len(ScoreKeyPrefix)+len(scoreBytes)+len(zk.member)+8+4)
 
 	var index = 0
-	copy(b[index:index+len(scoreKeyPrefix)], scoreKeyPrefix)
-	index += len(scoreKeyPrefix)
+	copy(b[index:index+len(ScoreKeyPrefix)], ScoreKeyPrefix)
+	index += len(ScoreKeyPrefix)
 
 	copy(b[index:index+len(zk.key)], zk.key)
 	index += len(zk.key)
@@ -179,3 +179,11 @@ func (zk *ZSetInternalKey) EncodeWithScore() []byte {
 	binary.LittleEndian.PutUint32(b[index:], uint32(len(zk.member)))
 	return b
 }
+
+// MemberVersion 返回成员key中所属key之后的版本号
+func MemberVersion(key []byte, ownerLen int) (int64, bool) {
+	if ownerLen <= 0 || len(key) < ownerLen+8 {
+		return 0, false
+	}
+	return int64(binary.LittleEndian.Uint64(key[ownerLen : ownerLen+8])), true
+}
@@ -29,6 +29,37 @@ func (meta *Meta) Value() Value {
 	return New(meta.Encode(), 0, iface.META_DATA)
 }
 
+// ParseMeta 检查数据是否为复杂数据类型的元数据 是则解码
+func ParseMeta(b []byte) (*Meta, bool) {
+	if len(b) == 0 {
+		return nil, false
+	}
+	switch iface.Type(b[0]) {
+	case iface.HASH, iface.SET, iface.ZSET, iface.LIST:
+	default:
+		return nil, false
+	}
+
+	var index = 1
+	for i := 0; i < 3; i++ {
+		_, n := binary.Varint(b[index:])
+		if n <= 0 {
+			return nil, false
+		}
+		index += n
+	}
+	if iface.Type(b[0]) == iface.LIST {
+		for i := 0; i < 2; i++ {
+			_, n := binary.Uvarint(b[index:])
+			if n <= 0 {
+				return nil, false
+			}
+			index += n
+		}
+	}
+	return DecodeMeta(b), true
+}
+
 // DecodeMeta 解码元数据
 func DecodeMeta(b []byte) *Meta {
 	dataType := iface.Type(b[0])
@@ -13,10 +13,11 @@ const (
 )
 
 type Value struct {
-	Data    []byte     // 数据
-	TTL     int64      // 存活时间
-	Created int64      // 数据创建时间
-	Type    iface.Type // 数据类型
+	Data     []byte     // 数据
+	TTL      int64      // 存活时间
+	Created  int64      // 数据创建时间
+	ExpireAt int64      // 过期的绝对时间 不为0时代替TTL
+	Type     iface.Type // 数据类型
 }
 
 // New 返回一个封装好的数据
@@ -88,6 +89,9 @@ func (v *Value) toString() string {
 
 // Alive 返回该数据是否存活
 func (v *Value) Alive() bool {
+	if v.ExpireAt != 0 {
+		return time.Now().Unix() < v.ExpireAt
+	}
 	return v.TTL == NeverExpire || time.Now().Unix()-v.Created < v.TTL
 }
 
@@ -0,0 +1,79 @@
+namespace go cdc
+
+struct Position {
+    1: required i32 fid    // 数据文件ID
+    2: required i64 offset // 文件内偏移
+}
+
+struct ChangeEvent {
+    1: required binary key
+    2: required binary value
+    3: required string op        // put 或 del
+    4: required i64 seq_no       // 事务序列号
+    5: required i64 timestamp    // 提交时间 纳秒
+    6: required Position pos     // 日志记录位置
+    7: required Position next    // 消费完成后应提交的位置
+    8: optional string db        // key所在的逻辑数据库 key不含数据库前缀
+}
+
+struct OpenStreamReq {
+    1: required string consumer
+    2: required bool from_committed // 是否从消费者已提交的位置开始
+    3: required Position start      // 指定开始重放的位置
+}
+
+struct OpenStreamResp {
+    1: required bool success
+    2: required string stream_id
+    3: required Position start
+    4: required string message
+}
+
+struct PollReq {
+    1: required string stream_id
+    2: required i32 max_events
+    3: required i64 timeout // 等待事件的最长时间 毫秒
+}
+
+struct PollResp {
+    1: required bool success
+    2: required list<ChangeEvent> events
+    3: required string message
+}
+
+struct CloseStreamReq {
+    1: required string stream_id
+}
+
+struct CloseStreamResp {
+    1: required bool success
+    2: required string message
+}
+
+struct CommitReq {
+    1: required string consumer
+    2: required Position pos
+}
+
+struct CommitResp {
+    1: required bool success
+    2: required string message
+}
+
+struct OffsetReq {
+    1: required string consumer
+}
+
+struct OffsetResp {
+    1: required bool success
+    2: required Position pos
+    3: required string message
+}
+
+service CDCService {
+    OpenStreamResp OpenStream(1: OpenStreamReq req)
+    PollResp Poll(1: PollReq req)
+    CloseStreamResp CloseStream(1: CloseStreamReq req)
+    CommitResp Commit(1: CommitReq req)
+    OffsetResp Offset(1: OffsetReq req)
+}
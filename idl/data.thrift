@@ -1,8 +1,17 @@
 namespace go data
 
+// 读一致性级别
+enum Consistency {
+    DEFAULT = 0    // 领导者租约读
+    STALE = 1      // 跟随者读 数据落后不超过max_staleness
+    CONSISTENT = 2 // 基于ReadIndex的线性一致读
+}
+
 struct SetReq {
     1: required string key
     2: required binary value
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
+    4: optional i64 ttl // 过期时间 单位秒 为0时不过期
 }
 
 struct SetResp {
@@ -13,6 +22,9 @@ struct SetResp {
 
 struct GetReq {
     1: required string key
+    2: optional string db // 逻辑数据库 为空时使用默认数据库
+    3: optional Consistency consistency
+    4: optional i64 max_staleness // 跟随者读允许落后的毫秒数 为0时不限制
 }
 
 struct GetResp {
@@ -24,6 +36,7 @@ struct GetResp {
 
 struct DelReq {
     1: required string key
+    2: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct DelResp {
@@ -35,6 +48,7 @@ struct DelResp {
 struct ExpireReq {
     1: required string key
     2: required i64 time
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct ExpireResp {
@@ -47,6 +61,7 @@ struct HSetReq {
     1: required string key
     2: required binary field
     3: required binary value
+    4: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct HSetResp {
@@ -58,6 +73,9 @@ struct HSetResp {
 struct HGetReq {
     1: required string key
     2: required binary field
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
+    4: optional Consistency consistency
+    5: optional i64 max_staleness // 跟随者读允许落后的毫秒数 为0时不限制
 }
 
 struct HGetResp {
@@ -70,6 +88,7 @@ struct HGetResp {
 struct HDelReq {
     1: required string key
     2: required binary field
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct HDelResp {
@@ -81,6 +100,7 @@ struct HDelResp {
 struct LPushReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct LPushResp {
@@ -92,6 +112,7 @@ struct LPushResp {
 struct RPushReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct RPushResp {
@@ -102,6 +123,7 @@ struct RPushResp {
 
 struct LPopReq {
     1: required string key
+    2: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct LPopResp {
@@ -113,6 +135,7 @@ struct LPopResp {
 
 struct RPopReq {
     1: required string key
+    2: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct RPopResp {
@@ -125,6 +148,7 @@ struct RPopResp {
 struct SAddReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct SAddResp {
@@ -136,6 +160,7 @@ struct SAddResp {
 struct SRemReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct SRemResp {
@@ -147,6 +172,8 @@ struct SRemResp {
 struct ZAddReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
+    4: optional double score
 }
 
 struct ZAddResp {
@@ -158,6 +185,7 @@ struct ZAddResp {
 struct ZRemReq {
     1: required string key
     2: required binary element
+    3: optional string db // 逻辑数据库 为空时使用默认数据库
 }
 
 struct ZRemResp {
@@ -166,6 +194,29 @@ struct ZRemResp {
     3: required i32 status_code
 }
 
+struct FlushDBReq {
+    1: optional string db
+}
+
+struct FlushDBResp {
+    1: required bool success
+    2: required string message
+    3: required i32 status_code
+}
+
+struct DBSizeReq {
+    1: optional string db
+    2: optional Consistency consistency
+    3: optional i64 max_staleness // 跟随者读允许落后的毫秒数 为0时不限制
+}
+
+struct DBSizeResp {
+    1: required bool success
+    2: required i64 size
+    3: required string message
+    4: required i32 status_code
+}
+
 service DataService {
     GetResp Get(1: GetReq req)
     SetResp Set(1: SetReq req)
@@ -182,4 +233,6 @@ service DataService {
     SRemResp SRem(1: SRemReq req)
     ZAddResp ZAdd(1: ZAddReq req)
     ZRemResp ZRem(1: ZRemReq req)
+    FlushDBResp FlushDB(1: FlushDBReq req)
+    DBSizeResp DBSize(1: DBSizeReq req)
 }
\ No newline at end of file
@@ -6,8 +6,47 @@ struct RegionListResp {
     1: required string message
 }
 
+// 复制进度由领导者记录 时间的单位为毫秒 未知时为-1
+struct ReplicaProgress {
+    1: required i32 region_id
+    2: required string node_id
+    3: required string address // raft地址
+    4: required string suffrage // Voter Nonvoter Staging
+    5: required i64 match_index // 跟随者的最新日志索引
+    6: required i64 lag_entries // 落后领导者的日志数
+    7: required i64 lag_time // 落后持续的时间
+    8: required i64 last_contact // 距上次成功复制的时间
+}
+
+// 节点在一个分区复制组中的状态
+struct ReplicaState {
+    1: required i32 region_id
+    2: required string node_id
+    3: required string address
+    4: required string state // Leader Follower Candidate Shutdown
+    5: required string leader // 领导者的raft地址
+    6: required i64 term
+    7: required i64 last_index
+    8: required i64 commit_index
+    9: required i64 applied_index
+    10: required i64 snapshot_index
+    11: required i64 last_contact // 距上次与领导者通信的时间 领导者为0
+    12: required list<ReplicaProgress> followers // 本节点是领导者时各跟随者的复制进度
+}
+
+// 全部分区的汇总 每个分区使用其领导者上的状态 无法查询领导者时使用本节点的状态
+struct ClusterState {
+    1: required i32 regions
+    2: required i32 leaders // 状态来自领导者的分区数
+    3: required i32 leaderless // 没有领导者的分区数
+    4: required i32 replicas
+    5: required i32 lagging // 落后领导者的跟随者数
+    6: required i64 max_lag_entries
+    7: required i64 max_lag_time
+}
+
 struct RegionStatusReq {
-    1: required string name // 分区名称
+    1: required string name // 分区编号 为空时返回全部分区
 }
 
 struct RegionStatusResp {
@@ -15,6 +54,9 @@ struct RegionStatusResp {
     2: required string address
     3: required i64 replica_count
     4: required string message
+    5: required bool success
+    6: required list<ReplicaState> regions
+    7: required ClusterState cluster
 }
 
 struct ReplicaListReq {}
@@ -24,13 +66,80 @@ struct ReplicaListResp {
 }
 
 struct ReplicaStatusReq {
-    1: required string name
+    1: required string name // 节点ID 为空时为本节点
 }
 
+// 其他节点的状态只能从其担任跟随者 本节点担任领导者的分区中获得
 struct ReplicaStatusResp {
     1: required string name
     2: required string address
     3: required string message
+    4: required bool success
+    5: required list<ReplicaState> replicas // 本节点在各分区的状态
+    6: required list<ReplicaProgress> progress // 其他节点在本节点担任领导者的分区中的复制进度
+}
+
+struct NamespaceUsage {
+    1: required string prefix // key前缀
+    2: required i64 max_bytes // 占用空间上限 0表示不限制
+    3: required i64 max_keys // key数量上限 0表示不限制
+    4: required i64 max_value_size // 单个value大小上限 0表示不限制
+    5: required i64 bytes // 已占用空间
+    6: required i64 keys // key数量
+}
+
+struct NamespaceUsageReq {}
+
+struct NamespaceUsageResp {
+    1: required bool success
+    2: required list<NamespaceUsage> namespaces
+    3: required string message
+}
+
+struct HashIndex {
+    1: required string name // 索引名称
+    2: required string field // 建立索引的哈希字段
+    3: required bool ready // 已有数据是否已经建立索引
+}
+
+struct CreateHashIndexReq {
+    1: required string name
+    2: required string field
+}
+
+struct CreateHashIndexResp {
+    1: required bool success
+    2: required string message
+}
+
+struct DropHashIndexReq {
+    1: required string name
+}
+
+struct DropHashIndexResp {
+    1: required bool success
+    2: required string message
+}
+
+struct ListHashIndexesReq {}
+
+struct ListHashIndexesResp {
+    1: required bool success
+    2: required list<HashIndex> indexes
+    3: required string message
+}
+
+struct QueryHashIndexReq {
+    1: required string name
+    2: optional binary min // 字段值下限 为空时不限制
+    3: optional binary max // 字段值上限 为空时不限制 与下限相同时为精确查询
+    4: optional string db
+}
+
+struct QueryHashIndexResp {
+    1: required bool success
+    2: required list<string> keys
+    3: required string message
 }
 
 service MetaService {
@@ -38,4 +147,9 @@ service MetaService {
     RegionStatusResp RegionStatus(1: RegionStatusReq req)
     ReplicaListResp ReplicaList(1: ReplicaListReq req)
     ReplicaStatusResp ReplicaStatus(1: ReplicaStatusReq req)
+    NamespaceUsageResp NamespaceUsage(1: NamespaceUsageReq req)
+    CreateHashIndexResp CreateHashIndex(1: CreateHashIndexReq req)
+    DropHashIndexResp DropHashIndex(1: DropHashIndexReq req)
+    ListHashIndexesResp ListHashIndexes(1: ListHashIndexesReq req)
+    QueryHashIndexResp QueryHashIndex(1: QueryHashIndexReq req)
 }
\ No newline at end of file
@@ -0,0 +1,54 @@
+namespace go pubsub
+
+struct Message {
+    1: required string topic
+    2: required binary data
+}
+
+struct PublishReq {
+    1: required string topic
+    2: required binary data
+}
+
+struct PublishResp {
+    1: required bool success
+    2: required string message
+}
+
+struct SubscribeReq {
+    1: required list<string> patterns // 主题模式 支持通配符
+}
+
+struct SubscribeResp {
+    1: required bool success
+    2: required string subscription_id
+    3: required string message
+}
+
+struct PollReq {
+    1: required string subscription_id
+    2: required i32 max_messages
+    3: required i64 timeout // 等待消息的最长时间 毫秒
+}
+
+struct PollResp {
+    1: required bool success
+    2: required list<Message> messages
+    3: required string message
+}
+
+struct UnsubscribeReq {
+    1: required string subscription_id
+}
+
+struct UnsubscribeResp {
+    1: required bool success
+    2: required string message
+}
+
+service PubSubService {
+    PublishResp Publish(1: PublishReq req)
+    SubscribeResp Subscribe(1: SubscribeReq req)
+    PollResp Poll(1: PollReq req)
+    UnsubscribeResp Unsubscribe(1: UnsubscribeReq req)
+}
@@ -1,9 +1,13 @@
 namespace go replica
 
+// region_id指定分区的复制组 为空时为分区0
 struct JoinReq {
     1: required string raft_addr
     2: required string service_addr
     3: required string node_id
+    4: optional i32 region_id
+    5: optional i32 regions // 加入节点的分区数量 与集群不一致时拒绝加入
+    6: optional string replica_addr // 加入节点的副本服务地址 用于向领导者查询复制进度
 }
 
 struct JoinResp {
@@ -16,14 +20,152 @@ struct GetIdResp {
     1: required string node_id
 }
 
-struct LeaderAddrReq {}
+struct LeaderAddrReq {
+    1: optional i32 region_id
+}
 
 struct LeaderAddrResp {
     1: required string address
 }
 
+// 成员管理请求只能发送到领导者 移除或降级投票节点前检查多数派是否可达
+struct AddNonvoterReq {
+    1: required string raft_addr
+    2: required string service_addr
+    3: required string node_id
+    4: optional i32 region_id
+}
+
+struct AddNonvoterResp {
+    1: required bool success
+    2: required string message
+}
+
+struct RemoveServerReq {
+    1: required string node_id
+    2: optional i32 region_id
+}
+
+struct RemoveServerResp {
+    1: required bool success
+    2: required string message
+}
+
+struct DemoteVoterReq {
+    1: required string node_id
+    2: optional i32 region_id
+}
+
+struct DemoteVoterResp {
+    1: required bool success
+    2: required string message
+}
+
+struct TransferLeadershipReq {
+    1: optional string node_id // 为空时选择日志最新的投票节点
+    2: optional i32 region_id
+}
+
+struct TransferLeadershipResp {
+    1: required bool success
+    2: required string message
+}
+
+struct Member {
+    1: required string node_id
+    2: required string raft_addr
+    3: required string suffrage // Voter Nonvoter Staging
+    4: required bool leader
+}
+
+struct MembersReq {
+    1: optional i32 region_id
+}
+
+struct MembersResp {
+    1: required bool success
+    2: required string message
+    3: required list<Member> members
+}
+
+// 生效的raft参数 时间的单位为毫秒
+struct RaftTuning {
+    1: required i64 snapshot_interval
+    2: required i64 snapshot_threshold
+    3: required i64 trailing_logs
+    4: required i64 heartbeat_timeout
+    5: required i64 election_timeout
+    6: required i64 leader_lease_timeout
+    7: required i32 max_append_entries
+    8: required i32 retain_snapshots
+}
+
+struct StatusReq {
+    1: optional i32 region_id
+}
+
+// 领导者观察到的跟随者复制进度 时间的单位为毫秒 未知时为-1
+struct Progress {
+    1: required string node_id
+    2: required string raft_addr
+    3: required string suffrage // Voter Nonvoter Staging
+    4: required i64 match_index
+    5: required i64 lag_entries
+    6: required i64 lag_time
+    7: required i64 last_contact
+}
+
+struct StatusResp {
+    1: required bool success
+    2: required string message
+    3: required string node_id
+    4: required string state // Leader Follower Candidate Shutdown
+    5: required string leader // 领导者的raft地址
+    6: required RaftTuning tuning
+    7: optional string raft_addr
+    8: optional i64 term
+    9: optional i64 last_index
+    10: optional i64 commit_index
+    11: optional i64 applied_index
+    12: optional i64 snapshot_index
+    13: optional i64 last_contact // 距上次与领导者通信的毫秒数 领导者为0 从未通信时为-1
+    14: optional i32 replicas // 复制组成员数
+    15: optional list<Progress> followers // 本节点是领导者时各跟随者的复制进度
+}
+
+// raft消息 命令和响应使用msgpack编码 通过副本服务转发时raft不需要单独的端口
+struct RaftReq {
+    1: required i32 region_id
+    2: required binary command
+}
+
+struct RaftResp {
+    1: required binary response
+    2: required string error // 目标节点处理失败时的错误信息
+}
+
+// 快照分块 同一次安装的分块使用相同的会话 按顺序发送
+struct RaftSnapshotReq {
+    1: required i32 region_id
+    2: required string session
+    3: required binary command // 只有第一个分块携带安装请求
+    4: required i64 offset
+    5: required binary data
+    6: required bool done // 最后一个分块返回安装结果
+}
+
 service ReplicaService {
     JoinResp Join(1: JoinReq req)
     LeaderAddrResp LeaderAddr(1: LeaderAddrReq req)
     GetIdResp GetId(1: GetIdReq req)
+    AddNonvoterResp AddNonvoter(1: AddNonvoterReq req)
+    RemoveServerResp RemoveServer(1: RemoveServerReq req)
+    DemoteVoterResp DemoteVoter(1: DemoteVoterReq req)
+    TransferLeadershipResp TransferLeadership(1: TransferLeadershipReq req)
+    MembersResp Members(1: MembersReq req)
+    StatusResp Status(1: StatusReq req)
+    RaftResp RaftAppendEntries(1: RaftReq req)
+    RaftResp RaftRequestVote(1: RaftReq req)
+    RaftResp RaftTimeoutNow(1: RaftReq req)
+    RaftResp RaftInstallSnapshot(1: RaftSnapshotReq req)
 }
\ No newline at end of file
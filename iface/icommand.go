@@ -0,0 +1,247 @@
+package iface
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"time"
+)
+
+/// 复制状态机指令的二进制编码
+/// 编码以魔数和版本号开头 旧版本的JSON编码以'{'开头 解码时按首字节区分
+/// 指令: 标志位 | 指令 | 数据库 | 参数列表 | [过期时间] | [请求ID] | [批量指令]
+/// 整数使用varint编码 字节串以长度开头 过期时间保存为绝对时间 应用日志时按绝对时间设置 不依赖各副本的时钟
+
+const (
+	commandMagic   byte = 0xCB
+	commandVersion byte = 1
+)
+
+const (
+	commandFlagTTL byte = 1 << iota
+	commandFlagRequestID
+	commandFlagBatch
+)
+
+// Command 复制状态机指令
+type Command struct {
+	Ins   INS    `json:"op,omitempty"`    // 指令
+	Key   string `json:"key,omitempty"`   // 键
+	Field string `json:"field,omitempty"` // 字段
+	Value []byte `json:"value,omitempty"` // 值
+	DB    string `json:"db,omitempty"`    // 逻辑数据库 为空时使用默认数据库
+
+	Params    [][]byte  `json:"-"` // 完整的参数列表 不为空时代替Key Field Value
+	TTL       int64     `json:"-"` // 执行成功后为key设置的过期时间 单位秒 为0时不设置 编码时换算为绝对时间
+	ExpireAt  int64     `json:"-"` // 过期的绝对时间 单位秒 为0时不设置 解码得到的指令只设置该字段
+	RequestID string    `json:"-"` // 请求ID 相同ID的指令只执行一次
+	Batch     []Command `json:"-"` // 批量指令 不为空时按顺序执行其中的指令
+}
+
+// Args 返回指令的参数 key不包含逻辑数据库前缀 由执行方选择数据库
+func (c Command) Args() [][]byte {
+	if c.Params != nil {
+		return c.Params
+	}
+
+	key := utils.S2B(c.Key)
+	switch c.Ins {
+	case FLUSH_DB, DB_SIZE:
+		return nil
+	case DEL, LEFT_POP_LIST, RIGHT_POP_LIST, DROP_HASH_INDEX:
+		return [][]byte{key}
+	case SET_HASH:
+		return [][]byte{key, utils.S2B(c.Field), c.Value}
+	case DEL_HASH, CREATE_HASH_INDEX:
+		return [][]byte{key, utils.S2B(c.Field)}
+	}
+	return [][]byte{key, c.Value}
+}
+
+// Applier 通过复制状态机执行写指令 集群模式下HTTP等接口的写请求通过它提交
+type Applier interface {
+	// Apply 当前节点不是领导者时转发给领导者 无法转发时返回领导者的地址
+	Apply(ctx context.Context, c Command) (res Result, leader string, err error)
+}
+
+// Encode 将指令编码
+func (c Command) Encode() ([]byte, error) {
+	buf := []byte{commandMagic, commandVersion}
+	return c.appendTo(buf, time.Now().Unix()), nil
+}
+
+func (c Command) appendTo(buf []byte, now int64) []byte {
+	var flags byte
+	if c.TTL != 0 || c.ExpireAt != 0 {
+		flags |= commandFlagTTL
+	}
+	if c.RequestID != "" {
+		flags |= commandFlagRequestID
+	}
+	if len(c.Batch) > 0 {
+		flags |= commandFlagBatch
+	}
+
+	buf = append(buf, flags)
+	buf = appendUvarint(buf, uint64(c.Ins))
+	buf = appendBytes(buf, utils.S2B(c.DB))
+
+	args := c.Args()
+	buf = appendUvarint(buf, uint64(len(args)))
+	for _, arg := range args {
+		buf = appendBytes(buf, arg)
+	}
+
+	if flags&commandFlagTTL != 0 {
+		expireAt := c.ExpireAt
+		if expireAt == 0 {
+			expireAt = now + c.TTL
+		}
+		buf = appendVarint(buf, expireAt)
+	}
+	if flags&commandFlagRequestID != 0 {
+		buf = appendBytes(buf, utils.S2B(c.RequestID))
+	}
+	if flags&commandFlagBatch != 0 {
+		buf = appendUvarint(buf, uint64(len(c.Batch)))
+		for _, sub := range c.Batch {
+			buf = sub.appendTo(buf, now)
+		}
+	}
+	return buf
+}
+
+// DecodeCommand 解码指令 兼容JSON编码的旧指令
+func DecodeCommand(b []byte) (Command, error) {
+	var c Command
+	if len(b) > 0 && b[0] == '{' {
+		err := json.Unmarshal(b, &c)
+		return c, err
+	}
+
+	if len(b) < 2 || b[0] != commandMagic {
+		return c, errno.ErrInvalidCommand
+	}
+	if b[1] != commandVersion {
+		return c, errno.ErrCommandVersion
+	}
+
+	d := commandDecoder{buf: b[2:]}
+	c = d.command()
+	if d.err == nil && len(d.buf) > 0 {
+		d.err = errno.ErrInvalidCommand
+	}
+	return c, d.err
+}
+
+type commandDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *commandDecoder) command() Command {
+	var c Command
+	flags := d.byte()
+	c.Ins = INS(d.uvarint())
+	c.DB = string(d.bytes())
+
+	n := d.uvarint()
+	if n > uint64(len(d.buf)) {
+		d.fail()
+		return c
+	}
+	c.Params = make([][]byte, n)
+	for i := range c.Params {
+		c.Params[i] = d.bytes()
+	}
+	if len(c.Params) > 0 {
+		c.Key = string(c.Params[0])
+	}
+
+	if flags&commandFlagTTL != 0 {
+		c.ExpireAt = d.varint()
+	}
+	if flags&commandFlagRequestID != 0 {
+		c.RequestID = string(d.bytes())
+	}
+	if flags&commandFlagBatch != 0 {
+		n = d.uvarint()
+		if n > uint64(len(d.buf)) {
+			d.fail()
+			return c
+		}
+		c.Batch = make([]Command, n)
+		for i := range c.Batch {
+			c.Batch[i] = d.command()
+		}
+	}
+	return c
+}
+
+func (d *commandDecoder) fail() {
+	if d.err == nil {
+		d.err = errno.ErrInvalidCommand
+	}
+	d.buf = nil
+}
+
+func (d *commandDecoder) byte() byte {
+	if len(d.buf) < 1 {
+		d.fail()
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *commandDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *commandDecoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *commandDecoder) bytes() []byte {
+	n := d.uvarint()
+	if n > uint64(len(d.buf)) {
+		d.fail()
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, d.buf)
+	d.buf = d.buf[n:]
+	return b
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendBytes(buf []byte, b []byte) []byte {
+	buf = appendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
@@ -0,0 +1,70 @@
+package iface
+
+import (
+	"encoding/json"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCommand_Encode(t *testing.T) {
+	c := Command{
+		Ins:       SET_HASH,
+		Key:       "hash",
+		Field:     "field",
+		Value:     []byte("value"),
+		DB:        "1",
+		TTL:       60,
+		RequestID: "req-1",
+		Batch: []Command{
+			{Ins: SET_STR, Params: [][]byte{[]byte("a"), []byte("1")}},
+			{Ins: DEL, Key: "b"},
+		},
+	}
+	b, err := c.Encode()
+	assert.Nil(t, err)
+
+	got, err := DecodeCommand(b)
+	assert.Nil(t, err)
+	assert.Equal(t, SET_HASH, got.Ins)
+	assert.Equal(t, "hash", got.Key)
+	assert.Equal(t, "1", got.DB)
+	assert.Equal(t, [][]byte{[]byte("hash"), []byte("field"), []byte("value")}, got.Args())
+	assert.Equal(t, int64(0), got.TTL)
+	assert.InDelta(t, time.Now().Unix()+60, got.ExpireAt, 1)
+	assert.Equal(t, "req-1", got.RequestID)
+	assert.Len(t, got.Batch, 2)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("1")}, got.Batch[0].Args())
+	assert.Equal(t, [][]byte{[]byte("b")}, got.Batch[1].Args())
+	assert.Equal(t, int64(0), got.Batch[1].ExpireAt)
+
+	// 解码后再编码 过期的绝对时间保持不变
+	again, err := got.Encode()
+	assert.Nil(t, err)
+	got2, err := DecodeCommand(again)
+	assert.Nil(t, err)
+	assert.Equal(t, got.ExpireAt, got2.ExpireAt)
+
+	// 截断或损坏的编码
+	for i := 0; i < len(b); i++ {
+		_, err = DecodeCommand(b[:i])
+		assert.NotNil(t, err)
+	}
+	_, err = DecodeCommand(append(b, 0))
+	assert.Equal(t, errno.ErrInvalidCommand, err)
+	_, err = DecodeCommand([]byte{commandMagic, commandVersion + 1})
+	assert.Equal(t, errno.ErrCommandVersion, err)
+}
+
+func TestCommand_DecodeJSON(t *testing.T) {
+	// 旧版本日志中的指令
+	b, err := json.Marshal(Command{Ins: SET_STR, Key: "key", Value: []byte("value"), DB: "2"})
+	assert.Nil(t, err)
+
+	c, err := DecodeCommand(b)
+	assert.Nil(t, err)
+	assert.Equal(t, SET_STR, c.Ins)
+	assert.Equal(t, "2", c.DB)
+	assert.Equal(t, [][]byte{[]byte("key"), []byte("value")}, c.Args())
+}
@@ -0,0 +1,48 @@
+package iface
+
+import (
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"strings"
+	"time"
+)
+
+/// 读一致性 副本节点按请求指定的级别决定能否读取本地数据
+
+// ConsistencyLevel 读一致性级别
+type ConsistencyLevel int
+
+const (
+	ReadDefault    ConsistencyLevel = iota // 领导者租约读 只在领导者上读取
+	ReadStale                              // 跟随者读 数据落后领导者不超过给定时间
+	ReadConsistent                         // 线性一致读 通过ReadIndex确认领导者身份后读取
+)
+
+var consistencyNames = map[string]ConsistencyLevel{
+	"":           ReadDefault,
+	"default":    ReadDefault,
+	"lease":      ReadDefault,
+	"stale":      ReadStale,
+	"consistent": ReadConsistent,
+}
+
+// ParseConsistency 解析一致性级别名称 为空时使用默认级别
+func ParseConsistency(name string) (ConsistencyLevel, error) {
+	level, ok := consistencyNames[strings.ToLower(name)]
+	if !ok {
+		return ReadDefault, errno.ErrInvalidConsistency
+	}
+	return level, nil
+}
+
+// ReadBarrier 读屏障 返回nil后可以按请求的一致性级别读取本地存储引擎
+type ReadBarrier interface {
+	// ReadBarrier maxStaleness只用于跟随者读 为0时不限制落后的时间
+	ReadBarrier(level ConsistencyLevel, maxStaleness time.Duration) error
+}
+
+// KeyReadBarrier 按key所在的分区等待读屏障 不需要等待其他分区
+type KeyReadBarrier interface {
+	ReadBarrier
+	// KeyReadBarrier key为存储引擎中实际存储的key
+	KeyReadBarrier(key []byte, level ConsistencyLevel, maxStaleness time.Duration) error
+}
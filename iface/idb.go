@@ -0,0 +1,71 @@
+package iface
+
+import (
+	"strconv"
+	"strings"
+)
+
+/// 逻辑数据库 同一个存储引擎中通过key前缀隔离的键空间
+
+// DefaultDB 默认数据库 其中的key不加前缀 兼容已有数据
+const DefaultDB = "0"
+
+// 逻辑数据库key前缀的标记 用户写入的key不应以此开头
+const dbKeyMark = "\x00db:"
+
+// 以此开头的key由存储引擎内部使用 不属于任何逻辑数据库
+const internalKeyMark = "\x00"
+
+// NormalizeDB 规范化数据库名称 编号和名称都可以作为数据库名称
+func NormalizeDB(db string) string {
+	if n, err := strconv.Atoi(db); err == nil && n >= 0 {
+		db = strconv.Itoa(n)
+	}
+	if db == "" {
+		return DefaultDB
+	}
+	return db
+}
+
+// DBPrefix 返回逻辑数据库的key前缀 默认数据库没有前缀
+func DBPrefix(db string) []byte {
+	db = NormalizeDB(db)
+	if db == DefaultDB {
+		return nil
+	}
+	return []byte(dbKeyMark + db + "\x00")
+}
+
+// DBKey 返回key在存储引擎中实际存储的key
+func DBKey(db, key string) string {
+	return string(DBPrefix(db)) + key
+}
+
+// InDB 判断存储引擎中的key是否属于指定的逻辑数据库
+func InDB(prefix, key []byte) bool {
+	if len(prefix) == 0 {
+		return len(key) < len(internalKeyMark) || string(key[:len(internalKeyMark)]) != internalKeyMark
+	}
+	return len(key) >= len(prefix) && string(key[:len(prefix)]) == string(prefix)
+}
+
+// SplitDB 将存储引擎中的key拆分为逻辑数据库名称和用户写入的key 存储引擎内部使用的key返回false
+func SplitDB(key []byte) (string, []byte, bool) {
+	if !strings.HasPrefix(string(key), internalKeyMark) {
+		return DefaultDB, key, true
+	}
+	if !strings.HasPrefix(string(key), dbKeyMark) {
+		return "", nil, false
+	}
+	n := strings.IndexByte(string(key[len(dbKeyMark):]), 0)
+	if n < 0 {
+		return "", nil, false
+	}
+	return string(key[len(dbKeyMark) : len(dbKeyMark)+n]), key[len(dbKeyMark)+n+1:], true
+}
+
+// UserKey 去掉逻辑数据库前缀 返回用户写入的key 存储引擎内部使用的key返回false
+func UserKey(key []byte) ([]byte, bool) {
+	_, userKey, ok := SplitDB(key)
+	return userKey, ok
+}
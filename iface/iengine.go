@@ -1,9 +1,8 @@
 package iface
 
 import (
-	"encoding/json"
 	"github.com/T4t4KAU/TikBase/engine/data"
-	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"io"
 )
 
 type INS int
@@ -28,6 +27,13 @@ const (
 
 	EXPIRE
 	KEYS
+	FLUSH_DB // 清空逻辑数据库
+	DB_SIZE  // 逻辑数据库key数量
+	REM_ZSET // 删除有序集合成员
+
+	CREATE_HASH_INDEX // 创建哈希字段二级索引 Key为索引名称 Field为字段
+	DROP_HASH_INDEX   // 删除哈希字段二级索引
+	EXPIRE_AT         // 设置过期的绝对时间 单位秒
 	NIL
 )
 
@@ -43,6 +49,16 @@ var binaryIns = map[INS]struct{}{
 	DEL:     {},
 }
 
+// 只读取数据的指令
+var readOnlyIns = map[INS]struct{}{
+	ECHO:          {},
+	GET_STR:       {},
+	GET_HASH:      {},
+	IS_MEMBER_SET: {},
+	KEYS:          {},
+	DB_SIZE:       {},
+}
+
 func (ins INS) BIN() bool {
 	_, ok := binaryIns[ins]
 	return ok
@@ -53,6 +69,12 @@ func (ins INS) TER() bool {
 	return ok
 }
 
+// ReadOnly 指令是否只读取数据
+func (ins INS) ReadOnly() bool {
+	_, ok := readOnlyIns[ins]
+	return ok
+}
+
 func (ins INS) String() string {
 	if s, ok := insMap[ins]; ok {
 		return s
@@ -62,8 +84,14 @@ func (ins INS) String() string {
 
 type Engine interface {
 	Exec(ins INS, args [][]byte) Result // 执行指令
-	Snapshot() ([]byte, error)          // 生成快照
-	RecoverFromBytes(data []byte) error // 恢复数据
+	Snapshot() (Snapshot, error)        // 生成快照
+	Restore(r io.Reader) error          // 从快照恢复数据 替换当前全部数据
+}
+
+// Snapshot 存储引擎在某一时刻的快照 写出时不阻塞之后的写入
+type Snapshot interface {
+	WriteTo(w io.Writer) (int64, error) // 将快照写入w
+	Release()                           // 释放快照占用的资源
 }
 
 type KVStore interface {
@@ -124,6 +152,9 @@ var insMap = map[INS]string{
 	GET_STR: "GET",
 	DEL:     "DEL",
 	EXPIRE:  "EXPIRE",
+
+	FLUSH_DB: "FLUSHDB",
+	DB_SIZE:  "DBSIZE",
 }
 
 type IWriteBatch interface {
@@ -142,20 +173,3 @@ type Filter interface {
 	Reset()
 	KeyLen() int
 }
-
-// Command 复制状态机指令
-type Command struct {
-	Ins   INS    `json:"op,omitempty"`    // 指令
-	Key   string `json:"key,omitempty"`   // 键
-	Field string `json:"field,omitempty"` // 字段
-	Value []byte `json:"value,omitempty"` // 值
-}
-
-// Encode 将指令编码
-func (c Command) Encode() ([]byte, error) {
-	return json.Marshal(c)
-}
-
-func (c Command) Args() [][]byte {
-	return utils.KeyValueBytes(c.Key, c.Value)
-}
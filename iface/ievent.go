@@ -0,0 +1,35 @@
+package iface
+
+// EventType 键空间事件类型
+type EventType string
+
+const (
+	EventSet     EventType = "set"
+	EventDel     EventType = "del"
+	EventExpire  EventType = "expire"
+	EventExpired EventType = "expired"
+	EventEvicted EventType = "evicted"
+	EventHSet    EventType = "hset"
+	EventHDel    EventType = "hdel"
+	EventLPush   EventType = "lpush"
+	EventRPush   EventType = "rpush"
+	EventLPop    EventType = "lpop"
+	EventRPop    EventType = "rpop"
+	EventSAdd    EventType = "sadd"
+	EventSRem    EventType = "srem"
+	EventZAdd    EventType = "zadd"
+	EventZRem    EventType = "zrem"
+)
+
+// Event 键空间事件 描述某个key上发生的变更
+type Event struct {
+	Type EventType `json:"type"`
+	Key  string    `json:"key"`
+	DB   string    `json:"db,omitempty"` // key所在的逻辑数据库 发布时填写
+	Time int64     `json:"time"`         // 事件发生时间 纳秒
+}
+
+// Notifier 事件通知器 存储引擎通过它向外发布键空间事件
+type Notifier interface {
+	Notify(event Event)
+}
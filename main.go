@@ -11,6 +11,7 @@ var (
 	ServerConfFile    = "./conf/server-config.yaml"
 	BaseConfigFile    = "./conf/base-config.yaml"
 	CacheConfigFile   = "./conf/cache-config.yaml"
+	TieredConfigFile  = "./conf/tiered-config.yaml"
 	ReplicaConfigFile = "./conf/replica-config-1.yaml"
 	SliceConfigFile   = "./conf/slice-config.yaml"
 )
@@ -30,6 +31,8 @@ func start() {
 		storeConf, err = config.ReadBaseConfigFile(BaseConfigFile)
 	case "cache":
 		storeConf, err = config.ReadCacheConfigFile(CacheConfigFile)
+	case "tiered":
+		storeConf, err = config.ReadTieredConfigFile(TieredConfigFile)
 	default:
 		panic("unknown engine name")
 	}
@@ -3,14 +3,28 @@ package config
 import (
 	"fmt"
 	"github.com/T4t4KAU/TikBase/engine/bases"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/spf13/viper"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 type StoreConfig interface{}
 
+// NamespaceConfig 命名空间配额配置 值为0表示不限制
+type NamespaceConfig struct {
+	Prefix       string `mapstructure:"prefix"`         // key前缀
+	MaxBytes     int64  `mapstructure:"max_bytes"`      // 占用空间上限
+	MaxKeys      int64  `mapstructure:"max_keys"`       // key数量上限
+	MaxValueSize int64  `mapstructure:"max_value_size"` // 单个value大小上限
+}
+
 type BaseStoreConfig struct {
 	Directory    string `mapstructure:"directory"`
 	Indexer      string `mapstructure:"indexer"`
+	IndexShards  int    `mapstructure:"index_shards"` // 索引分片数
 	DatafileSize int    `mapstructure:"datafile_size"`
 	BytesPerSync int    `mapstructure:"bytes_per_sync"`
 	SyncWrites   bool   `mapstructure:"sync_writes"`
@@ -23,18 +37,38 @@ type BaseStoreConfig struct {
 		Prefix  string `mapstructure:"prefix"`
 		Reverse bool   `mapstructure:"reverse"`
 	} `mapstructure:"iterator"`
-	MmapAtStartup bool `mapstructure:"mmap_at_startup"`
+	MmapAtStartup      bool              `mapstructure:"mmap_at_startup"`
+	IOType             string            `mapstructure:"io_type"`              // 数据文件IO类型
+	ChangeCapture      bool              `mapstructure:"change_capture"`       // 开启变更数据捕获
+	ChangeRetention    int               `mapstructure:"change_retention"`     // 消费者位置保留时间 秒
+	Namespaces         []NamespaceConfig `mapstructure:"namespaces"`           // 命名空间配额
+	Checkpoint         int               `mapstructure:"checkpoint"`           // 索引检查点间隔 秒
+	LoadWorkers        int               `mapstructure:"load_workers"`         // 启动时并发加载数据文件的数量
+	BlockCacheSize     int               `mapstructure:"block_cache_size"`     // 日志记录缓存字节数
+	BloomFalsePositive float64           `mapstructure:"bloom_false_positive"` // 数据文件布隆过滤器误判率
 }
 
 type CacheStoreConfig struct {
-	MaxEntrySize     uint   `mapstructure:"max_entry_size"`
-	MaxGcCount       uint   `mapstructure:"max_gc_count"`
-	GcDuration       uint   `mapstructure:"gc_duration"`
-	DumpFile         string `mapstructure:"dump_file"`
-	DumpDuration     uint   `mapstructure:"dump_duration"`
-	MapSizeOfSegment uint   `mapstructure:"map_size_of_segment"`
-	SegmentSize      uint   `mapstructure:"segment_size"`
-	CasSleepTime     uint   `mapstructure:"cas_sleep_time"`
+	MaxEntrySize     uint              `mapstructure:"max_entry_size"`
+	MaxGcCount       uint              `mapstructure:"max_gc_count"`
+	GcDuration       uint              `mapstructure:"gc_duration"`
+	DumpFile         string            `mapstructure:"dump_file"`
+	DumpDuration     uint              `mapstructure:"dump_duration"`
+	MapSizeOfSegment uint              `mapstructure:"map_size_of_segment"`
+	SegmentSize      uint              `mapstructure:"segment_size"`
+	CasSleepTime     uint              `mapstructure:"cas_sleep_time"`
+	Namespaces       []NamespaceConfig `mapstructure:"namespaces"`      // 命名空间配额
+	EvictWhenFull    bool              `mapstructure:"evict_when_full"` // 写满时淘汰数据 而不是拒绝写入
+}
+
+// TieredStoreConfig 分层存储配置 缓存层在前 磁盘层在后
+type TieredStoreConfig struct {
+	Cache         CacheStoreConfig `mapstructure:"cache"`
+	Base          BaseStoreConfig  `mapstructure:"base"`
+	WriteMode     string           `mapstructure:"write_mode"`     // 写入策略 through 或 behind
+	FlushInterval int              `mapstructure:"flush_interval"` // 写回刷盘间隔 毫秒
+	MaxPending    int              `mapstructure:"max_pending"`    // 待刷盘数据上限
+	HotTTL        int              `mapstructure:"hot_ttl"`        // 数据在缓存层的存活时间 秒
 }
 
 type ServerConfig struct {
@@ -46,6 +80,11 @@ type ServerConfig struct {
 	VirtualNodeCount int    `mapstructure:"node_count"`
 	Address          string `mapstructure:"bind_addr"`
 	JoinAddr         string `mapstructure:"join_addr"`
+	PubSubPort       int    `mapstructure:"pubsub_port"`        // 发布订阅服务端口
+	PubSubStreamPort int    `mapstructure:"pubsub_stream_port"` // 流式订阅服务端口 为0时不启动
+	WebPort          int    `mapstructure:"web_port"`           // HTTP服务端口 为0时不启动
+	CDCPort          int    `mapstructure:"cdc_port"`           // 变更数据捕获服务端口 为0时不启动
+	MetaPort         int    `mapstructure:"meta_port"`          // 元数据服务端口 为0时不启动
 }
 
 func ReadServerConfigFile(filePath string) (ServerConfig, error) {
@@ -95,6 +134,22 @@ func ReadCacheConfigFile(filepath string) (StoreConfig, error) {
 	return config, nil
 }
 
+func ReadTieredConfigFile(filepath string) (StoreConfig, error) {
+	viper.SetConfigFile(filepath)
+	viper.SetConfigType("yaml")
+	err := viper.ReadInConfig()
+	if err != nil {
+		return TieredStoreConfig{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var config TieredStoreConfig
+	err = viper.Unmarshal(&config)
+	if err != nil {
+		return TieredStoreConfig{}, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	return config, nil
+}
+
 func BaseEngineConfig(config BaseStoreConfig) (bases.Options, bases.WriteBatchOptions, bases.IteratorOptions) {
 	var idx bases.IndexerType
 	switch config.Indexer {
@@ -153,6 +208,134 @@ type ReplicaConfig struct {
 	SnapshotCount int    `mapstructure:"snapshot_count"`
 	Timeout       int    `mapstructure:"timeout"`
 	JoinAddr      string `mapstructure:"join_addr"`
+	Regions       int    `mapstructure:"regions"`   // 分区数量 每个分区由独立的raft复制组复制
+	LogStore      string `mapstructure:"log_store"` // raft日志存储 bolt 或 bases
+
+	// raft传输层 tcp 监听raft_addr kitex 通过副本服务转发 每个节点只监听副本服务端口
+	Transport string    `mapstructure:"transport"`
+	TLS       TLSConfig `mapstructure:"tls"` // 副本服务的双向TLS 未配置时不加密
+
+	// raft参数 为0时使用默认值 时间的单位为毫秒
+	SnapshotInterval  int `mapstructure:"snapshot_interval"`  // 检查是否需要生成快照的间隔
+	SnapshotThreshold int `mapstructure:"snapshot_threshold"` // 距上次快照的日志数量达到该值时生成快照
+	TrailingLogs      int `mapstructure:"trailing_logs"`      // 压缩日志后保留的日志数量
+	HeartbeatTimeout  int `mapstructure:"heartbeat_timeout"`
+	ElectionTimeout   int `mapstructure:"election_timeout"`
+	MaxAppendEntries  int `mapstructure:"max_append_entries"` // 单次追加日志请求中的最大日志数量
+}
+
+// TLSConfig 双向TLS配置 证书和CA均为PEM文件 三者同时配置时启用
+type TLSConfig struct {
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+	CAFile   string `mapstructure:"ca_file"`
+}
+
+// Enabled 是否启用TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// Validate 检查副本配置 raft参数的合法性在创建节点时由raft检查
+func (c *ReplicaConfig) Validate() error {
+	if c.Regions < 0 || c.SnapshotCount < 0 || c.SnapshotInterval < 0 || c.SnapshotThreshold < 0 ||
+		c.TrailingLogs < 0 || c.HeartbeatTimeout < 0 || c.ElectionTimeout < 0 || c.MaxAppendEntries < 0 {
+		return errno.ErrInvalidRaftConfig
+	}
+	switch strings.ToUpper(c.Transport) {
+	case "", "TCP", "KITEX":
+	default:
+		return errno.ErrUnknownTransport
+	}
+	if c.TLS.Enabled() && (c.TLS.CertFile == "" || c.TLS.KeyFile == "" || c.TLS.CAFile == "") {
+		return errno.ErrInvalidTLSConfig
+	}
+	// TLS只保护副本服务 监听raft_addr时raft消息不经过副本服务 仍以明文传输
+	if c.TLS.Enabled() && !c.KitexTransport() {
+		return errno.ErrTLSRequiresKitex
+	}
+
+	// 监听raft_addr时各分区的端口从raft_addr开始递增 不能与副本服务端口重叠
+	if !c.KitexTransport() && c.RaftAddr != "" {
+		used := map[string]bool{c.ServiceAddr: true}
+		for i := 0; i < c.RegionCount(); i++ {
+			addr, err := RegionAddr(c.RaftAddr, i)
+			if err != nil {
+				return err
+			}
+			if used[addr] {
+				return errno.ErrRegionAddrConflict
+			}
+			used[addr] = true
+		}
+	}
+	return nil
+}
+
+// KitexTransport raft消息是否通过副本服务转发
+func (c *ReplicaConfig) KitexTransport() bool {
+	return strings.ToUpper(c.Transport) == "KITEX"
+}
+
+// RegionRaftAddr 返回分区的raft地址 通过副本服务转发时所有分区共用副本服务地址
+func (c *ReplicaConfig) RegionRaftAddr(id int) (string, error) {
+	if c.KitexTransport() {
+		return c.ServiceAddr, nil
+	}
+	return RegionAddr(c.RaftAddr, id)
+}
+
+// RegionCount 返回分区数量 未配置时为1
+func (c *ReplicaConfig) RegionCount() int {
+	if c.Regions <= 0 {
+		return 1
+	}
+	return c.Regions
+}
+
+// RegionDir 返回分区的目录 分区0使用原目录 与单分区时的数据兼容
+// 其他分区使用与原目录同级的目录 不能放在原目录下 否则统计分区0的磁盘占用时会包含其他分区
+func RegionDir(dir string, id int) string {
+	if id == 0 || dir == "" {
+		return dir
+	}
+	return filepath.Clean(dir) + "-region-" + strconv.Itoa(id)
+}
+
+// RegionAddr 返回分区的raft地址 端口按分区编号递增
+func RegionAddr(addr string, id int) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, strconv.Itoa(p+id)), nil
+}
+
+// RegionStoreConfig 返回分区的存储配置 每个分区使用独立的数据目录
+func RegionStoreConfig(store StoreConfig, id int) StoreConfig {
+	switch cfg := store.(type) {
+	case BaseStoreConfig:
+		cfg.Directory = RegionDir(cfg.Directory, id)
+		return cfg
+	case CacheStoreConfig:
+		return regionCacheConfig(cfg, id)
+	case TieredStoreConfig:
+		cfg.Base.Directory = RegionDir(cfg.Base.Directory, id)
+		cfg.Cache = regionCacheConfig(cfg.Cache, id)
+		return cfg
+	}
+	return store
+}
+
+func regionCacheConfig(cfg CacheStoreConfig, id int) CacheStoreConfig {
+	if id > 0 && cfg.DumpFile != "" {
+		cfg.DumpFile += "." + strconv.Itoa(id)
+	}
+	return cfg
 }
 
 func ReadReplicaConfigFile(filePath string) (ReplicaConfig, error) {
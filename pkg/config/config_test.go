@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"testing"
 )
 
@@ -31,3 +32,29 @@ func TestReadRegionConfigFile(t *testing.T) {
 	}
 	fmt.Printf("%#v\n", c)
 }
+
+func TestReplicaConfig_Validate(t *testing.T) {
+	c := ReplicaConfig{ServiceAddr: "127.0.0.1:10041", RaftAddr: "127.0.0.1:10040", Regions: 2}
+	if err := c.Validate(); err != errno.ErrRegionAddrConflict {
+		t.Errorf("expected address conflict, got %v", err)
+	}
+	c.Transport = "kitex"
+	if err := c.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	// 监听raft_addr时raft消息不经过副本服务 不能启用TLS
+	c.TLS = TLSConfig{CertFile: "node.pem", KeyFile: "node.key", CAFile: "ca.pem"}
+	if err := c.Validate(); err != nil {
+		t.Error(err)
+	}
+	c.Transport = "tcp"
+	if err := c.Validate(); err != errno.ErrTLSRequiresKitex {
+		t.Errorf("expected tls to require kitex, got %v", err)
+	}
+
+	// 其他分区的目录与分区0的目录同级
+	if dir := RegionDir("./temp/", 1); dir != "temp-region-1" {
+		t.Errorf("unexpected region dir %s", dir)
+	}
+}
@@ -1,11 +1,14 @@
 package consts
 
 const (
-	MetaServiceName    = "MetaService"
-	ReplicaServiceName = "ReplicaService"
-	DataServiceName    = "DataService"
-	WebServiceName     = "WebService"
-	SliceServiceName   = "SliceService"
+	MetaServiceName         = "MetaService"
+	ReplicaServiceName      = "ReplicaService"
+	DataServiceName         = "DataService"
+	WebServiceName          = "WebService"
+	SliceServiceName        = "SliceService"
+	PubSubServiceName       = "PubSubService"
+	PubSubStreamServiceName = "PubSubStream"
+	CDCServiceName          = "CDCService"
 )
 
 const (
@@ -1,16 +1,24 @@
 package bptree
 
 import (
+	"bytes"
+	"encoding/binary"
 	"github.com/T4t4KAU/TikBase/engine/data"
 	"github.com/T4t4KAU/TikBase/iface"
 	"go.etcd.io/bbolt"
 	"path/filepath"
 )
 
-const indexFileName = "bptree-index"
+// IndexFileName B+树索引文件名
+const IndexFileName = "bptree-index"
 
-var indexBucketName = []byte("bitcask-index")
+var (
+	indexBucketName = []byte("bitcask-index")
+	metaBucketName  = []byte("bitcask-meta") // 索引元数据
+	keyCountKey     = []byte("bptree.keys")  // 索引中的key数量 与索引在同一事务中更新
+)
 
+// BPTree 基于bbolt的B+树索引 索引数据保存在磁盘上
 type BPTree struct {
 	tree *bbolt.DB
 }
@@ -18,14 +26,24 @@ type BPTree struct {
 func New(dirPath string, sync bool) *BPTree {
 	opts := bbolt.DefaultOptions
 	opts.NoSync = !sync
-	tree, err := bbolt.Open(filepath.Join(dirPath, indexFileName), 0644, opts)
+	tree, err := bbolt.Open(filepath.Join(dirPath, IndexFileName), 0644, opts)
 	if err != nil {
 		panic("failed to open bptree at startup")
 	}
 
 	if err := tree.Update(func(tx *bbolt.Tx) error {
-		_, e := tx.CreateBucketIfNotExists(indexBucketName)
-		return e
+		if _, e := tx.CreateBucketIfNotExists(indexBucketName); e != nil {
+			return e
+		}
+		meta, e := tx.CreateBucketIfNotExists(metaBucketName)
+		if e != nil {
+			return e
+		}
+		// 旧版本的索引文件没有记录key数量 启动时统计一次
+		if meta.Get(keyCountKey) == nil {
+			return putKeyCount(tx, tx.Bucket(indexBucketName).Stats().KeyN)
+		}
+		return nil
 	}); err != nil {
 		panic("failed to create bptree bucket at startup")
 	}
@@ -33,15 +51,22 @@ func New(dirPath string, sync bool) *BPTree {
 	return &BPTree{tree: tree}
 }
 
-func (tree *BPTree) Put(key []byte, pos *data.LogRecordPos) bool {
+// Put 写入索引 返回旧的位置信息
+func (tree *BPTree) Put(key []byte, pos *data.LogRecordPos) *data.LogRecordPos {
+	var old *data.LogRecordPos
 	if err := tree.tree.Update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket(indexBucketName)
+		if value := bucket.Get(key); len(value) != 0 {
+			old = data.DecodeLogRecordPos(value)
+		} else if err := putKeyCount(tx, keyCount(tx)+1); err != nil {
+			return err
+		}
 		return bucket.Put(key, data.EncodeLogRecordPos(pos))
 	}); err != nil {
 		panic("failed to put index in bptree")
 	}
 
-	return true
+	return old
 }
 
 func (tree *BPTree) Get(key []byte) *data.LogRecordPos {
@@ -60,21 +85,30 @@ func (tree *BPTree) Get(key []byte) *data.LogRecordPos {
 	return pos
 }
 
-func (tree *BPTree) Delete(key []byte) bool {
+// Delete 删除索引 返回旧的位置信息
+func (tree *BPTree) Delete(key []byte) (*data.LogRecordPos, bool) {
+	var old *data.LogRecordPos
 	if err := tree.tree.Update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket(indexBucketName)
-		return bucket.Delete(key)
+		if value := bucket.Get(key); len(value) != 0 {
+			old = data.DecodeLogRecordPos(value)
+			if err := putKeyCount(tx, keyCount(tx)-1); err != nil {
+				return err
+			}
+			return bucket.Delete(key)
+		}
+		return nil
 	}); err != nil {
 		panic("failed to delete index in bptree")
 	}
-	return true
+	return old, old != nil
 }
 
+// Size 返回元数据中记录的key数量 不遍历索引
 func (tree *BPTree) Size() int {
 	var size int
 	if err := tree.tree.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket(indexBucketName)
-		size = bucket.Stats().KeyN
+		size = keyCount(tx)
 		return nil
 	}); err != nil {
 		panic("failed to size in bptree")
@@ -82,10 +116,79 @@ func (tree *BPTree) Size() int {
 	return size
 }
 
+// Iterator 索引迭代器 迭代期间持有只读事务 使用后需要关闭
 func (tree *BPTree) Iterator(reverse bool) iface.Iterator {
 	return newIterator(tree.tree, reverse)
 }
 
+// Clear 清空索引和元数据
+func (tree *BPTree) Clear() error {
+	return tree.tree.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{indexBucketName, metaBucketName} {
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+		}
+		return putKeyCount(tx, 0)
+	})
+}
+
+// 读取事务中的key数量
+func keyCount(tx *bbolt.Tx) int {
+	value := tx.Bucket(metaBucketName).Get(keyCountKey)
+	if len(value) != 8 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint64(value))
+}
+
+// 在事务中更新key数量
+func putKeyCount(tx *bbolt.Tx, n int) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(n))
+	return tx.Bucket(metaBucketName).Put(keyCountKey, value)
+}
+
+// PutMeta 写入索引元数据
+func (tree *BPTree) PutMeta(key, value []byte) error {
+	return tree.tree.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(metaBucketName).Put(key, value)
+	})
+}
+
+// GetMeta 读取索引元数据 不存在时返回nil
+func (tree *BPTree) GetMeta(key []byte) []byte {
+	var value []byte
+	_ = tree.tree.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(metaBucketName).Get(key); v != nil {
+			value = make([]byte, len(v))
+			copy(value, v)
+		}
+		return nil
+	})
+	return value
+}
+
+// DeleteMeta 删除索引元数据
+func (tree *BPTree) DeleteMeta(key []byte) error {
+	return tree.tree.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(metaBucketName).Delete(key)
+	})
+}
+
+// Sync 将索引文件刷入磁盘
+func (tree *BPTree) Sync() error {
+	return tree.tree.Sync()
+}
+
+// Close 关闭索引文件
+func (tree *BPTree) Close() error {
+	return tree.tree.Close()
+}
+
 type Iterator struct {
 	tx        *bbolt.Tx
 	cursor    *bbolt.Cursor
@@ -104,6 +207,14 @@ func (it *Iterator) Rewind() {
 
 func (it *Iterator) Seek(key []byte) {
 	it.currKey, it.currValue = it.cursor.Seek(key)
+	// 反向迭代时定位到小于等于key的最大key
+	if it.reverse {
+		if it.currKey == nil {
+			it.currKey, it.currValue = it.cursor.Last()
+		} else if bytes.Compare(it.currKey, key) > 0 {
+			it.currKey, it.currValue = it.cursor.Prev()
+		}
+	}
 }
 
 func (it *Iterator) Next() {
@@ -118,8 +229,11 @@ func (it *Iterator) Valid() bool {
 	return len(it.currKey) != 0
 }
 
+// Key 返回key的副本 事务结束后key仍然有效
 func (it *Iterator) Key() []byte {
-	return it.currKey
+	key := make([]byte, len(it.currKey))
+	copy(key, it.currKey)
+	return key
 }
 
 func (it *Iterator) Value() *data.LogRecordPos {
@@ -127,7 +241,7 @@ func (it *Iterator) Value() *data.LogRecordPos {
 }
 
 func (it *Iterator) Close() {
-	_ = it.tx.Commit()
+	_ = it.tx.Rollback()
 }
 
 func newIterator(tree *bbolt.DB, reverse bool) *Iterator {
@@ -1,6 +1,8 @@
 package bptree
 
 import (
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/stretchr/testify/assert"
 	"go.etcd.io/bbolt"
 	"testing"
 )
@@ -25,3 +27,91 @@ func TestBPTree_Put(t *testing.T) {
 		return nil
 	})
 }
+
+func TestBPTree_Index(t *testing.T) {
+	dir := t.TempDir()
+	tree := New(dir, false)
+
+	assert.Nil(t, tree.Put([]byte("b"), &data.LogRecordPos{Fid: 1, Offset: 10, Size: 5}))
+	assert.Nil(t, tree.Put([]byte("a"), &data.LogRecordPos{Fid: 1, Offset: 20}))
+	old := tree.Put([]byte("b"), &data.LogRecordPos{Fid: 2, Offset: 30})
+	assert.Equal(t, &data.LogRecordPos{Fid: 1, Offset: 10, Size: 5}, old)
+	assert.Equal(t, 2, tree.Size())
+
+	old, ok := tree.Delete([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), old.Offset)
+	_, ok = tree.Delete([]byte("a"))
+	assert.False(t, ok)
+	assert.Nil(t, tree.Close())
+
+	// 重新打开后索引仍然存在
+	tree = New(dir, false)
+	defer tree.Close()
+	assert.Equal(t, int64(30), tree.Get([]byte("b")).Offset)
+	assert.Nil(t, tree.Get([]byte("a")))
+	assert.Equal(t, 1, tree.Size())
+
+	assert.Nil(t, tree.Clear())
+	assert.Equal(t, 0, tree.Size())
+}
+
+func TestBPTree_Meta(t *testing.T) {
+	tree := New(t.TempDir(), false)
+	defer tree.Close()
+
+	assert.Nil(t, tree.GetMeta([]byte("marker")))
+	assert.Nil(t, tree.PutMeta([]byte("marker"), []byte("v1")))
+	assert.Equal(t, []byte("v1"), tree.GetMeta([]byte("marker")))
+	assert.Nil(t, tree.DeleteMeta([]byte("marker")))
+	assert.Nil(t, tree.GetMeta([]byte("marker")))
+
+	assert.Nil(t, tree.PutMeta([]byte("marker"), []byte("v2")))
+	assert.Nil(t, tree.Clear())
+	assert.Nil(t, tree.GetMeta([]byte("marker")))
+}
+
+func TestBPTree_Iterator(t *testing.T) {
+	tree := New(t.TempDir(), false)
+	defer tree.Close()
+
+	for _, key := range []string{"c", "a", "e", "b", "d"} {
+		tree.Put([]byte(key), &data.LogRecordPos{})
+	}
+
+	keys := make([]string, 0)
+	it := tree.Iterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+
+	it = tree.Iterator(true)
+	it.Seek([]byte("cc"))
+	assert.Equal(t, "c", string(it.Key()))
+	it.Next()
+	assert.Equal(t, "b", string(it.Key()))
+	it.Close()
+
+	// 迭代器关闭后可以继续写入
+	tree.Put([]byte("f"), &data.LogRecordPos{})
+	assert.Equal(t, 6, tree.Size())
+}
+
+func TestBPTree_SizeUpgrade(t *testing.T) {
+	dir := t.TempDir()
+	tree := New(dir, false)
+	tree.Put([]byte("a"), &data.LogRecordPos{Fid: 1})
+	tree.Put([]byte("b"), &data.LogRecordPos{Fid: 1})
+
+	// 模拟没有记录key数量的旧版本索引文件
+	assert.Nil(t, tree.DeleteMeta(keyCountKey))
+	assert.Nil(t, tree.Close())
+
+	tree = New(dir, false)
+	defer tree.Close()
+	assert.Equal(t, 2, tree.Size())
+	tree.Delete([]byte("a"))
+	assert.Equal(t, 1, tree.Size())
+}
@@ -44,6 +44,9 @@ func (tree *Tree) Put(key []byte, pos *data.LogRecordPos) *data.LogRecordPos {
 }
 
 func (tree *Tree) Get(key []byte) *data.LogRecordPos {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+
 	if itv := tree.tree.Get(&Item{key: key}); itv != nil {
 		return itv.(*Item).pos
 	}
@@ -62,6 +65,9 @@ func (tree *Tree) Delete(key []byte) (*data.LogRecordPos, bool) {
 }
 
 func (tree *Tree) Size() int {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+
 	return tree.tree.Len()
 }
 
@@ -116,7 +122,7 @@ func (tree *Tree) Iterator(reverse bool) iface.Iterator {
 		return nil
 	}
 	tree.mutex.RLock()
-	defer tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
 
 	return newBTreeIterator(tree.tree, reverse)
 }
@@ -0,0 +1,177 @@
+package shard
+
+import (
+	"bytes"
+	"container/heap"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/iface"
+	"hash/fnv"
+)
+
+// Sharded Index
+
+// Index 分片索引 按key的哈希值分到多个子索引 每个子索引各自加锁
+// 不同分片上的读写互不阻塞
+type Index struct {
+	shards []iface.Indexer
+}
+
+// New 创建分片索引 newIndexer用于创建每个分片的子索引
+func New(n int, newIndexer func() iface.Indexer) *Index {
+	if n <= 0 {
+		n = 1
+	}
+	shards := make([]iface.Indexer, n)
+	for i := range shards {
+		shards[i] = newIndexer()
+	}
+	return &Index{shards: shards}
+}
+
+// 获取key所在的分片
+func (index *Index) shardOf(key []byte) iface.Indexer {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return index.shards[h.Sum32()%uint32(len(index.shards))]
+}
+
+// Put 写入索引 返回旧的位置信息
+func (index *Index) Put(key []byte, pos *data.LogRecordPos) *data.LogRecordPos {
+	return index.shardOf(key).Put(key, pos)
+}
+
+func (index *Index) Get(key []byte) *data.LogRecordPos {
+	return index.shardOf(key).Get(key)
+}
+
+// Delete 删除索引 返回旧的位置信息
+func (index *Index) Delete(key []byte) (*data.LogRecordPos, bool) {
+	return index.shardOf(key).Delete(key)
+}
+
+// Size 各分片数据量之和
+func (index *Index) Size() int {
+	var size int
+	for _, s := range index.shards {
+		size += s.Size()
+	}
+	return size
+}
+
+// Shards 分片数量
+func (index *Index) Shards() int {
+	return len(index.shards)
+}
+
+// Iterator 合并各分片的迭代器 按key有序输出
+func (index *Index) Iterator(reverse bool) iface.Iterator {
+	its := make([]iface.Iterator, len(index.shards))
+	for i, s := range index.shards {
+		its[i] = s.Iterator(reverse)
+	}
+
+	it := &Iterator{
+		reverse: reverse,
+		its:     its,
+	}
+	it.Rewind()
+	return it
+}
+
+// Iterator 多路归并迭代器 用堆维护各分片迭代器的当前位置
+// 同一个key只会出现在一个分片中 因此无需去重
+type Iterator struct {
+	reverse bool
+	its     []iface.Iterator
+	heap    iterHeap
+}
+
+// 使用当前有效的分片迭代器重建堆
+func (it *Iterator) init() {
+	it.heap = iterHeap{reverse: it.reverse, its: make([]iface.Iterator, 0, len(it.its))}
+	for _, sub := range it.its {
+		if sub.Valid() {
+			it.heap.its = append(it.heap.its, sub)
+		}
+	}
+	heap.Init(&it.heap)
+}
+
+func (it *Iterator) Rewind() {
+	for _, sub := range it.its {
+		sub.Rewind()
+	}
+	it.init()
+}
+
+func (it *Iterator) Seek(key []byte) {
+	for _, sub := range it.its {
+		sub.Seek(key)
+	}
+	it.init()
+}
+
+func (it *Iterator) Next() {
+	if !it.Valid() {
+		return
+	}
+	top := it.heap.its[0]
+	top.Next()
+	if top.Valid() {
+		heap.Fix(&it.heap, 0)
+	} else {
+		heap.Pop(&it.heap)
+	}
+}
+
+func (it *Iterator) Valid() bool {
+	return len(it.heap.its) > 0
+}
+
+func (it *Iterator) Key() []byte {
+	return it.heap.its[0].Key()
+}
+
+func (it *Iterator) Value() *data.LogRecordPos {
+	return it.heap.its[0].Value()
+}
+
+func (it *Iterator) Close() {
+	for _, sub := range it.its {
+		sub.Close()
+	}
+	it.heap.its = nil
+}
+
+// 按当前key排序的迭代器堆 反向迭代时为大顶堆
+type iterHeap struct {
+	reverse bool
+	its     []iface.Iterator
+}
+
+func (h *iterHeap) Len() int {
+	return len(h.its)
+}
+
+func (h *iterHeap) Less(i, j int) bool {
+	cmp := bytes.Compare(h.its[i].Key(), h.its[j].Key())
+	if h.reverse {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+func (h *iterHeap) Swap(i, j int) {
+	h.its[i], h.its[j] = h.its[j], h.its[i]
+}
+
+func (h *iterHeap) Push(x any) {
+	h.its = append(h.its, x.(iface.Iterator))
+}
+
+func (h *iterHeap) Pop() any {
+	n := len(h.its)
+	x := h.its[n-1]
+	h.its = h.its[:n-1]
+	return x
+}
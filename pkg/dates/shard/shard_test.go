@@ -0,0 +1,82 @@
+package shard
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/dates/btree"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func newIndex() *Index {
+	return New(4, func() iface.Indexer {
+		return btree.New()
+	})
+}
+
+func TestIndex_Put(t *testing.T) {
+	index := newIndex()
+
+	assert.Nil(t, index.Put([]byte("a"), &data.LogRecordPos{Fid: 1, Offset: 10}))
+	old := index.Put([]byte("a"), &data.LogRecordPos{Fid: 2, Offset: 20})
+	assert.Equal(t, int64(10), old.Offset)
+	assert.Equal(t, uint32(2), index.Get([]byte("a")).Fid)
+
+	old, ok := index.Delete([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), old.Offset)
+	assert.Nil(t, index.Get([]byte("a")))
+	assert.Equal(t, 0, index.Size())
+}
+
+func TestIndex_Iterator(t *testing.T) {
+	index := newIndex()
+	for i := 0; i < 100; i++ {
+		index.Put([]byte(fmt.Sprintf("key-%03d", i)), &data.LogRecordPos{Offset: int64(i)})
+	}
+	assert.Equal(t, 100, index.Size())
+
+	// 多个分片的数据合并后有序
+	it := index.Iterator(false)
+	var i int64
+	for it.Rewind(); it.Valid(); it.Next() {
+		assert.Equal(t, fmt.Sprintf("key-%03d", i), string(it.Key()))
+		assert.Equal(t, i, it.Value().Offset)
+		i++
+	}
+	assert.Equal(t, int64(100), i)
+
+	it.Seek([]byte("key-050"))
+	assert.Equal(t, "key-050", string(it.Key()))
+	it.Close()
+
+	it = index.Iterator(true)
+	defer it.Close()
+	assert.Equal(t, "key-099", string(it.Key()))
+	it.Seek([]byte("key-0505"))
+	assert.Equal(t, "key-050", string(it.Key()))
+	it.Next()
+	assert.Equal(t, "key-049", string(it.Key()))
+}
+
+func TestIndex_Concurrent(t *testing.T) {
+	index := newIndex()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				index.Put(key, &data.LogRecordPos{Offset: int64(j)})
+				assert.NotNil(t, index.Get(key))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 8000, index.Size())
+}
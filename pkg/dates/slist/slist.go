@@ -1,235 +1,210 @@
 package slist
 
 import (
+	"bytes"
 	"github.com/T4t4KAU/TikBase/engine/data"
-	"github.com/T4t4KAU/TikBase/pkg/utils"
+	"github.com/T4t4KAU/TikBase/iface"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 )
 
 // SkipList
 
-// 是否向上延伸
-func isInsertUp() bool {
-	rand.Seed(time.Now().UnixNano())
-	n := rand.Intn(2)
-	return n%2 == 0
-}
-
-// Node 跳表节点
-type Node struct {
-	Key   []byte
-	Value *data.LogRecordPos
-	Next  *Node // 指向后继结点
-	Down  *Node // 指向下方结点
-}
-
-type Filter func(node *Node) bool
+const (
+	maxLevel    = 32   // 最大层数
+	probability = 0.25 // 向上建立索引的概率
+)
 
-// Compare 比较结点大小
-func (n *Node) Compare(node *Node) int {
-	return utils.CompareKey(n.Key, node.Key)
+// 跳表结点
+type node struct {
+	key  []byte
+	pos  *data.LogRecordPos
+	next []*node // 每一层的后继结点
 }
 
-func newNode(key []byte, value *data.LogRecordPos) *Node {
-	return &Node{
-		Key:   key,
-		Value: value,
+func newNode(key []byte, pos *data.LogRecordPos, level int) *node {
+	return &node{
+		key:  key,
+		pos:  pos,
+		next: make([]*node, level),
 	}
 }
 
+// List 跳表索引
 type List struct {
-	Head  *Node
+	head  *node
+	level int // 当前层数
+	size  int
+	rand  *rand.Rand
 	mutex sync.RWMutex
 }
 
 // New 创建跳表
 func New() *List {
 	return &List{
-		// 创建头结点
-		Head: newNode([]byte(""), nil),
+		head:  newNode(nil, nil, maxLevel),
+		level: 1,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
-// Level 索引层数
-func (list *List) Level() int {
-	if list.Head == nil {
-		return 0
-	}
-
-	var level int
-	p := list.Head
-	for p != nil {
+// 随机生成结点层数
+// 访问此方法前要持有写锁
+func (list *List) randomLevel() int {
+	level := 1
+	for level < maxLevel && list.rand.Float64() < probability {
 		level++
-		p = p.Down
 	}
-	return level - 1
+	return level
 }
 
-// Insert 插入值
-func (list *List) Insert(key []byte, pos *data.LogRecordPos) bool {
-	if list == nil || list.Head == nil {
-		return false
+// 查找每一层中小于key的最大结点
+// 访问此方法前要持有锁
+func (list *List) findPrev(key []byte, prev []*node) *node {
+	p := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for p.next[i] != nil && bytes.Compare(p.next[i].key, key) < 0 {
+			p = p.next[i]
+		}
+		if prev != nil {
+			prev[i] = p
+		}
 	}
+	return p.next[0]
+}
 
-	// 保存结点路径
-	path := make([]*Node, 0)
-
+// Put 插入或更新数据 返回旧的位置信息
+func (list *List) Put(key []byte, pos *data.LogRecordPos) *data.LogRecordPos {
 	list.mutex.Lock()
 	defer list.mutex.Unlock()
 
-	p := list.Head
+	prev := make([]*node, maxLevel)
+	if n := list.findPrev(key, prev); n != nil && bytes.Equal(n.key, key) {
+		old := n.pos
+		n.pos = pos
+		return old
+	}
 
-	// 从下往上逐层遍历
-	// 找到插入值的前驱结点
-	for p != nil {
-		for p.Next != nil && utils.CompareBytes(p.Next.Key, key) < 0 {
-			p = p.Next
+	level := list.randomLevel()
+	if level > list.level {
+		for i := list.level; i < level; i++ {
+			prev[i] = list.head
 		}
-		// 将每层找到的结点存入路径
-		path = append(path, p)
-		p = p.Down
+		list.level = level
 	}
 
-	// 插入标识
-	var insertUpFlag = true
-	var downNode *Node
-
-	// 向当前层增加结点
-	for insertUpFlag && len(path) > 0 {
-		node := newNode(key, pos)
-		prevNode := path[len(path)-1]
-		path = path[:len(path)-1]
+	n := newNode(key, pos, level)
+	for i := 0; i < level; i++ {
+		n.next[i] = prev[i].next[i]
+		prev[i].next[i] = n
+	}
+	list.size++
 
-		// 将新结点插入
-		node.Next = prevNode.Next
-		node.Down = downNode
-		prevNode.Next = node
-		downNode = node
+	return nil
+}
 
-		// 随机选择是否向上建立索引
-		insertUpFlag = isInsertUp()
-	}
+// Get 获取key对应的位置信息
+func (list *List) Get(key []byte) *data.LogRecordPos {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
 
-	// 建立新的层
-	if len(path) <= 0 && isInsertUp() {
-		node := newNode(key, pos)
-		node.Down = downNode
-		newHead := newNode([]byte(""), nil)
-		newHead.Next = node
-		newHead.Down = list.Head
-		list.Head = newHead
+	if n := list.findPrev(key, nil); n != nil && bytes.Equal(n.key, key) {
+		return n.pos
 	}
-
-	return true
+	return nil
 }
 
-// Remove 删除元素
-func (list *List) Remove(key []byte) bool {
-	p, ok := list.Head, false
-
+// Delete 删除数据 返回旧的位置信息
+func (list *List) Delete(key []byte) (*data.LogRecordPos, bool) {
 	list.mutex.Lock()
 	defer list.mutex.Unlock()
 
-	for p != nil {
-		// 该层链表未到达末尾前 找到不大于key的最大结点
-		for p.Next != nil && utils.CompareKey(p.Next.Key, key) < 0 {
-			p = p.Next
-		}
+	prev := make([]*node, maxLevel)
+	n := list.findPrev(key, prev)
+	if n == nil || !bytes.Equal(n.key, key) {
+		return nil, false
+	}
 
-		// 该层链表到末尾或者到达最大key则下降
-		if p.Next == nil || utils.CompareKey(p.Next.Key, key) < 0 {
-			p = p.Down
-		} else {
-			p.Next = p.Next.Next
-			p = p.Down
-			ok = true
-		}
+	for i := 0; i < len(n.next); i++ {
+		prev[i].next[i] = n.next[i]
 	}
-	return ok
-}
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+	list.size--
 
-// Search 搜索
-func (list *List) Search(key []byte) (*Node, bool) {
-	p := list.Head
+	return n.pos, true
+}
 
+// Size 数据量
+func (list *List) Size() int {
 	list.mutex.RLock()
 	defer list.mutex.RUnlock()
 
-	for p != nil {
-		for p.Next != nil && utils.CompareBytes(p.Next.Key, key) < 0 {
-			p = p.Next
-		}
-
-		// 在该层搜索不到 下降到下一层
-		if p.Next == nil || utils.CompareBytes(p.Next.Key, key) < 0 {
-			p = p.Down
-		} else {
-			return p.Next, true
-		}
-	}
-	return &Node{}, false
+	return list.size
 }
 
-func (list *List) Update(key []byte, pos *data.LogRecordPos) bool {
-	p, ok := list.Head, false
-
-	list.mutex.Lock()
-	defer list.mutex.Unlock()
+// Iterator 索引迭代器 创建时复制当前数据
+func (list *List) Iterator(reverse bool) iface.Iterator {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
 
-	for p != nil {
-		// 该层链表未到达末尾前 找到不大于key的最大结点
-		for p.Next != nil && utils.CompareKey(p.Next.Key, key) < 0 {
-			p = p.Next
+	values := make([]*node, 0, list.size)
+	for p := list.head.next[0]; p != nil; p = p.next[0] {
+		values = append(values, &node{key: p.key, pos: p.pos})
+	}
+	if reverse {
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
 		}
+	}
 
-		// 该层链表到末尾或者到达最大key则下降
-		if p.Next == nil || utils.CompareKey(p.Next.Key, key) < 0 {
-			p = p.Down
-		} else {
-			p.Next.Value = pos
-			p = p.Down
-			ok = true
-		}
+	return &Iterator{
+		reverse: reverse,
+		values:  values,
 	}
-	return ok
 }
 
 type Iterator struct {
+	currIndex int
+	reverse   bool
+	values    []*node
 }
 
 func (it *Iterator) Rewind() {
-	//TODO implement me
-	panic("implement me")
+	it.currIndex = 0
 }
 
 func (it *Iterator) Seek(key []byte) {
-	//TODO implement me
-	panic("implement me")
+	if it.reverse {
+		it.currIndex = sort.Search(len(it.values), func(i int) bool {
+			return bytes.Compare(it.values[i].key, key) <= 0
+		})
+	} else {
+		it.currIndex = sort.Search(len(it.values), func(i int) bool {
+			return bytes.Compare(it.values[i].key, key) >= 0
+		})
+	}
 }
 
 func (it *Iterator) Next() {
-	//TODO implement me
-	panic("implement me")
+	it.currIndex += 1
 }
 
 func (it *Iterator) Valid() bool {
-	//TODO implement me
-	panic("implement me")
+	return it.currIndex < len(it.values)
 }
 
 func (it *Iterator) Key() []byte {
-	//TODO implement me
-	panic("implement me")
+	return it.values[it.currIndex].key
 }
 
 func (it *Iterator) Value() *data.LogRecordPos {
-	//TODO implement me
-	panic("implement me")
+	return it.values[it.currIndex].pos
 }
 
 func (it *Iterator) Close() {
-	//TODO implement me
-	panic("implement me")
+	it.values = nil
 }
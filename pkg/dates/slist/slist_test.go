@@ -1 +1,84 @@
 package slist
+
+import (
+	"fmt"
+	"github.com/T4t4KAU/TikBase/engine/data"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestList_Put(t *testing.T) {
+	list := New()
+
+	assert.Nil(t, list.Put([]byte("b"), &data.LogRecordPos{Fid: 1, Offset: 10}))
+	assert.Nil(t, list.Put([]byte("a"), &data.LogRecordPos{Fid: 1, Offset: 20}))
+	assert.Equal(t, 2, list.Size())
+
+	old := list.Put([]byte("b"), &data.LogRecordPos{Fid: 2, Offset: 30})
+	assert.Equal(t, int64(10), old.Offset)
+	assert.Equal(t, 2, list.Size())
+	assert.Equal(t, uint32(2), list.Get([]byte("b")).Fid)
+	assert.Nil(t, list.Get([]byte("c")))
+}
+
+func TestList_Delete(t *testing.T) {
+	list := New()
+	for i := 0; i < 100; i++ {
+		list.Put([]byte(fmt.Sprintf("key-%03d", i)), &data.LogRecordPos{Offset: int64(i)})
+	}
+
+	old, ok := list.Delete([]byte("key-050"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(50), old.Offset)
+	assert.Nil(t, list.Get([]byte("key-050")))
+	assert.Equal(t, 99, list.Size())
+
+	_, ok = list.Delete([]byte("key-050"))
+	assert.False(t, ok)
+}
+
+func TestList_Iterator(t *testing.T) {
+	list := New()
+	for _, key := range []string{"c", "a", "e", "b", "d"} {
+		list.Put([]byte(key), &data.LogRecordPos{})
+	}
+
+	keys := make([]string, 0)
+	it := list.Iterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+
+	it = list.Iterator(true)
+	it.Seek([]byte("cc"))
+	assert.Equal(t, "c", string(it.Key()))
+	it.Next()
+	assert.Equal(t, "b", string(it.Key()))
+	it.Close()
+}
+
+func TestList_Concurrent(t *testing.T) {
+	list := New()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := []byte(fmt.Sprintf("%d-%d", n, j))
+				list.Put(key, &data.LogRecordPos{})
+				assert.NotNil(t, list.Get(key))
+				if j%2 == 0 {
+					list.Delete(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 8*500, list.Size())
+}
@@ -19,6 +19,20 @@ var (
 	ErrInvalidProtocol        = errors.New("invalid protocol")
 	ErrHashKeyNotFound        = errors.New("hash key not found")
 	ErrSetMemberNotFound      = errors.New("set member not found")
+	ErrZSetMemberNotFound     = errors.New("zset member not found")
+	ErrChangeLogTruncated     = errors.New("change log has been truncated by merge")
+	ErrChangeConsumerLagging  = errors.New("change consumer is lagging, merge is not allowed")
+	ErrInvalidConsumerName    = errors.New("invalid change consumer name")
+	ErrExceedBytesQuota       = errors.New("namespace bytes quota exceeded")
+	ErrExceedKeysQuota        = errors.New("namespace key count quota exceeded")
+	ErrQuotaNotSupported      = errors.New("engine does not support namespace quotas")
+	ErrExceedValueSizeQuota   = errors.New("value size exceeds namespace quota")
+	ErrHashIndexExists        = errors.New("hash index already exists")
+	ErrHashIndexNotFound      = errors.New("hash index not found")
+	ErrHashIndexNotReady      = errors.New("hash index is building, try again later")
+	ErrInvalidHashIndex       = errors.New("invalid hash index name or field")
+	ErrHashIndexNotSupported  = errors.New("engine does not support hash indexes")
+	ErrIOTypeNotSupported     = errors.New("io type is not supported on this platform")
 
 	ErrHashDataIsEmpty = errors.New("hash data is empty")
 	ErrListDataIsEmpty = errors.New("list data is empty")
@@ -27,6 +41,41 @@ var (
 
 	ErrConnectionClosed = errors.New("connection closed")
 	ErrRaftOpenTimeout  = errors.New("timeout waiting for initial logs application")
+	ErrNotLeader        = errors.New("node is not the leader")
+	ErrNoLeader         = errors.New("no leader available")
+	ErrStaleRead        = errors.New("replica is too stale for the requested read")
+
+	ErrInvalidConsistency = errors.New("invalid consistency level")
+	ErrInvalidCommand     = errors.New("invalid replicated command encoding")
+	ErrCommandVersion     = errors.New("unsupported replicated command version")
+	ErrInvalidSnapshot    = errors.New("invalid or truncated snapshot")
+	ErrSnapshotVersion    = errors.New("unsupported snapshot version")
+
+	ErrServerNotFound = errors.New("server is not a member of the cluster")
+	ErrServerIsVoter  = errors.New("server is already a voter")
+	ErrNotVoter       = errors.New("server is not a voter")
+	ErrLeaderChange   = errors.New("transfer leadership before removing or demoting the leader")
+	ErrQuorumLost     = errors.New("membership change would leave the cluster without a reachable quorum")
+
+	ErrRegionNotFound       = errors.New("region not found")
+	ErrSnapshotNotSupported = errors.New("snapshots are taken per region")
+	ErrInvalidRegionCount   = errors.New("region count must be greater than 0")
+	ErrRegionCountMismatch  = errors.New("region count differs from the cluster or the previous run")
+	ErrRegionAddrConflict   = errors.New("region raft address conflicts with another address")
+	ErrApplyNotSupported    = errors.New("instruction cannot be applied through the data service")
+
+	ErrStableKeyNotFound = errors.New("not found") // raft按错误信息判断稳定存储中的key不存在
+	ErrInvalidRaftLog    = errors.New("invalid raft log entry")
+	ErrUnknownLogStore   = errors.New("unknown raft log store")
+	ErrInvalidRaftConfig = errors.New("invalid raft config")
+
+	ErrUnknownTransport        = errors.New("unknown raft transport")
+	ErrRaftNotForwarded        = errors.New("raft messages are not forwarded by the replica service")
+	ErrInvalidRaftMessage      = errors.New("invalid raft message")
+	ErrSnapshotChunkOutOfOrder = errors.New("snapshot chunk out of order")
+	ErrSnapshotTimeout         = errors.New("timeout waiting for snapshot chunk")
+	ErrInvalidTLSConfig        = errors.New("cert_file, key_file and ca_file must be set together")
+	ErrTLSRequiresKitex        = errors.New("tls requires the kitex transport, raft traffic over tcp is not encrypted")
 )
 
 var (
@@ -3,11 +3,16 @@ package filter
 import (
 	"errors"
 	"github.com/spaolacci/murmur3"
+	"math"
 )
 
+const minBloomBits = 64
+
 // BloomFilter 布隆过滤器
+// 生成的位图最后一个字节保存哈希函数个数
 type BloomFilter struct {
-	length     int
+	length     int     // 位图的位数
+	bitsPerKey float64 // 大于0时位图长度按key数量计算
 	hashedKeys []uint32
 }
 
@@ -21,6 +26,17 @@ func NewBloomFilter(length int) (*BloomFilter, error) {
 	}, nil
 }
 
+// NewBloomFilterWithRate 按误判率创建布隆过滤器 生成位图时根据key数量确定长度
+func NewBloomFilterWithRate(rate float64) (*BloomFilter, error) {
+	if rate <= 0 || rate >= 1 {
+		return nil, errors.New("false positive rate must be in (0, 1)")
+	}
+
+	return &BloomFilter{
+		bitsPerKey: -math.Log(rate) / (math.Ln2 * math.Ln2),
+	}, nil
+}
+
 func (f *BloomFilter) Add(key []byte) {
 	f.hashedKeys = append(f.hashedKeys, murmur3.Sum32(key))
 }
@@ -30,11 +46,16 @@ func (f *BloomFilter) Exist(bitmap, key []byte) bool {
 		bitmap = f.Hash()
 	}
 
+	if len(bitmap) < 2 {
+		return false
+	}
+
 	k := bitmap[len(bitmap)-1]
+	bits := uint32((len(bitmap) - 1) << 3)
 	hashedKey := murmur3.Sum32(key)
 	delta := (hashedKey >> 17) | (hashedKey << 15)
 	for i := uint32(0); i < uint32(k); i++ {
-		targetBit := (hashedKey + i*delta) % uint32(len(bitmap)<<3)
+		targetBit := (hashedKey + i*delta) % bits
 		if bitmap[targetBit>>3]&(1<<(targetBit&7)) == 0 {
 			return false
 		}
@@ -44,13 +65,22 @@ func (f *BloomFilter) Exist(bitmap, key []byte) bool {
 }
 
 func (f *BloomFilter) Hash() []byte {
-	k := f.bestK()
-	bitmap := f.bitmap(k)
+	length := f.length
+	if f.bitsPerKey > 0 {
+		length = int(math.Ceil(f.bitsPerKey * float64(len(f.hashedKeys))))
+		if length < minBloomBits {
+			length = minBloomBits
+		}
+	}
+
+	k := f.bestK(length)
+	bitmap := f.bitmap(length, k)
+	bits := uint32((len(bitmap) - 1) << 3)
 
 	for _, hashedKey := range f.hashedKeys {
 		delta := (hashedKey >> 17) | (hashedKey << 15)
 		for i := uint32(0); i < uint32(k); i++ {
-			targetBit := (hashedKey + i*delta) % uint32(len(bitmap)<<3)
+			targetBit := (hashedKey + i*delta) % bits
 			bitmap[targetBit>>3] |= 1 << (targetBit & 7)
 		}
 	}
@@ -66,15 +96,19 @@ func (f *BloomFilter) KeyLen() int {
 	return len(f.hashedKeys)
 }
 
-func (f *BloomFilter) bitmap(k uint8) []byte {
-	bitmapLen := (f.length + 7) >> 3
-	bitmap := make([]byte, bitmapLen)
+// 位图之后额外一个字节保存哈希函数个数
+func (f *BloomFilter) bitmap(length int, k uint8) []byte {
+	bitmapLen := (length + 7) >> 3
+	bitmap := make([]byte, bitmapLen+1)
 	bitmap[bitmapLen] = k
 	return bitmap
 }
 
-func (f *BloomFilter) bestK() uint8 {
-	k := uint8(69 * f.length / 100 / len(f.hashedKeys))
+func (f *BloomFilter) bestK(length int) uint8 {
+	if len(f.hashedKeys) == 0 {
+		return 1
+	}
+	k := 69 * length / 100 / len(f.hashedKeys)
 	// k ∈ [1,30]
 	if k < 1 {
 		k = 1
@@ -82,5 +116,5 @@ func (f *BloomFilter) bestK() uint8 {
 	if k > 30 {
 		k = 30
 	}
-	return k
+	return uint8(k)
 }
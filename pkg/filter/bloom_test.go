@@ -0,0 +1,47 @@
+package filter
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	f, err := NewBloomFilter(1000)
+	assert.Nil(t, err)
+
+	// 没有key时不会出错
+	assert.False(t, f.Exist(nil, []byte("key")))
+
+	for i := 0; i < 100; i++ {
+		f.Add([]byte(fmt.Sprintf("key-%d", i)))
+	}
+	bitmap := f.Hash()
+	assert.Equal(t, 126, len(bitmap))
+	for i := 0; i < 100; i++ {
+		assert.True(t, f.Exist(bitmap, []byte(fmt.Sprintf("key-%d", i))))
+	}
+}
+
+func TestBloomFilter_Rate(t *testing.T) {
+	_, err := NewBloomFilterWithRate(0)
+	assert.NotNil(t, err)
+
+	f, err := NewBloomFilterWithRate(0.01)
+	assert.Nil(t, err)
+	for i := 0; i < 10000; i++ {
+		f.Add([]byte(fmt.Sprintf("key-%d", i)))
+	}
+	bitmap := f.Hash()
+	for i := 0; i < 10000; i++ {
+		assert.True(t, f.Exist(bitmap, []byte(fmt.Sprintf("key-%d", i))))
+	}
+
+	var positives int
+	for i := 0; i < 10000; i++ {
+		if f.Exist(bitmap, []byte(fmt.Sprintf("other-%d", i))) {
+			positives++
+		}
+	}
+	assert.Less(t, positives, 200)
+}
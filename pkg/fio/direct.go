@@ -0,0 +1,252 @@
+package fio
+
+import (
+	"io"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+/// 直接IO 绕过页缓存读写文件
+/// O_DIRECT要求缓冲区地址 文件偏移和读写长度都按块对齐
+/// 写入的数据先在对齐缓冲区中累积 写满后整块写入 Sync时把未写满的块补零写入后截断到实际长度
+
+const (
+	blockSize       = 4096
+	directBufBlocks = 64 // 写缓冲区大小 按块计
+)
+
+// 分配地址按块对齐的缓冲区
+func alignedBlock(size int) []byte {
+	buf := make([]byte, size+blockSize)
+	shift := int(uintptr(unsafe.Pointer(&buf[0])) & (blockSize - 1))
+	if shift != 0 {
+		shift = blockSize - shift
+	}
+	return buf[shift : shift+size : shift+size]
+}
+
+func alignDown(n int64) int64 {
+	return n &^ (blockSize - 1)
+}
+
+func alignUp(n int64) int64 {
+	return alignDown(n + blockSize - 1)
+}
+
+// DirectIO 直接IO管理器
+type DirectIO struct {
+	mutex  sync.RWMutex
+	fd     *os.File
+	size   int64                                     // 文件的实际长度
+	buf    []byte                                    // 对齐的写缓冲区 保存尚未整块写入的数据
+	bufOff int64                                     // 写缓冲区对应的文件偏移 按块对齐
+	bufLen int                                       // 写缓冲区中的数据长度
+	dirty  bool                                      // 写缓冲区中是否有未写入文件的数据
+	readAt func(b []byte, offset int64) (int, error) // 读取对齐的块
+}
+
+func NewDirectIOManager(name string) (*DirectIO, error) {
+	fd, err := openDirect(name)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fd.Stat()
+	if err != nil {
+		_ = fd.Close()
+		return nil, err
+	}
+
+	d := &DirectIO{
+		fd:     fd,
+		size:   stat.Size(),
+		buf:    alignedBlock(directBufBlocks * blockSize),
+		bufOff: alignDown(stat.Size()),
+		readAt: fd.ReadAt,
+	}
+
+	// 读取最后一个未写满的块 后续写入从该块继续
+	d.bufLen = int(d.size - d.bufOff)
+	if d.bufLen > 0 {
+		if _, err = d.readAt(d.buf[:blockSize], d.bufOff); err != nil && err != io.EOF {
+			_ = fd.Close()
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// 以直接IO方式打开文件 文件系统不支持时退化为普通IO
+func openDirect(name string) (*os.File, error) {
+	fd, err := os.OpenFile(name, os.O_CREATE|os.O_RDWR|directFlag, DataFilePerm)
+	if err != nil && directFlag != 0 {
+		return os.OpenFile(name, os.O_CREATE|os.O_RDWR, DataFilePerm)
+	}
+	return fd, err
+}
+
+// Read 读取偏移处数据 尚未写入文件的部分从写缓冲区读取
+func (d *DirectIO) Read(b []byte, offset int64) (int, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if offset >= d.size {
+		return 0, io.EOF
+	}
+	end := offset + int64(len(b))
+	if end > d.size {
+		end = d.size
+	}
+
+	var n int
+	// 文件中的部分
+	if offset < d.bufOff {
+		diskEnd := end
+		if diskEnd > d.bufOff {
+			diskEnd = d.bufOff
+		}
+		start := alignDown(offset)
+		block := alignedBlock(int(alignUp(diskEnd) - start))
+		if _, err := d.readAt(block, start); err != nil && err != io.EOF {
+			return 0, err
+		}
+		n = copy(b[:diskEnd-offset], block[offset-start:])
+	}
+
+	// 写缓冲区中的部分
+	if end > d.bufOff {
+		from := offset + int64(n)
+		n += copy(b[n:end-offset], d.buf[from-d.bufOff:])
+	}
+
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Write 追加数据 写满缓冲区后整块写入文件
+func (d *DirectIO) Write(b []byte) (int, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var written int
+	for written < len(b) {
+		n := copy(d.buf[d.bufLen:], b[written:])
+		if d.bufLen+n == len(d.buf) {
+			if _, err := d.fd.WriteAt(d.buf, d.bufOff); err != nil {
+				return written, err
+			}
+			d.bufOff += int64(len(d.buf))
+			d.bufLen = 0
+			d.dirty = false
+		} else {
+			d.bufLen += n
+			d.dirty = true
+		}
+		d.size += int64(n)
+		written += n
+	}
+
+	return written, nil
+}
+
+// 将写缓冲区中未写满的块补零后写入文件 再截断到实际长度
+// 访问此方法前要持有写锁
+func (d *DirectIO) flush() error {
+	if !d.dirty || d.bufLen == 0 {
+		return nil
+	}
+
+	n := int(alignUp(int64(d.bufLen)))
+	for i := d.bufLen; i < n; i++ {
+		d.buf[i] = 0
+	}
+	if _, err := d.fd.WriteAt(d.buf[:n], d.bufOff); err != nil {
+		return err
+	}
+	if err := d.fd.Truncate(d.size); err != nil {
+		return err
+	}
+
+	// 只保留最后一个未写满的块
+	full := d.bufLen / blockSize * blockSize
+	if full > 0 {
+		copy(d.buf, d.buf[full:d.bufLen])
+		d.bufOff += int64(full)
+		d.bufLen -= full
+	}
+	d.dirty = false
+	return nil
+}
+
+// Sync 持久化
+func (d *DirectIO) Sync() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if err := d.flush(); err != nil {
+		return err
+	}
+	return d.fd.Sync()
+}
+
+func (d *DirectIO) Close() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if err := d.flush(); err != nil {
+		return err
+	}
+	return d.fd.Close()
+}
+
+func (d *DirectIO) Size() (int64, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.size, nil
+}
+
+// AsyncIO 异步IO管理器 写入使用直接IO 读取请求批量提交
+// 默认使用多个协程并发读取 通过NewUringIOManager创建时使用io_uring
+type AsyncIO struct {
+	*DirectIO
+}
+
+func NewAsyncIOManager(name string) (*AsyncIO, error) {
+	return newAsyncIO(name, defaultBatchReader())
+}
+
+// NewUringIOManager 创建读请求提交到io_uring的异步IO管理器 内核不支持时退化为并发读取
+func NewUringIOManager(name string) (*AsyncIO, error) {
+	return newAsyncIO(name, uringBatchReader())
+}
+
+func newAsyncIO(name string, reader *batchReader) (*AsyncIO, error) {
+	d, err := NewDirectIOManager(name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.readAt = func(b []byte, offset int64) (int, error) {
+		req := &ReadRequest{Buf: b, Offset: offset}
+		reader.read(d.fd, req)
+		return req.N, req.Err
+	}
+	return &AsyncIO{DirectIO: d}, nil
+}
+
+// ReadBatch 批量读取 所有请求一起提交 全部完成后返回
+// 每个请求的结果保存在请求的N和Err中
+func (a *AsyncIO) ReadBatch(reqs []*ReadRequest) {
+	var wg sync.WaitGroup
+	for _, req := range reqs {
+		wg.Add(1)
+		go func(req *ReadRequest) {
+			defer wg.Done()
+			req.N, req.Err = a.Read(req.Buf, req.Offset)
+		}(req)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,5 @@
+package fio
+
+import "syscall"
+
+const directFlag = syscall.O_DIRECT
@@ -0,0 +1,6 @@
+//go:build !linux
+
+package fio
+
+// 其他平台不使用O_DIRECT 读写仍按块对齐
+const directFlag = 0
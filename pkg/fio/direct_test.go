@@ -0,0 +1,113 @@
+package fio
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+func testDirectWriteRead(t *testing.T, ioType FileIOType) {
+	path := filepath.Join("/tmp", "direct.data")
+	destroyFile(path)
+	defer destroyFile(path)
+
+	iom, err := NewIOManager(path, ioType)
+	assert.Nil(t, err)
+
+	// 写入超过缓冲区大小的数据 部分在文件中 部分在缓冲区中
+	content := bytes.Repeat([]byte("0123456789abcdef"), directBufBlocks*blockSize/16+100)
+	for i := 0; i < len(content); i += 1000 {
+		end := i + 1000
+		if end > len(content) {
+			end = len(content)
+		}
+		n, err := iom.Write(content[i:end])
+		assert.Nil(t, err)
+		assert.Equal(t, end-i, n)
+	}
+
+	size, err := iom.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), size)
+
+	// 跨越缓冲区边界读取
+	off := int64(directBufBlocks*blockSize - 10)
+	b := make([]byte, 30)
+	n, err := iom.Read(b, off)
+	assert.Nil(t, err)
+	assert.Equal(t, 30, n)
+	assert.Equal(t, content[off:off+30], b)
+
+	// 读取到文件末尾
+	n, err = iom.Read(b, size-5)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 5, n)
+
+	assert.Nil(t, iom.Sync())
+	_, err = iom.Write([]byte("tail"))
+	assert.Nil(t, err)
+	assert.Nil(t, iom.Close())
+
+	// 重新打开后从最后一个未写满的块继续写入
+	iom, err = NewIOManager(path, ioType)
+	assert.Nil(t, err)
+	_, err = iom.Write([]byte("more"))
+	assert.Nil(t, err)
+
+	expected := append(append(append([]byte{}, content...), "tail"...), "more"...)
+	b = make([]byte, len(expected))
+	n, err = iom.Read(b, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, len(expected), n)
+	assert.Equal(t, expected, b)
+	assert.Nil(t, iom.Close())
+}
+
+func TestDirectIO_WriteRead(t *testing.T) {
+	testDirectWriteRead(t, DirectFIO)
+}
+
+func TestAsyncIO_WriteRead(t *testing.T) {
+	testDirectWriteRead(t, AsyncFIO)
+}
+
+func TestUringIO_WriteRead(t *testing.T) {
+	testDirectWriteRead(t, UringFIO)
+}
+
+func TestAsyncIO_ReadBatch(t *testing.T) {
+	path := filepath.Join("/tmp", "async.data")
+	destroyFile(path)
+	defer destroyFile(path)
+
+	a, err := NewAsyncIOManager(path)
+	assert.Nil(t, err)
+	defer a.Close()
+
+	content := make([]byte, 10*blockSize+123)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	_, err = a.Write(content)
+	assert.Nil(t, err)
+	assert.Nil(t, a.Sync())
+
+	reqs := make([]*ReadRequest, 0)
+	for off := int64(0); off < int64(len(content)); off += 1500 {
+		reqs = append(reqs, &ReadRequest{Buf: make([]byte, 100), Offset: off})
+	}
+	reqs = append(reqs, &ReadRequest{Buf: make([]byte, 100), Offset: int64(len(content)) - 50})
+	a.ReadBatch(reqs)
+
+	for _, req := range reqs[:len(reqs)-1] {
+		assert.Nil(t, req.Err)
+		assert.Equal(t, 100, req.N)
+		assert.Equal(t, content[req.Offset:req.Offset+100], req.Buf)
+	}
+	last := reqs[len(reqs)-1]
+	assert.Equal(t, io.EOF, last.Err)
+	assert.Equal(t, 50, last.N)
+	assert.Equal(t, content[last.Offset:], last.Buf[:50])
+}
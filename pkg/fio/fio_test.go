@@ -126,3 +126,68 @@ func TestMMap_Read(t *testing.T) {
 	t.Log(err)
 	t.Log(string(b3))
 }
+
+func TestWritableMMap(t *testing.T) {
+	path := filepath.Join("/tmp", "wmmap.data")
+	destroyFile(path)
+	defer destroyFile(path)
+
+	m, err := NewWritableMMapIOManager(path, 4096)
+	assert.Nil(t, err)
+
+	n, err := m.Write([]byte("key-a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, n)
+
+	// 写入后文件按预分配大小扩展
+	stat, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4096), stat.Size())
+
+	// 超过预分配大小时继续扩展
+	big := make([]byte, 5000)
+	big[4999] = 'x'
+	_, err = m.Write(big)
+	assert.Nil(t, err)
+	size, err := m.Size()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5005), size)
+
+	b := make([]byte, 5)
+	n, err = m.Read(b, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "key-a", string(b))
+	n, err = m.Read(b, 5003)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, byte('x'), b[1])
+
+	assert.Nil(t, m.Sync())
+	assert.Nil(t, m.Close())
+
+	// 关闭时截断到实际长度
+	stat, err = os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5005), stat.Size())
+
+	m, err = NewWritableMMapIOManager(path, 8192)
+	assert.Nil(t, err)
+	_, err = m.Write([]byte("key-b"))
+	assert.Nil(t, err)
+	n, err = m.Read(b, 5005)
+	assert.Nil(t, err)
+	assert.Equal(t, "key-b", string(b))
+
+	// 丢弃实际长度之后的数据
+	assert.Nil(t, m.Truncate(5))
+	_, err = m.Write([]byte("key-c"))
+	assert.Nil(t, err)
+	n, err = m.Read(b, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "key-c", string(b))
+	assert.Nil(t, m.Close())
+
+	stat, err = os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), stat.Size())
+}
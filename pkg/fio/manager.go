@@ -7,6 +7,10 @@ type FileIOType = byte
 const (
 	StandardFIO FileIOType = iota
 	MemoryMap
+	DirectFIO         // 直接IO 绕过页缓存
+	AsyncFIO          // 直接IO写入 批量异步读取
+	WritableMemoryMap // 可写MMap 文件预分配空间
+	UringFIO          // 直接IO写入 读请求批量提交到io_uring
 )
 
 // IOManager 抽象IO管理接口
@@ -27,12 +31,30 @@ type IOManager interface {
 	Size() (int64, error)
 }
 
+// Truncater 可以截断的IO管理器 预分配空间的文件需要设置实际数据长度
+type Truncater interface {
+	Truncate(size int64) error
+}
+
 func NewIOManager(fileName string, ioType FileIOType) (IOManager, error) {
+	return NewIOManagerWithSize(fileName, ioType, 0)
+}
+
+// NewIOManagerWithSize 创建IO管理器 prealloc为写入时预分配的文件大小 只对可写MMap有效
+func NewIOManagerWithSize(fileName string, ioType FileIOType, prealloc int64) (IOManager, error) {
 	switch ioType {
 	case StandardFIO:
 		return NewFileIOManager(fileName)
 	case MemoryMap:
 		return NewMMapIOManager(fileName)
+	case DirectFIO:
+		return NewDirectIOManager(fileName)
+	case AsyncFIO:
+		return NewAsyncIOManager(fileName)
+	case WritableMemoryMap:
+		return NewWritableMMapIOManager(fileName, prealloc)
+	case UringFIO:
+		return NewUringIOManager(fileName)
 	default:
 		panic("unsupported io type")
 	}
@@ -0,0 +1,19 @@
+package fio
+
+import (
+	"os"
+	"syscall"
+)
+
+// 为文件分配[from, to)的磁盘空间 并将文件长度扩展到to
+// 文件系统不支持fallocate时只扩展文件长度
+func preallocate(fd *os.File, from, to int64) error {
+	if to <= from {
+		return nil
+	}
+	err := syscall.Fallocate(int(fd.Fd()), 0, from, to-from)
+	if err == syscall.EOPNOTSUPP || err == syscall.ENOSYS {
+		return fd.Truncate(to)
+	}
+	return err
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package fio
+
+import "os"
+
+// 其他平台只扩展文件长度
+func preallocate(fd *os.File, from, to int64) error {
+	if to <= from {
+		return nil
+	}
+	return fd.Truncate(to)
+}
@@ -0,0 +1,107 @@
+package fio
+
+import (
+	"os"
+	"sync"
+)
+
+/// 批量读取 并发到达的读请求由一个协程收集后一起提交
+/// 默认由多个协程并发读取 显式启用时在Linux上提交到io_uring
+
+const maxReadBatch = 64 // 单次提交的最大请求数
+
+// ReadRequest 读请求 结果保存在N和Err中
+type ReadRequest struct {
+	Buf    []byte
+	Offset int64
+	N      int
+	Err    error
+
+	fd   *os.File
+	done chan struct{}
+}
+
+type batchReader struct {
+	reqCh  chan *ReadRequest
+	submit func(reqs []*ReadRequest) // 执行一批请求 返回时全部请求已完成
+}
+
+var (
+	batchReaderOnce sync.Once
+	sharedReader    *batchReader
+	uringReaderOnce sync.Once
+	uringReader     *batchReader
+)
+
+// 所有异步IO管理器共享一个批量读取器
+func defaultBatchReader() *batchReader {
+	batchReaderOnce.Do(func() {
+		sharedReader = newBatchReader(readParallel)
+	})
+	return sharedReader
+}
+
+// 所有io_uring管理器共享一个批量读取器
+func uringBatchReader() *batchReader {
+	uringReaderOnce.Do(func() {
+		uringReader = newBatchReader(newUringSubmitter())
+	})
+	return uringReader
+}
+
+func newBatchReader(submit func(reqs []*ReadRequest)) *batchReader {
+	r := &batchReader{
+		reqCh:  make(chan *ReadRequest, maxReadBatch),
+		submit: submit,
+	}
+	go r.dispatch()
+	return r
+}
+
+// 提交读请求并等待完成
+func (r *batchReader) read(fd *os.File, req *ReadRequest) {
+	req.fd = fd
+	req.done = make(chan struct{})
+	r.reqCh <- req
+	<-req.done
+}
+
+// 收集已到达的请求 一批一批地提交
+func (r *batchReader) dispatch() {
+	batch := make([]*ReadRequest, 0, maxReadBatch)
+	for req := range r.reqCh {
+		batch = append(batch[:0], req)
+	collect:
+		for len(batch) < maxReadBatch {
+			select {
+			case req = <-r.reqCh:
+				batch = append(batch, req)
+			default:
+				break collect
+			}
+		}
+
+		r.submit(batch)
+		for _, req = range batch {
+			close(req.done)
+		}
+	}
+}
+
+// 每个请求使用一个协程读取
+func readParallel(reqs []*ReadRequest) {
+	if len(reqs) == 1 {
+		reqs[0].N, reqs[0].Err = reqs[0].fd.ReadAt(reqs[0].Buf, reqs[0].Offset)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, req := range reqs {
+		wg.Add(1)
+		go func(req *ReadRequest) {
+			defer wg.Done()
+			req.N, req.Err = req.fd.ReadAt(req.Buf, req.Offset)
+		}(req)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,203 @@
+package fio
+
+import (
+	"io"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+)
+
+/// io_uring 提交队列和完成队列通过mmap与内核共享
+/// 一批请求写入提交队列后调用一次io_uring_enter提交并等待全部完成
+/// 只在显式选择URING类型时使用 内核不支持或被禁止(ENOSYS、EPERM等)时退化为多个协程并发读取
+
+const (
+	// 5.1之后新增的系统调用在各个架构上使用相同的编号
+	sysIOUringSetup = 425
+	sysIOUringEnter = 426
+
+	ioringOffSqRing = 0
+	ioringOffCqRing = 0x8000000
+	ioringOffSqes   = 0x10000000
+
+	ioringOpRead         = 22
+	ioringEnterGetEvents = 1
+
+	sqeSize = 64
+	cqeSize = 16
+)
+
+type sqringOffsets struct {
+	head, tail, ringMask, ringEntries, flags, dropped, array, resv1 uint32
+	userAddr                                                        uint64
+}
+
+type cqringOffsets struct {
+	head, tail, ringMask, ringEntries, overflow, cqes, flags, resv1 uint32
+	userAddr                                                        uint64
+}
+
+type uringParams struct {
+	sqEntries, cqEntries, flags, sqThreadCPU, sqThreadIdle, features, wqFd uint32
+	resv                                                                   [3]uint32
+	sqOff                                                                  sqringOffsets
+	cqOff                                                                  cqringOffsets
+}
+
+type uring struct {
+	fd     int
+	params uringParams
+	sqRing []byte
+	cqRing []byte
+	sqes   []byte
+	broken bool // 内核不支持读操作 之后全部使用协程读取
+}
+
+func newUringSubmitter() func(reqs []*ReadRequest) {
+	ring, err := newUring(maxReadBatch)
+	if err != nil {
+		return readParallel
+	}
+	return ring.submit
+}
+
+func newUring(entries uint32) (*uring, error) {
+	r := &uring{}
+	fd, _, errno := syscall.Syscall(sysIOUringSetup, uintptr(entries), uintptr(unsafe.Pointer(&r.params)), 0)
+	if errno != 0 {
+		return nil, errno
+	}
+	r.fd = int(fd)
+
+	p := &r.params
+	var err error
+	r.sqRing, err = syscall.Mmap(r.fd, ioringOffSqRing, int(p.sqOff.array+p.sqEntries*4),
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED|syscall.MAP_POPULATE)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	r.cqRing, err = syscall.Mmap(r.fd, ioringOffCqRing, int(p.cqOff.cqes+p.cqEntries*cqeSize),
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED|syscall.MAP_POPULATE)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	r.sqes, err = syscall.Mmap(r.fd, ioringOffSqes, int(p.sqEntries*sqeSize),
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED|syscall.MAP_POPULATE)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *uring) close() {
+	for _, m := range [][]byte{r.sqRing, r.cqRing, r.sqes} {
+		if m != nil {
+			_ = syscall.Munmap(m)
+		}
+	}
+	_ = syscall.Close(r.fd)
+}
+
+func ringUint32(ring []byte, off uint32) *uint32 {
+	return (*uint32)(unsafe.Pointer(&ring[off]))
+}
+
+func (r *uring) enter(toSubmit, minComplete uint32) error {
+	for {
+		_, _, errno := syscall.Syscall6(sysIOUringEnter, uintptr(r.fd), uintptr(toSubmit), uintptr(minComplete),
+			ioringEnterGetEvents, 0, 0)
+		if errno == syscall.EINTR {
+			continue
+		}
+		if errno != 0 {
+			return errno
+		}
+		return nil
+	}
+}
+
+// 提交一批读请求 只被批量读取器的分发协程调用
+func (r *uring) submit(reqs []*ReadRequest) {
+	if r.broken {
+		readParallel(reqs)
+		return
+	}
+
+	p := &r.params
+	sqMask := *ringUint32(r.sqRing, p.sqOff.ringMask)
+	sqTail := ringUint32(r.sqRing, p.sqOff.tail)
+	tail := atomic.LoadUint32(sqTail)
+
+	for i, req := range reqs {
+		idx := tail & sqMask
+		sqe := r.sqes[idx*sqeSize : (idx+1)*sqeSize]
+		for j := range sqe {
+			sqe[j] = 0
+		}
+		sqe[0] = ioringOpRead
+		*(*int32)(unsafe.Pointer(&sqe[4])) = int32(req.fd.Fd())
+		*(*uint64)(unsafe.Pointer(&sqe[8])) = uint64(req.Offset)
+		if len(req.Buf) > 0 {
+			*(*uint64)(unsafe.Pointer(&sqe[16])) = uint64(uintptr(unsafe.Pointer(&req.Buf[0])))
+		}
+		*(*uint32)(unsafe.Pointer(&sqe[24])) = uint32(len(req.Buf))
+		*(*uint64)(unsafe.Pointer(&sqe[32])) = uint64(i)
+		*ringUint32(r.sqRing, p.sqOff.array+idx*4) = idx
+		tail++
+	}
+	atomic.StoreUint32(sqTail, tail)
+
+	n := uint32(len(reqs))
+	if err := r.enter(n, n); err != nil {
+		r.broken = true
+		readParallel(reqs)
+		return
+	}
+
+	cqMask := *ringUint32(r.cqRing, p.cqOff.ringMask)
+	cqHead := ringUint32(r.cqRing, p.cqOff.head)
+	cqTail := ringUint32(r.cqRing, p.cqOff.tail)
+	var retry []*ReadRequest
+
+	for completed := uint32(0); completed < n; {
+		head := atomic.LoadUint32(cqHead)
+		if head == atomic.LoadUint32(cqTail) {
+			// 已提交的请求仍在内核中 出错也只能继续等待其完成
+			_ = r.enter(0, n-completed)
+			continue
+		}
+
+		cqe := r.cqRing[p.cqOff.cqes+(head&cqMask)*cqeSize:]
+		req := reqs[*(*uint64)(unsafe.Pointer(&cqe[0]))]
+		res := *(*int32)(unsafe.Pointer(&cqe[8]))
+		atomic.StoreUint32(cqHead, head+1)
+		completed++
+
+		switch {
+		case res == -int32(syscall.EINVAL) || res == -int32(syscall.EOPNOTSUPP):
+			// 内核不支持IORING_OP_READ
+			r.broken = true
+			retry = append(retry, req)
+		case res < 0:
+			req.N, req.Err = 0, syscall.Errno(-res)
+		case int(res) < len(req.Buf):
+			// 读取不完整 剩余部分同步读取 到达文件末尾时返回io.EOF
+			m, err := req.fd.ReadAt(req.Buf[res:], req.Offset+int64(res))
+			req.N, req.Err = int(res)+m, err
+			if req.N < len(req.Buf) && req.Err == nil {
+				req.Err = io.EOF
+			}
+		default:
+			req.N, req.Err = int(res), nil
+		}
+	}
+	runtime.KeepAlive(reqs)
+
+	if len(retry) > 0 {
+		readParallel(retry)
+	}
+}
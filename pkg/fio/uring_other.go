@@ -0,0 +1,8 @@
+//go:build !linux
+
+package fio
+
+// 其他平台不支持io_uring 使用多个协程并发读取
+func newUringSubmitter() func(reqs []*ReadRequest) {
+	return readParallel
+}
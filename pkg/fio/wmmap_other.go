@@ -0,0 +1,36 @@
+//go:build !unix
+
+package fio
+
+import "github.com/T4t4KAU/TikBase/pkg/errno"
+
+// WritableMMap 非unix平台不支持可写MMap
+type WritableMMap struct{}
+
+func NewWritableMMapIOManager(fileName string, prealloc int64) (*WritableMMap, error) {
+	return nil, errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Read(b []byte, offset int64) (int, error) {
+	return 0, errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Write(b []byte) (int, error) {
+	return 0, errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Sync() error {
+	return errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Truncate(size int64) error {
+	return errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Close() error {
+	return errno.ErrIOTypeNotSupported
+}
+
+func (m *WritableMMap) Size() (int64, error) {
+	return 0, errno.ErrIOTypeNotSupported
+}
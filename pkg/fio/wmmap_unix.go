@@ -0,0 +1,182 @@
+//go:build unix
+
+package fio
+
+import (
+	"io"
+	"os"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+/// 可写MMap 文件预先分配空间后整体映射到内存 读写直接访问映射区域
+/// 文件长度为预分配的长度 实际数据长度单独记录 关闭时截断到实际长度
+/// 预分配部分全部为0 异常退出后读取日志时遇到全0的记录头即认为到达文件末尾
+
+const mmapMinGrow = 1 << 20 // 未指定预分配大小时 每次至少扩展1MB
+
+// WritableMMap 可写MMap IO管理器
+type WritableMMap struct {
+	mutex    sync.RWMutex
+	fd       *os.File
+	data     []byte // 映射区域
+	size     int64  // 实际数据长度
+	fileSize int64  // 文件长度 包括预分配的部分
+	prealloc int64  // 预分配大小
+}
+
+// NewWritableMMapIOManager 打开文件并映射到内存 文件长度不足prealloc时预分配空间
+func NewWritableMMapIOManager(fileName string, prealloc int64) (*WritableMMap, error) {
+	fd, err := os.OpenFile(fileName, os.O_CREATE|os.O_RDWR, DataFilePerm)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fd.Stat()
+	if err != nil {
+		_ = fd.Close()
+		return nil, err
+	}
+
+	m := &WritableMMap{
+		fd:       fd,
+		size:     stat.Size(),
+		fileSize: stat.Size(),
+		prealloc: prealloc,
+	}
+	if err = m.grow(0); err != nil {
+		_ = fd.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+// 保证文件和映射区域能够容纳追加的n字节
+// 访问此方法前要持有写锁
+func (m *WritableMMap) grow(n int64) error {
+	need := m.size + n
+	if need <= m.fileSize && need <= int64(len(m.data)) && len(m.data) > 0 {
+		return nil
+	}
+
+	fileSize := m.fileSize
+	if need > fileSize {
+		fileSize = m.prealloc
+		if fileSize < need {
+			fileSize = need
+			if grow := m.fileSize * 2; grow > fileSize {
+				fileSize = grow
+			}
+			if grow := m.size + mmapMinGrow; grow > fileSize {
+				fileSize = grow
+			}
+		}
+		if err := preallocate(m.fd, m.fileSize, fileSize); err != nil {
+			return err
+		}
+		m.fileSize = fileSize
+	}
+	if fileSize == 0 || int64(len(m.data)) >= fileSize {
+		return nil
+	}
+
+	// 重新映射整个文件
+	if m.data != nil {
+		if err := syscall.Munmap(m.data); err != nil {
+			return err
+		}
+		m.data = nil
+	}
+	data, err := syscall.Mmap(int(m.fd.Fd()), 0, int(fileSize), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return err
+	}
+	m.data = data
+	return nil
+}
+
+func (m *WritableMMap) Read(b []byte, offset int64) (int, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if offset >= m.size {
+		return 0, io.EOF
+	}
+	n := copy(b, m.data[offset:m.size])
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Write 追加数据 空间不足时扩展文件并重新映射
+func (m *WritableMMap) Write(b []byte) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.grow(int64(len(b))); err != nil {
+		return 0, err
+	}
+	n := copy(m.data[m.size:], b)
+	m.size += int64(n)
+	return n, nil
+}
+
+// Sync 将映射区域中的修改写入磁盘
+func (m *WritableMMap) Sync() error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if len(m.data) == 0 {
+		return nil
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_MSYNC, uintptr(unsafe.Pointer(&m.data[0])), uintptr(len(m.data)), syscall.MS_SYNC)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// Truncate 设置实际数据长度并截断文件 之后的写入从该位置开始
+// 用于异常退出后 丢弃预分配部分或不完整的数据
+func (m *WritableMMap) Truncate(size int64) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if size > m.fileSize {
+		return io.ErrUnexpectedEOF
+	}
+	if err := m.fd.Truncate(size); err != nil {
+		return err
+	}
+	// 映射区域超出文件长度的部分不再访问 下次写入时重新扩展
+	m.size = size
+	m.fileSize = size
+	return nil
+}
+
+// Close 解除映射 并将文件截断到实际长度
+func (m *WritableMMap) Close() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.data != nil {
+		if err := syscall.Munmap(m.data); err != nil {
+			return err
+		}
+		m.data = nil
+	}
+	if m.size < m.fileSize {
+		if err := m.fd.Truncate(m.size); err != nil {
+			return err
+		}
+	}
+	return m.fd.Close()
+}
+
+func (m *WritableMMap) Size() (int64, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.size, nil
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"bytes"
+	"github.com/T4t4KAU/TikBase/pkg/net/http/router"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
+	"io"
+	"net/http"
+)
+
+// 以SSE的形式推送订阅的消息 通过查询参数pattern指定主题模式 可指定多个
+func (s *Server) subscribeHandler(ctx *router.Context) {
+	patterns := ctx.Req.URL.Query()["pattern"]
+	if len(patterns) == 0 {
+		ctx.Writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := ctx.Writer.(http.Flusher)
+	if !ok {
+		ctx.Writer.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	sub := s.queue.SubscribeTopic(patterns...)
+	defer s.queue.Evict(sub)
+
+	header := ctx.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	ctx.Writer.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case msg, ok := <-sub:
+			if !ok {
+				return
+			}
+			if err := writeEvent(ctx.Writer, msg); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ctx.Req.Context().Done():
+			// 客户端断开连接
+			return
+		}
+	}
+}
+
+// 按SSE格式写入一条消息 多行内容拆分为多个data字段
+func writeEvent(w io.Writer, msg queue.Message) error {
+	var buf bytes.Buffer
+	buf.WriteString("event: " + msg.Topic + "\n")
+	for _, line := range bytes.Split(msg.Bytes(), []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (s *Server) publishHandler(ctx *router.Context) {
+	topic := ctx.Params.ByName("topic")
+	data, err := io.ReadAll(ctx.Req.Body)
+	if err != nil {
+		ctx.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s.queue.Publish(queue.Message{
+		Topic: topic,
+		Data:  data,
+	})
+	ctx.Writer.WriteHeader(http.StatusOK)
+}
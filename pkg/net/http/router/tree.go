@@ -12,21 +12,27 @@ type node struct {
 	isWild   bool    // 是否精确匹配
 }
 
-// 第一个匹配成功的节点 用于插入
+// 部分完全相同的节点 用于插入
+// 静态路由和参数路由分别插入 避免静态路由覆盖参数路由
 func (n *node) matchChild(part string) *node {
 	for _, child := range n.children {
-		if child.part == part || child.isWild {
+		if child.part == part {
 			return child
 		}
 	}
 	return nil
 }
 
-// 所有匹配成功的节点 用于查找
+// 所有匹配成功的节点 用于查找 静态路由优先
 func (n *node) matchChildren(part string) []*node {
 	nodes := make([]*node, 0)
 	for _, child := range n.children {
-		if child.part == part || child.isWild {
+		if child.part == part && !child.isWild {
+			nodes = append(nodes, child)
+		}
+	}
+	for _, child := range n.children {
+		if child.isWild {
 			nodes = append(nodes, child)
 		}
 	}
@@ -1,28 +1,46 @@
 package http
 
 import (
+	"github.com/T4t4KAU/TikBase/engine"
 	"github.com/T4t4KAU/TikBase/engine/values"
 	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
 	"github.com/T4t4KAU/TikBase/pkg/net/http/router"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 )
 
-func StartServer(address string, eng iface.Engine) error {
-	return NewServer(eng).Run(address)
+func StartServer(address string, eng iface.Engine, mq *queue.MessageQueue) error {
+	return NewServer(eng, mq).Run(address)
 }
 
 type Server struct {
-	engine iface.Engine
+	engine  iface.Engine
+	queue   *queue.MessageQueue // 消息队列 为空时不提供发布订阅接口
+	barrier iface.ReadBarrier   // 读屏障 为空时直接读取本地数据
+	applier iface.Applier       // 写请求通过复制状态机提交 为空时直接写入本地数据
 }
 
-func NewServer(eng iface.Engine) *Server {
+func NewServer(eng iface.Engine, mq *queue.MessageQueue) *Server {
 	return &Server{
 		engine: eng,
+		queue:  mq,
 	}
 }
 
+// SetReadBarrier 设置读屏障 指定了请求头Consistency的读请求按该一致性级别读取
+func (s *Server) SetReadBarrier(barrier iface.ReadBarrier) {
+	s.barrier = barrier
+}
+
+// SetApplier 设置写请求的提交方式 集群模式下写请求必须通过raft复制
+func (s *Server) SetApplier(applier iface.Applier) {
+	s.applier = applier
+}
+
 func (s *Server) Run(address string) error {
 	return http.ListenAndServe(address, s.routerHandler())
 }
@@ -34,6 +52,12 @@ func (s *Server) routerHandler() *router.Router {
 	r.DELETE("/store/:key", s.deleteHandler)
 	r.GET("/store/status", s.statusHandler)
 	r.GET("/store/echo/:key", s.echoHandler)
+	r.GET("/db/:db/size", s.dbSizeHandler)
+	r.DELETE("/db/:db", s.flushDBHandler)
+	if s.queue != nil {
+		r.GET("/pubsub/subscribe", s.subscribeHandler)
+		r.POST("/pubsub/publish/:topic", s.publishHandler)
+	}
 	return r
 }
 
@@ -45,6 +69,74 @@ func parseTTL(request *http.Request) (int64, error) {
 	return strconv.ParseInt(ttls[0], 10, 64)
 }
 
+// 请求头Db指定逻辑数据库 未指定时使用默认数据库
+func (s *Server) dbOf(request *http.Request) iface.Engine {
+	return engine.Select(s.engine, request.Header.Get("Db"))
+}
+
+// 按请求头指定的一致性级别等待读屏障 不能读取时写入响应状态码并返回false
+// Consistency: default/stale/consistent Max-Staleness: 跟随者读允许落后的毫秒数
+// 未指定Consistency时直接读取本地数据 跟随者也可以响应
+// key不为空时只等待key所在分区的读屏障 为空时等待全部分区
+func (s *Server) readBarrier(ctx *router.Context, key []byte) bool {
+	consistency := ctx.Req.Header.Get("Consistency")
+	if s.barrier == nil || consistency == "" {
+		return true
+	}
+
+	level, err := iface.ParseConsistency(consistency)
+	if err != nil {
+		ctx.Writer.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	var maxStaleness int64
+	if v := ctx.Req.Header.Get("Max-Staleness"); v != "" {
+		maxStaleness, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || maxStaleness < 0 {
+			ctx.Writer.WriteHeader(http.StatusBadRequest)
+			return false
+		}
+	}
+
+	staleness := time.Duration(maxStaleness) * time.Millisecond
+	if kb, ok := s.barrier.(iface.KeyReadBarrier); ok && key != nil {
+		err = kb.KeyReadBarrier(key, level, staleness)
+	} else {
+		err = s.barrier.ReadBarrier(level, staleness)
+	}
+	switch err {
+	case nil:
+		return true
+	case errno.ErrNotLeader:
+		ctx.Writer.WriteHeader(http.StatusMisdirectedRequest)
+	default:
+		ctx.Writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = ctx.Writer.Write([]byte(err.Error()))
+	return false
+}
+
+// 执行写命令 设置了Applier时通过复制状态机提交 不能提交时写入响应状态码并返回false
+// 当前节点不是领导者且无法转发时返回421 响应体为领导者的地址
+func (s *Server) exec(ctx *router.Context, c iface.Command) (iface.Result, bool) {
+	if s.applier == nil {
+		return engine.Select(s.engine, c.DB).Exec(c.Ins, c.Args()), true
+	}
+
+	res, leader, err := s.applier.Apply(ctx.Req.Context(), c)
+	if leader != "" {
+		ctx.Writer.WriteHeader(http.StatusMisdirectedRequest)
+		_, _ = ctx.Writer.Write([]byte(leader))
+		return nil, false
+	}
+	if err != nil {
+		ctx.Writer.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = ctx.Writer.Write([]byte(err.Error()))
+		return nil, false
+	}
+	return res, true
+}
+
 func (s *Server) setHandler(ctx *router.Context) {
 	key := ctx.Params.ByName("key")
 	val, err := io.ReadAll(ctx.Req.Body)
@@ -53,8 +145,10 @@ func (s *Server) setHandler(ctx *router.Context) {
 		return
 	}
 
-	args := [][]byte{[]byte(key), val}
-	res := s.engine.Exec(iface.SET_STR, args)
+	res, ok := s.exec(ctx, iface.Command{Ins: iface.SET_STR, Key: key, Value: val, DB: ctx.Req.Header.Get("Db")})
+	if !ok {
+		return
+	}
 	if !res.Success() {
 		ctx.Writer.WriteHeader(http.StatusInternalServerError)
 		return
@@ -64,7 +158,10 @@ func (s *Server) setHandler(ctx *router.Context) {
 
 func (s *Server) getHandler(ctx *router.Context) {
 	key := ctx.Params.ByName("key")
-	res := s.engine.Exec(iface.GET_STR, [][]byte{[]byte(key)})
+	if !s.readBarrier(ctx, []byte(iface.DBKey(ctx.Req.Header.Get("Db"), key))) {
+		return
+	}
+	res := s.dbOf(ctx.Req).Exec(iface.GET_STR, [][]byte{[]byte(key)})
 	if !res.Success() {
 		ctx.Writer.WriteHeader(http.StatusNotFound)
 		return
@@ -74,7 +171,10 @@ func (s *Server) getHandler(ctx *router.Context) {
 
 func (s *Server) deleteHandler(ctx *router.Context) {
 	key := ctx.Params.ByName("key")
-	res := s.engine.Exec(iface.DEL, [][]byte{[]byte(key)})
+	res, ok := s.exec(ctx, iface.Command{Ins: iface.DEL, Key: key, DB: ctx.Req.Header.Get("Db")})
+	if !ok {
+		return
+	}
 	if !res.Success() {
 		ctx.Writer.WriteHeader(http.StatusNotFound)
 		return
@@ -88,9 +188,33 @@ func (s *Server) statusHandler(ctx *router.Context) {
 
 func (s *Server) echoHandler(ctx *router.Context) {
 	key := ctx.Params.ByName("key")
-	res := s.engine.Exec(iface.ECHO, [][]byte{[]byte(key)})
+	res := s.dbOf(ctx.Req).Exec(iface.ECHO, [][]byte{[]byte(key)})
 	if !res.Success() {
 		ctx.Writer.WriteHeader(http.StatusBadGateway)
 	}
 	_, _ = ctx.Writer.Write(res.Data())
 }
+
+func (s *Server) dbSizeHandler(ctx *router.Context) {
+	if !s.readBarrier(ctx, nil) {
+		return
+	}
+	res := engine.Select(s.engine, ctx.Params.ByName("db")).Exec(iface.DB_SIZE, nil)
+	if !res.Success() {
+		ctx.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = ctx.Writer.Write(res.Data())
+}
+
+func (s *Server) flushDBHandler(ctx *router.Context) {
+	res, ok := s.exec(ctx, iface.Command{Ins: iface.FLUSH_DB, DB: ctx.Params.ByName("db")})
+	if !ok {
+		return
+	}
+	if !res.Success() {
+		ctx.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	ctx.Writer.WriteHeader(http.StatusOK)
+}
@@ -1,13 +1,20 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/T4t4KAU/TikBase/engine"
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
+	"github.com/stretchr/testify/assert"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -63,7 +70,7 @@ func SendGetReq(key string) (int, []byte, error) {
 
 func startServer() error {
 	eng, _ := engine.NewCacheEngine()
-	s := NewServer(eng)
+	s := NewServer(eng, queue.New(queue.DefaultConfig))
 	err := s.Run(":9096")
 	if err != nil {
 		log.Fatalln("server start failed:", err)
@@ -122,3 +129,205 @@ func TestServer_Run(t *testing.T) {
 	}
 	println(code, string(data))
 }
+
+func TestServer_PubSub(t *testing.T) {
+	eng, _ := engine.NewCacheEngine()
+	mq := queue.New(queue.DefaultConfig)
+	eng.SetNotifier(queue.NewNotifier(mq))
+
+	ts := httptest.NewServer(NewServer(eng, mq).routerHandler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/pubsub/subscribe?pattern=__keyevent__:set&pattern=news")
+	assert.Nil(t, err)
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	res := eng.Exec(iface.SET_STR, [][]byte{[]byte("key"), []byte("value")})
+	assert.True(t, res.Success())
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	assert.Nil(t, err)
+	assert.Equal(t, "event: __keyevent__:set\n", line)
+	line, err = reader.ReadString('\n')
+	assert.Nil(t, err)
+	assert.Contains(t, line, `"key":"key"`)
+	_, _ = reader.ReadString('\n')
+
+	pub, err := http.Post(ts.URL+"/pubsub/publish/news", "text/plain", bytes.NewBufferString("hello"))
+	assert.Nil(t, err)
+	_ = pub.Body.Close()
+	assert.Equal(t, http.StatusOK, pub.StatusCode)
+
+	line, _ = reader.ReadString('\n')
+	assert.Equal(t, "event: news\n", line)
+	line, _ = reader.ReadString('\n')
+	assert.Equal(t, "data: hello\n", line)
+}
+
+func TestServer_SelectDB(t *testing.T) {
+	eng, _ := engine.NewCacheEngine()
+	ts := httptest.NewServer(NewServer(eng, nil).routerHandler())
+	defer ts.Close()
+
+	do := func(method, path, db string, body []byte) (int, string) {
+		request, err := http.NewRequest(method, ts.URL+path, bytes.NewBuffer(body))
+		assert.Nil(t, err)
+		if db != "" {
+			request.Header.Set("Db", db)
+		}
+		resp, err := http.DefaultClient.Do(request)
+		assert.Nil(t, err)
+		defer func(Body io.ReadCloser) {
+			_ = Body.Close()
+		}(resp.Body)
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(data)
+	}
+
+	code, _ := do(http.MethodPut, "/store/db-key", "2", []byte("value"))
+	assert.Equal(t, http.StatusCreated, code)
+
+	code, _ = do(http.MethodGet, "/store/db-key", "", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+	_, data := do(http.MethodGet, "/store/db-key", "2", nil)
+	assert.Equal(t, "value", data)
+
+	_, data = do(http.MethodGet, "/db/2/size", "", nil)
+	assert.Equal(t, "1", data)
+	code, _ = do(http.MethodDelete, "/db/2", "", nil)
+	assert.Equal(t, http.StatusOK, code)
+	_, data = do(http.MethodGet, "/db/2/size", "", nil)
+	assert.Equal(t, "0", data)
+}
+
+type testBarrier struct {
+	key          []byte
+	level        iface.ConsistencyLevel
+	maxStaleness time.Duration
+	err          error
+}
+
+func (b *testBarrier) ReadBarrier(level iface.ConsistencyLevel, maxStaleness time.Duration) error {
+	b.key, b.level, b.maxStaleness = nil, level, maxStaleness
+	return b.err
+}
+
+func (b *testBarrier) KeyReadBarrier(key []byte, level iface.ConsistencyLevel, maxStaleness time.Duration) error {
+	b.key, b.level, b.maxStaleness = key, level, maxStaleness
+	return b.err
+}
+
+func TestServer_Consistency(t *testing.T) {
+	eng, _ := engine.NewCacheEngine()
+	assert.True(t, eng.Exec(iface.SET_STR, [][]byte{[]byte("key"), []byte("value")}).Success())
+
+	barrier := &testBarrier{}
+	s := NewServer(eng, nil)
+	s.SetReadBarrier(barrier)
+	ts := httptest.NewServer(s.routerHandler())
+	defer ts.Close()
+
+	get := func(consistency, staleness string) int {
+		request, err := http.NewRequest(http.MethodGet, ts.URL+"/store/key", nil)
+		assert.Nil(t, err)
+		request.Header.Set("Consistency", consistency)
+		request.Header.Set("Max-Staleness", staleness)
+		resp, err := http.DefaultClient.Do(request)
+		assert.Nil(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get("default", ""))
+	assert.Equal(t, iface.ReadDefault, barrier.level)
+	assert.Equal(t, []byte("key"), barrier.key) // 读取单个key时只等待key所在的分区
+
+	assert.Equal(t, http.StatusOK, get("stale", "500"))
+	assert.Equal(t, iface.ReadStale, barrier.level)
+	assert.Equal(t, 500*time.Millisecond, barrier.maxStaleness)
+
+	assert.Equal(t, http.StatusBadRequest, get("linear", ""))
+	assert.Equal(t, http.StatusBadRequest, get("stale", "-1"))
+
+	barrier.err = errno.ErrNotLeader
+	assert.Equal(t, http.StatusMisdirectedRequest, get("consistent", ""))
+	assert.Equal(t, iface.ReadConsistent, barrier.level)
+
+	// 未指定一致性级别时不经过读屏障 跟随者直接读取本地数据
+	barrier.level = iface.ReadStale
+	assert.Equal(t, http.StatusOK, get("", ""))
+	assert.Equal(t, iface.ReadStale, barrier.level)
+
+	barrier.err = errno.ErrStaleRead
+	assert.Equal(t, http.StatusServiceUnavailable, get("stale", "10"))
+
+	// 统计逻辑数据库时等待全部分区
+	barrier.err = nil
+	request, err := http.NewRequest(http.MethodGet, ts.URL+"/db/0/size", nil)
+	assert.Nil(t, err)
+	request.Header.Set("Consistency", "consistent")
+	resp, err := http.DefaultClient.Do(request)
+	assert.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Nil(t, barrier.key)
+}
+
+type testApplier struct {
+	commands []iface.Command
+	leader   string
+}
+
+func (a *testApplier) Apply(_ context.Context, c iface.Command) (iface.Result, string, error) {
+	if a.leader != "" {
+		return nil, a.leader, nil
+	}
+	a.commands = append(a.commands, c)
+	return engine.NewSuccBaseResult(), "", nil
+}
+
+func TestServer_Apply(t *testing.T) {
+	eng, _ := engine.NewCacheEngine()
+	applier := &testApplier{}
+	s := NewServer(eng, nil)
+	s.SetApplier(applier)
+	ts := httptest.NewServer(s.routerHandler())
+	defer ts.Close()
+
+	do := func(method, path string, body []byte) (int, string) {
+		request, err := http.NewRequest(method, ts.URL+path, bytes.NewBuffer(body))
+		assert.Nil(t, err)
+		request.Header.Set("Db", "2")
+		resp, err := http.DefaultClient.Do(request)
+		assert.Nil(t, err)
+		defer func(Body io.ReadCloser) {
+			_ = Body.Close()
+		}(resp.Body)
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(data)
+	}
+
+	// 写请求通过复制状态机提交 不写入本地存储引擎
+	code, _ := do(http.MethodPut, "/store/key", []byte("value"))
+	assert.Equal(t, http.StatusCreated, code)
+	code, _ = do(http.MethodDelete, "/store/key", nil)
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = do(http.MethodDelete, "/db/3", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []iface.Command{
+		{Ins: iface.SET_STR, Key: "key", Value: []byte("value"), DB: "2"},
+		{Ins: iface.DEL, Key: "key", DB: "2"},
+		{Ins: iface.FLUSH_DB, DB: "3"},
+	}, applier.commands)
+	assert.False(t, engine.Select(eng, "2").Exec(iface.GET_STR, [][]byte{[]byte("key")}).Success())
+
+	// 无法转发给领导者时返回领导者地址
+	applier.leader = "127.0.0.1:9000"
+	code, data := do(http.MethodPut, "/store/key", []byte("value"))
+	assert.Equal(t, http.StatusMisdirectedRequest, code)
+	assert.Equal(t, "127.0.0.1:9000", data)
+}
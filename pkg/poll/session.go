@@ -0,0 +1,116 @@
+package poll
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/// 长轮询会话 客户端打开会话后通过会话ID反复拉取数据
+/// 长时间未拉取的会话视为已放弃 定期清理
+
+// Sessions 长轮询会话集合
+type Sessions[T any] struct {
+	mutex   sync.Mutex
+	items   map[string]*session[T]
+	nextId  uint64
+	idle    time.Duration // 空闲超时时间
+	onClose func(T)       // 会话关闭时调用
+}
+
+type session[T any] struct {
+	value  T
+	active int64 // 最近一次拉取的时间
+}
+
+// NewSessions 创建会话集合 onClose可以为空
+func NewSessions[T any](idle time.Duration, onClose func(T)) *Sessions[T] {
+	return &Sessions[T]{
+		items:   make(map[string]*session[T]),
+		idle:    idle,
+		onClose: onClose,
+	}
+}
+
+// Open 打开会话 返回会话ID
+func (s *Sessions[T]) Open(value T) string {
+	id := strconv.FormatUint(atomic.AddUint64(&s.nextId, 1), 10)
+	sess := &session[T]{value: value, active: time.Now().UnixNano()}
+
+	s.mutex.Lock()
+	s.items[id] = sess
+	s.mutex.Unlock()
+
+	return id
+}
+
+// Get 返回会话并刷新活跃时间
+func (s *Sessions[T]) Get(id string) (T, bool) {
+	s.mutex.Lock()
+	sess, ok := s.items[id]
+	s.mutex.Unlock()
+
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	atomic.StoreInt64(&sess.active, time.Now().UnixNano())
+	return sess.value, true
+}
+
+// Close 关闭会话 会话不存在时返回false
+func (s *Sessions[T]) Close(id string) bool {
+	s.mutex.Lock()
+	sess, ok := s.items[id]
+	delete(s.items, id)
+	s.mutex.Unlock()
+
+	if ok && s.onClose != nil {
+		s.onClose(sess.value)
+	}
+	return ok
+}
+
+// Reap 定期关闭空闲超时的会话 不会返回
+func (s *Sessions[T]) Reap() {
+	ticker := time.NewTicker(s.idle)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.expire(time.Now().Add(-s.idle))
+	}
+}
+
+// 关闭deadline之前没有拉取过的会话
+func (s *Sessions[T]) expire(deadline time.Time) {
+	var expired []T
+
+	s.mutex.Lock()
+	for id, sess := range s.items {
+		if atomic.LoadInt64(&sess.active) < deadline.UnixNano() {
+			delete(s.items, id)
+			expired = append(expired, sess.value)
+		}
+	}
+	s.mutex.Unlock()
+
+	if s.onClose != nil {
+		for _, value := range expired {
+			s.onClose(value)
+		}
+	}
+}
+
+// Limits 返回单次拉取的最大数量和等待时间 请求未指定时使用默认值
+func Limits(max int32, timeout int64, defaultMax int, defaultTimeout time.Duration) (int, time.Duration) {
+	n := int(max)
+	if n <= 0 {
+		n = defaultMax
+	}
+	wait := time.Duration(timeout) * time.Millisecond
+	if wait <= 0 {
+		wait = defaultTimeout
+	}
+	return n, wait
+}
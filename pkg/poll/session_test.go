@@ -0,0 +1,46 @@
+package poll
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSessions(t *testing.T) {
+	var closed []int
+	s := NewSessions[int](time.Minute, func(v int) {
+		closed = append(closed, v)
+	})
+
+	id1, id2 := s.Open(1), s.Open(2)
+	assert.NotEqual(t, id1, id2)
+
+	v, ok := s.Get(id1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	assert.True(t, s.Close(id1))
+	assert.False(t, s.Close(id1))
+	_, ok = s.Get(id1)
+	assert.False(t, ok)
+	assert.Equal(t, []int{1}, closed)
+
+	// 空闲超时的会话被关闭
+	s.expire(time.Now().Add(-time.Hour))
+	_, ok = s.Get(id2)
+	assert.True(t, ok)
+	s.expire(time.Now().Add(time.Hour))
+	_, ok = s.Get(id2)
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 2}, closed)
+}
+
+func TestLimits(t *testing.T) {
+	n, wait := Limits(0, 0, 100, time.Second)
+	assert.Equal(t, 100, n)
+	assert.Equal(t, time.Second, wait)
+
+	n, wait = Limits(10, 500, 100, time.Second)
+	assert.Equal(t, 10, n)
+	assert.Equal(t, 500*time.Millisecond, wait)
+}
@@ -5,19 +5,16 @@ import "time"
 type Config struct {
 	timeout  time.Duration
 	capacity int
-	nworker  int32
 }
 
 var DefaultConfig = Config{
 	timeout:  time.Second,
 	capacity: 10,
-	nworker:  5,
 }
 
-func NewConfig(timeout time.Duration, capacity int, nworker int32) Config {
+func NewConfig(timeout time.Duration, capacity int) Config {
 	return Config{
 		timeout:  timeout,
 		capacity: capacity,
-		nworker:  nworker,
 	}
 }
@@ -1,9 +1,8 @@
 package queue
 
 import (
-	"context"
-	conc "github.com/T4t4KAU/TikBase/pkg/conc/pool"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,16 +25,24 @@ type Queue interface {
 
 type MessageQueue struct {
 	mutex       sync.RWMutex
-	timeout     time.Duration
-	subscribers map[Subscriber]Filter
-	capacity    int        // 队列长度
-	workers     *conc.Pool // 超时时间
+	timeout     time.Duration // 订阅者超过该时间未消费时丢弃消息
+	subscribers map[Subscriber]*subscription
+	capacity    int // 队列长度
+}
+
+// 订阅者 每个订阅者由一个发送协程按发布顺序发送消息
+// 取消订阅时先通知发送协程退出 等待其退出后再关闭通道
+type subscription struct {
+	filter  Filter
+	pending chan Message // 等待发送的消息
+	quit    chan struct{}
+	done    chan struct{}
+	dropped uint64 // 等待队列已满或发送超时而丢弃的消息数
 }
 
 func New(config Config) *MessageQueue {
 	return &MessageQueue{
-		subscribers: make(map[Subscriber]Filter),
-		workers:     conc.NewPool("message queue", config.nworker),
+		subscribers: make(map[Subscriber]*subscription),
 		capacity:    config.capacity,
 		timeout:     config.timeout,
 	}
@@ -44,38 +51,92 @@ func New(config Config) *MessageQueue {
 // Subscribe 发起订阅
 func (mq *MessageQueue) Subscribe(filter Filter) Subscriber {
 	sub := make(Subscriber, mq.capacity)
+	s := &subscription{
+		filter:  filter,
+		pending: make(chan Message, mq.capacity),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
 	mq.mutex.Lock()
-	mq.subscribers[sub] = filter
+	mq.subscribers[sub] = s
 	mq.mutex.Unlock()
+
+	go mq.deliver(sub, s)
 	return sub
 }
 
-// Evict 取消订阅
+// Evict 取消订阅 不等待缓慢的订阅者消费消息
 func (mq *MessageQueue) Evict(sub Subscriber) {
 	mq.mutex.Lock()
-	defer mq.mutex.Unlock()
+	s, ok := mq.subscribers[sub]
 	delete(mq.subscribers, sub)
+	mq.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	close(s.quit)
+	<-s.done
 	close(sub)
 }
 
-// Publish 发布
+// Publish 发布 消息放入各订阅者的等待队列后返回 不等待订阅者消费
+// 同一个订阅者按发布顺序收到消息 等待队列已满时丢弃消息并计数
 func (mq *MessageQueue) Publish(message Message) {
 	mq.mutex.RLock()
 	defer mq.mutex.RUnlock()
 
-	for sub, f := range mq.subscribers {
-		mq.workers.Run(context.Background(), func() {
-			mq.send(sub, f, message)
-		})
+	for _, s := range mq.subscribers {
+		if s.filter != nil && !s.filter(message) {
+			continue
+		}
+		select {
+		case s.pending <- message:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
 	}
 }
 
-func (mq *MessageQueue) send(sub Subscriber, filter Filter, message Message) {
-	if filter != nil && !filter(message) {
-		return
+// Dropped 返回订阅者丢弃的消息数 订阅者依赖完整的消息流时 应在计数增加后重新同步数据
+func (mq *MessageQueue) Dropped(sub Subscriber) uint64 {
+	mq.mutex.RLock()
+	defer mq.mutex.RUnlock()
+
+	s, ok := mq.subscribers[sub]
+	if !ok {
+		return 0
 	}
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// 按顺序发送等待队列中的消息 直到取消订阅
+func (mq *MessageQueue) deliver(sub Subscriber, s *subscription) {
+	defer close(s.done)
+
+	for {
+		select {
+		case message := <-s.pending:
+			if !mq.send(sub, s, message) {
+				return
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// 发送超时时丢弃消息并计数 取消订阅时返回false
+func (mq *MessageQueue) send(sub Subscriber, s *subscription, message Message) bool {
+	timer := time.NewTimer(mq.timeout)
+	defer timer.Stop()
+
 	select {
 	case sub <- message:
-	case <-time.After(mq.timeout):
+	case <-timer.C:
+		atomic.AddUint64(&s.dropped, 1)
+	case <-s.quit:
+		return false
 	}
+	return true
 }
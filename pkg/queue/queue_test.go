@@ -1,6 +1,8 @@
 package queue
 
 import (
+	"github.com/T4t4KAU/TikBase/iface"
+	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
 )
@@ -9,7 +11,6 @@ func TestMessageQueue_Publish(t *testing.T) {
 	q := New(Config{
 		timeout:  time.Second,
 		capacity: 10,
-		nworker:  5,
 	})
 
 	ch := q.Subscribe(func(msg Message) bool {
@@ -36,7 +37,6 @@ func TestMessageQueue_Subscribe(t *testing.T) {
 	q := New(Config{
 		timeout:  time.Second,
 		capacity: 10,
-		nworker:  5,
 	})
 
 	ch := q.Subscribe(func(msg Message) bool {
@@ -59,3 +59,117 @@ func TestMessageQueue_Subscribe(t *testing.T) {
 
 	time.Sleep(60 * time.Second)
 }
+
+func TestMatch(t *testing.T) {
+	assert.True(t, Match("*", "anything"))
+	assert.True(t, Match("__keyspace__:*", "__keyspace__:user:1"))
+	assert.True(t, Match("user:?", "user:1"))
+	assert.True(t, Match("a*b*c", "axxbyyc"))
+	assert.False(t, Match("user:?", "user:10"))
+	assert.False(t, Match("__keyevent__:del", "__keyevent__:set"))
+}
+
+func TestNotifier_Notify(t *testing.T) {
+	q := New(DefaultConfig)
+
+	ch := q.SubscribeTopic(KeyEventTopic(iface.EventSet))
+	defer q.Evict(ch)
+
+	n := NewNotifier(q)
+	n.Notify(iface.Event{Type: iface.EventDel, Key: "key1"})
+	n.Notify(iface.Event{Type: iface.EventSet, Key: "key2"})
+
+	select {
+	case msg := <-ch:
+		assert.Equal(t, KeyEventTopic(iface.EventSet), msg.Topic)
+		assert.Equal(t, "key2", msg.Data.(iface.Event).Key)
+	case <-time.After(time.Second):
+		t.Fatal("event not received")
+	}
+
+	// 发布前去掉逻辑数据库前缀
+	n.Notify(iface.Event{Type: iface.EventSet, Key: iface.DBKey("1", "key3")})
+	select {
+	case msg := <-ch:
+		event := msg.Data.(iface.Event)
+		assert.Equal(t, "key3", event.Key)
+		assert.Equal(t, "1", event.DB)
+	case <-time.After(time.Second):
+		t.Fatal("event not received")
+	}
+}
+
+func TestMessageQueue_Evict(t *testing.T) {
+	q := New(Config{
+		timeout:  time.Minute,
+		capacity: 1,
+	})
+
+	// 不消费消息的订阅者占满队列
+	slow := q.SubscribeTopic("test")
+	fast := q.SubscribeTopic("test")
+	for i := 0; i < 3; i++ {
+		q.Publish(Message{Topic: "test", Data: i})
+		select {
+		case msg := <-fast:
+			assert.Equal(t, i, msg.Data)
+		case <-time.After(time.Second):
+			t.Fatal("message not received")
+		}
+	}
+
+	// 取消订阅不等待发送超时
+	done := make(chan struct{})
+	go func() {
+		q.Evict(slow)
+		q.Evict(fast)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("evict blocked by slow subscriber")
+	}
+	for range slow {
+	}
+
+	// 重复取消订阅不会重复关闭通道
+	q.Evict(slow)
+}
+
+func TestMessageQueue_Order(t *testing.T) {
+	q := New(NewConfig(time.Second, 1000))
+	ch := q.SubscribeTopic("test")
+	defer q.Evict(ch)
+
+	// 同一个订阅者按发布顺序收到消息
+	for i := 0; i < 1000; i++ {
+		q.Publish(Message{Topic: "test", Data: i})
+	}
+	for i := 0; i < 1000; i++ {
+		select {
+		case msg := <-ch:
+			assert.Equal(t, i, msg.Data)
+		case <-time.After(time.Second):
+			t.Fatal("message not received")
+		}
+	}
+	assert.Equal(t, uint64(0), q.Dropped(ch))
+}
+
+func TestMessageQueue_Dropped(t *testing.T) {
+	q := New(NewConfig(10*time.Millisecond, 1))
+	ch := q.SubscribeTopic("test")
+
+	// 不消费消息的订阅者 等待队列已满或发送超时的消息被丢弃并计数
+	for i := 0; i < 5; i++ {
+		q.Publish(Message{Topic: "test", Data: i})
+	}
+	assert.Eventually(t, func() bool {
+		return len(ch)+int(q.Dropped(ch)) == 5
+	}, time.Second, 10*time.Millisecond)
+	assert.Greater(t, q.Dropped(ch), uint64(0))
+
+	q.Evict(ch)
+	assert.Equal(t, uint64(0), q.Dropped(ch))
+}
@@ -0,0 +1,111 @@
+package queue
+
+import (
+	"encoding/json"
+	"github.com/T4t4KAU/TikBase/iface"
+)
+
+const (
+	KeySpaceTopicPrefix = "__keyspace__:" // 键空间通知 主题后缀为key 消息内容为事件
+	KeyEventTopicPrefix = "__keyevent__:" // 键事件通知 主题后缀为事件类型 消息内容为key
+)
+
+// KeySpaceTopic 返回key对应的键空间主题
+func KeySpaceTopic(key string) string {
+	return KeySpaceTopicPrefix + key
+}
+
+// KeyEventTopic 返回事件类型对应的主题
+func KeyEventTopic(typ iface.EventType) string {
+	return KeyEventTopicPrefix + string(typ)
+}
+
+// Match 判断主题是否匹配模式
+// 模式中 '*' 匹配任意长度字符 '?' 匹配单个字符
+func Match(pattern, topic string) bool {
+	p, t := 0, 0
+	star, mark := -1, 0
+
+	for t < len(topic) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == topic[t]):
+			p++
+			t++
+		case p < len(pattern) && pattern[p] == '*':
+			// 记录通配符位置 先尝试匹配空串
+			star, mark = p, t
+			p++
+		case star >= 0:
+			// 回溯 令通配符多吞一个字符
+			mark++
+			p, t = star+1, mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// TopicFilter 构造按模式过滤主题的过滤器 匹配任意一个模式即可
+func TopicFilter(patterns ...string) Filter {
+	return func(msg Message) bool {
+		for _, pattern := range patterns {
+			if Match(pattern, msg.Topic) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SubscribeTopic 按主题模式订阅
+func (mq *MessageQueue) SubscribeTopic(patterns ...string) Subscriber {
+	return mq.Subscribe(TopicFilter(patterns...))
+}
+
+// Bytes 将消息内容编码为字节 事件使用JSON编码
+func (msg Message) Bytes() []byte {
+	switch data := msg.Data.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return data
+	case string:
+		return []byte(data)
+	default:
+		b, _ := json.Marshal(data)
+		return b
+	}
+}
+
+// Notifier 将存储引擎产生的键空间事件发布到消息队列
+type Notifier struct {
+	queue *MessageQueue
+}
+
+func NewNotifier(mq *MessageQueue) *Notifier {
+	return &Notifier{queue: mq}
+}
+
+// Notify 同时发布键空间通知和键事件通知
+// 发布前去掉key的逻辑数据库前缀 存储引擎内部使用的key不发布
+func (n *Notifier) Notify(event iface.Event) {
+	db, key, ok := iface.SplitDB([]byte(event.Key))
+	if !ok {
+		return
+	}
+	event.DB, event.Key = db, string(key)
+
+	n.queue.Publish(Message{
+		Topic: KeySpaceTopic(event.Key),
+		Data:  event,
+	})
+	n.queue.Publish(Message{
+		Topic: KeyEventTopic(event.Type),
+		Data:  event,
+	})
+}
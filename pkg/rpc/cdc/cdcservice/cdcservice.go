@@ -0,0 +1,191 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package cdcservice
+
+import (
+	"context"
+	cdc "github.com/T4t4KAU/TikBase/pkg/rpc/cdc"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return cDCServiceServiceInfo
+}
+
+var cDCServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "CDCService"
+	handlerType := (*cdc.CDCService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"OpenStream":  kitex.NewMethodInfo(openStreamHandler, newCDCServiceOpenStreamArgs, newCDCServiceOpenStreamResult, false),
+		"Poll":        kitex.NewMethodInfo(pollHandler, newCDCServicePollArgs, newCDCServicePollResult, false),
+		"CloseStream": kitex.NewMethodInfo(closeStreamHandler, newCDCServiceCloseStreamArgs, newCDCServiceCloseStreamResult, false),
+		"Commit":      kitex.NewMethodInfo(commitHandler, newCDCServiceCommitArgs, newCDCServiceCommitResult, false),
+		"Offset":      kitex.NewMethodInfo(offsetHandler, newCDCServiceOffsetArgs, newCDCServiceOffsetResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName":     "cdc",
+		"ServiceFilePath": `idl/cdc.thrift`,
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.8.0",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func openStreamHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*cdc.CDCServiceOpenStreamArgs)
+	realResult := result.(*cdc.CDCServiceOpenStreamResult)
+	success, err := handler.(cdc.CDCService).OpenStream(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCDCServiceOpenStreamArgs() interface{} {
+	return cdc.NewCDCServiceOpenStreamArgs()
+}
+
+func newCDCServiceOpenStreamResult() interface{} {
+	return cdc.NewCDCServiceOpenStreamResult()
+}
+
+func pollHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*cdc.CDCServicePollArgs)
+	realResult := result.(*cdc.CDCServicePollResult)
+	success, err := handler.(cdc.CDCService).Poll(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCDCServicePollArgs() interface{} {
+	return cdc.NewCDCServicePollArgs()
+}
+
+func newCDCServicePollResult() interface{} {
+	return cdc.NewCDCServicePollResult()
+}
+
+func closeStreamHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*cdc.CDCServiceCloseStreamArgs)
+	realResult := result.(*cdc.CDCServiceCloseStreamResult)
+	success, err := handler.(cdc.CDCService).CloseStream(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCDCServiceCloseStreamArgs() interface{} {
+	return cdc.NewCDCServiceCloseStreamArgs()
+}
+
+func newCDCServiceCloseStreamResult() interface{} {
+	return cdc.NewCDCServiceCloseStreamResult()
+}
+
+func commitHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*cdc.CDCServiceCommitArgs)
+	realResult := result.(*cdc.CDCServiceCommitResult)
+	success, err := handler.(cdc.CDCService).Commit(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCDCServiceCommitArgs() interface{} {
+	return cdc.NewCDCServiceCommitArgs()
+}
+
+func newCDCServiceCommitResult() interface{} {
+	return cdc.NewCDCServiceCommitResult()
+}
+
+func offsetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*cdc.CDCServiceOffsetArgs)
+	realResult := result.(*cdc.CDCServiceOffsetResult)
+	success, err := handler.(cdc.CDCService).Offset(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCDCServiceOffsetArgs() interface{} {
+	return cdc.NewCDCServiceOffsetArgs()
+}
+
+func newCDCServiceOffsetResult() interface{} {
+	return cdc.NewCDCServiceOffsetResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) OpenStream(ctx context.Context, req *cdc.OpenStreamReq) (r *cdc.OpenStreamResp, err error) {
+	var _args cdc.CDCServiceOpenStreamArgs
+	_args.Req = req
+	var _result cdc.CDCServiceOpenStreamResult
+	if err = p.c.Call(ctx, "OpenStream", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Poll(ctx context.Context, req *cdc.PollReq) (r *cdc.PollResp, err error) {
+	var _args cdc.CDCServicePollArgs
+	_args.Req = req
+	var _result cdc.CDCServicePollResult
+	if err = p.c.Call(ctx, "Poll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CloseStream(ctx context.Context, req *cdc.CloseStreamReq) (r *cdc.CloseStreamResp, err error) {
+	var _args cdc.CDCServiceCloseStreamArgs
+	_args.Req = req
+	var _result cdc.CDCServiceCloseStreamResult
+	if err = p.c.Call(ctx, "CloseStream", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Commit(ctx context.Context, req *cdc.CommitReq) (r *cdc.CommitResp, err error) {
+	var _args cdc.CDCServiceCommitArgs
+	_args.Req = req
+	var _result cdc.CDCServiceCommitResult
+	if err = p.c.Call(ctx, "Commit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Offset(ctx context.Context, req *cdc.OffsetReq) (r *cdc.OffsetResp, err error) {
+	var _args cdc.CDCServiceOffsetArgs
+	_args.Req = req
+	var _result cdc.CDCServiceOffsetResult
+	if err = p.c.Call(ctx, "Offset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
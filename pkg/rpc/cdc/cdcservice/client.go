@@ -0,0 +1,73 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package cdcservice
+
+import (
+	"context"
+	cdc "github.com/T4t4KAU/TikBase/pkg/rpc/cdc"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	OpenStream(ctx context.Context, req *cdc.OpenStreamReq, callOptions ...callopt.Option) (r *cdc.OpenStreamResp, err error)
+	Poll(ctx context.Context, req *cdc.PollReq, callOptions ...callopt.Option) (r *cdc.PollResp, err error)
+	CloseStream(ctx context.Context, req *cdc.CloseStreamReq, callOptions ...callopt.Option) (r *cdc.CloseStreamResp, err error)
+	Commit(ctx context.Context, req *cdc.CommitReq, callOptions ...callopt.Option) (r *cdc.CommitResp, err error)
+	Offset(ctx context.Context, req *cdc.OffsetReq, callOptions ...callopt.Option) (r *cdc.OffsetResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kCDCServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kCDCServiceClient struct {
+	*kClient
+}
+
+func (p *kCDCServiceClient) OpenStream(ctx context.Context, req *cdc.OpenStreamReq, callOptions ...callopt.Option) (r *cdc.OpenStreamResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.OpenStream(ctx, req)
+}
+
+func (p *kCDCServiceClient) Poll(ctx context.Context, req *cdc.PollReq, callOptions ...callopt.Option) (r *cdc.PollResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Poll(ctx, req)
+}
+
+func (p *kCDCServiceClient) CloseStream(ctx context.Context, req *cdc.CloseStreamReq, callOptions ...callopt.Option) (r *cdc.CloseStreamResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CloseStream(ctx, req)
+}
+
+func (p *kCDCServiceClient) Commit(ctx context.Context, req *cdc.CommitReq, callOptions ...callopt.Option) (r *cdc.CommitResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Commit(ctx, req)
+}
+
+func (p *kCDCServiceClient) Offset(ctx context.Context, req *cdc.OffsetReq, callOptions ...callopt.Option) (r *cdc.OffsetResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Offset(ctx, req)
+}
@@ -0,0 +1,24 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package cdcservice
+
+import (
+	cdc "github.com/T4t4KAU/TikBase/pkg/rpc/cdc"
+	server "github.com/cloudwego/kitex/server"
+)
+
+// NewInvoker creates a server.Invoker with the given handler and options.
+func NewInvoker(handler cdc.CDCService, opts ...server.Option) server.Invoker {
+	var options []server.Option
+
+	options = append(options, opts...)
+
+	s := server.NewInvoker(options...)
+	if err := s.RegisterService(serviceInfo(), handler); err != nil {
+		panic(err)
+	}
+	if err := s.Init(); err != nil {
+		panic(err)
+	}
+	return s
+}
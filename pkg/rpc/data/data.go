@@ -1,18 +1,69 @@
-// Code generated by thriftgo (0.2.12). DO NOT EDIT.
+// Code generated by thriftgo (0.3.3). DO NOT EDIT.
 
 package data
 
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
 	"strings"
 )
 
+type Consistency int64
+
+const (
+	Consistency_DEFAULT    Consistency = 0
+	Consistency_STALE      Consistency = 1
+	Consistency_CONSISTENT Consistency = 2
+)
+
+func (p Consistency) String() string {
+	switch p {
+	case Consistency_DEFAULT:
+		return "DEFAULT"
+	case Consistency_STALE:
+		return "STALE"
+	case Consistency_CONSISTENT:
+		return "CONSISTENT"
+	}
+	return "<UNSET>"
+}
+
+func ConsistencyFromString(s string) (Consistency, error) {
+	switch s {
+	case "DEFAULT":
+		return Consistency_DEFAULT, nil
+	case "STALE":
+		return Consistency_STALE, nil
+	case "CONSISTENT":
+		return Consistency_CONSISTENT, nil
+	}
+	return Consistency(0), fmt.Errorf("not a valid Consistency string")
+}
+
+func ConsistencyPtr(v Consistency) *Consistency { return &v }
+func (p *Consistency) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = Consistency(result.Int64)
+	return
+}
+
+func (p *Consistency) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type SetReq struct {
-	Key   string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Value []byte `thrift:"value,2,required" frugal:"2,required,binary" json:"value"`
+	Key   string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Value []byte  `thrift:"value,2,required" frugal:"2,required,binary" json:"value"`
+	Db    *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
+	Ttl   *int64  `thrift:"ttl,4,optional" frugal:"4,optional,i64" json:"ttl,omitempty"`
 }
 
 func NewSetReq() *SetReq {
@@ -30,16 +81,50 @@ func (p *SetReq) GetKey() (v string) {
 func (p *SetReq) GetValue() (v []byte) {
 	return p.Value
 }
+
+var SetReq_Db_DEFAULT string
+
+func (p *SetReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return SetReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+
+var SetReq_Ttl_DEFAULT int64
+
+func (p *SetReq) GetTtl() (v int64) {
+	if !p.IsSetTtl() {
+		return SetReq_Ttl_DEFAULT
+	}
+	return *p.Ttl
+}
 func (p *SetReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *SetReq) SetValue(val []byte) {
 	p.Value = val
 }
+func (p *SetReq) SetDb(val *string) {
+	p.Db = val
+}
+func (p *SetReq) SetTtl(val *int64) {
+	p.Ttl = val
+}
 
 var fieldIDToName_SetReq = map[int16]string{
 	1: "key",
 	2: "value",
+	3: "db",
+	4: "ttl",
+}
+
+func (p *SetReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *SetReq) IsSetTtl() bool {
+	return p.Ttl != nil
 }
 
 func (p *SetReq) Read(iprot thrift.TProtocol) (err error) {
@@ -69,10 +154,10 @@ func (p *SetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -80,17 +165,36 @@ func (p *SetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetValue = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -127,6 +231,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SetReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -134,8 +239,8 @@ func (p *SetReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SetReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -143,6 +248,24 @@ func (p *SetReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *SetReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+func (p *SetReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Ttl = &v
+	}
+	return nil
+}
 
 func (p *SetReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -158,7 +281,14 @@ func (p *SetReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -193,7 +323,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SetReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -210,6 +339,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *SetReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *SetReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTtl() {
+		if err = oprot.WriteFieldBegin("ttl", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Ttl); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *SetReq) String() string {
 	if p == nil {
@@ -230,6 +395,12 @@ func (p *SetReq) DeepEqual(ano *SetReq) bool {
 	if !p.Field2DeepEqual(ano.Value) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Ttl) {
+		return false
+	}
 	return true
 }
 
@@ -247,6 +418,30 @@ func (p *SetReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *SetReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SetReq) Field4DeepEqual(src *int64) bool {
+
+	if p.Ttl == src {
+		return true
+	} else if p.Ttl == nil || src == nil {
+		return false
+	}
+	if *p.Ttl != *src {
+		return false
+	}
+	return true
+}
 
 type SetResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -317,10 +512,10 @@ func (p *SetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -328,10 +523,10 @@ func (p *SetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -339,17 +534,16 @@ func (p *SetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -391,6 +585,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SetResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -398,8 +593,8 @@ func (p *SetResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SetResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -407,8 +602,8 @@ func (p *SetResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SetResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -435,7 +630,6 @@ func (p *SetResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -470,7 +664,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SetResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -487,7 +680,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *SetResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -553,7 +745,10 @@ func (p *SetResp) Field3DeepEqual(src int32) bool {
 }
 
 type GetReq struct {
-	Key string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Key          string       `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Db           *string      `thrift:"db,2,optional" frugal:"2,optional,string" json:"db,omitempty"`
+	Consistency  *Consistency `thrift:"consistency,3,optional" frugal:"3,optional,Consistency" json:"consistency,omitempty"`
+	MaxStaleness *int64       `thrift:"max_staleness,4,optional" frugal:"4,optional,i64" json:"max_staleness,omitempty"`
 }
 
 func NewGetReq() *GetReq {
@@ -567,12 +762,63 @@ func (p *GetReq) InitDefault() {
 func (p *GetReq) GetKey() (v string) {
 	return p.Key
 }
+
+var GetReq_Db_DEFAULT string
+
+func (p *GetReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return GetReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+
+var GetReq_Consistency_DEFAULT Consistency
+
+func (p *GetReq) GetConsistency() (v Consistency) {
+	if !p.IsSetConsistency() {
+		return GetReq_Consistency_DEFAULT
+	}
+	return *p.Consistency
+}
+
+var GetReq_MaxStaleness_DEFAULT int64
+
+func (p *GetReq) GetMaxStaleness() (v int64) {
+	if !p.IsSetMaxStaleness() {
+		return GetReq_MaxStaleness_DEFAULT
+	}
+	return *p.MaxStaleness
+}
 func (p *GetReq) SetKey(val string) {
 	p.Key = val
 }
+func (p *GetReq) SetDb(val *string) {
+	p.Db = val
+}
+func (p *GetReq) SetConsistency(val *Consistency) {
+	p.Consistency = val
+}
+func (p *GetReq) SetMaxStaleness(val *int64) {
+	p.MaxStaleness = val
+}
 
 var fieldIDToName_GetReq = map[int16]string{
 	1: "key",
+	2: "db",
+	3: "consistency",
+	4: "max_staleness",
+}
+
+func (p *GetReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *GetReq) IsSetConsistency() bool {
+	return p.Consistency != nil
+}
+
+func (p *GetReq) IsSetMaxStaleness() bool {
+	return p.MaxStaleness != nil
 }
 
 func (p *GetReq) Read(iprot thrift.TProtocol) (err error) {
@@ -601,17 +847,46 @@ func (p *GetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -643,6 +918,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *GetReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -650,6 +926,34 @@ func (p *GetReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *GetReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+func (p *GetReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+	}
+	return nil
+}
+func (p *GetReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxStaleness = &v
+	}
+	return nil
+}
 
 func (p *GetReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -661,7 +965,18 @@ func (p *GetReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -696,16 +1011,70 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *GetReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("GetReq(%+v)", *p)
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *GetReq) DeepEqual(ano *GetReq) bool {
-	if p == ano {
+func (p *GetReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConsistency() {
+		if err = oprot.WriteFieldBegin("consistency", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Consistency)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxStaleness() {
+		if err = oprot.WriteFieldBegin("max_staleness", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxStaleness); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReq(%+v)", *p)
+}
+
+func (p *GetReq) DeepEqual(ano *GetReq) bool {
+	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
@@ -713,6 +1082,15 @@ func (p *GetReq) DeepEqual(ano *GetReq) bool {
 	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.Db) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Consistency) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.MaxStaleness) {
+		return false
+	}
 	return true
 }
 
@@ -723,6 +1101,42 @@ func (p *GetReq) Field1DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *GetReq) Field2DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetReq) Field3DeepEqual(src *Consistency) bool {
+
+	if p.Consistency == src {
+		return true
+	} else if p.Consistency == nil || src == nil {
+		return false
+	}
+	if *p.Consistency != *src {
+		return false
+	}
+	return true
+}
+func (p *GetReq) Field4DeepEqual(src *int64) bool {
+
+	if p.MaxStaleness == src {
+		return true
+	} else if p.MaxStaleness == nil || src == nil {
+		return false
+	}
+	if *p.MaxStaleness != *src {
+		return false
+	}
+	return true
+}
 
 type GetResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -803,10 +1217,10 @@ func (p *GetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -814,10 +1228,10 @@ func (p *GetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetValue = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -825,10 +1239,10 @@ func (p *GetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 4:
 			if fieldTypeId == thrift.I32 {
@@ -836,17 +1250,16 @@ func (p *GetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -893,6 +1306,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *GetResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -900,8 +1314,8 @@ func (p *GetResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *GetResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -909,8 +1323,8 @@ func (p *GetResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *GetResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -918,8 +1332,8 @@ func (p *GetResp) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *GetResp) ReadField4(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -950,7 +1364,6 @@ func (p *GetResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -985,7 +1398,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *GetResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -1002,7 +1414,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *GetResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -1019,7 +1430,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
 func (p *GetResp) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
@@ -1095,7 +1505,8 @@ func (p *GetResp) Field4DeepEqual(src int32) bool {
 }
 
 type DelReq struct {
-	Key string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Key string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Db  *string `thrift:"db,2,optional" frugal:"2,optional,string" json:"db,omitempty"`
 }
 
 func NewDelReq() *DelReq {
@@ -1109,12 +1520,29 @@ func (p *DelReq) InitDefault() {
 func (p *DelReq) GetKey() (v string) {
 	return p.Key
 }
+
+var DelReq_Db_DEFAULT string
+
+func (p *DelReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return DelReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *DelReq) SetKey(val string) {
 	p.Key = val
 }
+func (p *DelReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_DelReq = map[int16]string{
 	1: "key",
+	2: "db",
+}
+
+func (p *DelReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *DelReq) Read(iprot thrift.TProtocol) (err error) {
@@ -1143,17 +1571,26 @@ func (p *DelReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1185,6 +1622,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *DelReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1192,6 +1630,15 @@ func (p *DelReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *DelReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *DelReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1203,7 +1650,10 @@ func (p *DelReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1238,6 +1688,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *DelReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
 func (p *DelReq) String() string {
 	if p == nil {
@@ -1255,6 +1723,9 @@ func (p *DelReq) DeepEqual(ano *DelReq) bool {
 	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -1265,6 +1736,18 @@ func (p *DelReq) Field1DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *DelReq) Field2DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type DelResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -1335,10 +1818,10 @@ func (p *DelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -1346,10 +1829,10 @@ func (p *DelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -1357,17 +1840,16 @@ func (p *DelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1409,6 +1891,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *DelResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -1416,8 +1899,8 @@ func (p *DelResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *DelResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1425,8 +1908,8 @@ func (p *DelResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *DelResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -1453,7 +1936,6 @@ func (p *DelResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1488,7 +1970,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *DelResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -1505,7 +1986,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *DelResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -1571,8 +2051,9 @@ func (p *DelResp) Field3DeepEqual(src int32) bool {
 }
 
 type ExpireReq struct {
-	Key  string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Time int64  `thrift:"time,2,required" frugal:"2,required,i64" json:"time"`
+	Key  string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Time int64   `thrift:"time,2,required" frugal:"2,required,i64" json:"time"`
+	Db   *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewExpireReq() *ExpireReq {
@@ -1590,16 +2071,33 @@ func (p *ExpireReq) GetKey() (v string) {
 func (p *ExpireReq) GetTime() (v int64) {
 	return p.Time
 }
+
+var ExpireReq_Db_DEFAULT string
+
+func (p *ExpireReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return ExpireReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *ExpireReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *ExpireReq) SetTime(val int64) {
 	p.Time = val
 }
+func (p *ExpireReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_ExpireReq = map[int16]string{
 	1: "key",
 	2: "time",
+	3: "db",
+}
+
+func (p *ExpireReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *ExpireReq) Read(iprot thrift.TProtocol) (err error) {
@@ -1629,10 +2127,10 @@ func (p *ExpireReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.I64 {
@@ -1640,17 +2138,26 @@ func (p *ExpireReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetTime = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1687,6 +2194,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ExpireReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1694,8 +2202,8 @@ func (p *ExpireReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ExpireReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
@@ -1703,6 +2211,15 @@ func (p *ExpireReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *ExpireReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *ExpireReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1718,7 +2235,10 @@ func (p *ExpireReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1753,7 +2273,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ExpireReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("time", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
@@ -1770,6 +2289,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ExpireReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *ExpireReq) String() string {
 	if p == nil {
@@ -1790,6 +2327,9 @@ func (p *ExpireReq) DeepEqual(ano *ExpireReq) bool {
 	if !p.Field2DeepEqual(ano.Time) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -1807,6 +2347,18 @@ func (p *ExpireReq) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *ExpireReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type ExpireResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -1877,10 +2429,10 @@ func (p *ExpireResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -1888,10 +2440,10 @@ func (p *ExpireResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -1899,17 +2451,16 @@ func (p *ExpireResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1951,6 +2502,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ExpireResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -1958,8 +2510,8 @@ func (p *ExpireResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ExpireResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1967,8 +2519,8 @@ func (p *ExpireResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ExpireResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -1995,7 +2547,6 @@ func (p *ExpireResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2030,7 +2581,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ExpireResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -2047,7 +2597,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *ExpireResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -2113,9 +2662,10 @@ func (p *ExpireResp) Field3DeepEqual(src int32) bool {
 }
 
 type HSetReq struct {
-	Key   string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Field []byte `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
-	Value []byte `thrift:"value,3,required" frugal:"3,required,binary" json:"value"`
+	Key   string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Field []byte  `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
+	Value []byte  `thrift:"value,3,required" frugal:"3,required,binary" json:"value"`
+	Db    *string `thrift:"db,4,optional" frugal:"4,optional,string" json:"db,omitempty"`
 }
 
 func NewHSetReq() *HSetReq {
@@ -2137,6 +2687,15 @@ func (p *HSetReq) GetField() (v []byte) {
 func (p *HSetReq) GetValue() (v []byte) {
 	return p.Value
 }
+
+var HSetReq_Db_DEFAULT string
+
+func (p *HSetReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return HSetReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *HSetReq) SetKey(val string) {
 	p.Key = val
 }
@@ -2146,11 +2705,19 @@ func (p *HSetReq) SetField(val []byte) {
 func (p *HSetReq) SetValue(val []byte) {
 	p.Value = val
 }
+func (p *HSetReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_HSetReq = map[int16]string{
 	1: "key",
 	2: "field",
 	3: "value",
+	4: "db",
+}
+
+func (p *HSetReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *HSetReq) Read(iprot thrift.TProtocol) (err error) {
@@ -2181,10 +2748,10 @@ func (p *HSetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -2192,10 +2759,10 @@ func (p *HSetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetField = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -2203,17 +2770,26 @@ func (p *HSetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetValue = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2255,6 +2831,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HSetReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -2262,8 +2839,8 @@ func (p *HSetReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HSetReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -2271,8 +2848,8 @@ func (p *HSetReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HSetReq) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -2280,6 +2857,15 @@ func (p *HSetReq) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *HSetReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *HSetReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2299,7 +2885,10 @@ func (p *HSetReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2334,7 +2923,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HSetReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -2351,7 +2939,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *HSetReq) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("value", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -2368,6 +2955,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *HSetReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *HSetReq) String() string {
 	if p == nil {
@@ -2391,6 +2996,9 @@ func (p *HSetReq) DeepEqual(ano *HSetReq) bool {
 	if !p.Field3DeepEqual(ano.Value) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -2415,6 +3023,18 @@ func (p *HSetReq) Field3DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *HSetReq) Field4DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type HSetResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -2485,10 +3105,10 @@ func (p *HSetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -2496,10 +3116,10 @@ func (p *HSetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -2507,17 +3127,16 @@ func (p *HSetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2559,6 +3178,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HSetResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -2566,8 +3186,8 @@ func (p *HSetResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HSetResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -2575,8 +3195,8 @@ func (p *HSetResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HSetResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -2603,7 +3223,6 @@ func (p *HSetResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2638,7 +3257,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HSetResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -2655,7 +3273,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *HSetResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -2721,8 +3338,11 @@ func (p *HSetResp) Field3DeepEqual(src int32) bool {
 }
 
 type HGetReq struct {
-	Key   string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Field []byte `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
+	Key          string       `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Field        []byte       `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
+	Db           *string      `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
+	Consistency  *Consistency `thrift:"consistency,4,optional" frugal:"4,optional,Consistency" json:"consistency,omitempty"`
+	MaxStaleness *int64       `thrift:"max_staleness,5,optional" frugal:"5,optional,i64" json:"max_staleness,omitempty"`
 }
 
 func NewHGetReq() *HGetReq {
@@ -2740,16 +3360,67 @@ func (p *HGetReq) GetKey() (v string) {
 func (p *HGetReq) GetField() (v []byte) {
 	return p.Field
 }
+
+var HGetReq_Db_DEFAULT string
+
+func (p *HGetReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return HGetReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+
+var HGetReq_Consistency_DEFAULT Consistency
+
+func (p *HGetReq) GetConsistency() (v Consistency) {
+	if !p.IsSetConsistency() {
+		return HGetReq_Consistency_DEFAULT
+	}
+	return *p.Consistency
+}
+
+var HGetReq_MaxStaleness_DEFAULT int64
+
+func (p *HGetReq) GetMaxStaleness() (v int64) {
+	if !p.IsSetMaxStaleness() {
+		return HGetReq_MaxStaleness_DEFAULT
+	}
+	return *p.MaxStaleness
+}
 func (p *HGetReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *HGetReq) SetField(val []byte) {
 	p.Field = val
 }
+func (p *HGetReq) SetDb(val *string) {
+	p.Db = val
+}
+func (p *HGetReq) SetConsistency(val *Consistency) {
+	p.Consistency = val
+}
+func (p *HGetReq) SetMaxStaleness(val *int64) {
+	p.MaxStaleness = val
+}
 
 var fieldIDToName_HGetReq = map[int16]string{
 	1: "key",
 	2: "field",
+	3: "db",
+	4: "consistency",
+	5: "max_staleness",
+}
+
+func (p *HGetReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *HGetReq) IsSetConsistency() bool {
+	return p.Consistency != nil
+}
+
+func (p *HGetReq) IsSetMaxStaleness() bool {
+	return p.MaxStaleness != nil
 }
 
 func (p *HGetReq) Read(iprot thrift.TProtocol) (err error) {
@@ -2779,10 +3450,10 @@ func (p *HGetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -2790,17 +3461,46 @@ func (p *HGetReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetField = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2837,6 +3537,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HGetReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -2844,8 +3545,8 @@ func (p *HGetReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HGetReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -2853,6 +3554,34 @@ func (p *HGetReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *HGetReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+func (p *HGetReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+	}
+	return nil
+}
+func (p *HGetReq) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxStaleness = &v
+	}
+	return nil
+}
 
 func (p *HGetReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2868,7 +3597,18 @@ func (p *HGetReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2903,7 +3643,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HGetReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -2920,6 +3659,60 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *HGetReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *HGetReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConsistency() {
+		if err = oprot.WriteFieldBegin("consistency", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Consistency)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *HGetReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxStaleness() {
+		if err = oprot.WriteFieldBegin("max_staleness", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxStaleness); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
 func (p *HGetReq) String() string {
 	if p == nil {
@@ -2940,6 +3733,15 @@ func (p *HGetReq) DeepEqual(ano *HGetReq) bool {
 	if !p.Field2DeepEqual(ano.Field) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Consistency) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MaxStaleness) {
+		return false
+	}
 	return true
 }
 
@@ -2957,6 +3759,42 @@ func (p *HGetReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *HGetReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HGetReq) Field4DeepEqual(src *Consistency) bool {
+
+	if p.Consistency == src {
+		return true
+	} else if p.Consistency == nil || src == nil {
+		return false
+	}
+	if *p.Consistency != *src {
+		return false
+	}
+	return true
+}
+func (p *HGetReq) Field5DeepEqual(src *int64) bool {
+
+	if p.MaxStaleness == src {
+		return true
+	} else if p.MaxStaleness == nil || src == nil {
+		return false
+	}
+	if *p.MaxStaleness != *src {
+		return false
+	}
+	return true
+}
 
 type HGetResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -3037,10 +3875,10 @@ func (p *HGetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -3048,10 +3886,10 @@ func (p *HGetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetValue = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -3059,10 +3897,10 @@ func (p *HGetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 4:
 			if fieldTypeId == thrift.I32 {
@@ -3070,17 +3908,16 @@ func (p *HGetResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -3127,6 +3964,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HGetResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -3134,8 +3972,8 @@ func (p *HGetResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HGetResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -3143,8 +3981,8 @@ func (p *HGetResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HGetResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3152,8 +3990,8 @@ func (p *HGetResp) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HGetResp) ReadField4(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -3184,7 +4022,6 @@ func (p *HGetResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3219,7 +4056,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HGetResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -3236,7 +4072,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *HGetResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -3253,7 +4088,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
 func (p *HGetResp) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
@@ -3329,8 +4163,9 @@ func (p *HGetResp) Field4DeepEqual(src int32) bool {
 }
 
 type HDelReq struct {
-	Key   string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Field []byte `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
+	Key   string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Field []byte  `thrift:"field,2,required" frugal:"2,required,binary" json:"field"`
+	Db    *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewHDelReq() *HDelReq {
@@ -3348,16 +4183,33 @@ func (p *HDelReq) GetKey() (v string) {
 func (p *HDelReq) GetField() (v []byte) {
 	return p.Field
 }
+
+var HDelReq_Db_DEFAULT string
+
+func (p *HDelReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return HDelReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *HDelReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *HDelReq) SetField(val []byte) {
 	p.Field = val
 }
+func (p *HDelReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_HDelReq = map[int16]string{
 	1: "key",
 	2: "field",
+	3: "db",
+}
+
+func (p *HDelReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *HDelReq) Read(iprot thrift.TProtocol) (err error) {
@@ -3387,10 +4239,10 @@ func (p *HDelReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -3398,17 +4250,26 @@ func (p *HDelReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetField = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -3445,6 +4306,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HDelReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3452,8 +4314,8 @@ func (p *HDelReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HDelReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -3461,6 +4323,15 @@ func (p *HDelReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *HDelReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *HDelReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3476,7 +4347,10 @@ func (p *HDelReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3511,7 +4385,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HDelReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -3528,6 +4401,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *HDelReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *HDelReq) String() string {
 	if p == nil {
@@ -3548,6 +4439,9 @@ func (p *HDelReq) DeepEqual(ano *HDelReq) bool {
 	if !p.Field2DeepEqual(ano.Field) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -3565,6 +4459,18 @@ func (p *HDelReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *HDelReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type HDelResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -3635,10 +4541,10 @@ func (p *HDelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -3646,10 +4552,10 @@ func (p *HDelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -3657,17 +4563,16 @@ func (p *HDelResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -3709,6 +4614,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *HDelResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -3716,8 +4622,8 @@ func (p *HDelResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HDelResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3725,8 +4631,8 @@ func (p *HDelResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *HDelResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -3753,7 +4659,6 @@ func (p *HDelResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3788,7 +4693,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *HDelResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -3805,7 +4709,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *HDelResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -3871,8 +4774,9 @@ func (p *HDelResp) Field3DeepEqual(src int32) bool {
 }
 
 type LPushReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte  `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewLPushReq() *LPushReq {
@@ -3890,16 +4794,33 @@ func (p *LPushReq) GetKey() (v string) {
 func (p *LPushReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var LPushReq_Db_DEFAULT string
+
+func (p *LPushReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return LPushReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *LPushReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *LPushReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *LPushReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_LPushReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+}
+
+func (p *LPushReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *LPushReq) Read(iprot thrift.TProtocol) (err error) {
@@ -3929,10 +4850,10 @@ func (p *LPushReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -3940,17 +4861,26 @@ func (p *LPushReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -3987,6 +4917,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *LPushReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3994,8 +4925,8 @@ func (p *LPushReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPushReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -4003,6 +4934,15 @@ func (p *LPushReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *LPushReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *LPushReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4018,7 +4958,10 @@ func (p *LPushReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4053,7 +4996,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *LPushReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -4070,6 +5012,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *LPushReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *LPushReq) String() string {
 	if p == nil {
@@ -4090,6 +5050,9 @@ func (p *LPushReq) DeepEqual(ano *LPushReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -4107,6 +5070,18 @@ func (p *LPushReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *LPushReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type LPushResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -4177,10 +5152,10 @@ func (p *LPushResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -4188,10 +5163,10 @@ func (p *LPushResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -4199,17 +5174,16 @@ func (p *LPushResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -4251,6 +5225,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *LPushResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -4258,8 +5233,8 @@ func (p *LPushResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPushResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -4267,8 +5242,8 @@ func (p *LPushResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPushResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -4295,7 +5270,6 @@ func (p *LPushResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4330,7 +5304,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *LPushResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -4347,7 +5320,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *LPushResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -4413,8 +5385,9 @@ func (p *LPushResp) Field3DeepEqual(src int32) bool {
 }
 
 type RPushReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte  `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewRPushReq() *RPushReq {
@@ -4432,16 +5405,33 @@ func (p *RPushReq) GetKey() (v string) {
 func (p *RPushReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var RPushReq_Db_DEFAULT string
+
+func (p *RPushReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return RPushReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *RPushReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *RPushReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *RPushReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_RPushReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+}
+
+func (p *RPushReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *RPushReq) Read(iprot thrift.TProtocol) (err error) {
@@ -4471,10 +5461,10 @@ func (p *RPushReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -4482,17 +5472,26 @@ func (p *RPushReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -4529,6 +5528,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *RPushReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -4536,8 +5536,8 @@ func (p *RPushReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPushReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -4545,6 +5545,15 @@ func (p *RPushReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *RPushReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *RPushReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4560,7 +5569,10 @@ func (p *RPushReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4595,7 +5607,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *RPushReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -4612,6 +5623,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *RPushReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *RPushReq) String() string {
 	if p == nil {
@@ -4632,6 +5661,9 @@ func (p *RPushReq) DeepEqual(ano *RPushReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -4649,6 +5681,18 @@ func (p *RPushReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *RPushReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type RPushResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -4719,21 +5763,21 @@ func (p *RPushResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
 			}
-		case 2:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -4741,17 +5785,16 @@ func (p *RPushResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -4793,6 +5836,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *RPushResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -4800,8 +5844,8 @@ func (p *RPushResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPushResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -4809,8 +5853,8 @@ func (p *RPushResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPushResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -4837,7 +5881,6 @@ func (p *RPushResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4872,7 +5915,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *RPushResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -4889,7 +5931,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *RPushResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -4955,7 +5996,8 @@ func (p *RPushResp) Field3DeepEqual(src int32) bool {
 }
 
 type LPopReq struct {
-	Key string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Key string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Db  *string `thrift:"db,2,optional" frugal:"2,optional,string" json:"db,omitempty"`
 }
 
 func NewLPopReq() *LPopReq {
@@ -4969,12 +6011,29 @@ func (p *LPopReq) InitDefault() {
 func (p *LPopReq) GetKey() (v string) {
 	return p.Key
 }
+
+var LPopReq_Db_DEFAULT string
+
+func (p *LPopReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return LPopReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *LPopReq) SetKey(val string) {
 	p.Key = val
 }
+func (p *LPopReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_LPopReq = map[int16]string{
 	1: "key",
+	2: "db",
+}
+
+func (p *LPopReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *LPopReq) Read(iprot thrift.TProtocol) (err error) {
@@ -5003,17 +6062,26 @@ func (p *LPopReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -5045,6 +6113,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *LPopReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5052,6 +6121,15 @@ func (p *LPopReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *LPopReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *LPopReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -5063,7 +6141,10 @@ func (p *LPopReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5098,6 +6179,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *LPopReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
 func (p *LPopReq) String() string {
 	if p == nil {
@@ -5115,6 +6214,9 @@ func (p *LPopReq) DeepEqual(ano *LPopReq) bool {
 	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -5125,6 +6227,18 @@ func (p *LPopReq) Field1DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *LPopReq) Field2DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type LPopResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -5205,10 +6319,10 @@ func (p *LPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -5216,10 +6330,10 @@ func (p *LPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -5227,10 +6341,10 @@ func (p *LPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 4:
 			if fieldTypeId == thrift.I32 {
@@ -5238,17 +6352,16 @@ func (p *LPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -5295,6 +6408,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *LPopResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -5302,8 +6416,8 @@ func (p *LPopResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPopResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -5311,8 +6425,8 @@ func (p *LPopResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPopResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5320,8 +6434,8 @@ func (p *LPopResp) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *LPopResp) ReadField4(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -5352,7 +6466,6 @@ func (p *LPopResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5387,7 +6500,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *LPopResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -5404,7 +6516,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *LPopResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -5421,7 +6532,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
 func (p *LPopResp) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
@@ -5497,7 +6607,8 @@ func (p *LPopResp) Field4DeepEqual(src int32) bool {
 }
 
 type RPopReq struct {
-	Key string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Key string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Db  *string `thrift:"db,2,optional" frugal:"2,optional,string" json:"db,omitempty"`
 }
 
 func NewRPopReq() *RPopReq {
@@ -5511,12 +6622,29 @@ func (p *RPopReq) InitDefault() {
 func (p *RPopReq) GetKey() (v string) {
 	return p.Key
 }
+
+var RPopReq_Db_DEFAULT string
+
+func (p *RPopReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return RPopReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *RPopReq) SetKey(val string) {
 	p.Key = val
 }
+func (p *RPopReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_RPopReq = map[int16]string{
 	1: "key",
+	2: "db",
+}
+
+func (p *RPopReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *RPopReq) Read(iprot thrift.TProtocol) (err error) {
@@ -5545,17 +6673,26 @@ func (p *RPopReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -5587,6 +6724,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *RPopReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5594,6 +6732,15 @@ func (p *RPopReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *RPopReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *RPopReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -5605,7 +6752,10 @@ func (p *RPopReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5640,6 +6790,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *RPopReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
 func (p *RPopReq) String() string {
 	if p == nil {
@@ -5657,6 +6825,9 @@ func (p *RPopReq) DeepEqual(ano *RPopReq) bool {
 	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -5667,6 +6838,18 @@ func (p *RPopReq) Field1DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *RPopReq) Field2DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type RPopResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -5747,10 +6930,10 @@ func (p *RPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -5758,10 +6941,10 @@ func (p *RPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -5769,10 +6952,10 @@ func (p *RPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 4:
 			if fieldTypeId == thrift.I32 {
@@ -5780,17 +6963,16 @@ func (p *RPopResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -5837,6 +7019,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *RPopResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -5844,8 +7027,8 @@ func (p *RPopResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPopResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -5853,8 +7036,8 @@ func (p *RPopResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPopResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5862,8 +7045,8 @@ func (p *RPopResp) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *RPopResp) ReadField4(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -5894,7 +7077,6 @@ func (p *RPopResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5929,7 +7111,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *RPopResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -5946,7 +7127,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *RPopResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -5963,7 +7143,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
 func (p *RPopResp) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
@@ -6039,8 +7218,9 @@ func (p *RPopResp) Field4DeepEqual(src int32) bool {
 }
 
 type SAddReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte  `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewSAddReq() *SAddReq {
@@ -6058,16 +7238,33 @@ func (p *SAddReq) GetKey() (v string) {
 func (p *SAddReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var SAddReq_Db_DEFAULT string
+
+func (p *SAddReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return SAddReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *SAddReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *SAddReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *SAddReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_SAddReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+}
+
+func (p *SAddReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *SAddReq) Read(iprot thrift.TProtocol) (err error) {
@@ -6097,10 +7294,10 @@ func (p *SAddReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -6108,17 +7305,26 @@ func (p *SAddReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -6155,6 +7361,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SAddReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6162,8 +7369,8 @@ func (p *SAddReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SAddReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -6171,6 +7378,15 @@ func (p *SAddReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *SAddReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *SAddReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -6186,7 +7402,10 @@ func (p *SAddReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6221,7 +7440,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SAddReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -6238,6 +7456,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *SAddReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *SAddReq) String() string {
 	if p == nil {
@@ -6258,6 +7494,9 @@ func (p *SAddReq) DeepEqual(ano *SAddReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -6275,6 +7514,18 @@ func (p *SAddReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *SAddReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type SAddResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -6345,10 +7596,10 @@ func (p *SAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -6356,10 +7607,10 @@ func (p *SAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -6367,17 +7618,16 @@ func (p *SAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -6419,6 +7669,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SAddResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -6426,8 +7677,8 @@ func (p *SAddResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SAddResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6435,8 +7686,8 @@ func (p *SAddResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SAddResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -6463,7 +7714,6 @@ func (p *SAddResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6498,7 +7748,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SAddResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -6515,7 +7764,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *SAddResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -6581,8 +7829,9 @@ func (p *SAddResp) Field3DeepEqual(src int32) bool {
 }
 
 type SRemReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte  `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewSRemReq() *SRemReq {
@@ -6600,16 +7849,33 @@ func (p *SRemReq) GetKey() (v string) {
 func (p *SRemReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var SRemReq_Db_DEFAULT string
+
+func (p *SRemReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return SRemReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *SRemReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *SRemReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *SRemReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_SRemReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+}
+
+func (p *SRemReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *SRemReq) Read(iprot thrift.TProtocol) (err error) {
@@ -6639,10 +7905,10 @@ func (p *SRemReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -6650,17 +7916,26 @@ func (p *SRemReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -6697,6 +7972,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SRemReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6704,8 +7980,8 @@ func (p *SRemReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SRemReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -6713,6 +7989,15 @@ func (p *SRemReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *SRemReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *SRemReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -6728,7 +8013,10 @@ func (p *SRemReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6763,7 +8051,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SRemReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -6780,6 +8067,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *SRemReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *SRemReq) String() string {
 	if p == nil {
@@ -6800,6 +8105,9 @@ func (p *SRemReq) DeepEqual(ano *SRemReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -6817,6 +8125,18 @@ func (p *SRemReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *SRemReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type SRemResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -6887,10 +8207,10 @@ func (p *SRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -6898,10 +8218,10 @@ func (p *SRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -6909,17 +8229,16 @@ func (p *SRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -6961,6 +8280,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *SRemResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -6968,8 +8288,8 @@ func (p *SRemResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SRemResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6977,8 +8297,8 @@ func (p *SRemResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *SRemResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -7005,7 +8325,6 @@ func (p *SRemResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7040,7 +8359,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *SRemResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -7057,7 +8375,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *SRemResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -7123,8 +8440,10 @@ func (p *SRemResp) Field3DeepEqual(src int32) bool {
 }
 
 type ZAddReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string   `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte   `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string  `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
+	Score   *float64 `thrift:"score,4,optional" frugal:"4,optional,double" json:"score,omitempty"`
 }
 
 func NewZAddReq() *ZAddReq {
@@ -7142,16 +8461,50 @@ func (p *ZAddReq) GetKey() (v string) {
 func (p *ZAddReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var ZAddReq_Db_DEFAULT string
+
+func (p *ZAddReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return ZAddReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+
+var ZAddReq_Score_DEFAULT float64
+
+func (p *ZAddReq) GetScore() (v float64) {
+	if !p.IsSetScore() {
+		return ZAddReq_Score_DEFAULT
+	}
+	return *p.Score
+}
 func (p *ZAddReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *ZAddReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *ZAddReq) SetDb(val *string) {
+	p.Db = val
+}
+func (p *ZAddReq) SetScore(val *float64) {
+	p.Score = val
+}
 
 var fieldIDToName_ZAddReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+	4: "score",
+}
+
+func (p *ZAddReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *ZAddReq) IsSetScore() bool {
+	return p.Score != nil
 }
 
 func (p *ZAddReq) Read(iprot thrift.TProtocol) (err error) {
@@ -7181,10 +8534,10 @@ func (p *ZAddReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -7192,17 +8545,36 @@ func (p *ZAddReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -7239,6 +8611,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ZAddReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -7246,8 +8619,8 @@ func (p *ZAddReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZAddReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -7255,6 +8628,24 @@ func (p *ZAddReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *ZAddReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+func (p *ZAddReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		p.Score = &v
+	}
+	return nil
+}
 
 func (p *ZAddReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -7270,7 +8661,14 @@ func (p *ZAddReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7305,7 +8703,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ZAddReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -7322,6 +8719,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ZAddReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ZAddReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *ZAddReq) String() string {
 	if p == nil {
@@ -7342,6 +8775,12 @@ func (p *ZAddReq) DeepEqual(ano *ZAddReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Score) {
+		return false
+	}
 	return true
 }
 
@@ -7359,6 +8798,30 @@ func (p *ZAddReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *ZAddReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ZAddReq) Field4DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
 
 type ZAddResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -7429,10 +8892,10 @@ func (p *ZAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -7440,10 +8903,10 @@ func (p *ZAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -7451,17 +8914,16 @@ func (p *ZAddResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -7503,6 +8965,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ZAddResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -7510,8 +8973,8 @@ func (p *ZAddResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZAddResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -7519,8 +8982,8 @@ func (p *ZAddResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZAddResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -7547,7 +9010,6 @@ func (p *ZAddResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7582,7 +9044,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ZAddResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -7599,7 +9060,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *ZAddResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -7665,8 +9125,9 @@ func (p *ZAddResp) Field3DeepEqual(src int32) bool {
 }
 
 type ZRemReq struct {
-	Key     string `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
-	Element []byte `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Key     string  `thrift:"key,1,required" frugal:"1,required,string" json:"key"`
+	Element []byte  `thrift:"element,2,required" frugal:"2,required,binary" json:"element"`
+	Db      *string `thrift:"db,3,optional" frugal:"3,optional,string" json:"db,omitempty"`
 }
 
 func NewZRemReq() *ZRemReq {
@@ -7684,16 +9145,33 @@ func (p *ZRemReq) GetKey() (v string) {
 func (p *ZRemReq) GetElement() (v []byte) {
 	return p.Element
 }
+
+var ZRemReq_Db_DEFAULT string
+
+func (p *ZRemReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return ZRemReq_Db_DEFAULT
+	}
+	return *p.Db
+}
 func (p *ZRemReq) SetKey(val string) {
 	p.Key = val
 }
 func (p *ZRemReq) SetElement(val []byte) {
 	p.Element = val
 }
+func (p *ZRemReq) SetDb(val *string) {
+	p.Db = val
+}
 
 var fieldIDToName_ZRemReq = map[int16]string{
 	1: "key",
 	2: "element",
+	3: "db",
+}
+
+func (p *ZRemReq) IsSetDb() bool {
+	return p.Db != nil
 }
 
 func (p *ZRemReq) Read(iprot thrift.TProtocol) (err error) {
@@ -7723,10 +9201,10 @@ func (p *ZRemReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetKey = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -7734,17 +9212,26 @@ func (p *ZRemReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetElement = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -7781,6 +9268,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ZRemReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -7788,8 +9276,8 @@ func (p *ZRemReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZRemReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
@@ -7797,6 +9285,15 @@ func (p *ZRemReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *ZRemReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
 
 func (p *ZRemReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -7812,7 +9309,10 @@ func (p *ZRemReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7847,7 +9347,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ZRemReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("element", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -7864,6 +9363,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ZRemReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *ZRemReq) String() string {
 	if p == nil {
@@ -7884,6 +9401,9 @@ func (p *ZRemReq) DeepEqual(ano *ZRemReq) bool {
 	if !p.Field2DeepEqual(ano.Element) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Db) {
+		return false
+	}
 	return true
 }
 
@@ -7901,6 +9421,18 @@ func (p *ZRemReq) Field2DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *ZRemReq) Field3DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type ZRemResp struct {
 	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
@@ -7971,10 +9503,10 @@ func (p *ZRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetSuccess = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -7982,10 +9514,10 @@ func (p *ZRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.I32 {
@@ -7993,17 +9525,16 @@ func (p *ZRemResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetStatusCode = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -8045,6 +9576,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *ZRemResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
@@ -8052,8 +9584,8 @@ func (p *ZRemResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZRemResp) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -8061,8 +9593,8 @@ func (p *ZRemResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *ZRemResp) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -8089,7 +9621,6 @@ func (p *ZRemResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8124,7 +9655,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *ZRemResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -8141,7 +9671,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *ZRemResp) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
@@ -8206,265 +9735,1991 @@ func (p *ZRemResp) Field3DeepEqual(src int32) bool {
 	return true
 }
 
-type DataService interface {
-	Get(ctx context.Context, req *GetReq) (r *GetResp, err error)
-
-	Set(ctx context.Context, req *SetReq) (r *SetResp, err error)
+type FlushDBReq struct {
+	Db *string `thrift:"db,1,optional" frugal:"1,optional,string" json:"db,omitempty"`
+}
 
-	Del(ctx context.Context, req *DelReq) (r *DelResp, err error)
+func NewFlushDBReq() *FlushDBReq {
+	return &FlushDBReq{}
+}
 
-	Expire(ctx context.Context, req *ExpireReq) (r *ExpireResp, err error)
+func (p *FlushDBReq) InitDefault() {
+	*p = FlushDBReq{}
+}
 
-	HSet(ctx context.Context, req *HSetReq) (r *HSetResp, err error)
+var FlushDBReq_Db_DEFAULT string
 
-	HGet(ctx context.Context, req *HGetReq) (r *HGetResp, err error)
+func (p *FlushDBReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return FlushDBReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+func (p *FlushDBReq) SetDb(val *string) {
+	p.Db = val
+}
 
-	HDel(ctx context.Context, req *HDelReq) (r *HDelResp, err error)
+var fieldIDToName_FlushDBReq = map[int16]string{
+	1: "db",
+}
 
-	LPush(ctx context.Context, req *LPushReq) (r *LPushResp, err error)
+func (p *FlushDBReq) IsSetDb() bool {
+	return p.Db != nil
+}
 
-	RPush(ctx context.Context, req *RPushReq) (r *RPushResp, err error)
+func (p *FlushDBReq) Read(iprot thrift.TProtocol) (err error) {
 
-	LPop(ctx context.Context, req *LPopReq) (r *LPopResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-	RPop(ctx context.Context, req *RPopReq) (r *RPopResp, err error)
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-	SAdd(ctx context.Context, req *SAddReq) (r *SAddResp, err error)
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-	SRem(ctx context.Context, req *SRemReq) (r *SRemResp, err error)
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
 
-	ZAdd(ctx context.Context, req *ZAddReq) (r *ZAddResp, err error)
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FlushDBReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-	ZRem(ctx context.Context, req *ZRemReq) (r *ZRemResp, err error)
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type DataServiceClient struct {
-	c thrift.TClient
-}
+func (p *FlushDBReq) ReadField1(iprot thrift.TProtocol) error {
 
-func NewDataServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *DataServiceClient {
-	return &DataServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
 	}
+	return nil
 }
 
-func NewDataServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *DataServiceClient {
-	return &DataServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *FlushDBReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FlushDBReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func NewDataServiceClient(c thrift.TClient) *DataServiceClient {
-	return &DataServiceClient{
-		c: c,
+func (p *FlushDBReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *FlushDBReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlushDBReq(%+v)", *p)
 }
 
-func (p *DataServiceClient) Get(ctx context.Context, req *GetReq) (r *GetResp, err error) {
-	var _args DataServiceGetArgs
-	_args.Req = req
-	var _result DataServiceGetResult
-	if err = p.Client_().Call(ctx, "Get", &_args, &_result); err != nil {
-		return
+func (p *FlushDBReq) DeepEqual(ano *FlushDBReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) Set(ctx context.Context, req *SetReq) (r *SetResp, err error) {
-	var _args DataServiceSetArgs
-	_args.Req = req
-	var _result DataServiceSetResult
-	if err = p.Client_().Call(ctx, "Set", &_args, &_result); err != nil {
-		return
+	if !p.Field1DeepEqual(ano.Db) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *DataServiceClient) Del(ctx context.Context, req *DelReq) (r *DelResp, err error) {
-	var _args DataServiceDelArgs
-	_args.Req = req
-	var _result DataServiceDelResult
-	if err = p.Client_().Call(ctx, "Del", &_args, &_result); err != nil {
-		return
+
+func (p *FlushDBReq) Field1DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) Expire(ctx context.Context, req *ExpireReq) (r *ExpireResp, err error) {
-	var _args DataServiceExpireArgs
-	_args.Req = req
-	var _result DataServiceExpireResult
-	if err = p.Client_().Call(ctx, "Expire", &_args, &_result); err != nil {
-		return
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *DataServiceClient) HSet(ctx context.Context, req *HSetReq) (r *HSetResp, err error) {
-	var _args DataServiceHSetArgs
-	_args.Req = req
-	var _result DataServiceHSetResult
-	if err = p.Client_().Call(ctx, "HSet", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type FlushDBResp struct {
+	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message    string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+	StatusCode int32  `thrift:"status_code,3,required" frugal:"3,required,i32" json:"status_code"`
 }
-func (p *DataServiceClient) HGet(ctx context.Context, req *HGetReq) (r *HGetResp, err error) {
-	var _args DataServiceHGetArgs
-	_args.Req = req
-	var _result DataServiceHGetResult
-	if err = p.Client_().Call(ctx, "HGet", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func NewFlushDBResp() *FlushDBResp {
+	return &FlushDBResp{}
 }
-func (p *DataServiceClient) HDel(ctx context.Context, req *HDelReq) (r *HDelResp, err error) {
-	var _args DataServiceHDelArgs
-	_args.Req = req
+
+func (p *FlushDBResp) InitDefault() {
+	*p = FlushDBResp{}
+}
+
+func (p *FlushDBResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *FlushDBResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *FlushDBResp) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+func (p *FlushDBResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *FlushDBResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *FlushDBResp) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+
+var fieldIDToName_FlushDBResp = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "status_code",
+}
+
+func (p *FlushDBResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+	var issetStatusCode bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatusCode = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatusCode {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FlushDBResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FlushDBResp[fieldId]))
+}
+
+func (p *FlushDBResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *FlushDBResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+func (p *FlushDBResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *FlushDBResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FlushDBResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FlushDBResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FlushDBResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FlushDBResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *FlushDBResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlushDBResp(%+v)", *p)
+}
+
+func (p *FlushDBResp) DeepEqual(ano *FlushDBResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StatusCode) {
+		return false
+	}
+	return true
+}
+
+func (p *FlushDBResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *FlushDBResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *FlushDBResp) Field3DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+
+type DBSizeReq struct {
+	Db           *string      `thrift:"db,1,optional" frugal:"1,optional,string" json:"db,omitempty"`
+	Consistency  *Consistency `thrift:"consistency,2,optional" frugal:"2,optional,Consistency" json:"consistency,omitempty"`
+	MaxStaleness *int64       `thrift:"max_staleness,3,optional" frugal:"3,optional,i64" json:"max_staleness,omitempty"`
+}
+
+func NewDBSizeReq() *DBSizeReq {
+	return &DBSizeReq{}
+}
+
+func (p *DBSizeReq) InitDefault() {
+	*p = DBSizeReq{}
+}
+
+var DBSizeReq_Db_DEFAULT string
+
+func (p *DBSizeReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return DBSizeReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+
+var DBSizeReq_Consistency_DEFAULT Consistency
+
+func (p *DBSizeReq) GetConsistency() (v Consistency) {
+	if !p.IsSetConsistency() {
+		return DBSizeReq_Consistency_DEFAULT
+	}
+	return *p.Consistency
+}
+
+var DBSizeReq_MaxStaleness_DEFAULT int64
+
+func (p *DBSizeReq) GetMaxStaleness() (v int64) {
+	if !p.IsSetMaxStaleness() {
+		return DBSizeReq_MaxStaleness_DEFAULT
+	}
+	return *p.MaxStaleness
+}
+func (p *DBSizeReq) SetDb(val *string) {
+	p.Db = val
+}
+func (p *DBSizeReq) SetConsistency(val *Consistency) {
+	p.Consistency = val
+}
+func (p *DBSizeReq) SetMaxStaleness(val *int64) {
+	p.MaxStaleness = val
+}
+
+var fieldIDToName_DBSizeReq = map[int16]string{
+	1: "db",
+	2: "consistency",
+	3: "max_staleness",
+}
+
+func (p *DBSizeReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *DBSizeReq) IsSetConsistency() bool {
+	return p.Consistency != nil
+}
+
+func (p *DBSizeReq) IsSetMaxStaleness() bool {
+	return p.MaxStaleness != nil
+}
+
+func (p *DBSizeReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DBSizeReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DBSizeReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+func (p *DBSizeReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+	}
+	return nil
+}
+func (p *DBSizeReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxStaleness = &v
+	}
+	return nil
+}
+
+func (p *DBSizeReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DBSizeReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DBSizeReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DBSizeReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConsistency() {
+		if err = oprot.WriteFieldBegin("consistency", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Consistency)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DBSizeReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxStaleness() {
+		if err = oprot.WriteFieldBegin("max_staleness", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxStaleness); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DBSizeReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DBSizeReq(%+v)", *p)
+}
+
+func (p *DBSizeReq) DeepEqual(ano *DBSizeReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Db) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Consistency) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.MaxStaleness) {
+		return false
+	}
+	return true
+}
+
+func (p *DBSizeReq) Field1DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DBSizeReq) Field2DeepEqual(src *Consistency) bool {
+
+	if p.Consistency == src {
+		return true
+	} else if p.Consistency == nil || src == nil {
+		return false
+	}
+	if *p.Consistency != *src {
+		return false
+	}
+	return true
+}
+func (p *DBSizeReq) Field3DeepEqual(src *int64) bool {
+
+	if p.MaxStaleness == src {
+		return true
+	} else if p.MaxStaleness == nil || src == nil {
+		return false
+	}
+	if *p.MaxStaleness != *src {
+		return false
+	}
+	return true
+}
+
+type DBSizeResp struct {
+	Success    bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Size       int64  `thrift:"size,2,required" frugal:"2,required,i64" json:"size"`
+	Message    string `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+	StatusCode int32  `thrift:"status_code,4,required" frugal:"4,required,i32" json:"status_code"`
+}
+
+func NewDBSizeResp() *DBSizeResp {
+	return &DBSizeResp{}
+}
+
+func (p *DBSizeResp) InitDefault() {
+	*p = DBSizeResp{}
+}
+
+func (p *DBSizeResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DBSizeResp) GetSize() (v int64) {
+	return p.Size
+}
+
+func (p *DBSizeResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *DBSizeResp) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+func (p *DBSizeResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DBSizeResp) SetSize(val int64) {
+	p.Size = val
+}
+func (p *DBSizeResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *DBSizeResp) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+
+var fieldIDToName_DBSizeResp = map[int16]string{
+	1: "success",
+	2: "size",
+	3: "message",
+	4: "status_code",
+}
+
+func (p *DBSizeResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetSize bool = false
+	var issetMessage bool = false
+	var issetStatusCode bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSize = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatusCode = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatusCode {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DBSizeResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DBSizeResp[fieldId]))
+}
+
+func (p *DBSizeResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *DBSizeResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Size = v
+	}
+	return nil
+}
+func (p *DBSizeResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+func (p *DBSizeResp) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *DBSizeResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DBSizeResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DBSizeResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DBSizeResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Size); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DBSizeResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *DBSizeResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DBSizeResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DBSizeResp(%+v)", *p)
+}
+
+func (p *DBSizeResp) DeepEqual(ano *DBSizeResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Size) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.StatusCode) {
+		return false
+	}
+	return true
+}
+
+func (p *DBSizeResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *DBSizeResp) Field2DeepEqual(src int64) bool {
+
+	if p.Size != src {
+		return false
+	}
+	return true
+}
+func (p *DBSizeResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DBSizeResp) Field4DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+
+type DataService interface {
+	Get(ctx context.Context, req *GetReq) (r *GetResp, err error)
+
+	Set(ctx context.Context, req *SetReq) (r *SetResp, err error)
+
+	Del(ctx context.Context, req *DelReq) (r *DelResp, err error)
+
+	Expire(ctx context.Context, req *ExpireReq) (r *ExpireResp, err error)
+
+	HSet(ctx context.Context, req *HSetReq) (r *HSetResp, err error)
+
+	HGet(ctx context.Context, req *HGetReq) (r *HGetResp, err error)
+
+	HDel(ctx context.Context, req *HDelReq) (r *HDelResp, err error)
+
+	LPush(ctx context.Context, req *LPushReq) (r *LPushResp, err error)
+
+	RPush(ctx context.Context, req *RPushReq) (r *RPushResp, err error)
+
+	LPop(ctx context.Context, req *LPopReq) (r *LPopResp, err error)
+
+	RPop(ctx context.Context, req *RPopReq) (r *RPopResp, err error)
+
+	SAdd(ctx context.Context, req *SAddReq) (r *SAddResp, err error)
+
+	SRem(ctx context.Context, req *SRemReq) (r *SRemResp, err error)
+
+	ZAdd(ctx context.Context, req *ZAddReq) (r *ZAddResp, err error)
+
+	ZRem(ctx context.Context, req *ZRemReq) (r *ZRemResp, err error)
+
+	FlushDB(ctx context.Context, req *FlushDBReq) (r *FlushDBResp, err error)
+
+	DBSize(ctx context.Context, req *DBSizeReq) (r *DBSizeResp, err error)
+}
+
+type DataServiceClient struct {
+	c thrift.TClient
+}
+
+func NewDataServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *DataServiceClient {
+	return &DataServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewDataServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *DataServiceClient {
+	return &DataServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewDataServiceClient(c thrift.TClient) *DataServiceClient {
+	return &DataServiceClient{
+		c: c,
+	}
+}
+
+func (p *DataServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *DataServiceClient) Get(ctx context.Context, req *GetReq) (r *GetResp, err error) {
+	var _args DataServiceGetArgs
+	_args.Req = req
+	var _result DataServiceGetResult
+	if err = p.Client_().Call(ctx, "Get", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) Set(ctx context.Context, req *SetReq) (r *SetResp, err error) {
+	var _args DataServiceSetArgs
+	_args.Req = req
+	var _result DataServiceSetResult
+	if err = p.Client_().Call(ctx, "Set", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) Del(ctx context.Context, req *DelReq) (r *DelResp, err error) {
+	var _args DataServiceDelArgs
+	_args.Req = req
+	var _result DataServiceDelResult
+	if err = p.Client_().Call(ctx, "Del", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) Expire(ctx context.Context, req *ExpireReq) (r *ExpireResp, err error) {
+	var _args DataServiceExpireArgs
+	_args.Req = req
+	var _result DataServiceExpireResult
+	if err = p.Client_().Call(ctx, "Expire", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) HSet(ctx context.Context, req *HSetReq) (r *HSetResp, err error) {
+	var _args DataServiceHSetArgs
+	_args.Req = req
+	var _result DataServiceHSetResult
+	if err = p.Client_().Call(ctx, "HSet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) HGet(ctx context.Context, req *HGetReq) (r *HGetResp, err error) {
+	var _args DataServiceHGetArgs
+	_args.Req = req
+	var _result DataServiceHGetResult
+	if err = p.Client_().Call(ctx, "HGet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) HDel(ctx context.Context, req *HDelReq) (r *HDelResp, err error) {
+	var _args DataServiceHDelArgs
+	_args.Req = req
 	var _result DataServiceHDelResult
 	if err = p.Client_().Call(ctx, "HDel", &_args, &_result); err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) LPush(ctx context.Context, req *LPushReq) (r *LPushResp, err error) {
-	var _args DataServiceLPushArgs
-	_args.Req = req
-	var _result DataServiceLPushResult
-	if err = p.Client_().Call(ctx, "LPush", &_args, &_result); err != nil {
-		return
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) LPush(ctx context.Context, req *LPushReq) (r *LPushResp, err error) {
+	var _args DataServiceLPushArgs
+	_args.Req = req
+	var _result DataServiceLPushResult
+	if err = p.Client_().Call(ctx, "LPush", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) RPush(ctx context.Context, req *RPushReq) (r *RPushResp, err error) {
+	var _args DataServiceRPushArgs
+	_args.Req = req
+	var _result DataServiceRPushResult
+	if err = p.Client_().Call(ctx, "RPush", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) LPop(ctx context.Context, req *LPopReq) (r *LPopResp, err error) {
+	var _args DataServiceLPopArgs
+	_args.Req = req
+	var _result DataServiceLPopResult
+	if err = p.Client_().Call(ctx, "LPop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) RPop(ctx context.Context, req *RPopReq) (r *RPopResp, err error) {
+	var _args DataServiceRPopArgs
+	_args.Req = req
+	var _result DataServiceRPopResult
+	if err = p.Client_().Call(ctx, "RPop", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) SAdd(ctx context.Context, req *SAddReq) (r *SAddResp, err error) {
+	var _args DataServiceSAddArgs
+	_args.Req = req
+	var _result DataServiceSAddResult
+	if err = p.Client_().Call(ctx, "SAdd", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) SRem(ctx context.Context, req *SRemReq) (r *SRemResp, err error) {
+	var _args DataServiceSRemArgs
+	_args.Req = req
+	var _result DataServiceSRemResult
+	if err = p.Client_().Call(ctx, "SRem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) ZAdd(ctx context.Context, req *ZAddReq) (r *ZAddResp, err error) {
+	var _args DataServiceZAddArgs
+	_args.Req = req
+	var _result DataServiceZAddResult
+	if err = p.Client_().Call(ctx, "ZAdd", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) ZRem(ctx context.Context, req *ZRemReq) (r *ZRemResp, err error) {
+	var _args DataServiceZRemArgs
+	_args.Req = req
+	var _result DataServiceZRemResult
+	if err = p.Client_().Call(ctx, "ZRem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) FlushDB(ctx context.Context, req *FlushDBReq) (r *FlushDBResp, err error) {
+	var _args DataServiceFlushDBArgs
+	_args.Req = req
+	var _result DataServiceFlushDBResult
+	if err = p.Client_().Call(ctx, "FlushDB", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *DataServiceClient) DBSize(ctx context.Context, req *DBSizeReq) (r *DBSizeResp, err error) {
+	var _args DataServiceDBSizeArgs
+	_args.Req = req
+	var _result DataServiceDBSizeResult
+	if err = p.Client_().Call(ctx, "DBSize", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type DataServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      DataService
+}
+
+func (p *DataServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *DataServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *DataServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewDataServiceProcessor(handler DataService) *DataServiceProcessor {
+	self := &DataServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Get", &dataServiceProcessorGet{handler: handler})
+	self.AddToProcessorMap("Set", &dataServiceProcessorSet{handler: handler})
+	self.AddToProcessorMap("Del", &dataServiceProcessorDel{handler: handler})
+	self.AddToProcessorMap("Expire", &dataServiceProcessorExpire{handler: handler})
+	self.AddToProcessorMap("HSet", &dataServiceProcessorHSet{handler: handler})
+	self.AddToProcessorMap("HGet", &dataServiceProcessorHGet{handler: handler})
+	self.AddToProcessorMap("HDel", &dataServiceProcessorHDel{handler: handler})
+	self.AddToProcessorMap("LPush", &dataServiceProcessorLPush{handler: handler})
+	self.AddToProcessorMap("RPush", &dataServiceProcessorRPush{handler: handler})
+	self.AddToProcessorMap("LPop", &dataServiceProcessorLPop{handler: handler})
+	self.AddToProcessorMap("RPop", &dataServiceProcessorRPop{handler: handler})
+	self.AddToProcessorMap("SAdd", &dataServiceProcessorSAdd{handler: handler})
+	self.AddToProcessorMap("SRem", &dataServiceProcessorSRem{handler: handler})
+	self.AddToProcessorMap("ZAdd", &dataServiceProcessorZAdd{handler: handler})
+	self.AddToProcessorMap("ZRem", &dataServiceProcessorZRem{handler: handler})
+	self.AddToProcessorMap("FlushDB", &dataServiceProcessorFlushDB{handler: handler})
+	self.AddToProcessorMap("DBSize", &dataServiceProcessorDBSize{handler: handler})
+	return self
+}
+func (p *DataServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type dataServiceProcessorGet struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorGet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceGetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Get", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceGetResult{}
+	var retval *GetResp
+	if retval, err2 = p.handler.Get(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Get: "+err2.Error())
+		oprot.WriteMessageBegin("Get", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Get", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorSet struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorSet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceSetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Set", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceSetResult{}
+	var retval *SetResp
+	if retval, err2 = p.handler.Set(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Set: "+err2.Error())
+		oprot.WriteMessageBegin("Set", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Set", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorDel struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorDel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceDelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Del", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceDelResult{}
+	var retval *DelResp
+	if retval, err2 = p.handler.Del(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Del: "+err2.Error())
+		oprot.WriteMessageBegin("Del", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Del", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorExpire struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorExpire) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceExpireArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Expire", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceExpireResult{}
+	var retval *ExpireResp
+	if retval, err2 = p.handler.Expire(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Expire: "+err2.Error())
+		oprot.WriteMessageBegin("Expire", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Expire", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorHSet struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorHSet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceHSetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HSet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceHSetResult{}
+	var retval *HSetResp
+	if retval, err2 = p.handler.HSet(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HSet: "+err2.Error())
+		oprot.WriteMessageBegin("HSet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HSet", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorHGet struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorHGet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceHGetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HGet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceHGetResult{}
+	var retval *HGetResp
+	if retval, err2 = p.handler.HGet(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HGet: "+err2.Error())
+		oprot.WriteMessageBegin("HGet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HGet", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorHDel struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorHDel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceHDelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HDel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceHDelResult{}
+	var retval *HDelResp
+	if retval, err2 = p.handler.HDel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HDel: "+err2.Error())
+		oprot.WriteMessageBegin("HDel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HDel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type dataServiceProcessorLPush struct {
+	handler DataService
+}
+
+func (p *dataServiceProcessorLPush) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceLPushArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LPush", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceLPushResult{}
+	var retval *LPushResp
+	if retval, err2 = p.handler.LPush(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LPush: "+err2.Error())
+		oprot.WriteMessageBegin("LPush", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LPush", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) RPush(ctx context.Context, req *RPushReq) (r *RPushResp, err error) {
-	var _args DataServiceRPushArgs
-	_args.Req = req
-	var _result DataServiceRPushResult
-	if err = p.Client_().Call(ctx, "RPush", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *DataServiceClient) LPop(ctx context.Context, req *LPopReq) (r *LPopResp, err error) {
-	var _args DataServiceLPopArgs
-	_args.Req = req
-	var _result DataServiceLPopResult
-	if err = p.Client_().Call(ctx, "LPop", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type dataServiceProcessorRPush struct {
+	handler DataService
 }
-func (p *DataServiceClient) RPop(ctx context.Context, req *RPopReq) (r *RPopResp, err error) {
-	var _args DataServiceRPopArgs
-	_args.Req = req
-	var _result DataServiceRPopResult
-	if err = p.Client_().Call(ctx, "RPop", &_args, &_result); err != nil {
-		return
+
+func (p *dataServiceProcessorRPush) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceRPushArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RPush", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) SAdd(ctx context.Context, req *SAddReq) (r *SAddResp, err error) {
-	var _args DataServiceSAddArgs
-	_args.Req = req
-	var _result DataServiceSAddResult
-	if err = p.Client_().Call(ctx, "SAdd", &_args, &_result); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceRPushResult{}
+	var retval *RPushResp
+	if retval, err2 = p.handler.RPush(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RPush: "+err2.Error())
+		oprot.WriteMessageBegin("RPush", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) SRem(ctx context.Context, req *SRemReq) (r *SRemResp, err error) {
-	var _args DataServiceSRemArgs
-	_args.Req = req
-	var _result DataServiceSRemResult
-	if err = p.Client_().Call(ctx, "SRem", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageBegin("RPush", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) ZAdd(ctx context.Context, req *ZAddReq) (r *ZAddResp, err error) {
-	var _args DataServiceZAddArgs
-	_args.Req = req
-	var _result DataServiceZAddResult
-	if err = p.Client_().Call(ctx, "ZAdd", &_args, &_result); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *DataServiceClient) ZRem(ctx context.Context, req *ZRemReq) (r *ZRemResp, err error) {
-	var _args DataServiceZRemArgs
-	_args.Req = req
-	var _result DataServiceZRemResult
-	if err = p.Client_().Call(ctx, "ZRem", &_args, &_result); err != nil {
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
 
-type DataServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      DataService
+type dataServiceProcessorLPop struct {
+	handler DataService
 }
 
-func (p *DataServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+func (p *dataServiceProcessorLPop) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceLPopArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LPop", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
 
-func (p *DataServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DataServiceLPopResult{}
+	var retval *LPopResp
+	if retval, err2 = p.handler.LPop(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LPop: "+err2.Error())
+		oprot.WriteMessageBegin("LPop", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LPop", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *DataServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+type dataServiceProcessorRPop struct {
+	handler DataService
 }
 
-func NewDataServiceProcessor(handler DataService) *DataServiceProcessor {
-	self := &DataServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Get", &dataServiceProcessorGet{handler: handler})
-	self.AddToProcessorMap("Set", &dataServiceProcessorSet{handler: handler})
-	self.AddToProcessorMap("Del", &dataServiceProcessorDel{handler: handler})
-	self.AddToProcessorMap("Expire", &dataServiceProcessorExpire{handler: handler})
-	self.AddToProcessorMap("HSet", &dataServiceProcessorHSet{handler: handler})
-	self.AddToProcessorMap("HGet", &dataServiceProcessorHGet{handler: handler})
-	self.AddToProcessorMap("HDel", &dataServiceProcessorHDel{handler: handler})
-	self.AddToProcessorMap("LPush", &dataServiceProcessorLPush{handler: handler})
-	self.AddToProcessorMap("RPush", &dataServiceProcessorRPush{handler: handler})
-	self.AddToProcessorMap("LPop", &dataServiceProcessorLPop{handler: handler})
-	self.AddToProcessorMap("RPop", &dataServiceProcessorRPop{handler: handler})
-	self.AddToProcessorMap("SAdd", &dataServiceProcessorSAdd{handler: handler})
-	self.AddToProcessorMap("SRem", &dataServiceProcessorSRem{handler: handler})
-	self.AddToProcessorMap("ZAdd", &dataServiceProcessorZAdd{handler: handler})
-	self.AddToProcessorMap("ZRem", &dataServiceProcessorZRem{handler: handler})
-	return self
-}
-func (p *DataServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
+func (p *dataServiceProcessorRPop) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceRPopArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RPop", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
+
 	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	var err2 error
+	result := DataServiceRPopResult{}
+	var retval *RPopResp
+	if retval, err2 = p.handler.RPop(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RPop: "+err2.Error())
+		oprot.WriteMessageBegin("RPop", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RPop", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type dataServiceProcessorGet struct {
+type dataServiceProcessorSAdd struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorGet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceGetArgs{}
+func (p *dataServiceProcessorSAdd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceSAddArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Get", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("SAdd", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8473,11 +11728,11 @@ func (p *dataServiceProcessorGet) Process(ctx context.Context, seqId int32, ipro
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceGetResult{}
-	var retval *GetResp
-	if retval, err2 = p.handler.Get(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Get: "+err2.Error())
-		oprot.WriteMessageBegin("Get", thrift.EXCEPTION, seqId)
+	result := DataServiceSAddResult{}
+	var retval *SAddResp
+	if retval, err2 = p.handler.SAdd(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SAdd: "+err2.Error())
+		oprot.WriteMessageBegin("SAdd", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8485,7 +11740,7 @@ func (p *dataServiceProcessorGet) Process(ctx context.Context, seqId int32, ipro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("Get", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("SAdd", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8503,16 +11758,16 @@ func (p *dataServiceProcessorGet) Process(ctx context.Context, seqId int32, ipro
 	return true, err
 }
 
-type dataServiceProcessorSet struct {
+type dataServiceProcessorSRem struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorSet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceSetArgs{}
+func (p *dataServiceProcessorSRem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceSRemArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Set", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("SRem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8521,11 +11776,11 @@ func (p *dataServiceProcessorSet) Process(ctx context.Context, seqId int32, ipro
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceSetResult{}
-	var retval *SetResp
-	if retval, err2 = p.handler.Set(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Set: "+err2.Error())
-		oprot.WriteMessageBegin("Set", thrift.EXCEPTION, seqId)
+	result := DataServiceSRemResult{}
+	var retval *SRemResp
+	if retval, err2 = p.handler.SRem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SRem: "+err2.Error())
+		oprot.WriteMessageBegin("SRem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8533,7 +11788,7 @@ func (p *dataServiceProcessorSet) Process(ctx context.Context, seqId int32, ipro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("Set", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("SRem", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8551,16 +11806,16 @@ func (p *dataServiceProcessorSet) Process(ctx context.Context, seqId int32, ipro
 	return true, err
 }
 
-type dataServiceProcessorDel struct {
+type dataServiceProcessorZAdd struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorDel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceDelArgs{}
+func (p *dataServiceProcessorZAdd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceZAddArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Del", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ZAdd", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8569,11 +11824,11 @@ func (p *dataServiceProcessorDel) Process(ctx context.Context, seqId int32, ipro
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceDelResult{}
-	var retval *DelResp
-	if retval, err2 = p.handler.Del(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Del: "+err2.Error())
-		oprot.WriteMessageBegin("Del", thrift.EXCEPTION, seqId)
+	result := DataServiceZAddResult{}
+	var retval *ZAddResp
+	if retval, err2 = p.handler.ZAdd(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ZAdd: "+err2.Error())
+		oprot.WriteMessageBegin("ZAdd", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8581,7 +11836,7 @@ func (p *dataServiceProcessorDel) Process(ctx context.Context, seqId int32, ipro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("Del", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ZAdd", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8599,16 +11854,16 @@ func (p *dataServiceProcessorDel) Process(ctx context.Context, seqId int32, ipro
 	return true, err
 }
 
-type dataServiceProcessorExpire struct {
+type dataServiceProcessorZRem struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorExpire) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceExpireArgs{}
+func (p *dataServiceProcessorZRem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceZRemArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Expire", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ZRem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8617,11 +11872,11 @@ func (p *dataServiceProcessorExpire) Process(ctx context.Context, seqId int32, i
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceExpireResult{}
-	var retval *ExpireResp
-	if retval, err2 = p.handler.Expire(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Expire: "+err2.Error())
-		oprot.WriteMessageBegin("Expire", thrift.EXCEPTION, seqId)
+	result := DataServiceZRemResult{}
+	var retval *ZRemResp
+	if retval, err2 = p.handler.ZRem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ZRem: "+err2.Error())
+		oprot.WriteMessageBegin("ZRem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8629,7 +11884,7 @@ func (p *dataServiceProcessorExpire) Process(ctx context.Context, seqId int32, i
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("Expire", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ZRem", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8647,16 +11902,16 @@ func (p *dataServiceProcessorExpire) Process(ctx context.Context, seqId int32, i
 	return true, err
 }
 
-type dataServiceProcessorHSet struct {
+type dataServiceProcessorFlushDB struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorHSet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceHSetArgs{}
+func (p *dataServiceProcessorFlushDB) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceFlushDBArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("HSet", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("FlushDB", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8665,11 +11920,11 @@ func (p *dataServiceProcessorHSet) Process(ctx context.Context, seqId int32, ipr
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceHSetResult{}
-	var retval *HSetResp
-	if retval, err2 = p.handler.HSet(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HSet: "+err2.Error())
-		oprot.WriteMessageBegin("HSet", thrift.EXCEPTION, seqId)
+	result := DataServiceFlushDBResult{}
+	var retval *FlushDBResp
+	if retval, err2 = p.handler.FlushDB(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FlushDB: "+err2.Error())
+		oprot.WriteMessageBegin("FlushDB", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8677,7 +11932,7 @@ func (p *dataServiceProcessorHSet) Process(ctx context.Context, seqId int32, ipr
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("HSet", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("FlushDB", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8695,16 +11950,16 @@ func (p *dataServiceProcessorHSet) Process(ctx context.Context, seqId int32, ipr
 	return true, err
 }
 
-type dataServiceProcessorHGet struct {
+type dataServiceProcessorDBSize struct {
 	handler DataService
 }
 
-func (p *dataServiceProcessorHGet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceHGetArgs{}
+func (p *dataServiceProcessorDBSize) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DataServiceDBSizeArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("HGet", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("DBSize", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8713,11 +11968,11 @@ func (p *dataServiceProcessorHGet) Process(ctx context.Context, seqId int32, ipr
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DataServiceHGetResult{}
-	var retval *HGetResp
-	if retval, err2 = p.handler.HGet(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HGet: "+err2.Error())
-		oprot.WriteMessageBegin("HGet", thrift.EXCEPTION, seqId)
+	result := DataServiceDBSizeResult{}
+	var retval *DBSizeResp
+	if retval, err2 = p.handler.DBSize(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DBSize: "+err2.Error())
+		oprot.WriteMessageBegin("DBSize", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -8725,7 +11980,7 @@ func (p *dataServiceProcessorHGet) Process(ctx context.Context, seqId int32, ipr
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("HGet", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("DBSize", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -8743,471 +11998,727 @@ func (p *dataServiceProcessorHGet) Process(ctx context.Context, seqId int32, ipr
 	return true, err
 }
 
-type dataServiceProcessorHDel struct {
-	handler DataService
+type DataServiceGetArgs struct {
+	Req *GetReq `thrift:"req,1" frugal:"1,default,GetReq" json:"req"`
+}
+
+func NewDataServiceGetArgs() *DataServiceGetArgs {
+	return &DataServiceGetArgs{}
+}
+
+func (p *DataServiceGetArgs) InitDefault() {
+	*p = DataServiceGetArgs{}
+}
+
+var DataServiceGetArgs_Req_DEFAULT *GetReq
+
+func (p *DataServiceGetArgs) GetReq() (v *GetReq) {
+	if !p.IsSetReq() {
+		return DataServiceGetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *DataServiceGetArgs) SetReq(val *GetReq) {
+	p.Req = val
+}
+
+var fieldIDToName_DataServiceGetArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *DataServiceGetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DataServiceGetArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceGetArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewGetReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *DataServiceGetArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Get_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DataServiceGetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DataServiceGetArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataServiceGetArgs(%+v)", *p)
+}
+
+func (p *DataServiceGetArgs) DeepEqual(ano *DataServiceGetArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *DataServiceGetArgs) Field1DeepEqual(src *GetReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DataServiceGetResult struct {
+	Success *GetResp `thrift:"success,0,optional" frugal:"0,optional,GetResp" json:"success,omitempty"`
+}
+
+func NewDataServiceGetResult() *DataServiceGetResult {
+	return &DataServiceGetResult{}
+}
+
+func (p *DataServiceGetResult) InitDefault() {
+	*p = DataServiceGetResult{}
 }
 
-func (p *dataServiceProcessorHDel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceHDelArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("HDel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var DataServiceGetResult_Success_DEFAULT *GetResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceHDelResult{}
-	var retval *HDelResp
-	if retval, err2 = p.handler.HDel(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HDel: "+err2.Error())
-		oprot.WriteMessageBegin("HDel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("HDel", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *DataServiceGetResult) GetSuccess() (v *GetResp) {
+	if !p.IsSetSuccess() {
+		return DataServiceGetResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *DataServiceGetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetResp)
 }
 
-type dataServiceProcessorLPush struct {
-	handler DataService
+var fieldIDToName_DataServiceGetResult = map[int16]string{
+	0: "success",
 }
 
-func (p *dataServiceProcessorLPush) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceLPushArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("LPush", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceGetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DataServiceGetResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceLPushResult{}
-	var retval *LPushResp
-	if retval, err2 = p.handler.LPush(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LPush: "+err2.Error())
-		oprot.WriteMessageBegin("LPush", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("LPush", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceGetResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewGetResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *DataServiceGetResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Get_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type dataServiceProcessorRPush struct {
-	handler DataService
+func (p *DataServiceGetResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *dataServiceProcessorRPush) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceRPushArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RPush", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceGetResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("DataServiceGetResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceRPushResult{}
-	var retval *RPushResp
-	if retval, err2 = p.handler.RPush(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RPush: "+err2.Error())
-		oprot.WriteMessageBegin("RPush", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RPush", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *DataServiceGetResult) DeepEqual(ano *DataServiceGetResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *DataServiceGetResult) Field0DeepEqual(src *GetResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
+}
+
+type DataServiceSetArgs struct {
+	Req *SetReq `thrift:"req,1" frugal:"1,default,SetReq" json:"req"`
 }
 
-type dataServiceProcessorLPop struct {
-	handler DataService
+func NewDataServiceSetArgs() *DataServiceSetArgs {
+	return &DataServiceSetArgs{}
 }
 
-func (p *dataServiceProcessorLPop) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceLPopArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("LPop", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *DataServiceSetArgs) InitDefault() {
+	*p = DataServiceSetArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceLPopResult{}
-	var retval *LPopResp
-	if retval, err2 = p.handler.LPop(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LPop: "+err2.Error())
-		oprot.WriteMessageBegin("LPop", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("LPop", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var DataServiceSetArgs_Req_DEFAULT *SetReq
+
+func (p *DataServiceSetArgs) GetReq() (v *SetReq) {
+	if !p.IsSetReq() {
+		return DataServiceSetArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
+}
+func (p *DataServiceSetArgs) SetReq(val *SetReq) {
+	p.Req = val
 }
 
-type dataServiceProcessorRPop struct {
-	handler DataService
+var fieldIDToName_DataServiceSetArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *dataServiceProcessorRPop) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceRPopArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RPop", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceSetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DataServiceSetArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceRPopResult{}
-	var retval *RPopResp
-	if retval, err2 = p.handler.RPop(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RPop: "+err2.Error())
-		oprot.WriteMessageBegin("RPop", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("RPop", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceSetArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewSetReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *DataServiceSetArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Set_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
-}
-
-type dataServiceProcessorSAdd struct {
-	handler DataService
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *dataServiceProcessorSAdd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceSAddArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SAdd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceSetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceSAddResult{}
-	var retval *SAddResp
-	if retval, err2 = p.handler.SAdd(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SAdd: "+err2.Error())
-		oprot.WriteMessageBegin("SAdd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("SAdd", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DataServiceSetArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("DataServiceSetArgs(%+v)", *p)
+}
+
+func (p *DataServiceSetArgs) DeepEqual(ano *DataServiceSetArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *DataServiceSetArgs) Field1DeepEqual(src *SetReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type dataServiceProcessorSRem struct {
-	handler DataService
+type DataServiceSetResult struct {
+	Success *SetResp `thrift:"success,0,optional" frugal:"0,optional,SetResp" json:"success,omitempty"`
 }
 
-func (p *dataServiceProcessorSRem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceSRemArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SRem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewDataServiceSetResult() *DataServiceSetResult {
+	return &DataServiceSetResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceSRemResult{}
-	var retval *SRemResp
-	if retval, err2 = p.handler.SRem(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SRem: "+err2.Error())
-		oprot.WriteMessageBegin("SRem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SRem", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *DataServiceSetResult) InitDefault() {
+	*p = DataServiceSetResult{}
+}
+
+var DataServiceSetResult_Success_DEFAULT *SetResp
+
+func (p *DataServiceSetResult) GetSuccess() (v *SetResp) {
+	if !p.IsSetSuccess() {
+		return DataServiceSetResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *DataServiceSetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetResp)
 }
 
-type dataServiceProcessorZAdd struct {
-	handler DataService
+var fieldIDToName_DataServiceSetResult = map[int16]string{
+	0: "success",
 }
 
-func (p *dataServiceProcessorZAdd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceZAddArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ZAdd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceSetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DataServiceSetResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceZAddResult{}
-	var retval *ZAddResp
-	if retval, err2 = p.handler.ZAdd(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ZAdd: "+err2.Error())
-		oprot.WriteMessageBegin("ZAdd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("ZAdd", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceSetResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewSetResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *DataServiceSetResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Set_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type dataServiceProcessorZRem struct {
-	handler DataService
+func (p *DataServiceSetResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *dataServiceProcessorZRem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DataServiceZRemArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ZRem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DataServiceSetResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("DataServiceSetResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DataServiceZRemResult{}
-	var retval *ZRemResp
-	if retval, err2 = p.handler.ZRem(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ZRem: "+err2.Error())
-		oprot.WriteMessageBegin("ZRem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ZRem", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+func (p *DataServiceSetResult) DeepEqual(ano *DataServiceSetResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type DataServiceGetArgs struct {
-	Req *GetReq `thrift:"req,1" frugal:"1,default,GetReq" json:"req"`
+func (p *DataServiceSetResult) Field0DeepEqual(src *SetResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewDataServiceGetArgs() *DataServiceGetArgs {
-	return &DataServiceGetArgs{}
+type DataServiceDelArgs struct {
+	Req *DelReq `thrift:"req,1" frugal:"1,default,DelReq" json:"req"`
 }
 
-func (p *DataServiceGetArgs) InitDefault() {
-	*p = DataServiceGetArgs{}
+func NewDataServiceDelArgs() *DataServiceDelArgs {
+	return &DataServiceDelArgs{}
 }
 
-var DataServiceGetArgs_Req_DEFAULT *GetReq
+func (p *DataServiceDelArgs) InitDefault() {
+	*p = DataServiceDelArgs{}
+}
 
-func (p *DataServiceGetArgs) GetReq() (v *GetReq) {
+var DataServiceDelArgs_Req_DEFAULT *DelReq
+
+func (p *DataServiceDelArgs) GetReq() (v *DelReq) {
 	if !p.IsSetReq() {
-		return DataServiceGetArgs_Req_DEFAULT
+		return DataServiceDelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceGetArgs) SetReq(val *GetReq) {
+func (p *DataServiceDelArgs) SetReq(val *DelReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceGetArgs = map[int16]string{
+var fieldIDToName_DataServiceDelArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceGetArgs) IsSetReq() bool {
+func (p *DataServiceDelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceGetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9231,17 +12742,16 @@ func (p *DataServiceGetArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -9256,7 +12766,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9266,17 +12776,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceGetArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewGetReq()
+func (p *DataServiceDelArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewDelReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceGetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Get_args"); err != nil {
+	if err = oprot.WriteStructBegin("Del_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9284,7 +12795,6 @@ func (p *DataServiceGetArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9303,7 +12813,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceGetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9320,14 +12830,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceGetArgs) String() string {
+func (p *DataServiceDelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceGetArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceDelArgs(%+v)", *p)
 }
 
-func (p *DataServiceGetArgs) DeepEqual(ano *DataServiceGetArgs) bool {
+func (p *DataServiceDelArgs) DeepEqual(ano *DataServiceDelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9339,7 +12849,7 @@ func (p *DataServiceGetArgs) DeepEqual(ano *DataServiceGetArgs) bool {
 	return true
 }
 
-func (p *DataServiceGetArgs) Field1DeepEqual(src *GetReq) bool {
+func (p *DataServiceDelArgs) Field1DeepEqual(src *DelReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -9347,39 +12857,39 @@ func (p *DataServiceGetArgs) Field1DeepEqual(src *GetReq) bool {
 	return true
 }
 
-type DataServiceGetResult struct {
-	Success *GetResp `thrift:"success,0,optional" frugal:"0,optional,GetResp" json:"success,omitempty"`
+type DataServiceDelResult struct {
+	Success *DelResp `thrift:"success,0,optional" frugal:"0,optional,DelResp" json:"success,omitempty"`
 }
 
-func NewDataServiceGetResult() *DataServiceGetResult {
-	return &DataServiceGetResult{}
+func NewDataServiceDelResult() *DataServiceDelResult {
+	return &DataServiceDelResult{}
 }
 
-func (p *DataServiceGetResult) InitDefault() {
-	*p = DataServiceGetResult{}
+func (p *DataServiceDelResult) InitDefault() {
+	*p = DataServiceDelResult{}
 }
 
-var DataServiceGetResult_Success_DEFAULT *GetResp
+var DataServiceDelResult_Success_DEFAULT *DelResp
 
-func (p *DataServiceGetResult) GetSuccess() (v *GetResp) {
+func (p *DataServiceDelResult) GetSuccess() (v *DelResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceGetResult_Success_DEFAULT
+		return DataServiceDelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceGetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetResp)
+func (p *DataServiceDelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DelResp)
 }
 
-var fieldIDToName_DataServiceGetResult = map[int16]string{
+var fieldIDToName_DataServiceDelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceGetResult) IsSetSuccess() bool {
+func (p *DataServiceDelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceGetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9403,17 +12913,16 @@ func (p *DataServiceGetResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -9428,7 +12937,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9438,17 +12947,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceGetResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewGetResp()
+func (p *DataServiceDelResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewDelResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceGetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Get_result"); err != nil {
+	if err = oprot.WriteStructBegin("Del_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9456,7 +12966,6 @@ func (p *DataServiceGetResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9475,7 +12984,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceGetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9494,14 +13003,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceGetResult) String() string {
+func (p *DataServiceDelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceGetResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceDelResult(%+v)", *p)
 }
 
-func (p *DataServiceGetResult) DeepEqual(ano *DataServiceGetResult) bool {
+func (p *DataServiceDelResult) DeepEqual(ano *DataServiceDelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9513,7 +13022,7 @@ func (p *DataServiceGetResult) DeepEqual(ano *DataServiceGetResult) bool {
 	return true
 }
 
-func (p *DataServiceGetResult) Field0DeepEqual(src *GetResp) bool {
+func (p *DataServiceDelResult) Field0DeepEqual(src *DelResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -9521,39 +13030,39 @@ func (p *DataServiceGetResult) Field0DeepEqual(src *GetResp) bool {
 	return true
 }
 
-type DataServiceSetArgs struct {
-	Req *SetReq `thrift:"req,1" frugal:"1,default,SetReq" json:"req"`
+type DataServiceExpireArgs struct {
+	Req *ExpireReq `thrift:"req,1" frugal:"1,default,ExpireReq" json:"req"`
 }
 
-func NewDataServiceSetArgs() *DataServiceSetArgs {
-	return &DataServiceSetArgs{}
+func NewDataServiceExpireArgs() *DataServiceExpireArgs {
+	return &DataServiceExpireArgs{}
 }
 
-func (p *DataServiceSetArgs) InitDefault() {
-	*p = DataServiceSetArgs{}
+func (p *DataServiceExpireArgs) InitDefault() {
+	*p = DataServiceExpireArgs{}
 }
 
-var DataServiceSetArgs_Req_DEFAULT *SetReq
+var DataServiceExpireArgs_Req_DEFAULT *ExpireReq
 
-func (p *DataServiceSetArgs) GetReq() (v *SetReq) {
+func (p *DataServiceExpireArgs) GetReq() (v *ExpireReq) {
 	if !p.IsSetReq() {
-		return DataServiceSetArgs_Req_DEFAULT
+		return DataServiceExpireArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceSetArgs) SetReq(val *SetReq) {
+func (p *DataServiceExpireArgs) SetReq(val *ExpireReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceSetArgs = map[int16]string{
+var fieldIDToName_DataServiceExpireArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceSetArgs) IsSetReq() bool {
+func (p *DataServiceExpireArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceSetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9577,17 +13086,16 @@ func (p *DataServiceSetArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -9602,7 +13110,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9612,17 +13120,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSetArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewSetReq()
+func (p *DataServiceExpireArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewExpireReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Set_args"); err != nil {
+	if err = oprot.WriteStructBegin("Expire_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9630,7 +13139,6 @@ func (p *DataServiceSetArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9649,7 +13157,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9666,14 +13174,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceSetArgs) String() string {
+func (p *DataServiceExpireArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSetArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceExpireArgs(%+v)", *p)
 }
 
-func (p *DataServiceSetArgs) DeepEqual(ano *DataServiceSetArgs) bool {
+func (p *DataServiceExpireArgs) DeepEqual(ano *DataServiceExpireArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9685,7 +13193,7 @@ func (p *DataServiceSetArgs) DeepEqual(ano *DataServiceSetArgs) bool {
 	return true
 }
 
-func (p *DataServiceSetArgs) Field1DeepEqual(src *SetReq) bool {
+func (p *DataServiceExpireArgs) Field1DeepEqual(src *ExpireReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -9693,39 +13201,39 @@ func (p *DataServiceSetArgs) Field1DeepEqual(src *SetReq) bool {
 	return true
 }
 
-type DataServiceSetResult struct {
-	Success *SetResp `thrift:"success,0,optional" frugal:"0,optional,SetResp" json:"success,omitempty"`
+type DataServiceExpireResult struct {
+	Success *ExpireResp `thrift:"success,0,optional" frugal:"0,optional,ExpireResp" json:"success,omitempty"`
 }
 
-func NewDataServiceSetResult() *DataServiceSetResult {
-	return &DataServiceSetResult{}
+func NewDataServiceExpireResult() *DataServiceExpireResult {
+	return &DataServiceExpireResult{}
 }
 
-func (p *DataServiceSetResult) InitDefault() {
-	*p = DataServiceSetResult{}
+func (p *DataServiceExpireResult) InitDefault() {
+	*p = DataServiceExpireResult{}
 }
 
-var DataServiceSetResult_Success_DEFAULT *SetResp
+var DataServiceExpireResult_Success_DEFAULT *ExpireResp
 
-func (p *DataServiceSetResult) GetSuccess() (v *SetResp) {
+func (p *DataServiceExpireResult) GetSuccess() (v *ExpireResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceSetResult_Success_DEFAULT
+		return DataServiceExpireResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceSetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SetResp)
+func (p *DataServiceExpireResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExpireResp)
 }
 
-var fieldIDToName_DataServiceSetResult = map[int16]string{
+var fieldIDToName_DataServiceExpireResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceSetResult) IsSetSuccess() bool {
+func (p *DataServiceExpireResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceSetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9749,17 +13257,16 @@ func (p *DataServiceSetResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -9774,7 +13281,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9784,17 +13291,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSetResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewSetResp()
+func (p *DataServiceExpireResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewExpireResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Set_result"); err != nil {
+	if err = oprot.WriteStructBegin("Expire_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9802,7 +13310,6 @@ func (p *DataServiceSetResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9821,7 +13328,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceExpireResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9840,14 +13347,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceSetResult) String() string {
+func (p *DataServiceExpireResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSetResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceExpireResult(%+v)", *p)
 }
 
-func (p *DataServiceSetResult) DeepEqual(ano *DataServiceSetResult) bool {
+func (p *DataServiceExpireResult) DeepEqual(ano *DataServiceExpireResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9859,7 +13366,7 @@ func (p *DataServiceSetResult) DeepEqual(ano *DataServiceSetResult) bool {
 	return true
 }
 
-func (p *DataServiceSetResult) Field0DeepEqual(src *SetResp) bool {
+func (p *DataServiceExpireResult) Field0DeepEqual(src *ExpireResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -9867,39 +13374,39 @@ func (p *DataServiceSetResult) Field0DeepEqual(src *SetResp) bool {
 	return true
 }
 
-type DataServiceDelArgs struct {
-	Req *DelReq `thrift:"req,1" frugal:"1,default,DelReq" json:"req"`
+type DataServiceHSetArgs struct {
+	Req *HSetReq `thrift:"req,1" frugal:"1,default,HSetReq" json:"req"`
 }
 
-func NewDataServiceDelArgs() *DataServiceDelArgs {
-	return &DataServiceDelArgs{}
+func NewDataServiceHSetArgs() *DataServiceHSetArgs {
+	return &DataServiceHSetArgs{}
 }
 
-func (p *DataServiceDelArgs) InitDefault() {
-	*p = DataServiceDelArgs{}
+func (p *DataServiceHSetArgs) InitDefault() {
+	*p = DataServiceHSetArgs{}
 }
 
-var DataServiceDelArgs_Req_DEFAULT *DelReq
+var DataServiceHSetArgs_Req_DEFAULT *HSetReq
 
-func (p *DataServiceDelArgs) GetReq() (v *DelReq) {
+func (p *DataServiceHSetArgs) GetReq() (v *HSetReq) {
 	if !p.IsSetReq() {
-		return DataServiceDelArgs_Req_DEFAULT
+		return DataServiceHSetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceDelArgs) SetReq(val *DelReq) {
+func (p *DataServiceHSetArgs) SetReq(val *HSetReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceDelArgs = map[int16]string{
+var fieldIDToName_DataServiceHSetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceDelArgs) IsSetReq() bool {
+func (p *DataServiceHSetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceDelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9923,17 +13430,16 @@ func (p *DataServiceDelArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -9948,7 +13454,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9958,17 +13464,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceDelArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewDelReq()
+func (p *DataServiceHSetArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewHSetReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceDelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Del_args"); err != nil {
+	if err = oprot.WriteStructBegin("HSet_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9976,7 +13483,6 @@ func (p *DataServiceDelArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9995,7 +13501,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceDelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10012,14 +13518,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceDelArgs) String() string {
+func (p *DataServiceHSetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceDelArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceHSetArgs(%+v)", *p)
 }
 
-func (p *DataServiceDelArgs) DeepEqual(ano *DataServiceDelArgs) bool {
+func (p *DataServiceHSetArgs) DeepEqual(ano *DataServiceHSetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10031,7 +13537,7 @@ func (p *DataServiceDelArgs) DeepEqual(ano *DataServiceDelArgs) bool {
 	return true
 }
 
-func (p *DataServiceDelArgs) Field1DeepEqual(src *DelReq) bool {
+func (p *DataServiceHSetArgs) Field1DeepEqual(src *HSetReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -10039,39 +13545,39 @@ func (p *DataServiceDelArgs) Field1DeepEqual(src *DelReq) bool {
 	return true
 }
 
-type DataServiceDelResult struct {
-	Success *DelResp `thrift:"success,0,optional" frugal:"0,optional,DelResp" json:"success,omitempty"`
+type DataServiceHSetResult struct {
+	Success *HSetResp `thrift:"success,0,optional" frugal:"0,optional,HSetResp" json:"success,omitempty"`
 }
 
-func NewDataServiceDelResult() *DataServiceDelResult {
-	return &DataServiceDelResult{}
+func NewDataServiceHSetResult() *DataServiceHSetResult {
+	return &DataServiceHSetResult{}
 }
 
-func (p *DataServiceDelResult) InitDefault() {
-	*p = DataServiceDelResult{}
+func (p *DataServiceHSetResult) InitDefault() {
+	*p = DataServiceHSetResult{}
 }
 
-var DataServiceDelResult_Success_DEFAULT *DelResp
+var DataServiceHSetResult_Success_DEFAULT *HSetResp
 
-func (p *DataServiceDelResult) GetSuccess() (v *DelResp) {
+func (p *DataServiceHSetResult) GetSuccess() (v *HSetResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceDelResult_Success_DEFAULT
+		return DataServiceHSetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceDelResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DelResp)
+func (p *DataServiceHSetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HSetResp)
 }
 
-var fieldIDToName_DataServiceDelResult = map[int16]string{
+var fieldIDToName_DataServiceHSetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceDelResult) IsSetSuccess() bool {
+func (p *DataServiceHSetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceDelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10095,17 +13601,16 @@ func (p *DataServiceDelResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10120,7 +13625,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10130,17 +13635,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceDelResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewDelResp()
+func (p *DataServiceHSetResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewHSetResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceDelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Del_result"); err != nil {
+	if err = oprot.WriteStructBegin("HSet_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10148,7 +13654,6 @@ func (p *DataServiceDelResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10167,7 +13672,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceDelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHSetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10186,14 +13691,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceDelResult) String() string {
+func (p *DataServiceHSetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceDelResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceHSetResult(%+v)", *p)
 }
 
-func (p *DataServiceDelResult) DeepEqual(ano *DataServiceDelResult) bool {
+func (p *DataServiceHSetResult) DeepEqual(ano *DataServiceHSetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10205,7 +13710,7 @@ func (p *DataServiceDelResult) DeepEqual(ano *DataServiceDelResult) bool {
 	return true
 }
 
-func (p *DataServiceDelResult) Field0DeepEqual(src *DelResp) bool {
+func (p *DataServiceHSetResult) Field0DeepEqual(src *HSetResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10213,39 +13718,39 @@ func (p *DataServiceDelResult) Field0DeepEqual(src *DelResp) bool {
 	return true
 }
 
-type DataServiceExpireArgs struct {
-	Req *ExpireReq `thrift:"req,1" frugal:"1,default,ExpireReq" json:"req"`
+type DataServiceHGetArgs struct {
+	Req *HGetReq `thrift:"req,1" frugal:"1,default,HGetReq" json:"req"`
 }
 
-func NewDataServiceExpireArgs() *DataServiceExpireArgs {
-	return &DataServiceExpireArgs{}
+func NewDataServiceHGetArgs() *DataServiceHGetArgs {
+	return &DataServiceHGetArgs{}
 }
 
-func (p *DataServiceExpireArgs) InitDefault() {
-	*p = DataServiceExpireArgs{}
+func (p *DataServiceHGetArgs) InitDefault() {
+	*p = DataServiceHGetArgs{}
 }
 
-var DataServiceExpireArgs_Req_DEFAULT *ExpireReq
+var DataServiceHGetArgs_Req_DEFAULT *HGetReq
 
-func (p *DataServiceExpireArgs) GetReq() (v *ExpireReq) {
+func (p *DataServiceHGetArgs) GetReq() (v *HGetReq) {
 	if !p.IsSetReq() {
-		return DataServiceExpireArgs_Req_DEFAULT
+		return DataServiceHGetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceExpireArgs) SetReq(val *ExpireReq) {
+func (p *DataServiceHGetArgs) SetReq(val *HGetReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceExpireArgs = map[int16]string{
+var fieldIDToName_DataServiceHGetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceExpireArgs) IsSetReq() bool {
+func (p *DataServiceHGetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceExpireArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10269,17 +13774,16 @@ func (p *DataServiceExpireArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10294,7 +13798,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10304,17 +13808,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewExpireReq()
+func (p *DataServiceHGetArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewHGetReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceExpireArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Expire_args"); err != nil {
+	if err = oprot.WriteStructBegin("HGet_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10322,7 +13827,6 @@ func (p *DataServiceExpireArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10341,7 +13845,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10358,14 +13862,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceExpireArgs) String() string {
+func (p *DataServiceHGetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceExpireArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceHGetArgs(%+v)", *p)
 }
 
-func (p *DataServiceExpireArgs) DeepEqual(ano *DataServiceExpireArgs) bool {
+func (p *DataServiceHGetArgs) DeepEqual(ano *DataServiceHGetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10377,7 +13881,7 @@ func (p *DataServiceExpireArgs) DeepEqual(ano *DataServiceExpireArgs) bool {
 	return true
 }
 
-func (p *DataServiceExpireArgs) Field1DeepEqual(src *ExpireReq) bool {
+func (p *DataServiceHGetArgs) Field1DeepEqual(src *HGetReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -10385,39 +13889,39 @@ func (p *DataServiceExpireArgs) Field1DeepEqual(src *ExpireReq) bool {
 	return true
 }
 
-type DataServiceExpireResult struct {
-	Success *ExpireResp `thrift:"success,0,optional" frugal:"0,optional,ExpireResp" json:"success,omitempty"`
+type DataServiceHGetResult struct {
+	Success *HGetResp `thrift:"success,0,optional" frugal:"0,optional,HGetResp" json:"success,omitempty"`
 }
 
-func NewDataServiceExpireResult() *DataServiceExpireResult {
-	return &DataServiceExpireResult{}
+func NewDataServiceHGetResult() *DataServiceHGetResult {
+	return &DataServiceHGetResult{}
 }
 
-func (p *DataServiceExpireResult) InitDefault() {
-	*p = DataServiceExpireResult{}
+func (p *DataServiceHGetResult) InitDefault() {
+	*p = DataServiceHGetResult{}
 }
 
-var DataServiceExpireResult_Success_DEFAULT *ExpireResp
+var DataServiceHGetResult_Success_DEFAULT *HGetResp
 
-func (p *DataServiceExpireResult) GetSuccess() (v *ExpireResp) {
+func (p *DataServiceHGetResult) GetSuccess() (v *HGetResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceExpireResult_Success_DEFAULT
+		return DataServiceHGetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceExpireResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ExpireResp)
+func (p *DataServiceHGetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HGetResp)
 }
 
-var fieldIDToName_DataServiceExpireResult = map[int16]string{
+var fieldIDToName_DataServiceHGetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceExpireResult) IsSetSuccess() bool {
+func (p *DataServiceHGetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceExpireResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10441,17 +13945,16 @@ func (p *DataServiceExpireResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10466,7 +13969,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10476,17 +13979,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewExpireResp()
+func (p *DataServiceHGetResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewHGetResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceExpireResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Expire_result"); err != nil {
+	if err = oprot.WriteStructBegin("HGet_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10494,7 +13998,6 @@ func (p *DataServiceExpireResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10513,7 +14016,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHGetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10532,14 +14035,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceExpireResult) String() string {
+func (p *DataServiceHGetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceExpireResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceHGetResult(%+v)", *p)
 }
 
-func (p *DataServiceExpireResult) DeepEqual(ano *DataServiceExpireResult) bool {
+func (p *DataServiceHGetResult) DeepEqual(ano *DataServiceHGetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10551,7 +14054,7 @@ func (p *DataServiceExpireResult) DeepEqual(ano *DataServiceExpireResult) bool {
 	return true
 }
 
-func (p *DataServiceExpireResult) Field0DeepEqual(src *ExpireResp) bool {
+func (p *DataServiceHGetResult) Field0DeepEqual(src *HGetResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10559,39 +14062,39 @@ func (p *DataServiceExpireResult) Field0DeepEqual(src *ExpireResp) bool {
 	return true
 }
 
-type DataServiceHSetArgs struct {
-	Req *HSetReq `thrift:"req,1" frugal:"1,default,HSetReq" json:"req"`
+type DataServiceHDelArgs struct {
+	Req *HDelReq `thrift:"req,1" frugal:"1,default,HDelReq" json:"req"`
 }
 
-func NewDataServiceHSetArgs() *DataServiceHSetArgs {
-	return &DataServiceHSetArgs{}
+func NewDataServiceHDelArgs() *DataServiceHDelArgs {
+	return &DataServiceHDelArgs{}
 }
 
-func (p *DataServiceHSetArgs) InitDefault() {
-	*p = DataServiceHSetArgs{}
+func (p *DataServiceHDelArgs) InitDefault() {
+	*p = DataServiceHDelArgs{}
 }
 
-var DataServiceHSetArgs_Req_DEFAULT *HSetReq
+var DataServiceHDelArgs_Req_DEFAULT *HDelReq
 
-func (p *DataServiceHSetArgs) GetReq() (v *HSetReq) {
+func (p *DataServiceHDelArgs) GetReq() (v *HDelReq) {
 	if !p.IsSetReq() {
-		return DataServiceHSetArgs_Req_DEFAULT
+		return DataServiceHDelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceHSetArgs) SetReq(val *HSetReq) {
+func (p *DataServiceHDelArgs) SetReq(val *HDelReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceHSetArgs = map[int16]string{
+var fieldIDToName_DataServiceHDelArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceHSetArgs) IsSetReq() bool {
+func (p *DataServiceHDelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceHSetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10615,17 +14118,16 @@ func (p *DataServiceHSetArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10640,7 +14142,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10650,17 +14152,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewHSetReq()
+func (p *DataServiceHDelArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewHDelReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHSetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HSet_args"); err != nil {
+	if err = oprot.WriteStructBegin("HDel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10668,7 +14171,6 @@ func (p *DataServiceHSetArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10687,7 +14189,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10704,14 +14206,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceHSetArgs) String() string {
+func (p *DataServiceHDelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHSetArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceHDelArgs(%+v)", *p)
 }
 
-func (p *DataServiceHSetArgs) DeepEqual(ano *DataServiceHSetArgs) bool {
+func (p *DataServiceHDelArgs) DeepEqual(ano *DataServiceHDelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10723,7 +14225,7 @@ func (p *DataServiceHSetArgs) DeepEqual(ano *DataServiceHSetArgs) bool {
 	return true
 }
 
-func (p *DataServiceHSetArgs) Field1DeepEqual(src *HSetReq) bool {
+func (p *DataServiceHDelArgs) Field1DeepEqual(src *HDelReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -10731,39 +14233,39 @@ func (p *DataServiceHSetArgs) Field1DeepEqual(src *HSetReq) bool {
 	return true
 }
 
-type DataServiceHSetResult struct {
-	Success *HSetResp `thrift:"success,0,optional" frugal:"0,optional,HSetResp" json:"success,omitempty"`
+type DataServiceHDelResult struct {
+	Success *HDelResp `thrift:"success,0,optional" frugal:"0,optional,HDelResp" json:"success,omitempty"`
 }
 
-func NewDataServiceHSetResult() *DataServiceHSetResult {
-	return &DataServiceHSetResult{}
+func NewDataServiceHDelResult() *DataServiceHDelResult {
+	return &DataServiceHDelResult{}
 }
 
-func (p *DataServiceHSetResult) InitDefault() {
-	*p = DataServiceHSetResult{}
+func (p *DataServiceHDelResult) InitDefault() {
+	*p = DataServiceHDelResult{}
 }
 
-var DataServiceHSetResult_Success_DEFAULT *HSetResp
+var DataServiceHDelResult_Success_DEFAULT *HDelResp
 
-func (p *DataServiceHSetResult) GetSuccess() (v *HSetResp) {
+func (p *DataServiceHDelResult) GetSuccess() (v *HDelResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceHSetResult_Success_DEFAULT
+		return DataServiceHDelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceHSetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*HSetResp)
+func (p *DataServiceHDelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HDelResp)
 }
 
-var fieldIDToName_DataServiceHSetResult = map[int16]string{
+var fieldIDToName_DataServiceHDelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceHSetResult) IsSetSuccess() bool {
+func (p *DataServiceHDelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceHSetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10787,17 +14289,16 @@ func (p *DataServiceHSetResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10812,7 +14313,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10822,17 +14323,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewHSetResp()
+func (p *DataServiceHDelResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewHDelResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHSetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HSet_result"); err != nil {
+	if err = oprot.WriteStructBegin("HDel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10840,7 +14342,6 @@ func (p *DataServiceHSetResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10859,7 +14360,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceHDelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10878,14 +14379,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceHSetResult) String() string {
+func (p *DataServiceHDelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHSetResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceHDelResult(%+v)", *p)
 }
 
-func (p *DataServiceHSetResult) DeepEqual(ano *DataServiceHSetResult) bool {
+func (p *DataServiceHDelResult) DeepEqual(ano *DataServiceHDelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -10897,7 +14398,7 @@ func (p *DataServiceHSetResult) DeepEqual(ano *DataServiceHSetResult) bool {
 	return true
 }
 
-func (p *DataServiceHSetResult) Field0DeepEqual(src *HSetResp) bool {
+func (p *DataServiceHDelResult) Field0DeepEqual(src *HDelResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -10905,39 +14406,39 @@ func (p *DataServiceHSetResult) Field0DeepEqual(src *HSetResp) bool {
 	return true
 }
 
-type DataServiceHGetArgs struct {
-	Req *HGetReq `thrift:"req,1" frugal:"1,default,HGetReq" json:"req"`
+type DataServiceLPushArgs struct {
+	Req *LPushReq `thrift:"req,1" frugal:"1,default,LPushReq" json:"req"`
 }
 
-func NewDataServiceHGetArgs() *DataServiceHGetArgs {
-	return &DataServiceHGetArgs{}
+func NewDataServiceLPushArgs() *DataServiceLPushArgs {
+	return &DataServiceLPushArgs{}
 }
 
-func (p *DataServiceHGetArgs) InitDefault() {
-	*p = DataServiceHGetArgs{}
+func (p *DataServiceLPushArgs) InitDefault() {
+	*p = DataServiceLPushArgs{}
 }
 
-var DataServiceHGetArgs_Req_DEFAULT *HGetReq
+var DataServiceLPushArgs_Req_DEFAULT *LPushReq
 
-func (p *DataServiceHGetArgs) GetReq() (v *HGetReq) {
+func (p *DataServiceLPushArgs) GetReq() (v *LPushReq) {
 	if !p.IsSetReq() {
-		return DataServiceHGetArgs_Req_DEFAULT
+		return DataServiceLPushArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceHGetArgs) SetReq(val *HGetReq) {
+func (p *DataServiceLPushArgs) SetReq(val *LPushReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceHGetArgs = map[int16]string{
+var fieldIDToName_DataServiceLPushArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceHGetArgs) IsSetReq() bool {
+func (p *DataServiceLPushArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceHGetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10961,17 +14462,16 @@ func (p *DataServiceHGetArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -10986,7 +14486,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10996,17 +14496,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewHGetReq()
+func (p *DataServiceLPushArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewLPushReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHGetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HGet_args"); err != nil {
+	if err = oprot.WriteStructBegin("LPush_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11014,7 +14515,6 @@ func (p *DataServiceHGetArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11033,7 +14533,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11050,14 +14550,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceHGetArgs) String() string {
+func (p *DataServiceLPushArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHGetArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceLPushArgs(%+v)", *p)
 }
 
-func (p *DataServiceHGetArgs) DeepEqual(ano *DataServiceHGetArgs) bool {
+func (p *DataServiceLPushArgs) DeepEqual(ano *DataServiceLPushArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11069,7 +14569,7 @@ func (p *DataServiceHGetArgs) DeepEqual(ano *DataServiceHGetArgs) bool {
 	return true
 }
 
-func (p *DataServiceHGetArgs) Field1DeepEqual(src *HGetReq) bool {
+func (p *DataServiceLPushArgs) Field1DeepEqual(src *LPushReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -11077,39 +14577,39 @@ func (p *DataServiceHGetArgs) Field1DeepEqual(src *HGetReq) bool {
 	return true
 }
 
-type DataServiceHGetResult struct {
-	Success *HGetResp `thrift:"success,0,optional" frugal:"0,optional,HGetResp" json:"success,omitempty"`
+type DataServiceLPushResult struct {
+	Success *LPushResp `thrift:"success,0,optional" frugal:"0,optional,LPushResp" json:"success,omitempty"`
 }
 
-func NewDataServiceHGetResult() *DataServiceHGetResult {
-	return &DataServiceHGetResult{}
+func NewDataServiceLPushResult() *DataServiceLPushResult {
+	return &DataServiceLPushResult{}
 }
 
-func (p *DataServiceHGetResult) InitDefault() {
-	*p = DataServiceHGetResult{}
+func (p *DataServiceLPushResult) InitDefault() {
+	*p = DataServiceLPushResult{}
 }
 
-var DataServiceHGetResult_Success_DEFAULT *HGetResp
+var DataServiceLPushResult_Success_DEFAULT *LPushResp
 
-func (p *DataServiceHGetResult) GetSuccess() (v *HGetResp) {
+func (p *DataServiceLPushResult) GetSuccess() (v *LPushResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceHGetResult_Success_DEFAULT
+		return DataServiceLPushResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceHGetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*HGetResp)
+func (p *DataServiceLPushResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LPushResp)
 }
 
-var fieldIDToName_DataServiceHGetResult = map[int16]string{
+var fieldIDToName_DataServiceLPushResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceHGetResult) IsSetSuccess() bool {
+func (p *DataServiceLPushResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceHGetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11133,17 +14633,16 @@ func (p *DataServiceHGetResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -11158,7 +14657,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11168,17 +14667,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewHGetResp()
+func (p *DataServiceLPushResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewLPushResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHGetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HGet_result"); err != nil {
+	if err = oprot.WriteStructBegin("LPush_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11186,7 +14686,6 @@ func (p *DataServiceHGetResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11205,7 +14704,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPushResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11224,14 +14723,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceHGetResult) String() string {
+func (p *DataServiceLPushResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHGetResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceLPushResult(%+v)", *p)
 }
 
-func (p *DataServiceHGetResult) DeepEqual(ano *DataServiceHGetResult) bool {
+func (p *DataServiceLPushResult) DeepEqual(ano *DataServiceLPushResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11243,7 +14742,7 @@ func (p *DataServiceHGetResult) DeepEqual(ano *DataServiceHGetResult) bool {
 	return true
 }
 
-func (p *DataServiceHGetResult) Field0DeepEqual(src *HGetResp) bool {
+func (p *DataServiceLPushResult) Field0DeepEqual(src *LPushResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11251,39 +14750,39 @@ func (p *DataServiceHGetResult) Field0DeepEqual(src *HGetResp) bool {
 	return true
 }
 
-type DataServiceHDelArgs struct {
-	Req *HDelReq `thrift:"req,1" frugal:"1,default,HDelReq" json:"req"`
+type DataServiceRPushArgs struct {
+	Req *RPushReq `thrift:"req,1" frugal:"1,default,RPushReq" json:"req"`
 }
 
-func NewDataServiceHDelArgs() *DataServiceHDelArgs {
-	return &DataServiceHDelArgs{}
+func NewDataServiceRPushArgs() *DataServiceRPushArgs {
+	return &DataServiceRPushArgs{}
 }
 
-func (p *DataServiceHDelArgs) InitDefault() {
-	*p = DataServiceHDelArgs{}
+func (p *DataServiceRPushArgs) InitDefault() {
+	*p = DataServiceRPushArgs{}
 }
 
-var DataServiceHDelArgs_Req_DEFAULT *HDelReq
+var DataServiceRPushArgs_Req_DEFAULT *RPushReq
 
-func (p *DataServiceHDelArgs) GetReq() (v *HDelReq) {
+func (p *DataServiceRPushArgs) GetReq() (v *RPushReq) {
 	if !p.IsSetReq() {
-		return DataServiceHDelArgs_Req_DEFAULT
+		return DataServiceRPushArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceHDelArgs) SetReq(val *HDelReq) {
+func (p *DataServiceRPushArgs) SetReq(val *RPushReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceHDelArgs = map[int16]string{
+var fieldIDToName_DataServiceRPushArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceHDelArgs) IsSetReq() bool {
+func (p *DataServiceRPushArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceHDelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11307,17 +14806,16 @@ func (p *DataServiceHDelArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -11332,7 +14830,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11342,17 +14840,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewHDelReq()
+func (p *DataServiceRPushArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRPushReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHDelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HDel_args"); err != nil {
+	if err = oprot.WriteStructBegin("RPush_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11360,7 +14859,6 @@ func (p *DataServiceHDelArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11379,7 +14877,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11396,14 +14894,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceHDelArgs) String() string {
+func (p *DataServiceRPushArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHDelArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceRPushArgs(%+v)", *p)
 }
 
-func (p *DataServiceHDelArgs) DeepEqual(ano *DataServiceHDelArgs) bool {
+func (p *DataServiceRPushArgs) DeepEqual(ano *DataServiceRPushArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11415,7 +14913,7 @@ func (p *DataServiceHDelArgs) DeepEqual(ano *DataServiceHDelArgs) bool {
 	return true
 }
 
-func (p *DataServiceHDelArgs) Field1DeepEqual(src *HDelReq) bool {
+func (p *DataServiceRPushArgs) Field1DeepEqual(src *RPushReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -11423,39 +14921,39 @@ func (p *DataServiceHDelArgs) Field1DeepEqual(src *HDelReq) bool {
 	return true
 }
 
-type DataServiceHDelResult struct {
-	Success *HDelResp `thrift:"success,0,optional" frugal:"0,optional,HDelResp" json:"success,omitempty"`
+type DataServiceRPushResult struct {
+	Success *RPushResp `thrift:"success,0,optional" frugal:"0,optional,RPushResp" json:"success,omitempty"`
 }
 
-func NewDataServiceHDelResult() *DataServiceHDelResult {
-	return &DataServiceHDelResult{}
+func NewDataServiceRPushResult() *DataServiceRPushResult {
+	return &DataServiceRPushResult{}
 }
 
-func (p *DataServiceHDelResult) InitDefault() {
-	*p = DataServiceHDelResult{}
+func (p *DataServiceRPushResult) InitDefault() {
+	*p = DataServiceRPushResult{}
 }
 
-var DataServiceHDelResult_Success_DEFAULT *HDelResp
+var DataServiceRPushResult_Success_DEFAULT *RPushResp
 
-func (p *DataServiceHDelResult) GetSuccess() (v *HDelResp) {
+func (p *DataServiceRPushResult) GetSuccess() (v *RPushResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceHDelResult_Success_DEFAULT
+		return DataServiceRPushResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceHDelResult) SetSuccess(x interface{}) {
-	p.Success = x.(*HDelResp)
+func (p *DataServiceRPushResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RPushResp)
 }
 
-var fieldIDToName_DataServiceHDelResult = map[int16]string{
+var fieldIDToName_DataServiceRPushResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceHDelResult) IsSetSuccess() bool {
+func (p *DataServiceRPushResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceHDelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11479,17 +14977,16 @@ func (p *DataServiceHDelResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -11504,7 +15001,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11514,17 +15011,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewHDelResp()
+func (p *DataServiceRPushResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRPushResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceHDelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HDel_result"); err != nil {
+	if err = oprot.WriteStructBegin("RPush_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11532,7 +15030,6 @@ func (p *DataServiceHDelResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11551,7 +15048,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPushResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11570,14 +15067,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceHDelResult) String() string {
+func (p *DataServiceRPushResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceHDelResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceRPushResult(%+v)", *p)
 }
 
-func (p *DataServiceHDelResult) DeepEqual(ano *DataServiceHDelResult) bool {
+func (p *DataServiceRPushResult) DeepEqual(ano *DataServiceRPushResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11589,7 +15086,7 @@ func (p *DataServiceHDelResult) DeepEqual(ano *DataServiceHDelResult) bool {
 	return true
 }
 
-func (p *DataServiceHDelResult) Field0DeepEqual(src *HDelResp) bool {
+func (p *DataServiceRPushResult) Field0DeepEqual(src *RPushResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11597,39 +15094,39 @@ func (p *DataServiceHDelResult) Field0DeepEqual(src *HDelResp) bool {
 	return true
 }
 
-type DataServiceLPushArgs struct {
-	Req *LPushReq `thrift:"req,1" frugal:"1,default,LPushReq" json:"req"`
+type DataServiceLPopArgs struct {
+	Req *LPopReq `thrift:"req,1" frugal:"1,default,LPopReq" json:"req"`
 }
 
-func NewDataServiceLPushArgs() *DataServiceLPushArgs {
-	return &DataServiceLPushArgs{}
+func NewDataServiceLPopArgs() *DataServiceLPopArgs {
+	return &DataServiceLPopArgs{}
 }
 
-func (p *DataServiceLPushArgs) InitDefault() {
-	*p = DataServiceLPushArgs{}
+func (p *DataServiceLPopArgs) InitDefault() {
+	*p = DataServiceLPopArgs{}
 }
 
-var DataServiceLPushArgs_Req_DEFAULT *LPushReq
+var DataServiceLPopArgs_Req_DEFAULT *LPopReq
 
-func (p *DataServiceLPushArgs) GetReq() (v *LPushReq) {
+func (p *DataServiceLPopArgs) GetReq() (v *LPopReq) {
 	if !p.IsSetReq() {
-		return DataServiceLPushArgs_Req_DEFAULT
+		return DataServiceLPopArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceLPushArgs) SetReq(val *LPushReq) {
+func (p *DataServiceLPopArgs) SetReq(val *LPopReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceLPushArgs = map[int16]string{
+var fieldIDToName_DataServiceLPopArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceLPushArgs) IsSetReq() bool {
+func (p *DataServiceLPopArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceLPushArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11653,17 +15150,16 @@ func (p *DataServiceLPushArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -11678,7 +15174,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11688,17 +15184,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewLPushReq()
+func (p *DataServiceLPopArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewLPopReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceLPushArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LPush_args"); err != nil {
+	if err = oprot.WriteStructBegin("LPop_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11706,7 +15203,6 @@ func (p *DataServiceLPushArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11725,7 +15221,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11742,14 +15238,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceLPushArgs) String() string {
+func (p *DataServiceLPopArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceLPushArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceLPopArgs(%+v)", *p)
 }
 
-func (p *DataServiceLPushArgs) DeepEqual(ano *DataServiceLPushArgs) bool {
+func (p *DataServiceLPopArgs) DeepEqual(ano *DataServiceLPopArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11761,7 +15257,7 @@ func (p *DataServiceLPushArgs) DeepEqual(ano *DataServiceLPushArgs) bool {
 	return true
 }
 
-func (p *DataServiceLPushArgs) Field1DeepEqual(src *LPushReq) bool {
+func (p *DataServiceLPopArgs) Field1DeepEqual(src *LPopReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -11769,39 +15265,39 @@ func (p *DataServiceLPushArgs) Field1DeepEqual(src *LPushReq) bool {
 	return true
 }
 
-type DataServiceLPushResult struct {
-	Success *LPushResp `thrift:"success,0,optional" frugal:"0,optional,LPushResp" json:"success,omitempty"`
+type DataServiceLPopResult struct {
+	Success *LPopResp `thrift:"success,0,optional" frugal:"0,optional,LPopResp" json:"success,omitempty"`
 }
 
-func NewDataServiceLPushResult() *DataServiceLPushResult {
-	return &DataServiceLPushResult{}
+func NewDataServiceLPopResult() *DataServiceLPopResult {
+	return &DataServiceLPopResult{}
 }
 
-func (p *DataServiceLPushResult) InitDefault() {
-	*p = DataServiceLPushResult{}
+func (p *DataServiceLPopResult) InitDefault() {
+	*p = DataServiceLPopResult{}
 }
 
-var DataServiceLPushResult_Success_DEFAULT *LPushResp
+var DataServiceLPopResult_Success_DEFAULT *LPopResp
 
-func (p *DataServiceLPushResult) GetSuccess() (v *LPushResp) {
+func (p *DataServiceLPopResult) GetSuccess() (v *LPopResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceLPushResult_Success_DEFAULT
+		return DataServiceLPopResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceLPushResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LPushResp)
+func (p *DataServiceLPopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LPopResp)
 }
 
-var fieldIDToName_DataServiceLPushResult = map[int16]string{
+var fieldIDToName_DataServiceLPopResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceLPushResult) IsSetSuccess() bool {
+func (p *DataServiceLPopResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceLPushResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11825,17 +15321,16 @@ func (p *DataServiceLPushResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -11850,7 +15345,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11860,17 +15355,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewLPushResp()
+func (p *DataServiceLPopResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewLPopResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceLPushResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LPush_result"); err != nil {
+	if err = oprot.WriteStructBegin("LPop_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11878,7 +15374,6 @@ func (p *DataServiceLPushResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11897,7 +15392,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceLPopResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11916,14 +15411,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceLPushResult) String() string {
+func (p *DataServiceLPopResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceLPushResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceLPopResult(%+v)", *p)
 }
 
-func (p *DataServiceLPushResult) DeepEqual(ano *DataServiceLPushResult) bool {
+func (p *DataServiceLPopResult) DeepEqual(ano *DataServiceLPopResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11935,7 +15430,7 @@ func (p *DataServiceLPushResult) DeepEqual(ano *DataServiceLPushResult) bool {
 	return true
 }
 
-func (p *DataServiceLPushResult) Field0DeepEqual(src *LPushResp) bool {
+func (p *DataServiceLPopResult) Field0DeepEqual(src *LPopResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -11943,39 +15438,39 @@ func (p *DataServiceLPushResult) Field0DeepEqual(src *LPushResp) bool {
 	return true
 }
 
-type DataServiceRPushArgs struct {
-	Req *RPushReq `thrift:"req,1" frugal:"1,default,RPushReq" json:"req"`
+type DataServiceRPopArgs struct {
+	Req *RPopReq `thrift:"req,1" frugal:"1,default,RPopReq" json:"req"`
 }
 
-func NewDataServiceRPushArgs() *DataServiceRPushArgs {
-	return &DataServiceRPushArgs{}
+func NewDataServiceRPopArgs() *DataServiceRPopArgs {
+	return &DataServiceRPopArgs{}
 }
 
-func (p *DataServiceRPushArgs) InitDefault() {
-	*p = DataServiceRPushArgs{}
+func (p *DataServiceRPopArgs) InitDefault() {
+	*p = DataServiceRPopArgs{}
 }
 
-var DataServiceRPushArgs_Req_DEFAULT *RPushReq
+var DataServiceRPopArgs_Req_DEFAULT *RPopReq
 
-func (p *DataServiceRPushArgs) GetReq() (v *RPushReq) {
+func (p *DataServiceRPopArgs) GetReq() (v *RPopReq) {
 	if !p.IsSetReq() {
-		return DataServiceRPushArgs_Req_DEFAULT
+		return DataServiceRPopArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceRPushArgs) SetReq(val *RPushReq) {
+func (p *DataServiceRPopArgs) SetReq(val *RPopReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceRPushArgs = map[int16]string{
+var fieldIDToName_DataServiceRPopArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceRPushArgs) IsSetReq() bool {
+func (p *DataServiceRPopArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceRPushArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11999,17 +15494,16 @@ func (p *DataServiceRPushArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12024,7 +15518,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12034,17 +15528,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewRPushReq()
+func (p *DataServiceRPopArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRPopReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceRPushArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RPush_args"); err != nil {
+	if err = oprot.WriteStructBegin("RPop_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12052,7 +15547,6 @@ func (p *DataServiceRPushArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12071,7 +15565,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12088,14 +15582,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceRPushArgs) String() string {
+func (p *DataServiceRPopArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceRPushArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceRPopArgs(%+v)", *p)
 }
 
-func (p *DataServiceRPushArgs) DeepEqual(ano *DataServiceRPushArgs) bool {
+func (p *DataServiceRPopArgs) DeepEqual(ano *DataServiceRPopArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12107,7 +15601,7 @@ func (p *DataServiceRPushArgs) DeepEqual(ano *DataServiceRPushArgs) bool {
 	return true
 }
 
-func (p *DataServiceRPushArgs) Field1DeepEqual(src *RPushReq) bool {
+func (p *DataServiceRPopArgs) Field1DeepEqual(src *RPopReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -12115,39 +15609,39 @@ func (p *DataServiceRPushArgs) Field1DeepEqual(src *RPushReq) bool {
 	return true
 }
 
-type DataServiceRPushResult struct {
-	Success *RPushResp `thrift:"success,0,optional" frugal:"0,optional,RPushResp" json:"success,omitempty"`
+type DataServiceRPopResult struct {
+	Success *RPopResp `thrift:"success,0,optional" frugal:"0,optional,RPopResp" json:"success,omitempty"`
 }
 
-func NewDataServiceRPushResult() *DataServiceRPushResult {
-	return &DataServiceRPushResult{}
+func NewDataServiceRPopResult() *DataServiceRPopResult {
+	return &DataServiceRPopResult{}
 }
 
-func (p *DataServiceRPushResult) InitDefault() {
-	*p = DataServiceRPushResult{}
+func (p *DataServiceRPopResult) InitDefault() {
+	*p = DataServiceRPopResult{}
 }
 
-var DataServiceRPushResult_Success_DEFAULT *RPushResp
+var DataServiceRPopResult_Success_DEFAULT *RPopResp
 
-func (p *DataServiceRPushResult) GetSuccess() (v *RPushResp) {
+func (p *DataServiceRPopResult) GetSuccess() (v *RPopResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceRPushResult_Success_DEFAULT
+		return DataServiceRPopResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceRPushResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RPushResp)
+func (p *DataServiceRPopResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RPopResp)
 }
 
-var fieldIDToName_DataServiceRPushResult = map[int16]string{
+var fieldIDToName_DataServiceRPopResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceRPushResult) IsSetSuccess() bool {
+func (p *DataServiceRPopResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceRPushResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12171,17 +15665,16 @@ func (p *DataServiceRPushResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12196,7 +15689,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12206,17 +15699,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewRPushResp()
+func (p *DataServiceRPopResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRPopResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceRPushResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RPush_result"); err != nil {
+	if err = oprot.WriteStructBegin("RPop_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12224,7 +15718,6 @@ func (p *DataServiceRPushResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12243,7 +15736,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceRPopResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12262,14 +15755,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceRPushResult) String() string {
+func (p *DataServiceRPopResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceRPushResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceRPopResult(%+v)", *p)
 }
 
-func (p *DataServiceRPushResult) DeepEqual(ano *DataServiceRPushResult) bool {
+func (p *DataServiceRPopResult) DeepEqual(ano *DataServiceRPopResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12281,7 +15774,7 @@ func (p *DataServiceRPushResult) DeepEqual(ano *DataServiceRPushResult) bool {
 	return true
 }
 
-func (p *DataServiceRPushResult) Field0DeepEqual(src *RPushResp) bool {
+func (p *DataServiceRPopResult) Field0DeepEqual(src *RPopResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12289,39 +15782,39 @@ func (p *DataServiceRPushResult) Field0DeepEqual(src *RPushResp) bool {
 	return true
 }
 
-type DataServiceLPopArgs struct {
-	Req *LPopReq `thrift:"req,1" frugal:"1,default,LPopReq" json:"req"`
+type DataServiceSAddArgs struct {
+	Req *SAddReq `thrift:"req,1" frugal:"1,default,SAddReq" json:"req"`
 }
 
-func NewDataServiceLPopArgs() *DataServiceLPopArgs {
-	return &DataServiceLPopArgs{}
+func NewDataServiceSAddArgs() *DataServiceSAddArgs {
+	return &DataServiceSAddArgs{}
 }
 
-func (p *DataServiceLPopArgs) InitDefault() {
-	*p = DataServiceLPopArgs{}
+func (p *DataServiceSAddArgs) InitDefault() {
+	*p = DataServiceSAddArgs{}
 }
 
-var DataServiceLPopArgs_Req_DEFAULT *LPopReq
+var DataServiceSAddArgs_Req_DEFAULT *SAddReq
 
-func (p *DataServiceLPopArgs) GetReq() (v *LPopReq) {
+func (p *DataServiceSAddArgs) GetReq() (v *SAddReq) {
 	if !p.IsSetReq() {
-		return DataServiceLPopArgs_Req_DEFAULT
+		return DataServiceSAddArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceLPopArgs) SetReq(val *LPopReq) {
+func (p *DataServiceSAddArgs) SetReq(val *SAddReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceLPopArgs = map[int16]string{
+var fieldIDToName_DataServiceSAddArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceLPopArgs) IsSetReq() bool {
+func (p *DataServiceSAddArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceLPopArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12345,17 +15838,16 @@ func (p *DataServiceLPopArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12370,7 +15862,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12380,17 +15872,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewLPopReq()
+func (p *DataServiceSAddArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewSAddReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceLPopArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LPop_args"); err != nil {
+	if err = oprot.WriteStructBegin("SAdd_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12398,7 +15891,6 @@ func (p *DataServiceLPopArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12417,7 +15909,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12434,14 +15926,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceLPopArgs) String() string {
+func (p *DataServiceSAddArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceLPopArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceSAddArgs(%+v)", *p)
 }
 
-func (p *DataServiceLPopArgs) DeepEqual(ano *DataServiceLPopArgs) bool {
+func (p *DataServiceSAddArgs) DeepEqual(ano *DataServiceSAddArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12453,7 +15945,7 @@ func (p *DataServiceLPopArgs) DeepEqual(ano *DataServiceLPopArgs) bool {
 	return true
 }
 
-func (p *DataServiceLPopArgs) Field1DeepEqual(src *LPopReq) bool {
+func (p *DataServiceSAddArgs) Field1DeepEqual(src *SAddReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -12461,39 +15953,39 @@ func (p *DataServiceLPopArgs) Field1DeepEqual(src *LPopReq) bool {
 	return true
 }
 
-type DataServiceLPopResult struct {
-	Success *LPopResp `thrift:"success,0,optional" frugal:"0,optional,LPopResp" json:"success,omitempty"`
+type DataServiceSAddResult struct {
+	Success *SAddResp `thrift:"success,0,optional" frugal:"0,optional,SAddResp" json:"success,omitempty"`
 }
 
-func NewDataServiceLPopResult() *DataServiceLPopResult {
-	return &DataServiceLPopResult{}
+func NewDataServiceSAddResult() *DataServiceSAddResult {
+	return &DataServiceSAddResult{}
 }
 
-func (p *DataServiceLPopResult) InitDefault() {
-	*p = DataServiceLPopResult{}
+func (p *DataServiceSAddResult) InitDefault() {
+	*p = DataServiceSAddResult{}
 }
 
-var DataServiceLPopResult_Success_DEFAULT *LPopResp
+var DataServiceSAddResult_Success_DEFAULT *SAddResp
 
-func (p *DataServiceLPopResult) GetSuccess() (v *LPopResp) {
+func (p *DataServiceSAddResult) GetSuccess() (v *SAddResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceLPopResult_Success_DEFAULT
+		return DataServiceSAddResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceLPopResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LPopResp)
+func (p *DataServiceSAddResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SAddResp)
 }
 
-var fieldIDToName_DataServiceLPopResult = map[int16]string{
+var fieldIDToName_DataServiceSAddResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceLPopResult) IsSetSuccess() bool {
+func (p *DataServiceSAddResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceLPopResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12517,17 +16009,16 @@ func (p *DataServiceLPopResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12542,7 +16033,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12552,17 +16043,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewLPopResp()
+func (p *DataServiceSAddResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewSAddResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceLPopResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LPop_result"); err != nil {
+	if err = oprot.WriteStructBegin("SAdd_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12570,7 +16062,6 @@ func (p *DataServiceLPopResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12589,7 +16080,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSAddResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12608,14 +16099,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceLPopResult) String() string {
+func (p *DataServiceSAddResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceLPopResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceSAddResult(%+v)", *p)
 }
 
-func (p *DataServiceLPopResult) DeepEqual(ano *DataServiceLPopResult) bool {
+func (p *DataServiceSAddResult) DeepEqual(ano *DataServiceSAddResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12627,7 +16118,7 @@ func (p *DataServiceLPopResult) DeepEqual(ano *DataServiceLPopResult) bool {
 	return true
 }
 
-func (p *DataServiceLPopResult) Field0DeepEqual(src *LPopResp) bool {
+func (p *DataServiceSAddResult) Field0DeepEqual(src *SAddResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12635,39 +16126,39 @@ func (p *DataServiceLPopResult) Field0DeepEqual(src *LPopResp) bool {
 	return true
 }
 
-type DataServiceRPopArgs struct {
-	Req *RPopReq `thrift:"req,1" frugal:"1,default,RPopReq" json:"req"`
+type DataServiceSRemArgs struct {
+	Req *SRemReq `thrift:"req,1" frugal:"1,default,SRemReq" json:"req"`
 }
 
-func NewDataServiceRPopArgs() *DataServiceRPopArgs {
-	return &DataServiceRPopArgs{}
+func NewDataServiceSRemArgs() *DataServiceSRemArgs {
+	return &DataServiceSRemArgs{}
 }
 
-func (p *DataServiceRPopArgs) InitDefault() {
-	*p = DataServiceRPopArgs{}
+func (p *DataServiceSRemArgs) InitDefault() {
+	*p = DataServiceSRemArgs{}
 }
 
-var DataServiceRPopArgs_Req_DEFAULT *RPopReq
+var DataServiceSRemArgs_Req_DEFAULT *SRemReq
 
-func (p *DataServiceRPopArgs) GetReq() (v *RPopReq) {
+func (p *DataServiceSRemArgs) GetReq() (v *SRemReq) {
 	if !p.IsSetReq() {
-		return DataServiceRPopArgs_Req_DEFAULT
+		return DataServiceSRemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceRPopArgs) SetReq(val *RPopReq) {
+func (p *DataServiceSRemArgs) SetReq(val *SRemReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceRPopArgs = map[int16]string{
+var fieldIDToName_DataServiceSRemArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceRPopArgs) IsSetReq() bool {
+func (p *DataServiceSRemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceRPopArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12691,17 +16182,16 @@ func (p *DataServiceRPopArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12716,7 +16206,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12726,17 +16216,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewRPopReq()
+func (p *DataServiceSRemArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewSRemReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceRPopArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RPop_args"); err != nil {
+	if err = oprot.WriteStructBegin("SRem_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12744,7 +16235,6 @@ func (p *DataServiceRPopArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12763,7 +16253,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12780,14 +16270,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceRPopArgs) String() string {
+func (p *DataServiceSRemArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceRPopArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceSRemArgs(%+v)", *p)
 }
 
-func (p *DataServiceRPopArgs) DeepEqual(ano *DataServiceRPopArgs) bool {
+func (p *DataServiceSRemArgs) DeepEqual(ano *DataServiceSRemArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12799,7 +16289,7 @@ func (p *DataServiceRPopArgs) DeepEqual(ano *DataServiceRPopArgs) bool {
 	return true
 }
 
-func (p *DataServiceRPopArgs) Field1DeepEqual(src *RPopReq) bool {
+func (p *DataServiceSRemArgs) Field1DeepEqual(src *SRemReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -12807,39 +16297,39 @@ func (p *DataServiceRPopArgs) Field1DeepEqual(src *RPopReq) bool {
 	return true
 }
 
-type DataServiceRPopResult struct {
-	Success *RPopResp `thrift:"success,0,optional" frugal:"0,optional,RPopResp" json:"success,omitempty"`
+type DataServiceSRemResult struct {
+	Success *SRemResp `thrift:"success,0,optional" frugal:"0,optional,SRemResp" json:"success,omitempty"`
 }
 
-func NewDataServiceRPopResult() *DataServiceRPopResult {
-	return &DataServiceRPopResult{}
+func NewDataServiceSRemResult() *DataServiceSRemResult {
+	return &DataServiceSRemResult{}
 }
 
-func (p *DataServiceRPopResult) InitDefault() {
-	*p = DataServiceRPopResult{}
+func (p *DataServiceSRemResult) InitDefault() {
+	*p = DataServiceSRemResult{}
 }
 
-var DataServiceRPopResult_Success_DEFAULT *RPopResp
+var DataServiceSRemResult_Success_DEFAULT *SRemResp
 
-func (p *DataServiceRPopResult) GetSuccess() (v *RPopResp) {
+func (p *DataServiceSRemResult) GetSuccess() (v *SRemResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceRPopResult_Success_DEFAULT
+		return DataServiceSRemResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceRPopResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RPopResp)
+func (p *DataServiceSRemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SRemResp)
 }
 
-var fieldIDToName_DataServiceRPopResult = map[int16]string{
+var fieldIDToName_DataServiceSRemResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceRPopResult) IsSetSuccess() bool {
+func (p *DataServiceSRemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceRPopResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12863,17 +16353,16 @@ func (p *DataServiceRPopResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -12888,7 +16377,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12898,17 +16387,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewRPopResp()
+func (p *DataServiceSRemResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewSRemResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceRPopResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RPop_result"); err != nil {
+	if err = oprot.WriteStructBegin("SRem_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12916,7 +16406,6 @@ func (p *DataServiceRPopResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12935,7 +16424,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceSRemResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12954,14 +16443,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceRPopResult) String() string {
+func (p *DataServiceSRemResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceRPopResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceSRemResult(%+v)", *p)
 }
 
-func (p *DataServiceRPopResult) DeepEqual(ano *DataServiceRPopResult) bool {
+func (p *DataServiceSRemResult) DeepEqual(ano *DataServiceSRemResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12973,7 +16462,7 @@ func (p *DataServiceRPopResult) DeepEqual(ano *DataServiceRPopResult) bool {
 	return true
 }
 
-func (p *DataServiceRPopResult) Field0DeepEqual(src *RPopResp) bool {
+func (p *DataServiceSRemResult) Field0DeepEqual(src *SRemResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -12981,39 +16470,39 @@ func (p *DataServiceRPopResult) Field0DeepEqual(src *RPopResp) bool {
 	return true
 }
 
-type DataServiceSAddArgs struct {
-	Req *SAddReq `thrift:"req,1" frugal:"1,default,SAddReq" json:"req"`
+type DataServiceZAddArgs struct {
+	Req *ZAddReq `thrift:"req,1" frugal:"1,default,ZAddReq" json:"req"`
 }
 
-func NewDataServiceSAddArgs() *DataServiceSAddArgs {
-	return &DataServiceSAddArgs{}
+func NewDataServiceZAddArgs() *DataServiceZAddArgs {
+	return &DataServiceZAddArgs{}
 }
 
-func (p *DataServiceSAddArgs) InitDefault() {
-	*p = DataServiceSAddArgs{}
+func (p *DataServiceZAddArgs) InitDefault() {
+	*p = DataServiceZAddArgs{}
 }
 
-var DataServiceSAddArgs_Req_DEFAULT *SAddReq
+var DataServiceZAddArgs_Req_DEFAULT *ZAddReq
 
-func (p *DataServiceSAddArgs) GetReq() (v *SAddReq) {
+func (p *DataServiceZAddArgs) GetReq() (v *ZAddReq) {
 	if !p.IsSetReq() {
-		return DataServiceSAddArgs_Req_DEFAULT
+		return DataServiceZAddArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceSAddArgs) SetReq(val *SAddReq) {
+func (p *DataServiceZAddArgs) SetReq(val *ZAddReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceSAddArgs = map[int16]string{
+var fieldIDToName_DataServiceZAddArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceSAddArgs) IsSetReq() bool {
+func (p *DataServiceZAddArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceSAddArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13037,17 +16526,16 @@ func (p *DataServiceSAddArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13062,7 +16550,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13072,17 +16560,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewSAddReq()
+func (p *DataServiceZAddArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewZAddReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSAddArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SAdd_args"); err != nil {
+	if err = oprot.WriteStructBegin("ZAdd_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13090,7 +16579,6 @@ func (p *DataServiceSAddArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13109,7 +16597,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13126,14 +16614,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceSAddArgs) String() string {
+func (p *DataServiceZAddArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSAddArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceZAddArgs(%+v)", *p)
 }
 
-func (p *DataServiceSAddArgs) DeepEqual(ano *DataServiceSAddArgs) bool {
+func (p *DataServiceZAddArgs) DeepEqual(ano *DataServiceZAddArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13145,7 +16633,7 @@ func (p *DataServiceSAddArgs) DeepEqual(ano *DataServiceSAddArgs) bool {
 	return true
 }
 
-func (p *DataServiceSAddArgs) Field1DeepEqual(src *SAddReq) bool {
+func (p *DataServiceZAddArgs) Field1DeepEqual(src *ZAddReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -13153,39 +16641,39 @@ func (p *DataServiceSAddArgs) Field1DeepEqual(src *SAddReq) bool {
 	return true
 }
 
-type DataServiceSAddResult struct {
-	Success *SAddResp `thrift:"success,0,optional" frugal:"0,optional,SAddResp" json:"success,omitempty"`
+type DataServiceZAddResult struct {
+	Success *ZAddResp `thrift:"success,0,optional" frugal:"0,optional,ZAddResp" json:"success,omitempty"`
 }
 
-func NewDataServiceSAddResult() *DataServiceSAddResult {
-	return &DataServiceSAddResult{}
+func NewDataServiceZAddResult() *DataServiceZAddResult {
+	return &DataServiceZAddResult{}
 }
 
-func (p *DataServiceSAddResult) InitDefault() {
-	*p = DataServiceSAddResult{}
+func (p *DataServiceZAddResult) InitDefault() {
+	*p = DataServiceZAddResult{}
 }
 
-var DataServiceSAddResult_Success_DEFAULT *SAddResp
+var DataServiceZAddResult_Success_DEFAULT *ZAddResp
 
-func (p *DataServiceSAddResult) GetSuccess() (v *SAddResp) {
+func (p *DataServiceZAddResult) GetSuccess() (v *ZAddResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceSAddResult_Success_DEFAULT
+		return DataServiceZAddResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceSAddResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SAddResp)
+func (p *DataServiceZAddResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ZAddResp)
 }
 
-var fieldIDToName_DataServiceSAddResult = map[int16]string{
+var fieldIDToName_DataServiceZAddResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceSAddResult) IsSetSuccess() bool {
+func (p *DataServiceZAddResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceSAddResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13209,17 +16697,16 @@ func (p *DataServiceSAddResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13234,7 +16721,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13244,17 +16731,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewSAddResp()
+func (p *DataServiceZAddResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewZAddResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSAddResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SAdd_result"); err != nil {
+	if err = oprot.WriteStructBegin("ZAdd_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13262,7 +16750,6 @@ func (p *DataServiceSAddResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13281,7 +16768,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZAddResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13300,14 +16787,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceSAddResult) String() string {
+func (p *DataServiceZAddResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSAddResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceZAddResult(%+v)", *p)
 }
 
-func (p *DataServiceSAddResult) DeepEqual(ano *DataServiceSAddResult) bool {
+func (p *DataServiceZAddResult) DeepEqual(ano *DataServiceZAddResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13319,7 +16806,7 @@ func (p *DataServiceSAddResult) DeepEqual(ano *DataServiceSAddResult) bool {
 	return true
 }
 
-func (p *DataServiceSAddResult) Field0DeepEqual(src *SAddResp) bool {
+func (p *DataServiceZAddResult) Field0DeepEqual(src *ZAddResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -13327,39 +16814,39 @@ func (p *DataServiceSAddResult) Field0DeepEqual(src *SAddResp) bool {
 	return true
 }
 
-type DataServiceSRemArgs struct {
-	Req *SRemReq `thrift:"req,1" frugal:"1,default,SRemReq" json:"req"`
+type DataServiceZRemArgs struct {
+	Req *ZRemReq `thrift:"req,1" frugal:"1,default,ZRemReq" json:"req"`
 }
 
-func NewDataServiceSRemArgs() *DataServiceSRemArgs {
-	return &DataServiceSRemArgs{}
+func NewDataServiceZRemArgs() *DataServiceZRemArgs {
+	return &DataServiceZRemArgs{}
 }
 
-func (p *DataServiceSRemArgs) InitDefault() {
-	*p = DataServiceSRemArgs{}
+func (p *DataServiceZRemArgs) InitDefault() {
+	*p = DataServiceZRemArgs{}
 }
 
-var DataServiceSRemArgs_Req_DEFAULT *SRemReq
+var DataServiceZRemArgs_Req_DEFAULT *ZRemReq
 
-func (p *DataServiceSRemArgs) GetReq() (v *SRemReq) {
+func (p *DataServiceZRemArgs) GetReq() (v *ZRemReq) {
 	if !p.IsSetReq() {
-		return DataServiceSRemArgs_Req_DEFAULT
+		return DataServiceZRemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceSRemArgs) SetReq(val *SRemReq) {
+func (p *DataServiceZRemArgs) SetReq(val *ZRemReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceSRemArgs = map[int16]string{
+var fieldIDToName_DataServiceZRemArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceSRemArgs) IsSetReq() bool {
+func (p *DataServiceZRemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceSRemArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13383,17 +16870,16 @@ func (p *DataServiceSRemArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13408,7 +16894,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13418,17 +16904,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewSRemReq()
+func (p *DataServiceZRemArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewZRemReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSRemArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SRem_args"); err != nil {
+	if err = oprot.WriteStructBegin("ZRem_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13436,7 +16923,6 @@ func (p *DataServiceSRemArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13455,7 +16941,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13472,14 +16958,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceSRemArgs) String() string {
+func (p *DataServiceZRemArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSRemArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceZRemArgs(%+v)", *p)
 }
 
-func (p *DataServiceSRemArgs) DeepEqual(ano *DataServiceSRemArgs) bool {
+func (p *DataServiceZRemArgs) DeepEqual(ano *DataServiceZRemArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13491,7 +16977,7 @@ func (p *DataServiceSRemArgs) DeepEqual(ano *DataServiceSRemArgs) bool {
 	return true
 }
 
-func (p *DataServiceSRemArgs) Field1DeepEqual(src *SRemReq) bool {
+func (p *DataServiceZRemArgs) Field1DeepEqual(src *ZRemReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -13499,39 +16985,39 @@ func (p *DataServiceSRemArgs) Field1DeepEqual(src *SRemReq) bool {
 	return true
 }
 
-type DataServiceSRemResult struct {
-	Success *SRemResp `thrift:"success,0,optional" frugal:"0,optional,SRemResp" json:"success,omitempty"`
+type DataServiceZRemResult struct {
+	Success *ZRemResp `thrift:"success,0,optional" frugal:"0,optional,ZRemResp" json:"success,omitempty"`
 }
 
-func NewDataServiceSRemResult() *DataServiceSRemResult {
-	return &DataServiceSRemResult{}
+func NewDataServiceZRemResult() *DataServiceZRemResult {
+	return &DataServiceZRemResult{}
 }
 
-func (p *DataServiceSRemResult) InitDefault() {
-	*p = DataServiceSRemResult{}
+func (p *DataServiceZRemResult) InitDefault() {
+	*p = DataServiceZRemResult{}
 }
 
-var DataServiceSRemResult_Success_DEFAULT *SRemResp
+var DataServiceZRemResult_Success_DEFAULT *ZRemResp
 
-func (p *DataServiceSRemResult) GetSuccess() (v *SRemResp) {
+func (p *DataServiceZRemResult) GetSuccess() (v *ZRemResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceSRemResult_Success_DEFAULT
+		return DataServiceZRemResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceSRemResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SRemResp)
+func (p *DataServiceZRemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ZRemResp)
 }
 
-var fieldIDToName_DataServiceSRemResult = map[int16]string{
+var fieldIDToName_DataServiceZRemResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceSRemResult) IsSetSuccess() bool {
+func (p *DataServiceZRemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceSRemResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13555,17 +17041,16 @@ func (p *DataServiceSRemResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13580,7 +17065,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13590,17 +17075,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewSRemResp()
+func (p *DataServiceZRemResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewZRemResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceSRemResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SRem_result"); err != nil {
+	if err = oprot.WriteStructBegin("ZRem_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13608,7 +17094,6 @@ func (p *DataServiceSRemResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13627,7 +17112,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceZRemResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13646,14 +17131,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceSRemResult) String() string {
+func (p *DataServiceZRemResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceSRemResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceZRemResult(%+v)", *p)
 }
 
-func (p *DataServiceSRemResult) DeepEqual(ano *DataServiceSRemResult) bool {
+func (p *DataServiceZRemResult) DeepEqual(ano *DataServiceZRemResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13665,7 +17150,7 @@ func (p *DataServiceSRemResult) DeepEqual(ano *DataServiceSRemResult) bool {
 	return true
 }
 
-func (p *DataServiceSRemResult) Field0DeepEqual(src *SRemResp) bool {
+func (p *DataServiceZRemResult) Field0DeepEqual(src *ZRemResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -13673,39 +17158,39 @@ func (p *DataServiceSRemResult) Field0DeepEqual(src *SRemResp) bool {
 	return true
 }
 
-type DataServiceZAddArgs struct {
-	Req *ZAddReq `thrift:"req,1" frugal:"1,default,ZAddReq" json:"req"`
+type DataServiceFlushDBArgs struct {
+	Req *FlushDBReq `thrift:"req,1" frugal:"1,default,FlushDBReq" json:"req"`
 }
 
-func NewDataServiceZAddArgs() *DataServiceZAddArgs {
-	return &DataServiceZAddArgs{}
+func NewDataServiceFlushDBArgs() *DataServiceFlushDBArgs {
+	return &DataServiceFlushDBArgs{}
 }
 
-func (p *DataServiceZAddArgs) InitDefault() {
-	*p = DataServiceZAddArgs{}
+func (p *DataServiceFlushDBArgs) InitDefault() {
+	*p = DataServiceFlushDBArgs{}
 }
 
-var DataServiceZAddArgs_Req_DEFAULT *ZAddReq
+var DataServiceFlushDBArgs_Req_DEFAULT *FlushDBReq
 
-func (p *DataServiceZAddArgs) GetReq() (v *ZAddReq) {
+func (p *DataServiceFlushDBArgs) GetReq() (v *FlushDBReq) {
 	if !p.IsSetReq() {
-		return DataServiceZAddArgs_Req_DEFAULT
+		return DataServiceFlushDBArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceZAddArgs) SetReq(val *ZAddReq) {
+func (p *DataServiceFlushDBArgs) SetReq(val *FlushDBReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceZAddArgs = map[int16]string{
+var fieldIDToName_DataServiceFlushDBArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceZAddArgs) IsSetReq() bool {
+func (p *DataServiceFlushDBArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceZAddArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13729,17 +17214,16 @@ func (p *DataServiceZAddArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13754,7 +17238,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceFlushDBArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13764,17 +17248,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewZAddReq()
+func (p *DataServiceFlushDBArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewFlushDBReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceZAddArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ZAdd_args"); err != nil {
+	if err = oprot.WriteStructBegin("FlushDB_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13782,7 +17267,6 @@ func (p *DataServiceZAddArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13801,7 +17285,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13818,14 +17302,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceZAddArgs) String() string {
+func (p *DataServiceFlushDBArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceZAddArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceFlushDBArgs(%+v)", *p)
 }
 
-func (p *DataServiceZAddArgs) DeepEqual(ano *DataServiceZAddArgs) bool {
+func (p *DataServiceFlushDBArgs) DeepEqual(ano *DataServiceFlushDBArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13837,7 +17321,7 @@ func (p *DataServiceZAddArgs) DeepEqual(ano *DataServiceZAddArgs) bool {
 	return true
 }
 
-func (p *DataServiceZAddArgs) Field1DeepEqual(src *ZAddReq) bool {
+func (p *DataServiceFlushDBArgs) Field1DeepEqual(src *FlushDBReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -13845,39 +17329,39 @@ func (p *DataServiceZAddArgs) Field1DeepEqual(src *ZAddReq) bool {
 	return true
 }
 
-type DataServiceZAddResult struct {
-	Success *ZAddResp `thrift:"success,0,optional" frugal:"0,optional,ZAddResp" json:"success,omitempty"`
+type DataServiceFlushDBResult struct {
+	Success *FlushDBResp `thrift:"success,0,optional" frugal:"0,optional,FlushDBResp" json:"success,omitempty"`
 }
 
-func NewDataServiceZAddResult() *DataServiceZAddResult {
-	return &DataServiceZAddResult{}
+func NewDataServiceFlushDBResult() *DataServiceFlushDBResult {
+	return &DataServiceFlushDBResult{}
 }
 
-func (p *DataServiceZAddResult) InitDefault() {
-	*p = DataServiceZAddResult{}
+func (p *DataServiceFlushDBResult) InitDefault() {
+	*p = DataServiceFlushDBResult{}
 }
 
-var DataServiceZAddResult_Success_DEFAULT *ZAddResp
+var DataServiceFlushDBResult_Success_DEFAULT *FlushDBResp
 
-func (p *DataServiceZAddResult) GetSuccess() (v *ZAddResp) {
+func (p *DataServiceFlushDBResult) GetSuccess() (v *FlushDBResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceZAddResult_Success_DEFAULT
+		return DataServiceFlushDBResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceZAddResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ZAddResp)
+func (p *DataServiceFlushDBResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FlushDBResp)
 }
 
-var fieldIDToName_DataServiceZAddResult = map[int16]string{
+var fieldIDToName_DataServiceFlushDBResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceZAddResult) IsSetSuccess() bool {
+func (p *DataServiceFlushDBResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceZAddResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13901,17 +17385,16 @@ func (p *DataServiceZAddResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -13926,7 +17409,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceFlushDBResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13936,17 +17419,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewZAddResp()
+func (p *DataServiceFlushDBResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewFlushDBResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceZAddResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ZAdd_result"); err != nil {
+	if err = oprot.WriteStructBegin("FlushDB_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13954,7 +17438,6 @@ func (p *DataServiceZAddResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13973,7 +17456,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceFlushDBResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13992,14 +17475,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceZAddResult) String() string {
+func (p *DataServiceFlushDBResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceZAddResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceFlushDBResult(%+v)", *p)
 }
 
-func (p *DataServiceZAddResult) DeepEqual(ano *DataServiceZAddResult) bool {
+func (p *DataServiceFlushDBResult) DeepEqual(ano *DataServiceFlushDBResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -14011,7 +17494,7 @@ func (p *DataServiceZAddResult) DeepEqual(ano *DataServiceZAddResult) bool {
 	return true
 }
 
-func (p *DataServiceZAddResult) Field0DeepEqual(src *ZAddResp) bool {
+func (p *DataServiceFlushDBResult) Field0DeepEqual(src *FlushDBResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -14019,39 +17502,39 @@ func (p *DataServiceZAddResult) Field0DeepEqual(src *ZAddResp) bool {
 	return true
 }
 
-type DataServiceZRemArgs struct {
-	Req *ZRemReq `thrift:"req,1" frugal:"1,default,ZRemReq" json:"req"`
+type DataServiceDBSizeArgs struct {
+	Req *DBSizeReq `thrift:"req,1" frugal:"1,default,DBSizeReq" json:"req"`
 }
 
-func NewDataServiceZRemArgs() *DataServiceZRemArgs {
-	return &DataServiceZRemArgs{}
+func NewDataServiceDBSizeArgs() *DataServiceDBSizeArgs {
+	return &DataServiceDBSizeArgs{}
 }
 
-func (p *DataServiceZRemArgs) InitDefault() {
-	*p = DataServiceZRemArgs{}
+func (p *DataServiceDBSizeArgs) InitDefault() {
+	*p = DataServiceDBSizeArgs{}
 }
 
-var DataServiceZRemArgs_Req_DEFAULT *ZRemReq
+var DataServiceDBSizeArgs_Req_DEFAULT *DBSizeReq
 
-func (p *DataServiceZRemArgs) GetReq() (v *ZRemReq) {
+func (p *DataServiceDBSizeArgs) GetReq() (v *DBSizeReq) {
 	if !p.IsSetReq() {
-		return DataServiceZRemArgs_Req_DEFAULT
+		return DataServiceDBSizeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DataServiceZRemArgs) SetReq(val *ZRemReq) {
+func (p *DataServiceDBSizeArgs) SetReq(val *DBSizeReq) {
 	p.Req = val
 }
 
-var fieldIDToName_DataServiceZRemArgs = map[int16]string{
+var fieldIDToName_DataServiceDBSizeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DataServiceZRemArgs) IsSetReq() bool {
+func (p *DataServiceDBSizeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DataServiceZRemArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14075,17 +17558,16 @@ func (p *DataServiceZRemArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -14100,7 +17582,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDBSizeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14110,17 +17592,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewZRemReq()
+func (p *DataServiceDBSizeArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewDBSizeReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceZRemArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ZRem_args"); err != nil {
+	if err = oprot.WriteStructBegin("DBSize_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14128,7 +17611,6 @@ func (p *DataServiceZRemArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -14147,7 +17629,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14164,14 +17646,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DataServiceZRemArgs) String() string {
+func (p *DataServiceDBSizeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceZRemArgs(%+v)", *p)
+	return fmt.Sprintf("DataServiceDBSizeArgs(%+v)", *p)
 }
 
-func (p *DataServiceZRemArgs) DeepEqual(ano *DataServiceZRemArgs) bool {
+func (p *DataServiceDBSizeArgs) DeepEqual(ano *DataServiceDBSizeArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -14183,7 +17665,7 @@ func (p *DataServiceZRemArgs) DeepEqual(ano *DataServiceZRemArgs) bool {
 	return true
 }
 
-func (p *DataServiceZRemArgs) Field1DeepEqual(src *ZRemReq) bool {
+func (p *DataServiceDBSizeArgs) Field1DeepEqual(src *DBSizeReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -14191,39 +17673,39 @@ func (p *DataServiceZRemArgs) Field1DeepEqual(src *ZRemReq) bool {
 	return true
 }
 
-type DataServiceZRemResult struct {
-	Success *ZRemResp `thrift:"success,0,optional" frugal:"0,optional,ZRemResp" json:"success,omitempty"`
+type DataServiceDBSizeResult struct {
+	Success *DBSizeResp `thrift:"success,0,optional" frugal:"0,optional,DBSizeResp" json:"success,omitempty"`
 }
 
-func NewDataServiceZRemResult() *DataServiceZRemResult {
-	return &DataServiceZRemResult{}
+func NewDataServiceDBSizeResult() *DataServiceDBSizeResult {
+	return &DataServiceDBSizeResult{}
 }
 
-func (p *DataServiceZRemResult) InitDefault() {
-	*p = DataServiceZRemResult{}
+func (p *DataServiceDBSizeResult) InitDefault() {
+	*p = DataServiceDBSizeResult{}
 }
 
-var DataServiceZRemResult_Success_DEFAULT *ZRemResp
+var DataServiceDBSizeResult_Success_DEFAULT *DBSizeResp
 
-func (p *DataServiceZRemResult) GetSuccess() (v *ZRemResp) {
+func (p *DataServiceDBSizeResult) GetSuccess() (v *DBSizeResp) {
 	if !p.IsSetSuccess() {
-		return DataServiceZRemResult_Success_DEFAULT
+		return DataServiceDBSizeResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DataServiceZRemResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ZRemResp)
+func (p *DataServiceDBSizeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DBSizeResp)
 }
 
-var fieldIDToName_DataServiceZRemResult = map[int16]string{
+var fieldIDToName_DataServiceDBSizeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DataServiceZRemResult) IsSetSuccess() bool {
+func (p *DataServiceDBSizeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DataServiceZRemResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14247,17 +17729,16 @@ func (p *DataServiceZRemResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -14272,7 +17753,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDBSizeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14282,17 +17763,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewZRemResp()
+func (p *DataServiceDBSizeResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewDBSizeResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *DataServiceZRemResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ZRem_result"); err != nil {
+	if err = oprot.WriteStructBegin("DBSize_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14300,7 +17782,6 @@ func (p *DataServiceZRemResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -14319,7 +17800,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DataServiceDBSizeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14338,14 +17819,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DataServiceZRemResult) String() string {
+func (p *DataServiceDBSizeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataServiceZRemResult(%+v)", *p)
+	return fmt.Sprintf("DataServiceDBSizeResult(%+v)", *p)
 }
 
-func (p *DataServiceZRemResult) DeepEqual(ano *DataServiceZRemResult) bool {
+func (p *DataServiceDBSizeResult) DeepEqual(ano *DataServiceDBSizeResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -14357,7 +17838,7 @@ func (p *DataServiceZRemResult) DeepEqual(ano *DataServiceZRemResult) bool {
 	return true
 }
 
-func (p *DataServiceZRemResult) Field0DeepEqual(src *ZRemResp) bool {
+func (p *DataServiceDBSizeResult) Field0DeepEqual(src *DBSizeResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
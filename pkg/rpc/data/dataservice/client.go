@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package dataservice
 
@@ -26,6 +26,8 @@ type Client interface {
 	SRem(ctx context.Context, req *data.SRemReq, callOptions ...callopt.Option) (r *data.SRemResp, err error)
 	ZAdd(ctx context.Context, req *data.ZAddReq, callOptions ...callopt.Option) (r *data.ZAddResp, err error)
 	ZRem(ctx context.Context, req *data.ZRemReq, callOptions ...callopt.Option) (r *data.ZRemResp, err error)
+	FlushDB(ctx context.Context, req *data.FlushDBReq, callOptions ...callopt.Option) (r *data.FlushDBResp, err error)
+	DBSize(ctx context.Context, req *data.DBSizeReq, callOptions ...callopt.Option) (r *data.DBSizeResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -131,3 +133,13 @@ func (p *kDataServiceClient) ZRem(ctx context.Context, req *data.ZRemReq, callOp
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ZRem(ctx, req)
 }
+
+func (p *kDataServiceClient) FlushDB(ctx context.Context, req *data.FlushDBReq, callOptions ...callopt.Option) (r *data.FlushDBResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FlushDB(ctx, req)
+}
+
+func (p *kDataServiceClient) DBSize(ctx context.Context, req *data.DBSizeReq, callOptions ...callopt.Option) (r *data.DBSizeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DBSize(ctx, req)
+}
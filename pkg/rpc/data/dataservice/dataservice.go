@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package dataservice
 
@@ -19,31 +19,34 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "DataService"
 	handlerType := (*data.DataService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Get":    kitex.NewMethodInfo(getHandler, newDataServiceGetArgs, newDataServiceGetResult, false),
-		"Set":    kitex.NewMethodInfo(setHandler, newDataServiceSetArgs, newDataServiceSetResult, false),
-		"Del":    kitex.NewMethodInfo(delHandler, newDataServiceDelArgs, newDataServiceDelResult, false),
-		"Expire": kitex.NewMethodInfo(expireHandler, newDataServiceExpireArgs, newDataServiceExpireResult, false),
-		"HSet":   kitex.NewMethodInfo(hSetHandler, newDataServiceHSetArgs, newDataServiceHSetResult, false),
-		"HGet":   kitex.NewMethodInfo(hGetHandler, newDataServiceHGetArgs, newDataServiceHGetResult, false),
-		"HDel":   kitex.NewMethodInfo(hDelHandler, newDataServiceHDelArgs, newDataServiceHDelResult, false),
-		"LPush":  kitex.NewMethodInfo(lPushHandler, newDataServiceLPushArgs, newDataServiceLPushResult, false),
-		"RPush":  kitex.NewMethodInfo(rPushHandler, newDataServiceRPushArgs, newDataServiceRPushResult, false),
-		"LPop":   kitex.NewMethodInfo(lPopHandler, newDataServiceLPopArgs, newDataServiceLPopResult, false),
-		"RPop":   kitex.NewMethodInfo(rPopHandler, newDataServiceRPopArgs, newDataServiceRPopResult, false),
-		"SAdd":   kitex.NewMethodInfo(sAddHandler, newDataServiceSAddArgs, newDataServiceSAddResult, false),
-		"SRem":   kitex.NewMethodInfo(sRemHandler, newDataServiceSRemArgs, newDataServiceSRemResult, false),
-		"ZAdd":   kitex.NewMethodInfo(zAddHandler, newDataServiceZAddArgs, newDataServiceZAddResult, false),
-		"ZRem":   kitex.NewMethodInfo(zRemHandler, newDataServiceZRemArgs, newDataServiceZRemResult, false),
+		"Get":     kitex.NewMethodInfo(getHandler, newDataServiceGetArgs, newDataServiceGetResult, false),
+		"Set":     kitex.NewMethodInfo(setHandler, newDataServiceSetArgs, newDataServiceSetResult, false),
+		"Del":     kitex.NewMethodInfo(delHandler, newDataServiceDelArgs, newDataServiceDelResult, false),
+		"Expire":  kitex.NewMethodInfo(expireHandler, newDataServiceExpireArgs, newDataServiceExpireResult, false),
+		"HSet":    kitex.NewMethodInfo(hSetHandler, newDataServiceHSetArgs, newDataServiceHSetResult, false),
+		"HGet":    kitex.NewMethodInfo(hGetHandler, newDataServiceHGetArgs, newDataServiceHGetResult, false),
+		"HDel":    kitex.NewMethodInfo(hDelHandler, newDataServiceHDelArgs, newDataServiceHDelResult, false),
+		"LPush":   kitex.NewMethodInfo(lPushHandler, newDataServiceLPushArgs, newDataServiceLPushResult, false),
+		"RPush":   kitex.NewMethodInfo(rPushHandler, newDataServiceRPushArgs, newDataServiceRPushResult, false),
+		"LPop":    kitex.NewMethodInfo(lPopHandler, newDataServiceLPopArgs, newDataServiceLPopResult, false),
+		"RPop":    kitex.NewMethodInfo(rPopHandler, newDataServiceRPopArgs, newDataServiceRPopResult, false),
+		"SAdd":    kitex.NewMethodInfo(sAddHandler, newDataServiceSAddArgs, newDataServiceSAddResult, false),
+		"SRem":    kitex.NewMethodInfo(sRemHandler, newDataServiceSRemArgs, newDataServiceSRemResult, false),
+		"ZAdd":    kitex.NewMethodInfo(zAddHandler, newDataServiceZAddArgs, newDataServiceZAddResult, false),
+		"ZRem":    kitex.NewMethodInfo(zRemHandler, newDataServiceZRemArgs, newDataServiceZRemResult, false),
+		"FlushDB": kitex.NewMethodInfo(flushDBHandler, newDataServiceFlushDBArgs, newDataServiceFlushDBResult, false),
+		"DBSize":  kitex.NewMethodInfo(dBSizeHandler, newDataServiceDBSizeArgs, newDataServiceDBSizeResult, false),
 	}
 	extra := map[string]interface{}{
-		"PackageName": "data",
+		"PackageName":     "data",
+		"ServiceFilePath": `idl/data.thrift`,
 	}
 	svcInfo := &kitex.ServiceInfo{
 		ServiceName:     serviceName,
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.6.2",
+		KiteXGenVersion: "v0.8.0",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -319,6 +322,42 @@ func newDataServiceZRemResult() interface{} {
 	return data.NewDataServiceZRemResult()
 }
 
+func flushDBHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*data.DataServiceFlushDBArgs)
+	realResult := result.(*data.DataServiceFlushDBResult)
+	success, err := handler.(data.DataService).FlushDB(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newDataServiceFlushDBArgs() interface{} {
+	return data.NewDataServiceFlushDBArgs()
+}
+
+func newDataServiceFlushDBResult() interface{} {
+	return data.NewDataServiceFlushDBResult()
+}
+
+func dBSizeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*data.DataServiceDBSizeArgs)
+	realResult := result.(*data.DataServiceDBSizeResult)
+	success, err := handler.(data.DataService).DBSize(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newDataServiceDBSizeArgs() interface{} {
+	return data.NewDataServiceDBSizeArgs()
+}
+
+func newDataServiceDBSizeResult() interface{} {
+	return data.NewDataServiceDBSizeResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -478,3 +517,23 @@ func (p *kClient) ZRem(ctx context.Context, req *data.ZRemReq) (r *data.ZRemResp
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) FlushDB(ctx context.Context, req *data.FlushDBReq) (r *data.FlushDBResp, err error) {
+	var _args data.DataServiceFlushDBArgs
+	_args.Req = req
+	var _result data.DataServiceFlushDBResult
+	if err = p.c.Call(ctx, "FlushDB", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DBSize(ctx context.Context, req *data.DBSizeReq) (r *data.DBSizeResp, err error) {
+	var _args data.DataServiceDBSizeArgs
+	_args.Req = req
+	var _result data.DataServiceDBSizeResult
+	if err = p.c.Call(ctx, "DBSize", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
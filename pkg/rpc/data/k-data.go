@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package data
 
@@ -77,6 +77,34 @@ func (p *SetReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -151,6 +179,32 @@ func (p *SetReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SetReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
+func (p *SetReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Ttl = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *SetReq) FastWrite(buf []byte) int {
 	return 0
@@ -160,8 +214,10 @@ func (p *SetReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset := 0
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SetReq")
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -174,6 +230,8 @@ func (p *SetReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -198,6 +256,28 @@ func (p *SetReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *SetReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *SetReq) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetTtl() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "ttl", thrift.I64, 4)
+		offset += bthrift.Binary.WriteI64(buf[offset:], *p.Ttl)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *SetReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -216,6 +296,28 @@ func (p *SetReq) field2Length() int {
 	return l
 }
 
+func (p *SetReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *SetReq) field4Length() int {
+	l := 0
+	if p.IsSetTtl() {
+		l += bthrift.Binary.FieldBeginLength("ttl", thrift.I64, 4)
+		l += bthrift.Binary.I64Length(*p.Ttl)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *SetResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -502,6 +604,48 @@ func (p *GetReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -557,6 +701,47 @@ func (p *GetReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
+func (p *GetReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+
+	}
+	return offset, nil
+}
+
+func (p *GetReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.MaxStaleness = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *GetReq) FastWrite(buf []byte) int {
 	return 0
@@ -566,7 +751,10 @@ func (p *GetReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset := 0
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "GetReq")
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -578,6 +766,9 @@ func (p *GetReq) BLength() int {
 	l += bthrift.Binary.StructBeginLength("GetReq")
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -593,6 +784,39 @@ func (p *GetReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *GetReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 2)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *GetReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetConsistency() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "consistency", thrift.I32, 3)
+		offset += bthrift.Binary.WriteI32(buf[offset:], int32(*p.Consistency))
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *GetReq) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetMaxStaleness() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "max_staleness", thrift.I64, 4)
+		offset += bthrift.Binary.WriteI64(buf[offset:], *p.MaxStaleness)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *GetReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -602,6 +826,39 @@ func (p *GetReq) field1Length() int {
 	return l
 }
 
+func (p *GetReq) field2Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 2)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *GetReq) field3Length() int {
+	l := 0
+	if p.IsSetConsistency() {
+		l += bthrift.Binary.FieldBeginLength("consistency", thrift.I32, 3)
+		l += bthrift.Binary.I32Length(int32(*p.Consistency))
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *GetReq) field4Length() int {
+	l := 0
+	if p.IsSetMaxStaleness() {
+		l += bthrift.Binary.FieldBeginLength("max_staleness", thrift.I64, 4)
+		l += bthrift.Binary.I64Length(*p.MaxStaleness)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *GetResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -943,6 +1200,20 @@ func (p *DelReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -998,6 +1269,19 @@ func (p *DelReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *DelReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *DelReq) FastWrite(buf []byte) int {
 	return 0
@@ -1008,6 +1292,7 @@ func (p *DelReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "DelReq")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -1019,6 +1304,7 @@ func (p *DelReq) BLength() int {
 	l += bthrift.Binary.StructBeginLength("DelReq")
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -1034,6 +1320,17 @@ func (p *DelReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *DelReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 2)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *DelReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -1043,6 +1340,17 @@ func (p *DelReq) field1Length() int {
 	return l
 }
 
+func (p *DelReq) field2Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 2)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *DelResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -1345,15 +1653,29 @@ func (p *ExpireReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-
-		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
 		offset += l
 		if err != nil {
 			goto ReadFieldEndError
@@ -1419,6 +1741,19 @@ func (p *ExpireReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ExpireReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *ExpireReq) FastWrite(buf []byte) int {
 	return 0
@@ -1430,6 +1765,7 @@ func (p *ExpireReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWrite
 	if p != nil {
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -1442,6 +1778,7 @@ func (p *ExpireReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -1466,6 +1803,17 @@ func (p *ExpireReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWrite
 	return offset
 }
 
+func (p *ExpireReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *ExpireReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -1484,6 +1832,17 @@ func (p *ExpireReq) field2Length() int {
 	return l
 }
 
+func (p *ExpireReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *ExpireResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -1802,6 +2161,20 @@ func (p *HSetReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1895,6 +2268,19 @@ func (p *HSetReq) FastReadField3(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *HSetReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *HSetReq) FastWrite(buf []byte) int {
 	return 0
@@ -1907,6 +2293,7 @@ func (p *HSetReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 		offset += p.fastWriteField3(buf[offset:], binaryWriter)
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -1920,6 +2307,7 @@ func (p *HSetReq) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -1953,6 +2341,17 @@ func (p *HSetReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *HSetReq) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 4)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *HSetReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -1980,6 +2379,17 @@ func (p *HSetReq) field3Length() int {
 	return l
 }
 
+func (p *HSetReq) field4Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 4)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *HSetResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -2282,6 +2692,48 @@ func (p *HGetReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2356,6 +2808,47 @@ func (p *HGetReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *HGetReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
+func (p *HGetReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+
+	}
+	return offset, nil
+}
+
+func (p *HGetReq) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.MaxStaleness = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *HGetReq) FastWrite(buf []byte) int {
 	return 0
@@ -2365,8 +2858,11 @@ func (p *HGetReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset := 0
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HGetReq")
 	if p != nil {
+		offset += p.fastWriteField5(buf[offset:], binaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -2379,6 +2875,9 @@ func (p *HGetReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -2403,6 +2902,39 @@ func (p *HGetReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *HGetReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *HGetReq) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetConsistency() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "consistency", thrift.I32, 4)
+		offset += bthrift.Binary.WriteI32(buf[offset:], int32(*p.Consistency))
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *HGetReq) fastWriteField5(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetMaxStaleness() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "max_staleness", thrift.I64, 5)
+		offset += bthrift.Binary.WriteI64(buf[offset:], *p.MaxStaleness)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *HGetReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -2421,6 +2953,39 @@ func (p *HGetReq) field2Length() int {
 	return l
 }
 
+func (p *HGetReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *HGetReq) field4Length() int {
+	l := 0
+	if p.IsSetConsistency() {
+		l += bthrift.Binary.FieldBeginLength("consistency", thrift.I32, 4)
+		l += bthrift.Binary.I32Length(int32(*p.Consistency))
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *HGetReq) field5Length() int {
+	l := 0
+	if p.IsSetMaxStaleness() {
+		l += bthrift.Binary.FieldBeginLength("max_staleness", thrift.I64, 5)
+		l += bthrift.Binary.I64Length(*p.MaxStaleness)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *HGetResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -2778,6 +3343,20 @@ func (p *HDelReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2852,6 +3431,19 @@ func (p *HDelReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *HDelReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *HDelReq) FastWrite(buf []byte) int {
 	return 0
@@ -2863,6 +3455,7 @@ func (p *HDelReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -2875,6 +3468,7 @@ func (p *HDelReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -2899,6 +3493,17 @@ func (p *HDelReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *HDelReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *HDelReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -2917,6 +3522,17 @@ func (p *HDelReq) field2Length() int {
 	return l
 }
 
+func (p *HDelReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *HDelResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -3219,10 +3835,24 @@ func (p *LPushReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -3293,6 +3923,19 @@ func (p *LPushReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *LPushReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *LPushReq) FastWrite(buf []byte) int {
 	return 0
@@ -3304,6 +3947,7 @@ func (p *LPushReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -3316,6 +3960,7 @@ func (p *LPushReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -3340,6 +3985,17 @@ func (p *LPushReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter
 	return offset
 }
 
+func (p *LPushReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *LPushReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -3358,6 +4014,17 @@ func (p *LPushReq) field2Length() int {
 	return l
 }
 
+func (p *LPushReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *LPushResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -3660,6 +4327,20 @@ func (p *RPushReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3734,6 +4415,19 @@ func (p *RPushReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *RPushReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *RPushReq) FastWrite(buf []byte) int {
 	return 0
@@ -3745,6 +4439,7 @@ func (p *RPushReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -3757,6 +4452,7 @@ func (p *RPushReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -3781,6 +4477,17 @@ func (p *RPushReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter
 	return offset
 }
 
+func (p *RPushReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *RPushReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -3799,6 +4506,17 @@ func (p *RPushReq) field2Length() int {
 	return l
 }
 
+func (p *RPushReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *RPushResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -4085,6 +4803,20 @@ func (p *LPopReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4140,6 +4872,19 @@ func (p *LPopReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *LPopReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *LPopReq) FastWrite(buf []byte) int {
 	return 0
@@ -4150,6 +4895,7 @@ func (p *LPopReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPopReq")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -4161,6 +4907,7 @@ func (p *LPopReq) BLength() int {
 	l += bthrift.Binary.StructBeginLength("LPopReq")
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -4176,6 +4923,17 @@ func (p *LPopReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *LPopReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 2)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *LPopReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -4185,6 +4943,17 @@ func (p *LPopReq) field1Length() int {
 	return l
 }
 
+func (p *LPopReq) field2Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 2)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *LPopResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -4526,6 +5295,20 @@ func (p *RPopReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4581,6 +5364,19 @@ func (p *RPopReq) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *RPopReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *RPopReq) FastWrite(buf []byte) int {
 	return 0
@@ -4591,6 +5387,7 @@ func (p *RPopReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPopReq")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -4602,6 +5399,7 @@ func (p *RPopReq) BLength() int {
 	l += bthrift.Binary.StructBeginLength("RPopReq")
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -4617,6 +5415,17 @@ func (p *RPopReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *RPopReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 2)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *RPopReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -4626,6 +5435,17 @@ func (p *RPopReq) field1Length() int {
 	return l
 }
 
+func (p *RPopReq) field2Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 2)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *RPopResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -4983,6 +5803,20 @@ func (p *SAddReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5057,6 +5891,19 @@ func (p *SAddReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SAddReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *SAddReq) FastWrite(buf []byte) int {
 	return 0
@@ -5068,6 +5915,7 @@ func (p *SAddReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -5080,6 +5928,7 @@ func (p *SAddReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -5104,6 +5953,17 @@ func (p *SAddReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *SAddReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *SAddReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -5122,6 +5982,17 @@ func (p *SAddReq) field2Length() int {
 	return l
 }
 
+func (p *SAddReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *SAddResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -5424,6 +6295,20 @@ func (p *SRemReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5498,6 +6383,19 @@ func (p *SRemReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SRemReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *SRemReq) FastWrite(buf []byte) int {
 	return 0
@@ -5509,6 +6407,7 @@ func (p *SRemReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -5521,6 +6420,7 @@ func (p *SRemReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -5545,6 +6445,17 @@ func (p *SRemReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *SRemReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *SRemReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -5563,6 +6474,17 @@ func (p *SRemReq) field2Length() int {
 	return l
 }
 
+func (p *SRemReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *SRemResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -5865,12 +6787,40 @@ func (p *ZAddReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
 		}
 
 		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
@@ -5939,6 +6889,32 @@ func (p *ZAddReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ZAddReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
+func (p *ZAddReq) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Score = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *ZAddReq) FastWrite(buf []byte) int {
 	return 0
@@ -5948,8 +6924,10 @@ func (p *ZAddReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	offset := 0
 	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZAddReq")
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -5962,6 +6940,8 @@ func (p *ZAddReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -5986,6 +6966,28 @@ func (p *ZAddReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *ZAddReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *ZAddReq) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetScore() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "score", thrift.DOUBLE, 4)
+		offset += bthrift.Binary.WriteDouble(buf[offset:], *p.Score)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *ZAddReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -6004,6 +7006,28 @@ func (p *ZAddReq) field2Length() int {
 	return l
 }
 
+func (p *ZAddReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *ZAddReq) field4Length() int {
+	l := 0
+	if p.IsSetScore() {
+		l += bthrift.Binary.FieldBeginLength("score", thrift.DOUBLE, 4)
+		l += bthrift.Binary.DoubleLength(*p.Score)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *ZAddResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -6306,6 +7330,20 @@ func (p *ZRemReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6380,6 +7418,19 @@ func (p *ZRemReq) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ZRemReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
 // for compatibility
 func (p *ZRemReq) FastWrite(buf []byte) int {
 	return 0
@@ -6391,6 +7442,7 @@ func (p *ZRemReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter)
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
@@ -6403,6 +7455,7 @@ func (p *ZRemReq) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += bthrift.Binary.FieldStopLength()
 	l += bthrift.Binary.StructEndLength()
@@ -6427,6 +7480,17 @@ func (p *ZRemReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter)
 	return offset
 }
 
+func (p *ZRemReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 3)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
 func (p *ZRemReq) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("key", thrift.STRING, 1)
@@ -6445,6 +7509,17 @@ func (p *ZRemReq) field2Length() int {
 	return l
 }
 
+func (p *ZRemReq) field3Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 3)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
 func (p *ZRemResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
@@ -6693,7 +7768,7 @@ func (p *ZRemResp) field3Length() int {
 	return l
 }
 
-func (p *DataServiceGetArgs) FastRead(buf []byte) (int, error) {
+func (p *FlushDBReq) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -6716,7 +7791,7 @@ func (p *DataServiceGetArgs) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6755,7 +7830,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FlushDBReq[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -6764,27 +7839,27 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceGetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *FlushDBReq) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewGetReq()
-	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		p.Db = &v
+
 	}
-	p.Req = tmp
 	return offset, nil
 }
 
 // for compatibility
-func (p *DataServiceGetArgs) FastWrite(buf []byte) int {
+func (p *FlushDBReq) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *FlushDBReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Get_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "FlushDBReq")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -6793,9 +7868,9 @@ func (p *DataServiceGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.Bi
 	return offset
 }
 
-func (p *DataServiceGetArgs) BLength() int {
+func (p *FlushDBReq) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Get_args")
+	l += bthrift.Binary.StructBeginLength("FlushDBReq")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -6804,28 +7879,37 @@ func (p *DataServiceGetArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceGetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *FlushDBReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
-	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
-	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 1)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
 	return offset
 }
 
-func (p *DataServiceGetArgs) field1Length() int {
+func (p *FlushDBReq) field1Length() int {
 	l := 0
-	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
-	l += p.Req.BLength()
-	l += bthrift.Binary.FieldEndLength()
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 1)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
 	return l
 }
 
-func (p *DataServiceGetResult) FastRead(buf []byte) (int, error) {
+func (p *FlushDBResp) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+	var issetStatusCode bool = false
 	_, l, err = bthrift.Binary.ReadStructBegin(buf)
 	offset += l
 	if err != nil {
@@ -6842,13 +7926,44 @@ func (p *DataServiceGetResult) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 0:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField0(buf[offset:])
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetStatusCode = true
 			} else {
 				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6876,53 +7991,1450 @@ func (p *DataServiceGetResult) FastRead(buf []byte) (int, error) {
 		goto ReadStructEndError
 	}
 
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatusCode {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadStructBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FlushDBResp[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FlushDBResp[fieldId]))
 }
 
-func (p *DataServiceGetResult) FastReadField0(buf []byte) (int, error) {
+func (p *FlushDBResp) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewGetResp()
-	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+	if v, l, err := bthrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+
+		p.Success = v
+
+	}
+	return offset, nil
+}
+
+func (p *FlushDBResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.Message = v
+
+	}
+	return offset, nil
+}
+
+func (p *FlushDBResp) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.StatusCode = v
+
 	}
-	p.Success = tmp
 	return offset, nil
 }
 
 // for compatibility
-func (p *DataServiceGetResult) FastWrite(buf []byte) int {
+func (p *FlushDBResp) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceGetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *FlushDBResp) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Get_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "FlushDBResp")
 	if p != nil {
-		offset += p.fastWriteField0(buf[offset:], binaryWriter)
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
 	}
 	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
 	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
 	return offset
 }
 
-func (p *DataServiceGetResult) BLength() int {
+func (p *FlushDBResp) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Get_result")
+	l += bthrift.Binary.StructBeginLength("FlushDBResp")
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *FlushDBResp) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.BOOL, 1)
+	offset += bthrift.Binary.WriteBool(buf[offset:], p.Success)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *FlushDBResp) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "message", thrift.STRING, 2)
+	offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, p.Message)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *FlushDBResp) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "status_code", thrift.I32, 3)
+	offset += bthrift.Binary.WriteI32(buf[offset:], p.StatusCode)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *FlushDBResp) field1Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("success", thrift.BOOL, 1)
+	l += bthrift.Binary.BoolLength(p.Success)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *FlushDBResp) field2Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("message", thrift.STRING, 2)
+	l += bthrift.Binary.StringLengthNocopy(p.Message)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *FlushDBResp) field3Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("status_code", thrift.I32, 3)
+	l += bthrift.Binary.I32Length(p.StatusCode)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DBSizeReq) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DBSizeReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DBSizeReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.Db = &v
+
+	}
+	return offset, nil
+}
+
+func (p *DBSizeReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := Consistency(v)
+		p.Consistency = &tmp
+
+	}
+	return offset, nil
+}
+
+func (p *DBSizeReq) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		p.MaxStaleness = &v
+
+	}
+	return offset, nil
+}
+
+// for compatibility
+func (p *DBSizeReq) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DBSizeReq) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "DBSizeReq")
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeReq) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("DBSizeReq")
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DBSizeReq) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetDb() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "db", thrift.STRING, 1)
+		offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, *p.Db)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *DBSizeReq) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetConsistency() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "consistency", thrift.I32, 2)
+		offset += bthrift.Binary.WriteI32(buf[offset:], int32(*p.Consistency))
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *DBSizeReq) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetMaxStaleness() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "max_staleness", thrift.I64, 3)
+		offset += bthrift.Binary.WriteI64(buf[offset:], *p.MaxStaleness)
+
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *DBSizeReq) field1Length() int {
+	l := 0
+	if p.IsSetDb() {
+		l += bthrift.Binary.FieldBeginLength("db", thrift.STRING, 1)
+		l += bthrift.Binary.StringLengthNocopy(*p.Db)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *DBSizeReq) field2Length() int {
+	l := 0
+	if p.IsSetConsistency() {
+		l += bthrift.Binary.FieldBeginLength("consistency", thrift.I32, 2)
+		l += bthrift.Binary.I32Length(int32(*p.Consistency))
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *DBSizeReq) field3Length() int {
+	l := 0
+	if p.IsSetMaxStaleness() {
+		l += bthrift.Binary.FieldBeginLength("max_staleness", thrift.I64, 3)
+		l += bthrift.Binary.I64Length(*p.MaxStaleness)
+
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *DBSizeResp) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetSize bool = false
+	var issetMessage bool = false
+	var issetStatusCode bool = false
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSize = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetStatusCode = true
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatusCode {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DBSizeResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DBSizeResp[fieldId]))
+}
+
+func (p *DBSizeResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.Success = v
+
+	}
+	return offset, nil
+}
+
+func (p *DBSizeResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.Size = v
+
+	}
+	return offset, nil
+}
+
+func (p *DBSizeResp) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.Message = v
+
+	}
+	return offset, nil
+}
+
+func (p *DBSizeResp) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	if v, l, err := bthrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		p.StatusCode = v
+
+	}
+	return offset, nil
+}
+
+// for compatibility
+func (p *DBSizeResp) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DBSizeResp) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "DBSizeResp")
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+		offset += p.fastWriteField2(buf[offset:], binaryWriter)
+		offset += p.fastWriteField4(buf[offset:], binaryWriter)
+		offset += p.fastWriteField3(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeResp) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("DBSizeResp")
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DBSizeResp) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.BOOL, 1)
+	offset += bthrift.Binary.WriteBool(buf[offset:], p.Success)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeResp) fastWriteField2(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "size", thrift.I64, 2)
+	offset += bthrift.Binary.WriteI64(buf[offset:], p.Size)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeResp) fastWriteField3(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "message", thrift.STRING, 3)
+	offset += bthrift.Binary.WriteStringNocopy(buf[offset:], binaryWriter, p.Message)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeResp) fastWriteField4(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "status_code", thrift.I32, 4)
+	offset += bthrift.Binary.WriteI32(buf[offset:], p.StatusCode)
+
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DBSizeResp) field1Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("success", thrift.BOOL, 1)
+	l += bthrift.Binary.BoolLength(p.Success)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DBSizeResp) field2Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("size", thrift.I64, 2)
+	l += bthrift.Binary.I64Length(p.Size)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DBSizeResp) field3Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("message", thrift.STRING, 3)
+	l += bthrift.Binary.StringLengthNocopy(p.Message)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DBSizeResp) field4Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("status_code", thrift.I32, 4)
+	l += bthrift.Binary.I32Length(p.StatusCode)
+
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DataServiceGetArgs) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceGetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewGetReq()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceGetArgs) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Get_args")
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceGetArgs) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Get_args")
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DataServiceGetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceGetArgs) field1Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
+	l += p.Req.BLength()
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DataServiceGetResult) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceGetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceGetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewGetResp()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceGetResult) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceGetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Get_result")
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceGetResult) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Get_result")
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DataServiceGetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], binaryWriter)
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *DataServiceGetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
+		l += p.Success.BLength()
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *DataServiceSetArgs) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceSetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewSetReq()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceSetArgs) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Set_args")
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceSetArgs) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Set_args")
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DataServiceSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceSetArgs) field1Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
+	l += p.Req.BLength()
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DataServiceSetResult) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceSetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewSetResp()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceSetResult) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Set_result")
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceSetResult) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Set_result")
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DataServiceSetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], binaryWriter)
+		offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	}
+	return offset
+}
+
+func (p *DataServiceSetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
+		l += p.Success.BLength()
+		l += bthrift.Binary.FieldEndLength()
+	}
+	return l
+}
+
+func (p *DataServiceDelArgs) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceDelArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewDelReq()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceDelArgs) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Del_args")
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceDelArgs) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Del_args")
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += bthrift.Binary.FieldStopLength()
+	l += bthrift.Binary.StructEndLength()
+	return l
+}
+
+func (p *DataServiceDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
+	offset += bthrift.Binary.WriteFieldEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceDelArgs) field1Length() int {
+	l := 0
+	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
+	l += p.Req.BLength()
+	l += bthrift.Binary.FieldEndLength()
+	return l
+}
+
+func (p *DataServiceDelResult) FastRead(buf []byte) (int, error) {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	_, l, err = bthrift.Binary.ReadStructBegin(buf)
+	offset += l
+	if err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, l, err = bthrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = bthrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		l, err = bthrift.Binary.ReadFieldEnd(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	l, err = bthrift.Binary.ReadStructEnd(buf[offset:])
+	offset += l
+	if err != nil {
+		goto ReadStructEndError
+	}
+
+	return offset, nil
+ReadStructBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadFieldEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataServiceDelResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+
+	tmp := NewDelResp()
+	if l, err := tmp.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = tmp
+	return offset, nil
+}
+
+// for compatibility
+func (p *DataServiceDelResult) FastWrite(buf []byte) int {
+	return 0
+}
+
+func (p *DataServiceDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+	offset := 0
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Del_result")
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], binaryWriter)
+	}
+	offset += bthrift.Binary.WriteFieldStop(buf[offset:])
+	offset += bthrift.Binary.WriteStructEnd(buf[offset:])
+	return offset
+}
+
+func (p *DataServiceDelResult) BLength() int {
+	l := 0
+	l += bthrift.Binary.StructBeginLength("Del_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -6931,7 +9443,7 @@ func (p *DataServiceGetResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceGetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceDelResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -6941,7 +9453,7 @@ func (p *DataServiceGetResult) fastWriteField0(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceGetResult) field0Length() int {
+func (p *DataServiceDelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -6951,7 +9463,7 @@ func (p *DataServiceGetResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceSetArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceExpireArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7013,7 +9525,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7022,10 +9534,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceExpireArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSetReq()
+	tmp := NewExpireReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7036,13 +9548,13 @@ func (p *DataServiceSetArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSetArgs) FastWrite(buf []byte) int {
+func (p *DataServiceExpireArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceExpireArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Set_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Expire_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -7051,9 +9563,9 @@ func (p *DataServiceSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.Bi
 	return offset
 }
 
-func (p *DataServiceSetArgs) BLength() int {
+func (p *DataServiceExpireArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Set_args")
+	l += bthrift.Binary.StructBeginLength("Expire_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -7062,7 +9574,7 @@ func (p *DataServiceSetArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceExpireArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -7070,7 +9582,7 @@ func (p *DataServiceSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.Bi
 	return offset
 }
 
-func (p *DataServiceSetArgs) field1Length() int {
+func (p *DataServiceExpireArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -7078,7 +9590,7 @@ func (p *DataServiceSetArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceSetResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceExpireResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7140,7 +9652,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7149,10 +9661,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceExpireResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSetResp()
+	tmp := NewExpireResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7163,13 +9675,13 @@ func (p *DataServiceSetResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSetResult) FastWrite(buf []byte) int {
+func (p *DataServiceExpireResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceExpireResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Set_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Expire_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -7178,9 +9690,9 @@ func (p *DataServiceSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceSetResult) BLength() int {
+func (p *DataServiceExpireResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Set_result")
+	l += bthrift.Binary.StructBeginLength("Expire_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -7189,7 +9701,7 @@ func (p *DataServiceSetResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceSetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceExpireResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -7199,7 +9711,7 @@ func (p *DataServiceSetResult) fastWriteField0(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceSetResult) field0Length() int {
+func (p *DataServiceExpireResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -7209,7 +9721,7 @@ func (p *DataServiceSetResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceDelArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHSetArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7271,7 +9783,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7280,10 +9792,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceDelArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceHSetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewDelReq()
+	tmp := NewHSetReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7294,13 +9806,13 @@ func (p *DataServiceDelArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceDelArgs) FastWrite(buf []byte) int {
+func (p *DataServiceHSetArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Del_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HSet_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -7309,9 +9821,9 @@ func (p *DataServiceDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.Bi
 	return offset
 }
 
-func (p *DataServiceDelArgs) BLength() int {
+func (p *DataServiceHSetArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Del_args")
+	l += bthrift.Binary.StructBeginLength("HSet_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -7320,7 +9832,7 @@ func (p *DataServiceDelArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -7328,7 +9840,7 @@ func (p *DataServiceDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.Bi
 	return offset
 }
 
-func (p *DataServiceDelArgs) field1Length() int {
+func (p *DataServiceHSetArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -7336,7 +9848,7 @@ func (p *DataServiceDelArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceDelResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHSetResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7398,7 +9910,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDelResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7407,10 +9919,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceDelResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceHSetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewDelResp()
+	tmp := NewHSetResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7421,13 +9933,13 @@ func (p *DataServiceDelResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceDelResult) FastWrite(buf []byte) int {
+func (p *DataServiceHSetResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Del_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HSet_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -7436,9 +9948,9 @@ func (p *DataServiceDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceDelResult) BLength() int {
+func (p *DataServiceHSetResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Del_result")
+	l += bthrift.Binary.StructBeginLength("HSet_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -7447,7 +9959,7 @@ func (p *DataServiceDelResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceDelResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHSetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -7457,7 +9969,7 @@ func (p *DataServiceDelResult) fastWriteField0(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceDelResult) field0Length() int {
+func (p *DataServiceHSetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -7467,7 +9979,7 @@ func (p *DataServiceDelResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceExpireArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHGetArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7529,7 +10041,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7538,10 +10050,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceHGetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewExpireReq()
+	tmp := NewHGetReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7552,13 +10064,13 @@ func (p *DataServiceExpireArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceExpireArgs) FastWrite(buf []byte) int {
+func (p *DataServiceHGetArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceExpireArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Expire_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HGet_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -7567,9 +10079,9 @@ func (p *DataServiceExpireArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceExpireArgs) BLength() int {
+func (p *DataServiceHGetArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Expire_args")
+	l += bthrift.Binary.StructBeginLength("HGet_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -7578,7 +10090,7 @@ func (p *DataServiceExpireArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceExpireArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHGetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -7586,7 +10098,7 @@ func (p *DataServiceExpireArgs) fastWriteField1(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceExpireArgs) field1Length() int {
+func (p *DataServiceHGetArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -7594,7 +10106,7 @@ func (p *DataServiceExpireArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceExpireResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHGetResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7656,7 +10168,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceExpireResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7665,10 +10177,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceExpireResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceHGetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewExpireResp()
+	tmp := NewHGetResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7679,13 +10191,13 @@ func (p *DataServiceExpireResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceExpireResult) FastWrite(buf []byte) int {
+func (p *DataServiceHGetResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceExpireResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHGetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "Expire_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HGet_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -7694,9 +10206,9 @@ func (p *DataServiceExpireResult) FastWriteNocopy(buf []byte, binaryWriter bthri
 	return offset
 }
 
-func (p *DataServiceExpireResult) BLength() int {
+func (p *DataServiceHGetResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("Expire_result")
+	l += bthrift.Binary.StructBeginLength("HGet_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -7705,7 +10217,7 @@ func (p *DataServiceExpireResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceExpireResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHGetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -7715,7 +10227,7 @@ func (p *DataServiceExpireResult) fastWriteField0(buf []byte, binaryWriter bthri
 	return offset
 }
 
-func (p *DataServiceExpireResult) field0Length() int {
+func (p *DataServiceHGetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -7725,7 +10237,7 @@ func (p *DataServiceExpireResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceHSetArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHDelArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7787,7 +10299,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7796,10 +10308,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceHDelArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHSetReq()
+	tmp := NewHDelReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7810,13 +10322,13 @@ func (p *DataServiceHSetArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHSetArgs) FastWrite(buf []byte) int {
+func (p *DataServiceHDelArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HSet_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HDel_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -7825,9 +10337,9 @@ func (p *DataServiceHSetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHSetArgs) BLength() int {
+func (p *DataServiceHDelArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HSet_args")
+	l += bthrift.Binary.StructBeginLength("HDel_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -7836,7 +10348,7 @@ func (p *DataServiceHSetArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceHSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -7844,7 +10356,7 @@ func (p *DataServiceHSetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHSetArgs) field1Length() int {
+func (p *DataServiceHDelArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -7852,7 +10364,7 @@ func (p *DataServiceHSetArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceHSetResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceHDelResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -7914,7 +10426,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -7923,10 +10435,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceHDelResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHSetResp()
+	tmp := NewHDelResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7937,13 +10449,13 @@ func (p *DataServiceHSetResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHSetResult) FastWrite(buf []byte) int {
+func (p *DataServiceHDelResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HSet_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HDel_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -7952,9 +10464,9 @@ func (p *DataServiceHSetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHSetResult) BLength() int {
+func (p *DataServiceHDelResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HSet_result")
+	l += bthrift.Binary.StructBeginLength("HDel_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -7963,7 +10475,7 @@ func (p *DataServiceHSetResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceHSetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceHDelResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -7973,7 +10485,7 @@ func (p *DataServiceHSetResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHSetResult) field0Length() int {
+func (p *DataServiceHDelResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -7983,7 +10495,7 @@ func (p *DataServiceHSetResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceHGetArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceLPushArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8045,7 +10557,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8054,10 +10566,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceLPushArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHGetReq()
+	tmp := NewLPushReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8068,13 +10580,13 @@ func (p *DataServiceHGetArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHGetArgs) FastWrite(buf []byte) int {
+func (p *DataServiceLPushArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HGet_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPush_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -8083,9 +10595,9 @@ func (p *DataServiceHGetArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHGetArgs) BLength() int {
+func (p *DataServiceLPushArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HGet_args")
+	l += bthrift.Binary.StructBeginLength("LPush_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -8094,7 +10606,7 @@ func (p *DataServiceHGetArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceHGetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -8102,7 +10614,7 @@ func (p *DataServiceHGetArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHGetArgs) field1Length() int {
+func (p *DataServiceLPushArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -8110,7 +10622,7 @@ func (p *DataServiceHGetArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceHGetResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceLPushResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8172,7 +10684,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHGetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8181,10 +10693,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHGetResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceLPushResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHGetResp()
+	tmp := NewLPushResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8195,13 +10707,13 @@ func (p *DataServiceHGetResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHGetResult) FastWrite(buf []byte) int {
+func (p *DataServiceLPushResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHGetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HGet_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPush_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -8210,9 +10722,9 @@ func (p *DataServiceHGetResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHGetResult) BLength() int {
+func (p *DataServiceLPushResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HGet_result")
+	l += bthrift.Binary.StructBeginLength("LPush_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -8221,7 +10733,7 @@ func (p *DataServiceHGetResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceHGetResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPushResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -8231,7 +10743,7 @@ func (p *DataServiceHGetResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHGetResult) field0Length() int {
+func (p *DataServiceLPushResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -8241,7 +10753,7 @@ func (p *DataServiceHGetResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceHDelArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceRPushArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8303,7 +10815,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8312,10 +10824,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceRPushArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHDelReq()
+	tmp := NewRPushReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8326,13 +10838,13 @@ func (p *DataServiceHDelArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHDelArgs) FastWrite(buf []byte) int {
+func (p *DataServiceRPushArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HDel_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPush_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -8341,9 +10853,9 @@ func (p *DataServiceHDelArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHDelArgs) BLength() int {
+func (p *DataServiceRPushArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HDel_args")
+	l += bthrift.Binary.StructBeginLength("RPush_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -8352,7 +10864,7 @@ func (p *DataServiceHDelArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceHDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -8360,7 +10872,7 @@ func (p *DataServiceHDelArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceHDelArgs) field1Length() int {
+func (p *DataServiceRPushArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -8368,7 +10880,7 @@ func (p *DataServiceHDelArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceHDelResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceRPushResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8430,7 +10942,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceHDelResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8439,10 +10951,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceHDelResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceRPushResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewHDelResp()
+	tmp := NewRPushResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8453,13 +10965,13 @@ func (p *DataServiceHDelResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceHDelResult) FastWrite(buf []byte) int {
+func (p *DataServiceRPushResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceHDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "HDel_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPush_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -8468,9 +10980,9 @@ func (p *DataServiceHDelResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHDelResult) BLength() int {
+func (p *DataServiceRPushResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("HDel_result")
+	l += bthrift.Binary.StructBeginLength("RPush_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -8479,7 +10991,7 @@ func (p *DataServiceHDelResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceHDelResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPushResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -8489,7 +11001,7 @@ func (p *DataServiceHDelResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceHDelResult) field0Length() int {
+func (p *DataServiceRPushResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -8499,7 +11011,7 @@ func (p *DataServiceHDelResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceLPushArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceLPopArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8561,7 +11073,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8570,10 +11082,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceLPopArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewLPushReq()
+	tmp := NewLPopReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8584,13 +11096,13 @@ func (p *DataServiceLPushArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceLPushArgs) FastWrite(buf []byte) int {
+func (p *DataServiceLPopArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceLPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPush_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPop_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -8599,9 +11111,9 @@ func (p *DataServiceLPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceLPushArgs) BLength() int {
+func (p *DataServiceLPopArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("LPush_args")
+	l += bthrift.Binary.StructBeginLength("LPop_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -8610,7 +11122,7 @@ func (p *DataServiceLPushArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceLPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -8618,7 +11130,7 @@ func (p *DataServiceLPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceLPushArgs) field1Length() int {
+func (p *DataServiceLPopArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -8626,7 +11138,7 @@ func (p *DataServiceLPushArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceLPushResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceLPopResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8688,7 +11200,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPushResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8697,10 +11209,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPushResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceLPopResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewLPushResp()
+	tmp := NewLPopResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8711,13 +11223,13 @@ func (p *DataServiceLPushResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceLPushResult) FastWrite(buf []byte) int {
+func (p *DataServiceLPopResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceLPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPush_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPop_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -8726,9 +11238,9 @@ func (p *DataServiceLPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrif
 	return offset
 }
 
-func (p *DataServiceLPushResult) BLength() int {
+func (p *DataServiceLPopResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("LPush_result")
+	l += bthrift.Binary.StructBeginLength("LPop_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -8737,7 +11249,7 @@ func (p *DataServiceLPushResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceLPushResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceLPopResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -8747,7 +11259,7 @@ func (p *DataServiceLPushResult) fastWriteField0(buf []byte, binaryWriter bthrif
 	return offset
 }
 
-func (p *DataServiceLPushResult) field0Length() int {
+func (p *DataServiceLPopResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -8757,7 +11269,7 @@ func (p *DataServiceLPushResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceRPushArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceRPopArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8819,7 +11331,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8828,10 +11340,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceRPopArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewRPushReq()
+	tmp := NewRPopReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8842,13 +11354,13 @@ func (p *DataServiceRPushArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceRPushArgs) FastWrite(buf []byte) int {
+func (p *DataServiceRPopArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceRPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPush_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPop_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -8857,9 +11369,9 @@ func (p *DataServiceRPushArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceRPushArgs) BLength() int {
+func (p *DataServiceRPopArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("RPush_args")
+	l += bthrift.Binary.StructBeginLength("RPop_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -8868,7 +11380,7 @@ func (p *DataServiceRPushArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceRPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -8876,7 +11388,7 @@ func (p *DataServiceRPushArgs) fastWriteField1(buf []byte, binaryWriter bthrift.
 	return offset
 }
 
-func (p *DataServiceRPushArgs) field1Length() int {
+func (p *DataServiceRPopArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -8884,7 +11396,7 @@ func (p *DataServiceRPushArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceRPushResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceRPopResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -8946,7 +11458,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPushResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -8955,10 +11467,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPushResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceRPopResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewRPushResp()
+	tmp := NewRPopResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -8969,13 +11481,13 @@ func (p *DataServiceRPushResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceRPushResult) FastWrite(buf []byte) int {
+func (p *DataServiceRPopResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceRPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPush_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPop_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -8984,9 +11496,9 @@ func (p *DataServiceRPushResult) FastWriteNocopy(buf []byte, binaryWriter bthrif
 	return offset
 }
 
-func (p *DataServiceRPushResult) BLength() int {
+func (p *DataServiceRPopResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("RPush_result")
+	l += bthrift.Binary.StructBeginLength("RPop_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -8995,7 +11507,7 @@ func (p *DataServiceRPushResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceRPushResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceRPopResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -9005,7 +11517,7 @@ func (p *DataServiceRPushResult) fastWriteField0(buf []byte, binaryWriter bthrif
 	return offset
 }
 
-func (p *DataServiceRPushResult) field0Length() int {
+func (p *DataServiceRPopResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -9015,7 +11527,7 @@ func (p *DataServiceRPushResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceLPopArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceSAddArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9077,7 +11589,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9086,10 +11598,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceSAddArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewLPopReq()
+	tmp := NewSAddReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9100,13 +11612,13 @@ func (p *DataServiceLPopArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceLPopArgs) FastWrite(buf []byte) int {
+func (p *DataServiceSAddArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceLPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPop_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SAdd_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -9115,9 +11627,9 @@ func (p *DataServiceLPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceLPopArgs) BLength() int {
+func (p *DataServiceSAddArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("LPop_args")
+	l += bthrift.Binary.StructBeginLength("SAdd_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -9126,7 +11638,7 @@ func (p *DataServiceLPopArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceLPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -9134,7 +11646,7 @@ func (p *DataServiceLPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceLPopArgs) field1Length() int {
+func (p *DataServiceSAddArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -9142,7 +11654,7 @@ func (p *DataServiceLPopArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceLPopResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceSAddResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9204,7 +11716,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceLPopResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9213,10 +11725,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceLPopResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceSAddResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewLPopResp()
+	tmp := NewSAddResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9227,13 +11739,13 @@ func (p *DataServiceLPopResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceLPopResult) FastWrite(buf []byte) int {
+func (p *DataServiceSAddResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceLPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "LPop_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SAdd_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -9242,9 +11754,9 @@ func (p *DataServiceLPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceLPopResult) BLength() int {
+func (p *DataServiceSAddResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("LPop_result")
+	l += bthrift.Binary.StructBeginLength("SAdd_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -9253,7 +11765,7 @@ func (p *DataServiceLPopResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceLPopResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSAddResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -9263,7 +11775,7 @@ func (p *DataServiceLPopResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceLPopResult) field0Length() int {
+func (p *DataServiceSAddResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -9273,7 +11785,7 @@ func (p *DataServiceLPopResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceRPopArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceSRemArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9335,7 +11847,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9344,10 +11856,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceSRemArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewRPopReq()
+	tmp := NewSRemReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9358,13 +11870,13 @@ func (p *DataServiceRPopArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceRPopArgs) FastWrite(buf []byte) int {
+func (p *DataServiceSRemArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceRPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPop_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SRem_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -9373,9 +11885,9 @@ func (p *DataServiceRPopArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceRPopArgs) BLength() int {
+func (p *DataServiceSRemArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("RPop_args")
+	l += bthrift.Binary.StructBeginLength("SRem_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -9384,7 +11896,7 @@ func (p *DataServiceRPopArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceRPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -9392,7 +11904,7 @@ func (p *DataServiceRPopArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceRPopArgs) field1Length() int {
+func (p *DataServiceSRemArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -9400,7 +11912,7 @@ func (p *DataServiceRPopArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceRPopResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceSRemResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9462,7 +11974,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceRPopResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9471,10 +11983,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceRPopResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceSRemResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewRPopResp()
+	tmp := NewSRemResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9485,13 +11997,13 @@ func (p *DataServiceRPopResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceRPopResult) FastWrite(buf []byte) int {
+func (p *DataServiceSRemResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceRPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "RPop_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SRem_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -9500,9 +12012,9 @@ func (p *DataServiceRPopResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceRPopResult) BLength() int {
+func (p *DataServiceSRemResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("RPop_result")
+	l += bthrift.Binary.StructBeginLength("SRem_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -9511,7 +12023,7 @@ func (p *DataServiceRPopResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceRPopResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceSRemResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -9521,7 +12033,7 @@ func (p *DataServiceRPopResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceRPopResult) field0Length() int {
+func (p *DataServiceSRemResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -9531,7 +12043,7 @@ func (p *DataServiceRPopResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceSAddArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceZAddArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9593,7 +12105,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9602,10 +12114,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceZAddArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSAddReq()
+	tmp := NewZAddReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9616,13 +12128,13 @@ func (p *DataServiceSAddArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSAddArgs) FastWrite(buf []byte) int {
+func (p *DataServiceZAddArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SAdd_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZAdd_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -9631,9 +12143,9 @@ func (p *DataServiceSAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceSAddArgs) BLength() int {
+func (p *DataServiceZAddArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("SAdd_args")
+	l += bthrift.Binary.StructBeginLength("ZAdd_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -9642,7 +12154,7 @@ func (p *DataServiceSAddArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceSAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -9650,7 +12162,7 @@ func (p *DataServiceSAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceSAddArgs) field1Length() int {
+func (p *DataServiceZAddArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -9658,7 +12170,7 @@ func (p *DataServiceSAddArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceSAddResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceZAddResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9720,7 +12232,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSAddResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9729,10 +12241,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSAddResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceZAddResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSAddResp()
+	tmp := NewZAddResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9743,13 +12255,13 @@ func (p *DataServiceSAddResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSAddResult) FastWrite(buf []byte) int {
+func (p *DataServiceZAddResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SAdd_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZAdd_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -9758,9 +12270,9 @@ func (p *DataServiceSAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceSAddResult) BLength() int {
+func (p *DataServiceZAddResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("SAdd_result")
+	l += bthrift.Binary.StructBeginLength("ZAdd_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -9769,7 +12281,7 @@ func (p *DataServiceSAddResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceSAddResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZAddResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -9779,7 +12291,7 @@ func (p *DataServiceSAddResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceSAddResult) field0Length() int {
+func (p *DataServiceZAddResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -9789,7 +12301,7 @@ func (p *DataServiceSAddResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceSRemArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceZRemArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9851,7 +12363,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9860,10 +12372,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceZRemArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSRemReq()
+	tmp := NewZRemReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -9874,13 +12386,13 @@ func (p *DataServiceSRemArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSRemArgs) FastWrite(buf []byte) int {
+func (p *DataServiceZRemArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SRem_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZRem_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -9889,9 +12401,9 @@ func (p *DataServiceSRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceSRemArgs) BLength() int {
+func (p *DataServiceZRemArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("SRem_args")
+	l += bthrift.Binary.StructBeginLength("ZRem_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -9900,7 +12412,7 @@ func (p *DataServiceSRemArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceSRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -9908,7 +12420,7 @@ func (p *DataServiceSRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceSRemArgs) field1Length() int {
+func (p *DataServiceZRemArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -9916,7 +12428,7 @@ func (p *DataServiceSRemArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceSRemResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceZRemResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -9978,7 +12490,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceSRemResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -9987,10 +12499,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceSRemResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceZRemResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewSRemResp()
+	tmp := NewZRemResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10001,13 +12513,13 @@ func (p *DataServiceSRemResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceSRemResult) FastWrite(buf []byte) int {
+func (p *DataServiceZRemResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceSRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "SRem_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZRem_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -10016,9 +12528,9 @@ func (p *DataServiceSRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceSRemResult) BLength() int {
+func (p *DataServiceZRemResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("SRem_result")
+	l += bthrift.Binary.StructBeginLength("ZRem_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -10027,7 +12539,7 @@ func (p *DataServiceSRemResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceSRemResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceZRemResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -10037,7 +12549,7 @@ func (p *DataServiceSRemResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceSRemResult) field0Length() int {
+func (p *DataServiceZRemResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -10047,7 +12559,7 @@ func (p *DataServiceSRemResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceZAddArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceFlushDBArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -10109,7 +12621,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceFlushDBArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -10118,10 +12630,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceFlushDBArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewZAddReq()
+	tmp := NewFlushDBReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10132,13 +12644,13 @@ func (p *DataServiceZAddArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceZAddArgs) FastWrite(buf []byte) int {
+func (p *DataServiceFlushDBArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceZAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceFlushDBArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZAdd_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "FlushDB_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -10147,9 +12659,9 @@ func (p *DataServiceZAddArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceZAddArgs) BLength() int {
+func (p *DataServiceFlushDBArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("ZAdd_args")
+	l += bthrift.Binary.StructBeginLength("FlushDB_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -10158,7 +12670,7 @@ func (p *DataServiceZAddArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceZAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceFlushDBArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -10166,7 +12678,7 @@ func (p *DataServiceZAddArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceZAddArgs) field1Length() int {
+func (p *DataServiceFlushDBArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -10174,7 +12686,7 @@ func (p *DataServiceZAddArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceZAddResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceFlushDBResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -10236,7 +12748,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZAddResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceFlushDBResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -10245,10 +12757,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZAddResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceFlushDBResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewZAddResp()
+	tmp := NewFlushDBResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10259,13 +12771,13 @@ func (p *DataServiceZAddResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceZAddResult) FastWrite(buf []byte) int {
+func (p *DataServiceFlushDBResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceZAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceFlushDBResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZAdd_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "FlushDB_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -10274,9 +12786,9 @@ func (p *DataServiceZAddResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceZAddResult) BLength() int {
+func (p *DataServiceFlushDBResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("ZAdd_result")
+	l += bthrift.Binary.StructBeginLength("FlushDB_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -10285,7 +12797,7 @@ func (p *DataServiceZAddResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceZAddResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceFlushDBResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -10295,7 +12807,7 @@ func (p *DataServiceZAddResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceZAddResult) field0Length() int {
+func (p *DataServiceFlushDBResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -10305,7 +12817,7 @@ func (p *DataServiceZAddResult) field0Length() int {
 	return l
 }
 
-func (p *DataServiceZRemArgs) FastRead(buf []byte) (int, error) {
+func (p *DataServiceDBSizeArgs) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -10367,7 +12879,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDBSizeArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -10376,10 +12888,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemArgs) FastReadField1(buf []byte) (int, error) {
+func (p *DataServiceDBSizeArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewZRemReq()
+	tmp := NewDBSizeReq()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10390,13 +12902,13 @@ func (p *DataServiceZRemArgs) FastReadField1(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceZRemArgs) FastWrite(buf []byte) int {
+func (p *DataServiceDBSizeArgs) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceZRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceDBSizeArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZRem_args")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "DBSize_args")
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], binaryWriter)
 	}
@@ -10405,9 +12917,9 @@ func (p *DataServiceZRemArgs) FastWriteNocopy(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceZRemArgs) BLength() int {
+func (p *DataServiceDBSizeArgs) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("ZRem_args")
+	l += bthrift.Binary.StructBeginLength("DBSize_args")
 	if p != nil {
 		l += p.field1Length()
 	}
@@ -10416,7 +12928,7 @@ func (p *DataServiceZRemArgs) BLength() int {
 	return l
 }
 
-func (p *DataServiceZRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceDBSizeArgs) fastWriteField1(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "req", thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], binaryWriter)
@@ -10424,7 +12936,7 @@ func (p *DataServiceZRemArgs) fastWriteField1(buf []byte, binaryWriter bthrift.B
 	return offset
 }
 
-func (p *DataServiceZRemArgs) field1Length() int {
+func (p *DataServiceDBSizeArgs) field1Length() int {
 	l := 0
 	l += bthrift.Binary.FieldBeginLength("req", thrift.STRUCT, 1)
 	l += p.Req.BLength()
@@ -10432,7 +12944,7 @@ func (p *DataServiceZRemArgs) field1Length() int {
 	return l
 }
 
-func (p *DataServiceZRemResult) FastRead(buf []byte) (int, error) {
+func (p *DataServiceDBSizeResult) FastRead(buf []byte) (int, error) {
 	var err error
 	var offset int
 	var l int
@@ -10494,7 +13006,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceZRemResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataServiceDBSizeResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 ReadFieldEndError:
@@ -10503,10 +13015,10 @@ ReadStructEndError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataServiceZRemResult) FastReadField0(buf []byte) (int, error) {
+func (p *DataServiceDBSizeResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
 
-	tmp := NewZRemResp()
+	tmp := NewDBSizeResp()
 	if l, err := tmp.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -10517,13 +13029,13 @@ func (p *DataServiceZRemResult) FastReadField0(buf []byte) (int, error) {
 }
 
 // for compatibility
-func (p *DataServiceZRemResult) FastWrite(buf []byte) int {
+func (p *DataServiceDBSizeResult) FastWrite(buf []byte) int {
 	return 0
 }
 
-func (p *DataServiceZRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceDBSizeResult) FastWriteNocopy(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
-	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "ZRem_result")
+	offset += bthrift.Binary.WriteStructBegin(buf[offset:], "DBSize_result")
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], binaryWriter)
 	}
@@ -10532,9 +13044,9 @@ func (p *DataServiceZRemResult) FastWriteNocopy(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceZRemResult) BLength() int {
+func (p *DataServiceDBSizeResult) BLength() int {
 	l := 0
-	l += bthrift.Binary.StructBeginLength("ZRem_result")
+	l += bthrift.Binary.StructBeginLength("DBSize_result")
 	if p != nil {
 		l += p.field0Length()
 	}
@@ -10543,7 +13055,7 @@ func (p *DataServiceZRemResult) BLength() int {
 	return l
 }
 
-func (p *DataServiceZRemResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
+func (p *DataServiceDBSizeResult) fastWriteField0(buf []byte, binaryWriter bthrift.BinaryWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += bthrift.Binary.WriteFieldBegin(buf[offset:], "success", thrift.STRUCT, 0)
@@ -10553,7 +13065,7 @@ func (p *DataServiceZRemResult) fastWriteField0(buf []byte, binaryWriter bthrift
 	return offset
 }
 
-func (p *DataServiceZRemResult) field0Length() int {
+func (p *DataServiceDBSizeResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += bthrift.Binary.FieldBeginLength("success", thrift.STRUCT, 0)
@@ -10682,3 +13194,19 @@ func (p *DataServiceZRemArgs) GetFirstArgument() interface{} {
 func (p *DataServiceZRemResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *DataServiceFlushDBArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *DataServiceFlushDBResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *DataServiceDBSizeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *DataServiceDBSizeResult) GetResult() interface{} {
+	return p.Success
+}
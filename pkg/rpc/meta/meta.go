@@ -1,8 +1,9 @@
-// Code generated by thriftgo (0.2.12). DO NOT EDIT.
+// Code generated by thriftgo (0.3.3). DO NOT EDIT.
 
 package meta
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
@@ -42,7 +43,6 @@ func (p *RegionListReq) Read(iprot thrift.TProtocol) (err error) {
 		if err = iprot.Skip(fieldTypeId); err != nil {
 			goto SkipFieldTypeError
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -70,7 +70,6 @@ func (p *RegionListReq) Write(oprot thrift.TProtocol) (err error) {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -152,17 +151,16 @@ func (p *RegionListResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -194,6 +192,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *RegionListResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -212,7 +211,6 @@ func (p *RegionListResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -275,34 +273,104 @@ func (p *RegionListResp) Field1DeepEqual(src string) bool {
 	return true
 }
 
-type RegionStatusReq struct {
-	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+type ReplicaProgress struct {
+	RegionId    int32  `thrift:"region_id,1,required" frugal:"1,required,i32" json:"region_id"`
+	NodeId      string `thrift:"node_id,2,required" frugal:"2,required,string" json:"node_id"`
+	Address     string `thrift:"address,3,required" frugal:"3,required,string" json:"address"`
+	Suffrage    string `thrift:"suffrage,4,required" frugal:"4,required,string" json:"suffrage"`
+	MatchIndex  int64  `thrift:"match_index,5,required" frugal:"5,required,i64" json:"match_index"`
+	LagEntries  int64  `thrift:"lag_entries,6,required" frugal:"6,required,i64" json:"lag_entries"`
+	LagTime     int64  `thrift:"lag_time,7,required" frugal:"7,required,i64" json:"lag_time"`
+	LastContact int64  `thrift:"last_contact,8,required" frugal:"8,required,i64" json:"last_contact"`
 }
 
-func NewRegionStatusReq() *RegionStatusReq {
-	return &RegionStatusReq{}
+func NewReplicaProgress() *ReplicaProgress {
+	return &ReplicaProgress{}
 }
 
-func (p *RegionStatusReq) InitDefault() {
-	*p = RegionStatusReq{}
+func (p *ReplicaProgress) InitDefault() {
+	*p = ReplicaProgress{}
 }
 
-func (p *RegionStatusReq) GetName() (v string) {
-	return p.Name
+func (p *ReplicaProgress) GetRegionId() (v int32) {
+	return p.RegionId
 }
-func (p *RegionStatusReq) SetName(val string) {
-	p.Name = val
+
+func (p *ReplicaProgress) GetNodeId() (v string) {
+	return p.NodeId
 }
 
-var fieldIDToName_RegionStatusReq = map[int16]string{
-	1: "name",
+func (p *ReplicaProgress) GetAddress() (v string) {
+	return p.Address
 }
 
-func (p *RegionStatusReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaProgress) GetSuffrage() (v string) {
+	return p.Suffrage
+}
+
+func (p *ReplicaProgress) GetMatchIndex() (v int64) {
+	return p.MatchIndex
+}
+
+func (p *ReplicaProgress) GetLagEntries() (v int64) {
+	return p.LagEntries
+}
+
+func (p *ReplicaProgress) GetLagTime() (v int64) {
+	return p.LagTime
+}
+
+func (p *ReplicaProgress) GetLastContact() (v int64) {
+	return p.LastContact
+}
+func (p *ReplicaProgress) SetRegionId(val int32) {
+	p.RegionId = val
+}
+func (p *ReplicaProgress) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *ReplicaProgress) SetAddress(val string) {
+	p.Address = val
+}
+func (p *ReplicaProgress) SetSuffrage(val string) {
+	p.Suffrage = val
+}
+func (p *ReplicaProgress) SetMatchIndex(val int64) {
+	p.MatchIndex = val
+}
+func (p *ReplicaProgress) SetLagEntries(val int64) {
+	p.LagEntries = val
+}
+func (p *ReplicaProgress) SetLagTime(val int64) {
+	p.LagTime = val
+}
+func (p *ReplicaProgress) SetLastContact(val int64) {
+	p.LastContact = val
+}
+
+var fieldIDToName_ReplicaProgress = map[int16]string{
+	1: "region_id",
+	2: "node_id",
+	3: "address",
+	4: "suffrage",
+	5: "match_index",
+	6: "lag_entries",
+	7: "lag_time",
+	8: "last_contact",
+}
+
+func (p *ReplicaProgress) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetName bool = false
+	var issetRegionId bool = false
+	var issetNodeId bool = false
+	var issetAddress bool = false
+	var issetSuffrage bool = false
+	var issetMatchIndex bool = false
+	var issetLagEntries bool = false
+	var issetLagTime bool = false
+	var issetLastContact bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -319,22 +387,98 @@ func (p *RegionStatusReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				issetRegionId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddress = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuffrage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMatchIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLagEntries = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLagTime = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
 				}
+				issetLastContact = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -343,17 +487,52 @@ func (p *RegionStatusReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetName {
+	if !issetRegionId {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetNodeId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddress {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuffrage {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMatchIndex {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLagEntries {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLagTime {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastContact {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RegionStatusReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaProgress[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -362,21 +541,85 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RegionStatusReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaProgress[fieldId]))
 }
 
-func (p *RegionStatusReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReplicaProgress) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Name = v
+		p.NodeId = v
 	}
 	return nil
 }
+func (p *ReplicaProgress) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *RegionStatusReq) Write(oprot thrift.TProtocol) (err error) {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Address = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Suffrage = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MatchIndex = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LagEntries = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LagTime = v
+	}
+	return nil
+}
+func (p *ReplicaProgress) ReadField8(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastContact = v
+	}
+	return nil
+}
+
+func (p *ReplicaProgress) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionStatusReq"); err != nil {
+	if err = oprot.WriteStructBegin("ReplicaProgress"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -384,7 +627,34 @@ func (p *RegionStatusReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -403,11 +673,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RegionStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *ReplicaProgress) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteI32(p.RegionId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -419,92 +689,354 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *RegionStatusReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ReplicaProgress) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("RegionStatusReq(%+v)", *p)
-}
-
-func (p *RegionStatusReq) DeepEqual(ano *RegionStatusReq) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
 	}
-	if !p.Field1DeepEqual(ano.Name) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *RegionStatusReq) Field1DeepEqual(src string) bool {
-
-	if strings.Compare(p.Name, src) != 0 {
-		return false
+func (p *ReplicaProgress) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true
+	if err := oprot.WriteString(p.Address); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ReplicaProgress) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("suffrage", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Suffrage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ReplicaProgress) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_index", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MatchIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ReplicaProgress) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lag_entries", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LagEntries); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ReplicaProgress) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lag_time", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LagTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ReplicaProgress) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_contact", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastContact); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-type RegionStatusResp struct {
-	Name         string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
-	Address      string `thrift:"address,2,required" frugal:"2,required,string" json:"address"`
-	ReplicaCount int64  `thrift:"replica_count,3,required" frugal:"3,required,i64" json:"replica_count"`
-	Message      string `thrift:"message,4,required" frugal:"4,required,string" json:"message"`
+func (p *ReplicaProgress) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaProgress(%+v)", *p)
 }
 
-func NewRegionStatusResp() *RegionStatusResp {
-	return &RegionStatusResp{}
+func (p *ReplicaProgress) DeepEqual(ano *ReplicaProgress) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Address) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Suffrage) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MatchIndex) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.LagEntries) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.LagTime) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.LastContact) {
+		return false
+	}
+	return true
 }
 
-func (p *RegionStatusResp) InitDefault() {
-	*p = RegionStatusResp{}
+func (p *ReplicaProgress) Field1DeepEqual(src int32) bool {
+
+	if p.RegionId != src {
+		return false
+	}
+	return true
 }
+func (p *ReplicaProgress) Field2DeepEqual(src string) bool {
 
-func (p *RegionStatusResp) GetName() (v string) {
-	return p.Name
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ReplicaProgress) Field3DeepEqual(src string) bool {
 
-func (p *RegionStatusResp) GetAddress() (v string) {
+	if strings.Compare(p.Address, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ReplicaProgress) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Suffrage, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ReplicaProgress) Field5DeepEqual(src int64) bool {
+
+	if p.MatchIndex != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaProgress) Field6DeepEqual(src int64) bool {
+
+	if p.LagEntries != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaProgress) Field7DeepEqual(src int64) bool {
+
+	if p.LagTime != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaProgress) Field8DeepEqual(src int64) bool {
+
+	if p.LastContact != src {
+		return false
+	}
+	return true
+}
+
+type ReplicaState struct {
+	RegionId      int32              `thrift:"region_id,1,required" frugal:"1,required,i32" json:"region_id"`
+	NodeId        string             `thrift:"node_id,2,required" frugal:"2,required,string" json:"node_id"`
+	Address       string             `thrift:"address,3,required" frugal:"3,required,string" json:"address"`
+	State         string             `thrift:"state,4,required" frugal:"4,required,string" json:"state"`
+	Leader        string             `thrift:"leader,5,required" frugal:"5,required,string" json:"leader"`
+	Term          int64              `thrift:"term,6,required" frugal:"6,required,i64" json:"term"`
+	LastIndex     int64              `thrift:"last_index,7,required" frugal:"7,required,i64" json:"last_index"`
+	CommitIndex   int64              `thrift:"commit_index,8,required" frugal:"8,required,i64" json:"commit_index"`
+	AppliedIndex  int64              `thrift:"applied_index,9,required" frugal:"9,required,i64" json:"applied_index"`
+	SnapshotIndex int64              `thrift:"snapshot_index,10,required" frugal:"10,required,i64" json:"snapshot_index"`
+	LastContact   int64              `thrift:"last_contact,11,required" frugal:"11,required,i64" json:"last_contact"`
+	Followers     []*ReplicaProgress `thrift:"followers,12,required" frugal:"12,required,list<ReplicaProgress>" json:"followers"`
+}
+
+func NewReplicaState() *ReplicaState {
+	return &ReplicaState{}
+}
+
+func (p *ReplicaState) InitDefault() {
+	*p = ReplicaState{}
+}
+
+func (p *ReplicaState) GetRegionId() (v int32) {
+	return p.RegionId
+}
+
+func (p *ReplicaState) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+func (p *ReplicaState) GetAddress() (v string) {
 	return p.Address
 }
 
-func (p *RegionStatusResp) GetReplicaCount() (v int64) {
-	return p.ReplicaCount
+func (p *ReplicaState) GetState() (v string) {
+	return p.State
 }
 
-func (p *RegionStatusResp) GetMessage() (v string) {
-	return p.Message
+func (p *ReplicaState) GetLeader() (v string) {
+	return p.Leader
 }
-func (p *RegionStatusResp) SetName(val string) {
-	p.Name = val
+
+func (p *ReplicaState) GetTerm() (v int64) {
+	return p.Term
 }
-func (p *RegionStatusResp) SetAddress(val string) {
+
+func (p *ReplicaState) GetLastIndex() (v int64) {
+	return p.LastIndex
+}
+
+func (p *ReplicaState) GetCommitIndex() (v int64) {
+	return p.CommitIndex
+}
+
+func (p *ReplicaState) GetAppliedIndex() (v int64) {
+	return p.AppliedIndex
+}
+
+func (p *ReplicaState) GetSnapshotIndex() (v int64) {
+	return p.SnapshotIndex
+}
+
+func (p *ReplicaState) GetLastContact() (v int64) {
+	return p.LastContact
+}
+
+func (p *ReplicaState) GetFollowers() (v []*ReplicaProgress) {
+	return p.Followers
+}
+func (p *ReplicaState) SetRegionId(val int32) {
+	p.RegionId = val
+}
+func (p *ReplicaState) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *ReplicaState) SetAddress(val string) {
 	p.Address = val
 }
-func (p *RegionStatusResp) SetReplicaCount(val int64) {
-	p.ReplicaCount = val
+func (p *ReplicaState) SetState(val string) {
+	p.State = val
 }
-func (p *RegionStatusResp) SetMessage(val string) {
-	p.Message = val
+func (p *ReplicaState) SetLeader(val string) {
+	p.Leader = val
+}
+func (p *ReplicaState) SetTerm(val int64) {
+	p.Term = val
+}
+func (p *ReplicaState) SetLastIndex(val int64) {
+	p.LastIndex = val
+}
+func (p *ReplicaState) SetCommitIndex(val int64) {
+	p.CommitIndex = val
+}
+func (p *ReplicaState) SetAppliedIndex(val int64) {
+	p.AppliedIndex = val
+}
+func (p *ReplicaState) SetSnapshotIndex(val int64) {
+	p.SnapshotIndex = val
+}
+func (p *ReplicaState) SetLastContact(val int64) {
+	p.LastContact = val
+}
+func (p *ReplicaState) SetFollowers(val []*ReplicaProgress) {
+	p.Followers = val
 }
 
-var fieldIDToName_RegionStatusResp = map[int16]string{
-	1: "name",
-	2: "address",
-	3: "replica_count",
-	4: "message",
+var fieldIDToName_ReplicaState = map[int16]string{
+	1:  "region_id",
+	2:  "node_id",
+	3:  "address",
+	4:  "state",
+	5:  "leader",
+	6:  "term",
+	7:  "last_index",
+	8:  "commit_index",
+	9:  "applied_index",
+	10: "snapshot_index",
+	11: "last_contact",
+	12: "followers",
 }
 
-func (p *RegionStatusResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaState) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetName bool = false
+	var issetRegionId bool = false
+	var issetNodeId bool = false
 	var issetAddress bool = false
-	var issetReplicaCount bool = false
-	var issetMessage bool = false
+	var issetState bool = false
+	var issetLeader bool = false
+	var issetTerm bool = false
+	var issetLastIndex bool = false
+	var issetCommitIndex bool = false
+	var issetAppliedIndex bool = false
+	var issetSnapshotIndex bool = false
+	var issetLastContact bool = false
+	var issetFollowers bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -521,110 +1053,247 @@ func (p *RegionStatusResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				issetRegionId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAddress = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetReplicaCount = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				issetAddress = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 4:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				issetState = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
 				}
+				issetLeader = true
+				break
 			}
-		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	if !issetName {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetAddress {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetReplicaCount {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetMessage {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RegionStatusResp[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RegionStatusResp[fieldId]))
-}
-
-func (p *RegionStatusResp) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTerm = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommitIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAppliedIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSnapshotIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastContact = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFollowers = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRegionId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNodeId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddress {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetState {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLeader {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTerm {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastIndex {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCommitIndex {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAppliedIndex {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSnapshotIndex {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastContact {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFollowers {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaState[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaState[fieldId]))
+}
+
+func (p *ReplicaState) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Name = v
+		p.RegionId = v
 	}
 	return nil
 }
+func (p *ReplicaState) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *RegionStatusResp) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -632,28 +1301,102 @@ func (p *RegionStatusResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *ReplicaState) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *RegionStatusResp) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ReplicaCount = v
+		p.State = v
 	}
 	return nil
 }
+func (p *ReplicaState) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *RegionStatusResp) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Message = v
+		p.Leader = v
 	}
 	return nil
 }
+func (p *ReplicaState) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *RegionStatusResp) Write(oprot thrift.TProtocol) (err error) {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Term = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastIndex = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField8(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CommitIndex = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField9(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.AppliedIndex = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField10(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SnapshotIndex = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField11(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastContact = v
+	}
+	return nil
+}
+func (p *ReplicaState) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Followers = make([]*ReplicaProgress, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewReplicaProgress()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Followers = append(p.Followers, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaState) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionStatusResp"); err != nil {
+	if err = oprot.WriteStructBegin("ReplicaState"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -673,7 +1416,38 @@ func (p *RegionStatusResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -692,11 +1466,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RegionStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *ReplicaState) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteI32(p.RegionId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -708,12 +1482,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *RegionStatusResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("address", thrift.STRING, 2); err != nil {
+func (p *ReplicaState) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Address); err != nil {
+	if err := oprot.WriteString(p.NodeId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -725,12 +1498,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *RegionStatusResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("replica_count", thrift.I64, 3); err != nil {
+func (p *ReplicaState) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ReplicaCount); err != nil {
+	if err := oprot.WriteString(p.Address); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -742,12 +1514,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *RegionStatusResp) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+func (p *ReplicaState) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("state", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteString(p.State); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -759,186 +1530,374 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-func (p *RegionStatusResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ReplicaState) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("RegionStatusResp(%+v)", *p)
-}
-
-func (p *RegionStatusResp) DeepEqual(ano *RegionStatusResp) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+	if err := oprot.WriteString(p.Leader); err != nil {
+		return err
 	}
-	if !p.Field1DeepEqual(ano.Name) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if !p.Field2DeepEqual(ano.Address) {
-		return false
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ReplicaState) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field3DeepEqual(ano.ReplicaCount) {
-		return false
+	if err := oprot.WriteI64(p.Term); err != nil {
+		return err
 	}
-	if !p.Field4DeepEqual(ano.Message) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true
-}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ReplicaState) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_index", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ReplicaState) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("commit_index", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommitIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ReplicaState) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("applied_index", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.AppliedIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ReplicaState) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("snapshot_index", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SnapshotIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ReplicaState) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_contact", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastContact); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ReplicaState) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("followers", thrift.LIST, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Followers)); err != nil {
+		return err
+	}
+	for _, v := range p.Followers {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
 
-func (p *RegionStatusResp) Field1DeepEqual(src string) bool {
+func (p *ReplicaState) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaState(%+v)", *p)
+}
 
-	if strings.Compare(p.Name, src) != 0 {
+func (p *ReplicaState) DeepEqual(ano *ReplicaState) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Address) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.State) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Leader) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Term) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.LastIndex) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CommitIndex) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.AppliedIndex) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.SnapshotIndex) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.LastContact) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.Followers) {
 		return false
 	}
 	return true
 }
-func (p *RegionStatusResp) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.Address, src) != 0 {
+func (p *ReplicaState) Field1DeepEqual(src int32) bool {
+
+	if p.RegionId != src {
 		return false
 	}
 	return true
 }
-func (p *RegionStatusResp) Field3DeepEqual(src int64) bool {
+func (p *ReplicaState) Field2DeepEqual(src string) bool {
 
-	if p.ReplicaCount != src {
+	if strings.Compare(p.NodeId, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *RegionStatusResp) Field4DeepEqual(src string) bool {
+func (p *ReplicaState) Field3DeepEqual(src string) bool {
 
-	if strings.Compare(p.Message, src) != 0 {
+	if strings.Compare(p.Address, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *ReplicaState) Field4DeepEqual(src string) bool {
 
-type ReplicaListReq struct {
+	if strings.Compare(p.State, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ReplicaState) Field5DeepEqual(src string) bool {
 
-func NewReplicaListReq() *ReplicaListReq {
-	return &ReplicaListReq{}
+	if strings.Compare(p.Leader, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ReplicaState) Field6DeepEqual(src int64) bool {
 
-func (p *ReplicaListReq) InitDefault() {
-	*p = ReplicaListReq{}
+	if p.Term != src {
+		return false
+	}
+	return true
 }
+func (p *ReplicaState) Field7DeepEqual(src int64) bool {
 
-var fieldIDToName_ReplicaListReq = map[int16]string{}
+	if p.LastIndex != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaState) Field8DeepEqual(src int64) bool {
 
-func (p *ReplicaListReq) Read(iprot thrift.TProtocol) (err error) {
+	if p.CommitIndex != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaState) Field9DeepEqual(src int64) bool {
 
-	var fieldTypeId thrift.TType
-	var fieldId int16
+	if p.AppliedIndex != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaState) Field10DeepEqual(src int64) bool {
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	if p.SnapshotIndex != src {
+		return false
 	}
+	return true
+}
+func (p *ReplicaState) Field11DeepEqual(src int64) bool {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
+	if p.LastContact != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaState) Field12DeepEqual(src []*ReplicaProgress) bool {
 
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if len(p.Followers) != len(src) {
+		return false
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	for i, v := range p.Followers {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
+	return true
+}
 
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+type ClusterState struct {
+	Regions       int32 `thrift:"regions,1,required" frugal:"1,required,i32" json:"regions"`
+	Leaders       int32 `thrift:"leaders,2,required" frugal:"2,required,i32" json:"leaders"`
+	Leaderless    int32 `thrift:"leaderless,3,required" frugal:"3,required,i32" json:"leaderless"`
+	Replicas      int32 `thrift:"replicas,4,required" frugal:"4,required,i32" json:"replicas"`
+	Lagging       int32 `thrift:"lagging,5,required" frugal:"5,required,i32" json:"lagging"`
+	MaxLagEntries int64 `thrift:"max_lag_entries,6,required" frugal:"6,required,i64" json:"max_lag_entries"`
+	MaxLagTime    int64 `thrift:"max_lag_time,7,required" frugal:"7,required,i64" json:"max_lag_time"`
+}
 
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+func NewClusterState() *ClusterState {
+	return &ClusterState{}
 }
 
-func (p *ReplicaListReq) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ReplicaListReq"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
+func (p *ClusterState) InitDefault() {
+	*p = ClusterState{}
+}
 
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+func (p *ClusterState) GetRegions() (v int32) {
+	return p.Regions
 }
 
-func (p *ReplicaListReq) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ReplicaListReq(%+v)", *p)
+func (p *ClusterState) GetLeaders() (v int32) {
+	return p.Leaders
 }
 
-func (p *ReplicaListReq) DeepEqual(ano *ReplicaListReq) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	return true
+func (p *ClusterState) GetLeaderless() (v int32) {
+	return p.Leaderless
 }
 
-type ReplicaListResp struct {
-	Message string `thrift:"message,1,required" frugal:"1,required,string" json:"message"`
+func (p *ClusterState) GetReplicas() (v int32) {
+	return p.Replicas
 }
 
-func NewReplicaListResp() *ReplicaListResp {
-	return &ReplicaListResp{}
+func (p *ClusterState) GetLagging() (v int32) {
+	return p.Lagging
 }
 
-func (p *ReplicaListResp) InitDefault() {
-	*p = ReplicaListResp{}
+func (p *ClusterState) GetMaxLagEntries() (v int64) {
+	return p.MaxLagEntries
 }
 
-func (p *ReplicaListResp) GetMessage() (v string) {
-	return p.Message
+func (p *ClusterState) GetMaxLagTime() (v int64) {
+	return p.MaxLagTime
 }
-func (p *ReplicaListResp) SetMessage(val string) {
-	p.Message = val
+func (p *ClusterState) SetRegions(val int32) {
+	p.Regions = val
+}
+func (p *ClusterState) SetLeaders(val int32) {
+	p.Leaders = val
+}
+func (p *ClusterState) SetLeaderless(val int32) {
+	p.Leaderless = val
+}
+func (p *ClusterState) SetReplicas(val int32) {
+	p.Replicas = val
+}
+func (p *ClusterState) SetLagging(val int32) {
+	p.Lagging = val
+}
+func (p *ClusterState) SetMaxLagEntries(val int64) {
+	p.MaxLagEntries = val
+}
+func (p *ClusterState) SetMaxLagTime(val int64) {
+	p.MaxLagTime = val
 }
 
-var fieldIDToName_ReplicaListResp = map[int16]string{
-	1: "message",
+var fieldIDToName_ClusterState = map[int16]string{
+	1: "regions",
+	2: "leaders",
+	3: "leaderless",
+	4: "replicas",
+	5: "lagging",
+	6: "max_lag_entries",
+	7: "max_lag_time",
 }
 
-func (p *ReplicaListResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ClusterState) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetMessage bool = false
+	var issetRegions bool = false
+	var issetLeaders bool = false
+	var issetLeaderless bool = false
+	var issetReplicas bool = false
+	var issetLagging bool = false
+	var issetMaxLagEntries bool = false
+	var issetMaxLagTime bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -955,41 +1914,136 @@ func (p *ReplicaListResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				issetRegions = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
 				}
+				issetLeaders = true
+				break
 			}
-		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLeaderless = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReplicas = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLagging = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxLagEntries = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxLagTime = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
 		goto ReadStructEndError
 	}
 
-	if !issetMessage {
+	if !issetRegions {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetLeaders {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLeaderless {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReplicas {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLagging {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxLagEntries {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxLagTime {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaListResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClusterState[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -998,21 +2052,76 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaListResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClusterState[fieldId]))
 }
 
-func (p *ReplicaListResp) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ClusterState) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Message = v
+		p.Regions = v
 	}
 	return nil
 }
+func (p *ClusterState) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *ReplicaListResp) Write(oprot thrift.TProtocol) (err error) {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Leaders = v
+	}
+	return nil
+}
+func (p *ClusterState) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Leaderless = v
+	}
+	return nil
+}
+func (p *ClusterState) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Replicas = v
+	}
+	return nil
+}
+func (p *ClusterState) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Lagging = v
+	}
+	return nil
+}
+func (p *ClusterState) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxLagEntries = v
+	}
+	return nil
+}
+func (p *ClusterState) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxLagTime = v
+	}
+	return nil
+}
+
+func (p *ClusterState) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaListResp"); err != nil {
+	if err = oprot.WriteStructBegin("ClusterState"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1020,7 +2129,30 @@ func (p *ReplicaListResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1039,11 +2171,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaListResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
+func (p *ClusterState) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("regions", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteI32(p.Regions); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1055,59 +2187,215 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *ClusterState) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leaders", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Leaders); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ClusterState) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leaderless", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Leaderless); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ClusterState) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("replicas", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Replicas); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ClusterState) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lagging", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Lagging); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ClusterState) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_lag_entries", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MaxLagEntries); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ClusterState) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_lag_time", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MaxLagTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-func (p *ReplicaListResp) String() string {
+func (p *ClusterState) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaListResp(%+v)", *p)
+	return fmt.Sprintf("ClusterState(%+v)", *p)
 }
 
-func (p *ReplicaListResp) DeepEqual(ano *ReplicaListResp) bool {
+func (p *ClusterState) DeepEqual(ano *ClusterState) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Message) {
+	if !p.Field1DeepEqual(ano.Regions) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Leaders) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Leaderless) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Replicas) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Lagging) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MaxLagEntries) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.MaxLagTime) {
 		return false
 	}
 	return true
 }
 
-func (p *ReplicaListResp) Field1DeepEqual(src string) bool {
+func (p *ClusterState) Field1DeepEqual(src int32) bool {
 
-	if strings.Compare(p.Message, src) != 0 {
+	if p.Regions != src {
 		return false
 	}
 	return true
 }
+func (p *ClusterState) Field2DeepEqual(src int32) bool {
 
-type ReplicaStatusReq struct {
-	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	if p.Leaders != src {
+		return false
+	}
+	return true
 }
+func (p *ClusterState) Field3DeepEqual(src int32) bool {
 
-func NewReplicaStatusReq() *ReplicaStatusReq {
-	return &ReplicaStatusReq{}
+	if p.Leaderless != src {
+		return false
+	}
+	return true
 }
+func (p *ClusterState) Field4DeepEqual(src int32) bool {
 
-func (p *ReplicaStatusReq) InitDefault() {
-	*p = ReplicaStatusReq{}
+	if p.Replicas != src {
+		return false
+	}
+	return true
 }
+func (p *ClusterState) Field5DeepEqual(src int32) bool {
 
-func (p *ReplicaStatusReq) GetName() (v string) {
-	return p.Name
-}
-func (p *ReplicaStatusReq) SetName(val string) {
-	p.Name = val
+	if p.Lagging != src {
+		return false
+	}
+	return true
 }
+func (p *ClusterState) Field6DeepEqual(src int64) bool {
 
-var fieldIDToName_ReplicaStatusReq = map[int16]string{
-	1: "name",
+	if p.MaxLagEntries != src {
+		return false
+	}
+	return true
 }
+func (p *ClusterState) Field7DeepEqual(src int64) bool {
+
+	if p.MaxLagTime != src {
+		return false
+	}
+	return true
+}
+
+type RegionStatusReq struct {
+	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+}
+
+func NewRegionStatusReq() *RegionStatusReq {
+	return &RegionStatusReq{}
+}
+
+func (p *RegionStatusReq) InitDefault() {
+	*p = RegionStatusReq{}
+}
+
+func (p *RegionStatusReq) GetName() (v string) {
+	return p.Name
+}
+func (p *RegionStatusReq) SetName(val string) {
+	p.Name = val
+}
+
+var fieldIDToName_RegionStatusReq = map[int16]string{
+	1: "name",
+}
+
+func (p *RegionStatusReq) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *ReplicaStatusReq) Read(iprot thrift.TProtocol) (err error) {
-
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetName bool = false
@@ -1132,17 +2420,16 @@ func (p *ReplicaStatusReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetName = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1161,7 +2448,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaStatusReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RegionStatusReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1170,10 +2457,11 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaStatusReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RegionStatusReq[fieldId]))
 }
 
-func (p *ReplicaStatusReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *RegionStatusReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1182,9 +2470,9 @@ func (p *ReplicaStatusReq) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ReplicaStatusReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *RegionStatusReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaStatusReq"); err != nil {
+	if err = oprot.WriteStructBegin("RegionStatusReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1192,7 +2480,6 @@ func (p *ReplicaStatusReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1211,7 +2498,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RegionStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1228,14 +2515,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ReplicaStatusReq) String() string {
+func (p *RegionStatusReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaStatusReq(%+v)", *p)
+	return fmt.Sprintf("RegionStatusReq(%+v)", *p)
 }
 
-func (p *ReplicaStatusReq) DeepEqual(ano *ReplicaStatusReq) bool {
+func (p *RegionStatusReq) DeepEqual(ano *RegionStatusReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1247,7 +2534,7 @@ func (p *ReplicaStatusReq) DeepEqual(ano *ReplicaStatusReq) bool {
 	return true
 }
 
-func (p *ReplicaStatusReq) Field1DeepEqual(src string) bool {
+func (p *RegionStatusReq) Field1DeepEqual(src string) bool {
 
 	if strings.Compare(p.Name, src) != 0 {
 		return false
@@ -1255,54 +2542,103 @@ func (p *ReplicaStatusReq) Field1DeepEqual(src string) bool {
 	return true
 }
 
-type ReplicaStatusResp struct {
-	Name    string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
-	Address string `thrift:"address,2,required" frugal:"2,required,string" json:"address"`
-	Message string `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+type RegionStatusResp struct {
+	Name         string          `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Address      string          `thrift:"address,2,required" frugal:"2,required,string" json:"address"`
+	ReplicaCount int64           `thrift:"replica_count,3,required" frugal:"3,required,i64" json:"replica_count"`
+	Message      string          `thrift:"message,4,required" frugal:"4,required,string" json:"message"`
+	Success      bool            `thrift:"success,5,required" frugal:"5,required,bool" json:"success"`
+	Regions      []*ReplicaState `thrift:"regions,6,required" frugal:"6,required,list<ReplicaState>" json:"regions"`
+	Cluster      *ClusterState   `thrift:"cluster,7,required" frugal:"7,required,ClusterState" json:"cluster"`
 }
 
-func NewReplicaStatusResp() *ReplicaStatusResp {
-	return &ReplicaStatusResp{}
+func NewRegionStatusResp() *RegionStatusResp {
+	return &RegionStatusResp{}
 }
 
-func (p *ReplicaStatusResp) InitDefault() {
-	*p = ReplicaStatusResp{}
+func (p *RegionStatusResp) InitDefault() {
+	*p = RegionStatusResp{}
 }
 
-func (p *ReplicaStatusResp) GetName() (v string) {
+func (p *RegionStatusResp) GetName() (v string) {
 	return p.Name
 }
 
-func (p *ReplicaStatusResp) GetAddress() (v string) {
+func (p *RegionStatusResp) GetAddress() (v string) {
 	return p.Address
 }
 
-func (p *ReplicaStatusResp) GetMessage() (v string) {
+func (p *RegionStatusResp) GetReplicaCount() (v int64) {
+	return p.ReplicaCount
+}
+
+func (p *RegionStatusResp) GetMessage() (v string) {
 	return p.Message
 }
-func (p *ReplicaStatusResp) SetName(val string) {
+
+func (p *RegionStatusResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *RegionStatusResp) GetRegions() (v []*ReplicaState) {
+	return p.Regions
+}
+
+var RegionStatusResp_Cluster_DEFAULT *ClusterState
+
+func (p *RegionStatusResp) GetCluster() (v *ClusterState) {
+	if !p.IsSetCluster() {
+		return RegionStatusResp_Cluster_DEFAULT
+	}
+	return p.Cluster
+}
+func (p *RegionStatusResp) SetName(val string) {
 	p.Name = val
 }
-func (p *ReplicaStatusResp) SetAddress(val string) {
+func (p *RegionStatusResp) SetAddress(val string) {
 	p.Address = val
 }
-func (p *ReplicaStatusResp) SetMessage(val string) {
+func (p *RegionStatusResp) SetReplicaCount(val int64) {
+	p.ReplicaCount = val
+}
+func (p *RegionStatusResp) SetMessage(val string) {
 	p.Message = val
 }
+func (p *RegionStatusResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *RegionStatusResp) SetRegions(val []*ReplicaState) {
+	p.Regions = val
+}
+func (p *RegionStatusResp) SetCluster(val *ClusterState) {
+	p.Cluster = val
+}
 
-var fieldIDToName_ReplicaStatusResp = map[int16]string{
+var fieldIDToName_RegionStatusResp = map[int16]string{
 	1: "name",
 	2: "address",
-	3: "message",
+	3: "replica_count",
+	4: "message",
+	5: "success",
+	6: "regions",
+	7: "cluster",
 }
 
-func (p *ReplicaStatusResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *RegionStatusResp) IsSetCluster() bool {
+	return p.Cluster != nil
+}
+
+func (p *RegionStatusResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetName bool = false
 	var issetAddress bool = false
+	var issetReplicaCount bool = false
 	var issetMessage bool = false
+	var issetSuccess bool = false
+	var issetRegions bool = false
+	var issetCluster bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1324,10 +2660,10 @@ func (p *ReplicaStatusResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetName = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -1335,28 +2671,71 @@ func (p *ReplicaStatusResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetAddress = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetReplicaCount = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRegions = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
 				}
+				issetCluster = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1375,17 +2754,37 @@ func (p *ReplicaStatusResp) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetMessage {
+	if !issetReplicaCount {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetMessage {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuccess {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRegions {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCluster {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaStatusResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RegionStatusResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1394,10 +2793,11 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaStatusResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RegionStatusResp[fieldId]))
 }
 
-func (p *ReplicaStatusResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *RegionStatusResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1405,8 +2805,8 @@ func (p *ReplicaStatusResp) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *RegionStatusResp) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *ReplicaStatusResp) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1414,8 +2814,17 @@ func (p *ReplicaStatusResp) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *RegionStatusResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ReplicaCount = v
+	}
+	return nil
+}
+func (p *RegionStatusResp) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *ReplicaStatusResp) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -1423,10 +2832,47 @@ func (p *ReplicaStatusResp) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *RegionStatusResp) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *ReplicaStatusResp) Write(oprot thrift.TProtocol) (err error) {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *RegionStatusResp) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Regions = make([]*ReplicaState, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewReplicaState()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Regions = append(p.Regions, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *RegionStatusResp) ReadField7(iprot thrift.TProtocol) error {
+	p.Cluster = NewClusterState()
+
+	if err := p.Cluster.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *RegionStatusResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaStatusResp"); err != nil {
+	if err = oprot.WriteStructBegin("RegionStatusResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1442,7 +2888,22 @@ func (p *ReplicaStatusResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1461,7 +2922,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RegionStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1477,8 +2938,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *ReplicaStatusResp) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *RegionStatusResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("address", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1494,12 +2954,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *ReplicaStatusResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+func (p *RegionStatusResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("replica_count", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteI64(p.ReplicaCount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1511,396 +2970,6737 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *ReplicaStatusResp) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ReplicaStatusResp(%+v)", *p)
-}
-
-func (p *ReplicaStatusResp) DeepEqual(ano *ReplicaStatusResp) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+func (p *RegionStatusResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field1DeepEqual(ano.Name) {
-		return false
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RegionStatusResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RegionStatusResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("regions", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Regions)); err != nil {
+		return err
+	}
+	for _, v := range p.Regions {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *RegionStatusResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cluster", thrift.STRUCT, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Cluster.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *RegionStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RegionStatusResp(%+v)", *p)
+}
+
+func (p *RegionStatusResp) DeepEqual(ano *RegionStatusResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
 	}
 	if !p.Field2DeepEqual(ano.Address) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Message) {
+	if !p.Field3DeepEqual(ano.ReplicaCount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Regions) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Cluster) {
 		return false
 	}
 	return true
 }
 
-func (p *ReplicaStatusResp) Field1DeepEqual(src string) bool {
+func (p *RegionStatusResp) Field1DeepEqual(src string) bool {
 
 	if strings.Compare(p.Name, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ReplicaStatusResp) Field2DeepEqual(src string) bool {
+func (p *RegionStatusResp) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.Address, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ReplicaStatusResp) Field3DeepEqual(src string) bool {
+func (p *RegionStatusResp) Field3DeepEqual(src int64) bool {
+
+	if p.ReplicaCount != src {
+		return false
+	}
+	return true
+}
+func (p *RegionStatusResp) Field4DeepEqual(src string) bool {
 
 	if strings.Compare(p.Message, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *RegionStatusResp) Field5DeepEqual(src bool) bool {
 
-type MetaService interface {
-	RegionList(ctx context.Context, req *RegionListReq) (r *RegionListResp, err error)
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *RegionStatusResp) Field6DeepEqual(src []*ReplicaState) bool {
 
-	RegionStatus(ctx context.Context, req *RegionStatusReq) (r *RegionStatusResp, err error)
+	if len(p.Regions) != len(src) {
+		return false
+	}
+	for i, v := range p.Regions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *RegionStatusResp) Field7DeepEqual(src *ClusterState) bool {
 
-	ReplicaList(ctx context.Context, req *ReplicaListReq) (r *ReplicaListResp, err error)
+	if !p.Cluster.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
-	ReplicaStatus(ctx context.Context, req *ReplicaStatusReq) (r *ReplicaStatusResp, err error)
+type ReplicaListReq struct {
 }
 
-type MetaServiceClient struct {
-	c thrift.TClient
+func NewReplicaListReq() *ReplicaListReq {
+	return &ReplicaListReq{}
 }
 
-func NewMetaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MetaServiceClient {
-	return &MetaServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *ReplicaListReq) InitDefault() {
+	*p = ReplicaListReq{}
 }
 
-func NewMetaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MetaServiceClient {
-	return &MetaServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+var fieldIDToName_ReplicaListReq = map[int16]string{}
+
+func (p *ReplicaListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewMetaServiceClient(c thrift.TClient) *MetaServiceClient {
-	return &MetaServiceClient{
-		c: c,
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func (p *MetaServiceClient) Client_() thrift.TClient {
-	return p.c
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceClient) RegionList(ctx context.Context, req *RegionListReq) (r *RegionListResp, err error) {
-	var _args MetaServiceRegionListArgs
-	_args.Req = req
-	var _result MetaServiceRegionListResult
-	if err = p.Client_().Call(ctx, "RegionList", &_args, &_result); err != nil {
-		return
+func (p *ReplicaListReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ReplicaListReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *MetaServiceClient) RegionStatus(ctx context.Context, req *RegionStatusReq) (r *RegionStatusResp, err error) {
-	var _args MetaServiceRegionStatusArgs
-	_args.Req = req
-	var _result MetaServiceRegionStatusResult
-	if err = p.Client_().Call(ctx, "RegionStatus", &_args, &_result); err != nil {
-		return
+	if p != nil {
 	}
-	return _result.GetSuccess(), nil
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *MetaServiceClient) ReplicaList(ctx context.Context, req *ReplicaListReq) (r *ReplicaListResp, err error) {
-	var _args MetaServiceReplicaListArgs
-	_args.Req = req
-	var _result MetaServiceReplicaListResult
-	if err = p.Client_().Call(ctx, "ReplicaList", &_args, &_result); err != nil {
-		return
+
+func (p *ReplicaListReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("ReplicaListReq(%+v)", *p)
 }
-func (p *MetaServiceClient) ReplicaStatus(ctx context.Context, req *ReplicaStatusReq) (r *ReplicaStatusResp, err error) {
-	var _args MetaServiceReplicaStatusArgs
-	_args.Req = req
-	var _result MetaServiceReplicaStatusResult
-	if err = p.Client_().Call(ctx, "ReplicaStatus", &_args, &_result); err != nil {
-		return
+
+func (p *ReplicaListReq) DeepEqual(ano *ReplicaListReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
 
-type MetaServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      MetaService
+type ReplicaListResp struct {
+	Message string `thrift:"message,1,required" frugal:"1,required,string" json:"message"`
 }
 
-func (p *MetaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewReplicaListResp() *ReplicaListResp {
+	return &ReplicaListResp{}
 }
 
-func (p *MetaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *ReplicaListResp) InitDefault() {
+	*p = ReplicaListResp{}
 }
 
-func (p *MetaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *ReplicaListResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *ReplicaListResp) SetMessage(val string) {
+	p.Message = val
 }
 
-func NewMetaServiceProcessor(handler MetaService) *MetaServiceProcessor {
-	self := &MetaServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("RegionList", &metaServiceProcessorRegionList{handler: handler})
-	self.AddToProcessorMap("RegionStatus", &metaServiceProcessorRegionStatus{handler: handler})
-	self.AddToProcessorMap("ReplicaList", &metaServiceProcessorReplicaList{handler: handler})
-	self.AddToProcessorMap("ReplicaStatus", &metaServiceProcessorReplicaStatus{handler: handler})
-	return self
-}
-func (p *MetaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+var fieldIDToName_ReplicaListResp = map[int16]string{
+	1: "message",
 }
 
-type metaServiceProcessorRegionList struct {
-	handler MetaService
-}
+func (p *ReplicaListResp) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *metaServiceProcessorRegionList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := MetaServiceRegionListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RegionList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetMessage bool = false
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := MetaServiceRegionListResult{}
-	var retval *RegionListResp
-	if retval, err2 = p.handler.RegionList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegionList: "+err2.Error())
-		oprot.WriteMessageBegin("RegionList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RegionList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err != nil {
-		return
+
+	if !issetMessage {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type metaServiceProcessorRegionStatus struct {
-	handler MetaService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaListResp[fieldId]))
 }
 
-func (p *metaServiceProcessorRegionStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := MetaServiceRegionStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RegionStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ReplicaListResp) ReadField1(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := MetaServiceRegionStatusResult{}
-	var retval *RegionStatusResp
-	if retval, err2 = p.handler.RegionStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegionStatus: "+err2.Error())
-		oprot.WriteMessageBegin("RegionStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RegionStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+		p.Message = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *ReplicaListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaListResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type metaServiceProcessorReplicaList struct {
-	handler MetaService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *metaServiceProcessorReplicaList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := MetaServiceReplicaListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ReplicaList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ReplicaListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := MetaServiceReplicaListResult{}
-	var retval *ReplicaListResp
-	if retval, err2 = p.handler.ReplicaList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReplicaList: "+err2.Error())
-		oprot.WriteMessageBegin("ReplicaList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("ReplicaList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaListResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("ReplicaListResp(%+v)", *p)
+}
+
+func (p *ReplicaListResp) DeepEqual(ano *ReplicaListResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Message) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *ReplicaListResp) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type metaServiceProcessorReplicaStatus struct {
-	handler MetaService
+type ReplicaStatusReq struct {
+	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
 }
 
-func (p *metaServiceProcessorReplicaStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := MetaServiceReplicaStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ReplicaStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewReplicaStatusReq() *ReplicaStatusReq {
+	return &ReplicaStatusReq{}
+}
+
+func (p *ReplicaStatusReq) InitDefault() {
+	*p = ReplicaStatusReq{}
+}
+
+func (p *ReplicaStatusReq) GetName() (v string) {
+	return p.Name
+}
+func (p *ReplicaStatusReq) SetName(val string) {
+	p.Name = val
+}
+
+var fieldIDToName_ReplicaStatusReq = map[int16]string{
+	1: "name",
+}
+
+func (p *ReplicaStatusReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := MetaServiceReplicaStatusResult{}
-	var retval *ReplicaStatusResp
-	if retval, err2 = p.handler.ReplicaStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReplicaStatus: "+err2.Error())
-		oprot.WriteMessageBegin("ReplicaStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaStatusReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaStatusReq[fieldId]))
+}
+
+func (p *ReplicaStatusReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.Name = v
 	}
-	if err2 = oprot.WriteMessageBegin("ReplicaStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *ReplicaStatusReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaStatusReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaStatusReq(%+v)", *p)
+}
+
+func (p *ReplicaStatusReq) DeepEqual(ano *ReplicaStatusReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaStatusReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type ReplicaStatusResp struct {
+	Name     string             `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Address  string             `thrift:"address,2,required" frugal:"2,required,string" json:"address"`
+	Message  string             `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+	Success  bool               `thrift:"success,4,required" frugal:"4,required,bool" json:"success"`
+	Replicas []*ReplicaState    `thrift:"replicas,5,required" frugal:"5,required,list<ReplicaState>" json:"replicas"`
+	Progress []*ReplicaProgress `thrift:"progress,6,required" frugal:"6,required,list<ReplicaProgress>" json:"progress"`
+}
+
+func NewReplicaStatusResp() *ReplicaStatusResp {
+	return &ReplicaStatusResp{}
+}
+
+func (p *ReplicaStatusResp) InitDefault() {
+	*p = ReplicaStatusResp{}
+}
+
+func (p *ReplicaStatusResp) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ReplicaStatusResp) GetAddress() (v string) {
+	return p.Address
+}
+
+func (p *ReplicaStatusResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *ReplicaStatusResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ReplicaStatusResp) GetReplicas() (v []*ReplicaState) {
+	return p.Replicas
+}
+
+func (p *ReplicaStatusResp) GetProgress() (v []*ReplicaProgress) {
+	return p.Progress
+}
+func (p *ReplicaStatusResp) SetName(val string) {
+	p.Name = val
+}
+func (p *ReplicaStatusResp) SetAddress(val string) {
+	p.Address = val
+}
+func (p *ReplicaStatusResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ReplicaStatusResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ReplicaStatusResp) SetReplicas(val []*ReplicaState) {
+	p.Replicas = val
+}
+func (p *ReplicaStatusResp) SetProgress(val []*ReplicaProgress) {
+	p.Progress = val
+}
+
+var fieldIDToName_ReplicaStatusResp = map[int16]string{
+	1: "name",
+	2: "address",
+	3: "message",
+	4: "success",
+	5: "replicas",
+	6: "progress",
+}
+
+func (p *ReplicaStatusResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetAddress bool = false
+	var issetMessage bool = false
+	var issetSuccess bool = false
+	var issetReplicas bool = false
+	var issetProgress bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddress = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReplicas = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetProgress = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddress {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuccess {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReplicas {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetProgress {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaStatusResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReplicaStatusResp[fieldId]))
+}
+
+func (p *ReplicaStatusResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+func (p *ReplicaStatusResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Address = v
+	}
+	return nil
+}
+func (p *ReplicaStatusResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+func (p *ReplicaStatusResp) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *ReplicaStatusResp) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Replicas = make([]*ReplicaState, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewReplicaState()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Replicas = append(p.Replicas, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *ReplicaStatusResp) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Progress = make([]*ReplicaProgress, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewReplicaProgress()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Progress = append(p.Progress, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaStatusResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaStatusResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ReplicaStatusResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Address); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ReplicaStatusResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ReplicaStatusResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ReplicaStatusResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("replicas", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Replicas)); err != nil {
+		return err
+	}
+	for _, v := range p.Replicas {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ReplicaStatusResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("progress", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Progress)); err != nil {
+		return err
+	}
+	for _, v := range p.Progress {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ReplicaStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaStatusResp(%+v)", *p)
+}
+
+func (p *ReplicaStatusResp) DeepEqual(ano *ReplicaStatusResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Address) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Replicas) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Progress) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaStatusResp) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ReplicaStatusResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Address, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ReplicaStatusResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ReplicaStatusResp) Field4DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *ReplicaStatusResp) Field5DeepEqual(src []*ReplicaState) bool {
+
+	if len(p.Replicas) != len(src) {
+		return false
+	}
+	for i, v := range p.Replicas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ReplicaStatusResp) Field6DeepEqual(src []*ReplicaProgress) bool {
+
+	if len(p.Progress) != len(src) {
+		return false
+	}
+	for i, v := range p.Progress {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type NamespaceUsage struct {
+	Prefix       string `thrift:"prefix,1,required" frugal:"1,required,string" json:"prefix"`
+	MaxBytes     int64  `thrift:"max_bytes,2,required" frugal:"2,required,i64" json:"max_bytes"`
+	MaxKeys      int64  `thrift:"max_keys,3,required" frugal:"3,required,i64" json:"max_keys"`
+	MaxValueSize int64  `thrift:"max_value_size,4,required" frugal:"4,required,i64" json:"max_value_size"`
+	Bytes        int64  `thrift:"bytes,5,required" frugal:"5,required,i64" json:"bytes"`
+	Keys         int64  `thrift:"keys,6,required" frugal:"6,required,i64" json:"keys"`
+}
+
+func NewNamespaceUsage() *NamespaceUsage {
+	return &NamespaceUsage{}
+}
+
+func (p *NamespaceUsage) InitDefault() {
+	*p = NamespaceUsage{}
+}
+
+func (p *NamespaceUsage) GetPrefix() (v string) {
+	return p.Prefix
+}
+
+func (p *NamespaceUsage) GetMaxBytes() (v int64) {
+	return p.MaxBytes
+}
+
+func (p *NamespaceUsage) GetMaxKeys() (v int64) {
+	return p.MaxKeys
+}
+
+func (p *NamespaceUsage) GetMaxValueSize() (v int64) {
+	return p.MaxValueSize
+}
+
+func (p *NamespaceUsage) GetBytes() (v int64) {
+	return p.Bytes
+}
+
+func (p *NamespaceUsage) GetKeys() (v int64) {
+	return p.Keys
+}
+func (p *NamespaceUsage) SetPrefix(val string) {
+	p.Prefix = val
+}
+func (p *NamespaceUsage) SetMaxBytes(val int64) {
+	p.MaxBytes = val
+}
+func (p *NamespaceUsage) SetMaxKeys(val int64) {
+	p.MaxKeys = val
+}
+func (p *NamespaceUsage) SetMaxValueSize(val int64) {
+	p.MaxValueSize = val
+}
+func (p *NamespaceUsage) SetBytes(val int64) {
+	p.Bytes = val
+}
+func (p *NamespaceUsage) SetKeys(val int64) {
+	p.Keys = val
+}
+
+var fieldIDToName_NamespaceUsage = map[int16]string{
+	1: "prefix",
+	2: "max_bytes",
+	3: "max_keys",
+	4: "max_value_size",
+	5: "bytes",
+	6: "keys",
+}
+
+func (p *NamespaceUsage) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPrefix bool = false
+	var issetMaxBytes bool = false
+	var issetMaxKeys bool = false
+	var issetMaxValueSize bool = false
+	var issetBytes bool = false
+	var issetKeys bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPrefix = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxBytes = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxKeys = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxValueSize = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBytes = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetKeys = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPrefix {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxBytes {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxKeys {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxValueSize {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetBytes {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetKeys {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NamespaceUsage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_NamespaceUsage[fieldId]))
+}
+
+func (p *NamespaceUsage) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Prefix = v
+	}
+	return nil
+}
+func (p *NamespaceUsage) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxBytes = v
+	}
+	return nil
+}
+func (p *NamespaceUsage) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxKeys = v
+	}
+	return nil
+}
+func (p *NamespaceUsage) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MaxValueSize = v
+	}
+	return nil
+}
+func (p *NamespaceUsage) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Bytes = v
+	}
+	return nil
+}
+func (p *NamespaceUsage) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Keys = v
+	}
+	return nil
+}
+
+func (p *NamespaceUsage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NamespaceUsage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NamespaceUsage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prefix", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Prefix); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *NamespaceUsage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_bytes", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MaxBytes); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *NamespaceUsage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_keys", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MaxKeys); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *NamespaceUsage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_value_size", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MaxValueSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *NamespaceUsage) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("bytes", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Bytes); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *NamespaceUsage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("keys", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Keys); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *NamespaceUsage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NamespaceUsage(%+v)", *p)
+}
+
+func (p *NamespaceUsage) DeepEqual(ano *NamespaceUsage) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Prefix) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.MaxBytes) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.MaxKeys) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.MaxValueSize) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Bytes) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Keys) {
+		return false
+	}
+	return true
+}
+
+func (p *NamespaceUsage) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Prefix, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsage) Field2DeepEqual(src int64) bool {
+
+	if p.MaxBytes != src {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsage) Field3DeepEqual(src int64) bool {
+
+	if p.MaxKeys != src {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsage) Field4DeepEqual(src int64) bool {
+
+	if p.MaxValueSize != src {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsage) Field5DeepEqual(src int64) bool {
+
+	if p.Bytes != src {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsage) Field6DeepEqual(src int64) bool {
+
+	if p.Keys != src {
+		return false
+	}
+	return true
+}
+
+type NamespaceUsageReq struct {
+}
+
+func NewNamespaceUsageReq() *NamespaceUsageReq {
+	return &NamespaceUsageReq{}
+}
+
+func (p *NamespaceUsageReq) InitDefault() {
+	*p = NamespaceUsageReq{}
+}
+
+var fieldIDToName_NamespaceUsageReq = map[int16]string{}
+
+func (p *NamespaceUsageReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NamespaceUsageReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("NamespaceUsageReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NamespaceUsageReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NamespaceUsageReq(%+v)", *p)
+}
+
+func (p *NamespaceUsageReq) DeepEqual(ano *NamespaceUsageReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+type NamespaceUsageResp struct {
+	Success    bool              `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Namespaces []*NamespaceUsage `thrift:"namespaces,2,required" frugal:"2,required,list<NamespaceUsage>" json:"namespaces"`
+	Message    string            `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+}
+
+func NewNamespaceUsageResp() *NamespaceUsageResp {
+	return &NamespaceUsageResp{}
+}
+
+func (p *NamespaceUsageResp) InitDefault() {
+	*p = NamespaceUsageResp{}
+}
+
+func (p *NamespaceUsageResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *NamespaceUsageResp) GetNamespaces() (v []*NamespaceUsage) {
+	return p.Namespaces
+}
+
+func (p *NamespaceUsageResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *NamespaceUsageResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *NamespaceUsageResp) SetNamespaces(val []*NamespaceUsage) {
+	p.Namespaces = val
+}
+func (p *NamespaceUsageResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_NamespaceUsageResp = map[int16]string{
+	1: "success",
+	2: "namespaces",
+	3: "message",
+}
+
+func (p *NamespaceUsageResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetNamespaces bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNamespaces = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNamespaces {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NamespaceUsageResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_NamespaceUsageResp[fieldId]))
+}
+
+func (p *NamespaceUsageResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *NamespaceUsageResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Namespaces = make([]*NamespaceUsage, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewNamespaceUsage()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Namespaces = append(p.Namespaces, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *NamespaceUsageResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *NamespaceUsageResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NamespaceUsageResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NamespaceUsageResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *NamespaceUsageResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("namespaces", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Namespaces)); err != nil {
+		return err
+	}
+	for _, v := range p.Namespaces {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *NamespaceUsageResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *NamespaceUsageResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NamespaceUsageResp(%+v)", *p)
+}
+
+func (p *NamespaceUsageResp) DeepEqual(ano *NamespaceUsageResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Namespaces) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *NamespaceUsageResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *NamespaceUsageResp) Field2DeepEqual(src []*NamespaceUsage) bool {
+
+	if len(p.Namespaces) != len(src) {
+		return false
+	}
+	for i, v := range p.Namespaces {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *NamespaceUsageResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type HashIndex struct {
+	Name  string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Field string `thrift:"field,2,required" frugal:"2,required,string" json:"field"`
+	Ready bool   `thrift:"ready,3,required" frugal:"3,required,bool" json:"ready"`
+}
+
+func NewHashIndex() *HashIndex {
+	return &HashIndex{}
+}
+
+func (p *HashIndex) InitDefault() {
+	*p = HashIndex{}
+}
+
+func (p *HashIndex) GetName() (v string) {
+	return p.Name
+}
+
+func (p *HashIndex) GetField() (v string) {
+	return p.Field
+}
+
+func (p *HashIndex) GetReady() (v bool) {
+	return p.Ready
+}
+func (p *HashIndex) SetName(val string) {
+	p.Name = val
+}
+func (p *HashIndex) SetField(val string) {
+	p.Field = val
+}
+func (p *HashIndex) SetReady(val bool) {
+	p.Ready = val
+}
+
+var fieldIDToName_HashIndex = map[int16]string{
+	1: "name",
+	2: "field",
+	3: "ready",
+}
+
+func (p *HashIndex) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetField bool = false
+	var issetReady bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetField = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReady = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetField {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReady {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HashIndex[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_HashIndex[fieldId]))
+}
+
+func (p *HashIndex) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+func (p *HashIndex) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Field = v
+	}
+	return nil
+}
+func (p *HashIndex) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Ready = v
+	}
+	return nil
+}
+
+func (p *HashIndex) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HashIndex"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HashIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *HashIndex) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *HashIndex) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ready", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Ready); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *HashIndex) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HashIndex(%+v)", *p)
+}
+
+func (p *HashIndex) DeepEqual(ano *HashIndex) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Field) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Ready) {
+		return false
+	}
+	return true
+}
+
+func (p *HashIndex) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HashIndex) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Field, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HashIndex) Field3DeepEqual(src bool) bool {
+
+	if p.Ready != src {
+		return false
+	}
+	return true
+}
+
+type CreateHashIndexReq struct {
+	Name  string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Field string `thrift:"field,2,required" frugal:"2,required,string" json:"field"`
+}
+
+func NewCreateHashIndexReq() *CreateHashIndexReq {
+	return &CreateHashIndexReq{}
+}
+
+func (p *CreateHashIndexReq) InitDefault() {
+	*p = CreateHashIndexReq{}
+}
+
+func (p *CreateHashIndexReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateHashIndexReq) GetField() (v string) {
+	return p.Field
+}
+func (p *CreateHashIndexReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateHashIndexReq) SetField(val string) {
+	p.Field = val
+}
+
+var fieldIDToName_CreateHashIndexReq = map[int16]string{
+	1: "name",
+	2: "field",
+}
+
+func (p *CreateHashIndexReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetField bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetField = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetField {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateHashIndexReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateHashIndexReq[fieldId]))
+}
+
+func (p *CreateHashIndexReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+func (p *CreateHashIndexReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Field = v
+	}
+	return nil
+}
+
+func (p *CreateHashIndexReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateHashIndexReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateHashIndexReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateHashIndexReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateHashIndexReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateHashIndexReq(%+v)", *p)
+}
+
+func (p *CreateHashIndexReq) DeepEqual(ano *CreateHashIndexReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Field) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateHashIndexReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateHashIndexReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Field, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type CreateHashIndexResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewCreateHashIndexResp() *CreateHashIndexResp {
+	return &CreateHashIndexResp{}
+}
+
+func (p *CreateHashIndexResp) InitDefault() {
+	*p = CreateHashIndexResp{}
+}
+
+func (p *CreateHashIndexResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateHashIndexResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *CreateHashIndexResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateHashIndexResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_CreateHashIndexResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *CreateHashIndexResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateHashIndexResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateHashIndexResp[fieldId]))
+}
+
+func (p *CreateHashIndexResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *CreateHashIndexResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *CreateHashIndexResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateHashIndexResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateHashIndexResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateHashIndexResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateHashIndexResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateHashIndexResp(%+v)", *p)
+}
+
+func (p *CreateHashIndexResp) DeepEqual(ano *CreateHashIndexResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateHashIndexResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *CreateHashIndexResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type DropHashIndexReq struct {
+	Name string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+}
+
+func NewDropHashIndexReq() *DropHashIndexReq {
+	return &DropHashIndexReq{}
+}
+
+func (p *DropHashIndexReq) InitDefault() {
+	*p = DropHashIndexReq{}
+}
+
+func (p *DropHashIndexReq) GetName() (v string) {
+	return p.Name
+}
+func (p *DropHashIndexReq) SetName(val string) {
+	p.Name = val
+}
+
+var fieldIDToName_DropHashIndexReq = map[int16]string{
+	1: "name",
+}
+
+func (p *DropHashIndexReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DropHashIndexReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DropHashIndexReq[fieldId]))
+}
+
+func (p *DropHashIndexReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *DropHashIndexReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DropHashIndexReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DropHashIndexReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DropHashIndexReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DropHashIndexReq(%+v)", *p)
+}
+
+func (p *DropHashIndexReq) DeepEqual(ano *DropHashIndexReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	return true
+}
+
+func (p *DropHashIndexReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type DropHashIndexResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewDropHashIndexResp() *DropHashIndexResp {
+	return &DropHashIndexResp{}
+}
+
+func (p *DropHashIndexResp) InitDefault() {
+	*p = DropHashIndexResp{}
+}
+
+func (p *DropHashIndexResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DropHashIndexResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *DropHashIndexResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DropHashIndexResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_DropHashIndexResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *DropHashIndexResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DropHashIndexResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DropHashIndexResp[fieldId]))
+}
+
+func (p *DropHashIndexResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *DropHashIndexResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *DropHashIndexResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DropHashIndexResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DropHashIndexResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DropHashIndexResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DropHashIndexResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DropHashIndexResp(%+v)", *p)
+}
+
+func (p *DropHashIndexResp) DeepEqual(ano *DropHashIndexResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *DropHashIndexResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *DropHashIndexResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type ListHashIndexesReq struct {
+}
+
+func NewListHashIndexesReq() *ListHashIndexesReq {
+	return &ListHashIndexesReq{}
+}
+
+func (p *ListHashIndexesReq) InitDefault() {
+	*p = ListHashIndexesReq{}
+}
+
+var fieldIDToName_ListHashIndexesReq = map[int16]string{}
+
+func (p *ListHashIndexesReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListHashIndexesReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListHashIndexesReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListHashIndexesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListHashIndexesReq(%+v)", *p)
+}
+
+func (p *ListHashIndexesReq) DeepEqual(ano *ListHashIndexesReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+type ListHashIndexesResp struct {
+	Success bool         `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Indexes []*HashIndex `thrift:"indexes,2,required" frugal:"2,required,list<HashIndex>" json:"indexes"`
+	Message string       `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+}
+
+func NewListHashIndexesResp() *ListHashIndexesResp {
+	return &ListHashIndexesResp{}
+}
+
+func (p *ListHashIndexesResp) InitDefault() {
+	*p = ListHashIndexesResp{}
+}
+
+func (p *ListHashIndexesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListHashIndexesResp) GetIndexes() (v []*HashIndex) {
+	return p.Indexes
+}
+
+func (p *ListHashIndexesResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *ListHashIndexesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListHashIndexesResp) SetIndexes(val []*HashIndex) {
+	p.Indexes = val
+}
+func (p *ListHashIndexesResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_ListHashIndexesResp = map[int16]string{
+	1: "success",
+	2: "indexes",
+	3: "message",
+}
+
+func (p *ListHashIndexesResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetIndexes bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIndexes = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIndexes {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListHashIndexesResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListHashIndexesResp[fieldId]))
+}
+
+func (p *ListHashIndexesResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *ListHashIndexesResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Indexes = make([]*HashIndex, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewHashIndex()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Indexes = append(p.Indexes, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *ListHashIndexesResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *ListHashIndexesResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListHashIndexesResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListHashIndexesResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListHashIndexesResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("indexes", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Indexes)); err != nil {
+		return err
+	}
+	for _, v := range p.Indexes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListHashIndexesResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListHashIndexesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListHashIndexesResp(%+v)", *p)
+}
+
+func (p *ListHashIndexesResp) DeepEqual(ano *ListHashIndexesResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Indexes) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *ListHashIndexesResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *ListHashIndexesResp) Field2DeepEqual(src []*HashIndex) bool {
+
+	if len(p.Indexes) != len(src) {
+		return false
+	}
+	for i, v := range p.Indexes {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListHashIndexesResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type QueryHashIndexReq struct {
+	Name string  `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Min  []byte  `thrift:"min,2,optional" frugal:"2,optional,binary" json:"min,omitempty"`
+	Max  []byte  `thrift:"max,3,optional" frugal:"3,optional,binary" json:"max,omitempty"`
+	Db   *string `thrift:"db,4,optional" frugal:"4,optional,string" json:"db,omitempty"`
+}
+
+func NewQueryHashIndexReq() *QueryHashIndexReq {
+	return &QueryHashIndexReq{}
+}
+
+func (p *QueryHashIndexReq) InitDefault() {
+	*p = QueryHashIndexReq{}
+}
+
+func (p *QueryHashIndexReq) GetName() (v string) {
+	return p.Name
+}
+
+var QueryHashIndexReq_Min_DEFAULT []byte
+
+func (p *QueryHashIndexReq) GetMin() (v []byte) {
+	if !p.IsSetMin() {
+		return QueryHashIndexReq_Min_DEFAULT
+	}
+	return p.Min
+}
+
+var QueryHashIndexReq_Max_DEFAULT []byte
+
+func (p *QueryHashIndexReq) GetMax() (v []byte) {
+	if !p.IsSetMax() {
+		return QueryHashIndexReq_Max_DEFAULT
+	}
+	return p.Max
+}
+
+var QueryHashIndexReq_Db_DEFAULT string
+
+func (p *QueryHashIndexReq) GetDb() (v string) {
+	if !p.IsSetDb() {
+		return QueryHashIndexReq_Db_DEFAULT
+	}
+	return *p.Db
+}
+func (p *QueryHashIndexReq) SetName(val string) {
+	p.Name = val
+}
+func (p *QueryHashIndexReq) SetMin(val []byte) {
+	p.Min = val
+}
+func (p *QueryHashIndexReq) SetMax(val []byte) {
+	p.Max = val
+}
+func (p *QueryHashIndexReq) SetDb(val *string) {
+	p.Db = val
+}
+
+var fieldIDToName_QueryHashIndexReq = map[int16]string{
+	1: "name",
+	2: "min",
+	3: "max",
+	4: "db",
+}
+
+func (p *QueryHashIndexReq) IsSetMin() bool {
+	return p.Min != nil
+}
+
+func (p *QueryHashIndexReq) IsSetMax() bool {
+	return p.Max != nil
+}
+
+func (p *QueryHashIndexReq) IsSetDb() bool {
+	return p.Db != nil
+}
+
+func (p *QueryHashIndexReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryHashIndexReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryHashIndexReq[fieldId]))
+}
+
+func (p *QueryHashIndexReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+func (p *QueryHashIndexReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Min = []byte(v)
+	}
+	return nil
+}
+func (p *QueryHashIndexReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Max = []byte(v)
+	}
+	return nil
+}
+func (p *QueryHashIndexReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Db = &v
+	}
+	return nil
+}
+
+func (p *QueryHashIndexReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryHashIndexReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryHashIndexReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryHashIndexReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMin() {
+		if err = oprot.WriteFieldBegin("min", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBinary([]byte(p.Min)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryHashIndexReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMax() {
+		if err = oprot.WriteFieldBegin("max", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBinary([]byte(p.Max)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *QueryHashIndexReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDb() {
+		if err = oprot.WriteFieldBegin("db", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Db); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryHashIndexReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryHashIndexReq(%+v)", *p)
+}
+
+func (p *QueryHashIndexReq) DeepEqual(ano *QueryHashIndexReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Min) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Max) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Db) {
+		return false
+	}
+	return true
+}
+
+func (p *QueryHashIndexReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryHashIndexReq) Field2DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Min, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryHashIndexReq) Field3DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Max, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryHashIndexReq) Field4DeepEqual(src *string) bool {
+
+	if p.Db == src {
+		return true
+	} else if p.Db == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Db, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type QueryHashIndexResp struct {
+	Success bool     `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Keys    []string `thrift:"keys,2,required" frugal:"2,required,list<string>" json:"keys"`
+	Message string   `thrift:"message,3,required" frugal:"3,required,string" json:"message"`
+}
+
+func NewQueryHashIndexResp() *QueryHashIndexResp {
+	return &QueryHashIndexResp{}
+}
+
+func (p *QueryHashIndexResp) InitDefault() {
+	*p = QueryHashIndexResp{}
+}
+
+func (p *QueryHashIndexResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *QueryHashIndexResp) GetKeys() (v []string) {
+	return p.Keys
+}
+
+func (p *QueryHashIndexResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *QueryHashIndexResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *QueryHashIndexResp) SetKeys(val []string) {
+	p.Keys = val
+}
+func (p *QueryHashIndexResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_QueryHashIndexResp = map[int16]string{
+	1: "success",
+	2: "keys",
+	3: "message",
+}
+
+func (p *QueryHashIndexResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetKeys bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetKeys = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetKeys {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryHashIndexResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryHashIndexResp[fieldId]))
+}
+
+func (p *QueryHashIndexResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *QueryHashIndexResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Keys = make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.Keys = append(p.Keys, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *QueryHashIndexResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *QueryHashIndexResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryHashIndexResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryHashIndexResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryHashIndexResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("keys", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Keys)); err != nil {
+		return err
+	}
+	for _, v := range p.Keys {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryHashIndexResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryHashIndexResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryHashIndexResp(%+v)", *p)
+}
+
+func (p *QueryHashIndexResp) DeepEqual(ano *QueryHashIndexResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Keys) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *QueryHashIndexResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *QueryHashIndexResp) Field2DeepEqual(src []string) bool {
+
+	if len(p.Keys) != len(src) {
+		return false
+	}
+	for i, v := range p.Keys {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *QueryHashIndexResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type MetaService interface {
+	RegionList(ctx context.Context, req *RegionListReq) (r *RegionListResp, err error)
+
+	RegionStatus(ctx context.Context, req *RegionStatusReq) (r *RegionStatusResp, err error)
+
+	ReplicaList(ctx context.Context, req *ReplicaListReq) (r *ReplicaListResp, err error)
+
+	ReplicaStatus(ctx context.Context, req *ReplicaStatusReq) (r *ReplicaStatusResp, err error)
+
+	NamespaceUsage(ctx context.Context, req *NamespaceUsageReq) (r *NamespaceUsageResp, err error)
+
+	CreateHashIndex(ctx context.Context, req *CreateHashIndexReq) (r *CreateHashIndexResp, err error)
+
+	DropHashIndex(ctx context.Context, req *DropHashIndexReq) (r *DropHashIndexResp, err error)
+
+	ListHashIndexes(ctx context.Context, req *ListHashIndexesReq) (r *ListHashIndexesResp, err error)
+
+	QueryHashIndex(ctx context.Context, req *QueryHashIndexReq) (r *QueryHashIndexResp, err error)
+}
+
+type MetaServiceClient struct {
+	c thrift.TClient
+}
+
+func NewMetaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MetaServiceClient {
+	return &MetaServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewMetaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MetaServiceClient {
+	return &MetaServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewMetaServiceClient(c thrift.TClient) *MetaServiceClient {
+	return &MetaServiceClient{
+		c: c,
+	}
+}
+
+func (p *MetaServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *MetaServiceClient) RegionList(ctx context.Context, req *RegionListReq) (r *RegionListResp, err error) {
+	var _args MetaServiceRegionListArgs
+	_args.Req = req
+	var _result MetaServiceRegionListResult
+	if err = p.Client_().Call(ctx, "RegionList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) RegionStatus(ctx context.Context, req *RegionStatusReq) (r *RegionStatusResp, err error) {
+	var _args MetaServiceRegionStatusArgs
+	_args.Req = req
+	var _result MetaServiceRegionStatusResult
+	if err = p.Client_().Call(ctx, "RegionStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) ReplicaList(ctx context.Context, req *ReplicaListReq) (r *ReplicaListResp, err error) {
+	var _args MetaServiceReplicaListArgs
+	_args.Req = req
+	var _result MetaServiceReplicaListResult
+	if err = p.Client_().Call(ctx, "ReplicaList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) ReplicaStatus(ctx context.Context, req *ReplicaStatusReq) (r *ReplicaStatusResp, err error) {
+	var _args MetaServiceReplicaStatusArgs
+	_args.Req = req
+	var _result MetaServiceReplicaStatusResult
+	if err = p.Client_().Call(ctx, "ReplicaStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) NamespaceUsage(ctx context.Context, req *NamespaceUsageReq) (r *NamespaceUsageResp, err error) {
+	var _args MetaServiceNamespaceUsageArgs
+	_args.Req = req
+	var _result MetaServiceNamespaceUsageResult
+	if err = p.Client_().Call(ctx, "NamespaceUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) CreateHashIndex(ctx context.Context, req *CreateHashIndexReq) (r *CreateHashIndexResp, err error) {
+	var _args MetaServiceCreateHashIndexArgs
+	_args.Req = req
+	var _result MetaServiceCreateHashIndexResult
+	if err = p.Client_().Call(ctx, "CreateHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) DropHashIndex(ctx context.Context, req *DropHashIndexReq) (r *DropHashIndexResp, err error) {
+	var _args MetaServiceDropHashIndexArgs
+	_args.Req = req
+	var _result MetaServiceDropHashIndexResult
+	if err = p.Client_().Call(ctx, "DropHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) ListHashIndexes(ctx context.Context, req *ListHashIndexesReq) (r *ListHashIndexesResp, err error) {
+	var _args MetaServiceListHashIndexesArgs
+	_args.Req = req
+	var _result MetaServiceListHashIndexesResult
+	if err = p.Client_().Call(ctx, "ListHashIndexes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *MetaServiceClient) QueryHashIndex(ctx context.Context, req *QueryHashIndexReq) (r *QueryHashIndexResp, err error) {
+	var _args MetaServiceQueryHashIndexArgs
+	_args.Req = req
+	var _result MetaServiceQueryHashIndexResult
+	if err = p.Client_().Call(ctx, "QueryHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type MetaServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      MetaService
+}
+
+func (p *MetaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *MetaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *MetaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewMetaServiceProcessor(handler MetaService) *MetaServiceProcessor {
+	self := &MetaServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("RegionList", &metaServiceProcessorRegionList{handler: handler})
+	self.AddToProcessorMap("RegionStatus", &metaServiceProcessorRegionStatus{handler: handler})
+	self.AddToProcessorMap("ReplicaList", &metaServiceProcessorReplicaList{handler: handler})
+	self.AddToProcessorMap("ReplicaStatus", &metaServiceProcessorReplicaStatus{handler: handler})
+	self.AddToProcessorMap("NamespaceUsage", &metaServiceProcessorNamespaceUsage{handler: handler})
+	self.AddToProcessorMap("CreateHashIndex", &metaServiceProcessorCreateHashIndex{handler: handler})
+	self.AddToProcessorMap("DropHashIndex", &metaServiceProcessorDropHashIndex{handler: handler})
+	self.AddToProcessorMap("ListHashIndexes", &metaServiceProcessorListHashIndexes{handler: handler})
+	self.AddToProcessorMap("QueryHashIndex", &metaServiceProcessorQueryHashIndex{handler: handler})
+	return self
+}
+func (p *MetaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type metaServiceProcessorRegionList struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorRegionList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceRegionListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RegionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceRegionListResult{}
+	var retval *RegionListResp
+	if retval, err2 = p.handler.RegionList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegionList: "+err2.Error())
+		oprot.WriteMessageBegin("RegionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RegionList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorRegionStatus struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorRegionStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceRegionStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RegionStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceRegionStatusResult{}
+	var retval *RegionStatusResp
+	if retval, err2 = p.handler.RegionStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegionStatus: "+err2.Error())
+		oprot.WriteMessageBegin("RegionStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RegionStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorReplicaList struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorReplicaList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceReplicaListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReplicaList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceReplicaListResult{}
+	var retval *ReplicaListResp
+	if retval, err2 = p.handler.ReplicaList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReplicaList: "+err2.Error())
+		oprot.WriteMessageBegin("ReplicaList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReplicaList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorReplicaStatus struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorReplicaStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceReplicaStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReplicaStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceReplicaStatusResult{}
+	var retval *ReplicaStatusResp
+	if retval, err2 = p.handler.ReplicaStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReplicaStatus: "+err2.Error())
+		oprot.WriteMessageBegin("ReplicaStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReplicaStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorNamespaceUsage struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorNamespaceUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceNamespaceUsageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("NamespaceUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceNamespaceUsageResult{}
+	var retval *NamespaceUsageResp
+	if retval, err2 = p.handler.NamespaceUsage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing NamespaceUsage: "+err2.Error())
+		oprot.WriteMessageBegin("NamespaceUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("NamespaceUsage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorCreateHashIndex struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorCreateHashIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceCreateHashIndexArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceCreateHashIndexResult{}
+	var retval *CreateHashIndexResp
+	if retval, err2 = p.handler.CreateHashIndex(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateHashIndex: "+err2.Error())
+		oprot.WriteMessageBegin("CreateHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateHashIndex", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorDropHashIndex struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorDropHashIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceDropHashIndexArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DropHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceDropHashIndexResult{}
+	var retval *DropHashIndexResp
+	if retval, err2 = p.handler.DropHashIndex(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DropHashIndex: "+err2.Error())
+		oprot.WriteMessageBegin("DropHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DropHashIndex", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorListHashIndexes struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorListHashIndexes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceListHashIndexesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListHashIndexes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceListHashIndexesResult{}
+	var retval *ListHashIndexesResp
+	if retval, err2 = p.handler.ListHashIndexes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListHashIndexes: "+err2.Error())
+		oprot.WriteMessageBegin("ListHashIndexes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListHashIndexes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type metaServiceProcessorQueryHashIndex struct {
+	handler MetaService
+}
+
+func (p *metaServiceProcessorQueryHashIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := MetaServiceQueryHashIndexArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := MetaServiceQueryHashIndexResult{}
+	var retval *QueryHashIndexResp
+	if retval, err2 = p.handler.QueryHashIndex(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryHashIndex: "+err2.Error())
+		oprot.WriteMessageBegin("QueryHashIndex", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryHashIndex", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type MetaServiceRegionListArgs struct {
+	Req *RegionListReq `thrift:"req,1" frugal:"1,default,RegionListReq" json:"req"`
+}
+
+func NewMetaServiceRegionListArgs() *MetaServiceRegionListArgs {
+	return &MetaServiceRegionListArgs{}
+}
+
+func (p *MetaServiceRegionListArgs) InitDefault() {
+	*p = MetaServiceRegionListArgs{}
+}
+
+var MetaServiceRegionListArgs_Req_DEFAULT *RegionListReq
+
+func (p *MetaServiceRegionListArgs) GetReq() (v *RegionListReq) {
+	if !p.IsSetReq() {
+		return MetaServiceRegionListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MetaServiceRegionListArgs) SetReq(val *RegionListReq) {
+	p.Req = val
+}
+
+var fieldIDToName_MetaServiceRegionListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *MetaServiceRegionListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MetaServiceRegionListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRegionListReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceRegionListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RegionList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceRegionListArgs(%+v)", *p)
+}
+
+func (p *MetaServiceRegionListArgs) DeepEqual(ano *MetaServiceRegionListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceRegionListArgs) Field1DeepEqual(src *RegionListReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceRegionListResult struct {
+	Success *RegionListResp `thrift:"success,0,optional" frugal:"0,optional,RegionListResp" json:"success,omitempty"`
+}
+
+func NewMetaServiceRegionListResult() *MetaServiceRegionListResult {
+	return &MetaServiceRegionListResult{}
+}
+
+func (p *MetaServiceRegionListResult) InitDefault() {
+	*p = MetaServiceRegionListResult{}
+}
+
+var MetaServiceRegionListResult_Success_DEFAULT *RegionListResp
+
+func (p *MetaServiceRegionListResult) GetSuccess() (v *RegionListResp) {
+	if !p.IsSetSuccess() {
+		return MetaServiceRegionListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MetaServiceRegionListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegionListResp)
+}
+
+var fieldIDToName_MetaServiceRegionListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *MetaServiceRegionListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MetaServiceRegionListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRegionListResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceRegionListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RegionList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *MetaServiceRegionListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceRegionListResult(%+v)", *p)
+}
+
+func (p *MetaServiceRegionListResult) DeepEqual(ano *MetaServiceRegionListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceRegionListResult) Field0DeepEqual(src *RegionListResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceRegionStatusArgs struct {
+	Req *RegionStatusReq `thrift:"req,1" frugal:"1,default,RegionStatusReq" json:"req"`
+}
+
+func NewMetaServiceRegionStatusArgs() *MetaServiceRegionStatusArgs {
+	return &MetaServiceRegionStatusArgs{}
+}
+
+func (p *MetaServiceRegionStatusArgs) InitDefault() {
+	*p = MetaServiceRegionStatusArgs{}
+}
+
+var MetaServiceRegionStatusArgs_Req_DEFAULT *RegionStatusReq
+
+func (p *MetaServiceRegionStatusArgs) GetReq() (v *RegionStatusReq) {
+	if !p.IsSetReq() {
+		return MetaServiceRegionStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MetaServiceRegionStatusArgs) SetReq(val *RegionStatusReq) {
+	p.Req = val
+}
+
+var fieldIDToName_MetaServiceRegionStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *MetaServiceRegionStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MetaServiceRegionStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRegionStatusReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceRegionStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RegionStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceRegionStatusArgs(%+v)", *p)
+}
+
+func (p *MetaServiceRegionStatusArgs) DeepEqual(ano *MetaServiceRegionStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceRegionStatusArgs) Field1DeepEqual(src *RegionStatusReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceRegionStatusResult struct {
+	Success *RegionStatusResp `thrift:"success,0,optional" frugal:"0,optional,RegionStatusResp" json:"success,omitempty"`
+}
+
+func NewMetaServiceRegionStatusResult() *MetaServiceRegionStatusResult {
+	return &MetaServiceRegionStatusResult{}
+}
+
+func (p *MetaServiceRegionStatusResult) InitDefault() {
+	*p = MetaServiceRegionStatusResult{}
+}
+
+var MetaServiceRegionStatusResult_Success_DEFAULT *RegionStatusResp
+
+func (p *MetaServiceRegionStatusResult) GetSuccess() (v *RegionStatusResp) {
+	if !p.IsSetSuccess() {
+		return MetaServiceRegionStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MetaServiceRegionStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegionStatusResp)
+}
+
+var fieldIDToName_MetaServiceRegionStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *MetaServiceRegionStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MetaServiceRegionStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRegionStatusResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceRegionStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RegionStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *MetaServiceRegionStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceRegionStatusResult(%+v)", *p)
+}
+
+func (p *MetaServiceRegionStatusResult) DeepEqual(ano *MetaServiceRegionStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceRegionStatusResult) Field0DeepEqual(src *RegionStatusResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceReplicaListArgs struct {
+	Req *ReplicaListReq `thrift:"req,1" frugal:"1,default,ReplicaListReq" json:"req"`
+}
+
+func NewMetaServiceReplicaListArgs() *MetaServiceReplicaListArgs {
+	return &MetaServiceReplicaListArgs{}
+}
+
+func (p *MetaServiceReplicaListArgs) InitDefault() {
+	*p = MetaServiceReplicaListArgs{}
+}
+
+var MetaServiceReplicaListArgs_Req_DEFAULT *ReplicaListReq
+
+func (p *MetaServiceReplicaListArgs) GetReq() (v *ReplicaListReq) {
+	if !p.IsSetReq() {
+		return MetaServiceReplicaListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MetaServiceReplicaListArgs) SetReq(val *ReplicaListReq) {
+	p.Req = val
+}
+
+var fieldIDToName_MetaServiceReplicaListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *MetaServiceReplicaListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MetaServiceReplicaListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewReplicaListReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceReplicaListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceReplicaListArgs(%+v)", *p)
+}
+
+func (p *MetaServiceReplicaListArgs) DeepEqual(ano *MetaServiceReplicaListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceReplicaListArgs) Field1DeepEqual(src *ReplicaListReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceReplicaListResult struct {
+	Success *ReplicaListResp `thrift:"success,0,optional" frugal:"0,optional,ReplicaListResp" json:"success,omitempty"`
+}
+
+func NewMetaServiceReplicaListResult() *MetaServiceReplicaListResult {
+	return &MetaServiceReplicaListResult{}
+}
+
+func (p *MetaServiceReplicaListResult) InitDefault() {
+	*p = MetaServiceReplicaListResult{}
+}
+
+var MetaServiceReplicaListResult_Success_DEFAULT *ReplicaListResp
+
+func (p *MetaServiceReplicaListResult) GetSuccess() (v *ReplicaListResp) {
+	if !p.IsSetSuccess() {
+		return MetaServiceReplicaListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MetaServiceReplicaListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReplicaListResp)
+}
+
+var fieldIDToName_MetaServiceReplicaListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *MetaServiceReplicaListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MetaServiceReplicaListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewReplicaListResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceReplicaListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceReplicaListResult(%+v)", *p)
+}
+
+func (p *MetaServiceReplicaListResult) DeepEqual(ano *MetaServiceReplicaListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceReplicaListResult) Field0DeepEqual(src *ReplicaListResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceReplicaStatusArgs struct {
+	Req *ReplicaStatusReq `thrift:"req,1" frugal:"1,default,ReplicaStatusReq" json:"req"`
+}
+
+func NewMetaServiceReplicaStatusArgs() *MetaServiceReplicaStatusArgs {
+	return &MetaServiceReplicaStatusArgs{}
+}
+
+func (p *MetaServiceReplicaStatusArgs) InitDefault() {
+	*p = MetaServiceReplicaStatusArgs{}
+}
+
+var MetaServiceReplicaStatusArgs_Req_DEFAULT *ReplicaStatusReq
+
+func (p *MetaServiceReplicaStatusArgs) GetReq() (v *ReplicaStatusReq) {
+	if !p.IsSetReq() {
+		return MetaServiceReplicaStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MetaServiceReplicaStatusArgs) SetReq(val *ReplicaStatusReq) {
+	p.Req = val
+}
+
+var fieldIDToName_MetaServiceReplicaStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *MetaServiceReplicaStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MetaServiceReplicaStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewReplicaStatusReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceReplicaStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceReplicaStatusArgs(%+v)", *p)
+}
+
+func (p *MetaServiceReplicaStatusArgs) DeepEqual(ano *MetaServiceReplicaStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceReplicaStatusArgs) Field1DeepEqual(src *ReplicaStatusReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceReplicaStatusResult struct {
+	Success *ReplicaStatusResp `thrift:"success,0,optional" frugal:"0,optional,ReplicaStatusResp" json:"success,omitempty"`
+}
+
+func NewMetaServiceReplicaStatusResult() *MetaServiceReplicaStatusResult {
+	return &MetaServiceReplicaStatusResult{}
+}
+
+func (p *MetaServiceReplicaStatusResult) InitDefault() {
+	*p = MetaServiceReplicaStatusResult{}
+}
+
+var MetaServiceReplicaStatusResult_Success_DEFAULT *ReplicaStatusResp
+
+func (p *MetaServiceReplicaStatusResult) GetSuccess() (v *ReplicaStatusResp) {
+	if !p.IsSetSuccess() {
+		return MetaServiceReplicaStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MetaServiceReplicaStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReplicaStatusResp)
+}
+
+var fieldIDToName_MetaServiceReplicaStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *MetaServiceReplicaStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MetaServiceReplicaStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewReplicaStatusResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceReplicaStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplicaStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *MetaServiceReplicaStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceReplicaStatusResult(%+v)", *p)
+}
+
+func (p *MetaServiceReplicaStatusResult) DeepEqual(ano *MetaServiceReplicaStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceReplicaStatusResult) Field0DeepEqual(src *ReplicaStatusResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceNamespaceUsageArgs struct {
+	Req *NamespaceUsageReq `thrift:"req,1" frugal:"1,default,NamespaceUsageReq" json:"req"`
+}
+
+func NewMetaServiceNamespaceUsageArgs() *MetaServiceNamespaceUsageArgs {
+	return &MetaServiceNamespaceUsageArgs{}
+}
+
+func (p *MetaServiceNamespaceUsageArgs) InitDefault() {
+	*p = MetaServiceNamespaceUsageArgs{}
+}
+
+var MetaServiceNamespaceUsageArgs_Req_DEFAULT *NamespaceUsageReq
+
+func (p *MetaServiceNamespaceUsageArgs) GetReq() (v *NamespaceUsageReq) {
+	if !p.IsSetReq() {
+		return MetaServiceNamespaceUsageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MetaServiceNamespaceUsageArgs) SetReq(val *NamespaceUsageReq) {
+	p.Req = val
+}
+
+var fieldIDToName_MetaServiceNamespaceUsageArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *MetaServiceNamespaceUsageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MetaServiceNamespaceUsageArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceNamespaceUsageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewNamespaceUsageReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceNamespaceUsageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NamespaceUsage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceNamespaceUsageArgs(%+v)", *p)
+}
+
+func (p *MetaServiceNamespaceUsageArgs) DeepEqual(ano *MetaServiceNamespaceUsageArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *MetaServiceNamespaceUsageArgs) Field1DeepEqual(src *NamespaceUsageReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type MetaServiceNamespaceUsageResult struct {
+	Success *NamespaceUsageResp `thrift:"success,0,optional" frugal:"0,optional,NamespaceUsageResp" json:"success,omitempty"`
+}
+
+func NewMetaServiceNamespaceUsageResult() *MetaServiceNamespaceUsageResult {
+	return &MetaServiceNamespaceUsageResult{}
+}
+
+func (p *MetaServiceNamespaceUsageResult) InitDefault() {
+	*p = MetaServiceNamespaceUsageResult{}
+}
+
+var MetaServiceNamespaceUsageResult_Success_DEFAULT *NamespaceUsageResp
+
+func (p *MetaServiceNamespaceUsageResult) GetSuccess() (v *NamespaceUsageResp) {
+	if !p.IsSetSuccess() {
+		return MetaServiceNamespaceUsageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MetaServiceNamespaceUsageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*NamespaceUsageResp)
+}
+
+var fieldIDToName_MetaServiceNamespaceUsageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *MetaServiceNamespaceUsageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MetaServiceNamespaceUsageResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceNamespaceUsageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewNamespaceUsageResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MetaServiceNamespaceUsageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NamespaceUsage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *MetaServiceNamespaceUsageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MetaServiceNamespaceUsageResult(%+v)", *p)
+}
+
+func (p *MetaServiceNamespaceUsageResult) DeepEqual(ano *MetaServiceNamespaceUsageResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *MetaServiceNamespaceUsageResult) Field0DeepEqual(src *NamespaceUsageResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type MetaServiceRegionListArgs struct {
-	Req *RegionListReq `thrift:"req,1" frugal:"1,default,RegionListReq" json:"req"`
+type MetaServiceCreateHashIndexArgs struct {
+	Req *CreateHashIndexReq `thrift:"req,1" frugal:"1,default,CreateHashIndexReq" json:"req"`
 }
 
-func NewMetaServiceRegionListArgs() *MetaServiceRegionListArgs {
-	return &MetaServiceRegionListArgs{}
+func NewMetaServiceCreateHashIndexArgs() *MetaServiceCreateHashIndexArgs {
+	return &MetaServiceCreateHashIndexArgs{}
 }
 
-func (p *MetaServiceRegionListArgs) InitDefault() {
-	*p = MetaServiceRegionListArgs{}
+func (p *MetaServiceCreateHashIndexArgs) InitDefault() {
+	*p = MetaServiceCreateHashIndexArgs{}
 }
 
-var MetaServiceRegionListArgs_Req_DEFAULT *RegionListReq
+var MetaServiceCreateHashIndexArgs_Req_DEFAULT *CreateHashIndexReq
 
-func (p *MetaServiceRegionListArgs) GetReq() (v *RegionListReq) {
+func (p *MetaServiceCreateHashIndexArgs) GetReq() (v *CreateHashIndexReq) {
 	if !p.IsSetReq() {
-		return MetaServiceRegionListArgs_Req_DEFAULT
+		return MetaServiceCreateHashIndexArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *MetaServiceRegionListArgs) SetReq(val *RegionListReq) {
+func (p *MetaServiceCreateHashIndexArgs) SetReq(val *CreateHashIndexReq) {
 	p.Req = val
 }
 
-var fieldIDToName_MetaServiceRegionListArgs = map[int16]string{
+var fieldIDToName_MetaServiceCreateHashIndexArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *MetaServiceRegionListArgs) IsSetReq() bool {
+func (p *MetaServiceCreateHashIndexArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *MetaServiceRegionListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1924,17 +9724,16 @@ func (p *MetaServiceRegionListArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1949,7 +9748,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceCreateHashIndexArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1959,17 +9758,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewRegionListReq()
+func (p *MetaServiceCreateHashIndexArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewCreateHashIndexReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceRegionListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionList_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateHashIndex_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1977,7 +9777,6 @@ func (p *MetaServiceRegionListArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1996,7 +9795,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2013,14 +9812,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListArgs) String() string {
+func (p *MetaServiceCreateHashIndexArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceRegionListArgs(%+v)", *p)
+	return fmt.Sprintf("MetaServiceCreateHashIndexArgs(%+v)", *p)
 }
 
-func (p *MetaServiceRegionListArgs) DeepEqual(ano *MetaServiceRegionListArgs) bool {
+func (p *MetaServiceCreateHashIndexArgs) DeepEqual(ano *MetaServiceCreateHashIndexArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2032,7 +9831,7 @@ func (p *MetaServiceRegionListArgs) DeepEqual(ano *MetaServiceRegionListArgs) bo
 	return true
 }
 
-func (p *MetaServiceRegionListArgs) Field1DeepEqual(src *RegionListReq) bool {
+func (p *MetaServiceCreateHashIndexArgs) Field1DeepEqual(src *CreateHashIndexReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2040,39 +9839,39 @@ func (p *MetaServiceRegionListArgs) Field1DeepEqual(src *RegionListReq) bool {
 	return true
 }
 
-type MetaServiceRegionListResult struct {
-	Success *RegionListResp `thrift:"success,0,optional" frugal:"0,optional,RegionListResp" json:"success,omitempty"`
+type MetaServiceCreateHashIndexResult struct {
+	Success *CreateHashIndexResp `thrift:"success,0,optional" frugal:"0,optional,CreateHashIndexResp" json:"success,omitempty"`
 }
 
-func NewMetaServiceRegionListResult() *MetaServiceRegionListResult {
-	return &MetaServiceRegionListResult{}
+func NewMetaServiceCreateHashIndexResult() *MetaServiceCreateHashIndexResult {
+	return &MetaServiceCreateHashIndexResult{}
 }
 
-func (p *MetaServiceRegionListResult) InitDefault() {
-	*p = MetaServiceRegionListResult{}
+func (p *MetaServiceCreateHashIndexResult) InitDefault() {
+	*p = MetaServiceCreateHashIndexResult{}
 }
 
-var MetaServiceRegionListResult_Success_DEFAULT *RegionListResp
+var MetaServiceCreateHashIndexResult_Success_DEFAULT *CreateHashIndexResp
 
-func (p *MetaServiceRegionListResult) GetSuccess() (v *RegionListResp) {
+func (p *MetaServiceCreateHashIndexResult) GetSuccess() (v *CreateHashIndexResp) {
 	if !p.IsSetSuccess() {
-		return MetaServiceRegionListResult_Success_DEFAULT
+		return MetaServiceCreateHashIndexResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *MetaServiceRegionListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RegionListResp)
+func (p *MetaServiceCreateHashIndexResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateHashIndexResp)
 }
 
-var fieldIDToName_MetaServiceRegionListResult = map[int16]string{
+var fieldIDToName_MetaServiceCreateHashIndexResult = map[int16]string{
 	0: "success",
 }
 
-func (p *MetaServiceRegionListResult) IsSetSuccess() bool {
+func (p *MetaServiceCreateHashIndexResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *MetaServiceRegionListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2096,17 +9895,16 @@ func (p *MetaServiceRegionListResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2121,7 +9919,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceCreateHashIndexResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2131,17 +9929,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewRegionListResp()
+func (p *MetaServiceCreateHashIndexResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewCreateHashIndexResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceRegionListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionList_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateHashIndex_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2149,7 +9948,6 @@ func (p *MetaServiceRegionListResult) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2168,7 +9966,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceCreateHashIndexResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2187,14 +9985,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *MetaServiceRegionListResult) String() string {
+func (p *MetaServiceCreateHashIndexResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceRegionListResult(%+v)", *p)
+	return fmt.Sprintf("MetaServiceCreateHashIndexResult(%+v)", *p)
 }
 
-func (p *MetaServiceRegionListResult) DeepEqual(ano *MetaServiceRegionListResult) bool {
+func (p *MetaServiceCreateHashIndexResult) DeepEqual(ano *MetaServiceCreateHashIndexResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2206,7 +10004,7 @@ func (p *MetaServiceRegionListResult) DeepEqual(ano *MetaServiceRegionListResult
 	return true
 }
 
-func (p *MetaServiceRegionListResult) Field0DeepEqual(src *RegionListResp) bool {
+func (p *MetaServiceCreateHashIndexResult) Field0DeepEqual(src *CreateHashIndexResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2214,39 +10012,39 @@ func (p *MetaServiceRegionListResult) Field0DeepEqual(src *RegionListResp) bool
 	return true
 }
 
-type MetaServiceRegionStatusArgs struct {
-	Req *RegionStatusReq `thrift:"req,1" frugal:"1,default,RegionStatusReq" json:"req"`
+type MetaServiceDropHashIndexArgs struct {
+	Req *DropHashIndexReq `thrift:"req,1" frugal:"1,default,DropHashIndexReq" json:"req"`
 }
 
-func NewMetaServiceRegionStatusArgs() *MetaServiceRegionStatusArgs {
-	return &MetaServiceRegionStatusArgs{}
+func NewMetaServiceDropHashIndexArgs() *MetaServiceDropHashIndexArgs {
+	return &MetaServiceDropHashIndexArgs{}
 }
 
-func (p *MetaServiceRegionStatusArgs) InitDefault() {
-	*p = MetaServiceRegionStatusArgs{}
+func (p *MetaServiceDropHashIndexArgs) InitDefault() {
+	*p = MetaServiceDropHashIndexArgs{}
 }
 
-var MetaServiceRegionStatusArgs_Req_DEFAULT *RegionStatusReq
+var MetaServiceDropHashIndexArgs_Req_DEFAULT *DropHashIndexReq
 
-func (p *MetaServiceRegionStatusArgs) GetReq() (v *RegionStatusReq) {
+func (p *MetaServiceDropHashIndexArgs) GetReq() (v *DropHashIndexReq) {
 	if !p.IsSetReq() {
-		return MetaServiceRegionStatusArgs_Req_DEFAULT
+		return MetaServiceDropHashIndexArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *MetaServiceRegionStatusArgs) SetReq(val *RegionStatusReq) {
+func (p *MetaServiceDropHashIndexArgs) SetReq(val *DropHashIndexReq) {
 	p.Req = val
 }
 
-var fieldIDToName_MetaServiceRegionStatusArgs = map[int16]string{
+var fieldIDToName_MetaServiceDropHashIndexArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *MetaServiceRegionStatusArgs) IsSetReq() bool {
+func (p *MetaServiceDropHashIndexArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *MetaServiceRegionStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2270,17 +10068,16 @@ func (p *MetaServiceRegionStatusArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2295,7 +10092,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceDropHashIndexArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2305,17 +10102,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewRegionStatusReq()
+func (p *MetaServiceDropHashIndexArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewDropHashIndexReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceRegionStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("DropHashIndex_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2323,7 +10121,6 @@ func (p *MetaServiceRegionStatusArgs) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2342,7 +10139,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2359,14 +10156,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusArgs) String() string {
+func (p *MetaServiceDropHashIndexArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceRegionStatusArgs(%+v)", *p)
+	return fmt.Sprintf("MetaServiceDropHashIndexArgs(%+v)", *p)
 }
 
-func (p *MetaServiceRegionStatusArgs) DeepEqual(ano *MetaServiceRegionStatusArgs) bool {
+func (p *MetaServiceDropHashIndexArgs) DeepEqual(ano *MetaServiceDropHashIndexArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2378,7 +10175,7 @@ func (p *MetaServiceRegionStatusArgs) DeepEqual(ano *MetaServiceRegionStatusArgs
 	return true
 }
 
-func (p *MetaServiceRegionStatusArgs) Field1DeepEqual(src *RegionStatusReq) bool {
+func (p *MetaServiceDropHashIndexArgs) Field1DeepEqual(src *DropHashIndexReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2386,39 +10183,39 @@ func (p *MetaServiceRegionStatusArgs) Field1DeepEqual(src *RegionStatusReq) bool
 	return true
 }
 
-type MetaServiceRegionStatusResult struct {
-	Success *RegionStatusResp `thrift:"success,0,optional" frugal:"0,optional,RegionStatusResp" json:"success,omitempty"`
+type MetaServiceDropHashIndexResult struct {
+	Success *DropHashIndexResp `thrift:"success,0,optional" frugal:"0,optional,DropHashIndexResp" json:"success,omitempty"`
 }
 
-func NewMetaServiceRegionStatusResult() *MetaServiceRegionStatusResult {
-	return &MetaServiceRegionStatusResult{}
+func NewMetaServiceDropHashIndexResult() *MetaServiceDropHashIndexResult {
+	return &MetaServiceDropHashIndexResult{}
 }
 
-func (p *MetaServiceRegionStatusResult) InitDefault() {
-	*p = MetaServiceRegionStatusResult{}
+func (p *MetaServiceDropHashIndexResult) InitDefault() {
+	*p = MetaServiceDropHashIndexResult{}
 }
 
-var MetaServiceRegionStatusResult_Success_DEFAULT *RegionStatusResp
+var MetaServiceDropHashIndexResult_Success_DEFAULT *DropHashIndexResp
 
-func (p *MetaServiceRegionStatusResult) GetSuccess() (v *RegionStatusResp) {
+func (p *MetaServiceDropHashIndexResult) GetSuccess() (v *DropHashIndexResp) {
 	if !p.IsSetSuccess() {
-		return MetaServiceRegionStatusResult_Success_DEFAULT
+		return MetaServiceDropHashIndexResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *MetaServiceRegionStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RegionStatusResp)
+func (p *MetaServiceDropHashIndexResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DropHashIndexResp)
 }
 
-var fieldIDToName_MetaServiceRegionStatusResult = map[int16]string{
+var fieldIDToName_MetaServiceDropHashIndexResult = map[int16]string{
 	0: "success",
 }
 
-func (p *MetaServiceRegionStatusResult) IsSetSuccess() bool {
+func (p *MetaServiceDropHashIndexResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *MetaServiceRegionStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2442,17 +10239,16 @@ func (p *MetaServiceRegionStatusResult) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2467,7 +10263,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceRegionStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceDropHashIndexResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2477,17 +10273,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewRegionStatusResp()
+func (p *MetaServiceDropHashIndexResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewDropHashIndexResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceRegionStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RegionStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("DropHashIndex_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2495,7 +10292,6 @@ func (p *MetaServiceRegionStatusResult) Write(oprot thrift.TProtocol) (err error
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2514,7 +10310,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceDropHashIndexResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2533,14 +10329,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *MetaServiceRegionStatusResult) String() string {
+func (p *MetaServiceDropHashIndexResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceRegionStatusResult(%+v)", *p)
+	return fmt.Sprintf("MetaServiceDropHashIndexResult(%+v)", *p)
 }
 
-func (p *MetaServiceRegionStatusResult) DeepEqual(ano *MetaServiceRegionStatusResult) bool {
+func (p *MetaServiceDropHashIndexResult) DeepEqual(ano *MetaServiceDropHashIndexResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2552,7 +10348,7 @@ func (p *MetaServiceRegionStatusResult) DeepEqual(ano *MetaServiceRegionStatusRe
 	return true
 }
 
-func (p *MetaServiceRegionStatusResult) Field0DeepEqual(src *RegionStatusResp) bool {
+func (p *MetaServiceDropHashIndexResult) Field0DeepEqual(src *DropHashIndexResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2560,39 +10356,39 @@ func (p *MetaServiceRegionStatusResult) Field0DeepEqual(src *RegionStatusResp) b
 	return true
 }
 
-type MetaServiceReplicaListArgs struct {
-	Req *ReplicaListReq `thrift:"req,1" frugal:"1,default,ReplicaListReq" json:"req"`
+type MetaServiceListHashIndexesArgs struct {
+	Req *ListHashIndexesReq `thrift:"req,1" frugal:"1,default,ListHashIndexesReq" json:"req"`
 }
 
-func NewMetaServiceReplicaListArgs() *MetaServiceReplicaListArgs {
-	return &MetaServiceReplicaListArgs{}
+func NewMetaServiceListHashIndexesArgs() *MetaServiceListHashIndexesArgs {
+	return &MetaServiceListHashIndexesArgs{}
 }
 
-func (p *MetaServiceReplicaListArgs) InitDefault() {
-	*p = MetaServiceReplicaListArgs{}
+func (p *MetaServiceListHashIndexesArgs) InitDefault() {
+	*p = MetaServiceListHashIndexesArgs{}
 }
 
-var MetaServiceReplicaListArgs_Req_DEFAULT *ReplicaListReq
+var MetaServiceListHashIndexesArgs_Req_DEFAULT *ListHashIndexesReq
 
-func (p *MetaServiceReplicaListArgs) GetReq() (v *ReplicaListReq) {
+func (p *MetaServiceListHashIndexesArgs) GetReq() (v *ListHashIndexesReq) {
 	if !p.IsSetReq() {
-		return MetaServiceReplicaListArgs_Req_DEFAULT
+		return MetaServiceListHashIndexesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *MetaServiceReplicaListArgs) SetReq(val *ReplicaListReq) {
+func (p *MetaServiceListHashIndexesArgs) SetReq(val *ListHashIndexesReq) {
 	p.Req = val
 }
 
-var fieldIDToName_MetaServiceReplicaListArgs = map[int16]string{
+var fieldIDToName_MetaServiceListHashIndexesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *MetaServiceReplicaListArgs) IsSetReq() bool {
+func (p *MetaServiceListHashIndexesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *MetaServiceReplicaListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2616,17 +10412,16 @@ func (p *MetaServiceReplicaListArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2641,7 +10436,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceListHashIndexesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2651,17 +10446,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewReplicaListReq()
+func (p *MetaServiceListHashIndexesArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewListHashIndexesReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceReplicaListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListHashIndexes_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2669,7 +10465,6 @@ func (p *MetaServiceReplicaListArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2688,7 +10483,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2705,14 +10500,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListArgs) String() string {
+func (p *MetaServiceListHashIndexesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceReplicaListArgs(%+v)", *p)
+	return fmt.Sprintf("MetaServiceListHashIndexesArgs(%+v)", *p)
 }
 
-func (p *MetaServiceReplicaListArgs) DeepEqual(ano *MetaServiceReplicaListArgs) bool {
+func (p *MetaServiceListHashIndexesArgs) DeepEqual(ano *MetaServiceListHashIndexesArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2724,7 +10519,7 @@ func (p *MetaServiceReplicaListArgs) DeepEqual(ano *MetaServiceReplicaListArgs)
 	return true
 }
 
-func (p *MetaServiceReplicaListArgs) Field1DeepEqual(src *ReplicaListReq) bool {
+func (p *MetaServiceListHashIndexesArgs) Field1DeepEqual(src *ListHashIndexesReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2732,39 +10527,39 @@ func (p *MetaServiceReplicaListArgs) Field1DeepEqual(src *ReplicaListReq) bool {
 	return true
 }
 
-type MetaServiceReplicaListResult struct {
-	Success *ReplicaListResp `thrift:"success,0,optional" frugal:"0,optional,ReplicaListResp" json:"success,omitempty"`
+type MetaServiceListHashIndexesResult struct {
+	Success *ListHashIndexesResp `thrift:"success,0,optional" frugal:"0,optional,ListHashIndexesResp" json:"success,omitempty"`
 }
 
-func NewMetaServiceReplicaListResult() *MetaServiceReplicaListResult {
-	return &MetaServiceReplicaListResult{}
+func NewMetaServiceListHashIndexesResult() *MetaServiceListHashIndexesResult {
+	return &MetaServiceListHashIndexesResult{}
 }
 
-func (p *MetaServiceReplicaListResult) InitDefault() {
-	*p = MetaServiceReplicaListResult{}
+func (p *MetaServiceListHashIndexesResult) InitDefault() {
+	*p = MetaServiceListHashIndexesResult{}
 }
 
-var MetaServiceReplicaListResult_Success_DEFAULT *ReplicaListResp
+var MetaServiceListHashIndexesResult_Success_DEFAULT *ListHashIndexesResp
 
-func (p *MetaServiceReplicaListResult) GetSuccess() (v *ReplicaListResp) {
+func (p *MetaServiceListHashIndexesResult) GetSuccess() (v *ListHashIndexesResp) {
 	if !p.IsSetSuccess() {
-		return MetaServiceReplicaListResult_Success_DEFAULT
+		return MetaServiceListHashIndexesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *MetaServiceReplicaListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReplicaListResp)
+func (p *MetaServiceListHashIndexesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListHashIndexesResp)
 }
 
-var fieldIDToName_MetaServiceReplicaListResult = map[int16]string{
+var fieldIDToName_MetaServiceListHashIndexesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *MetaServiceReplicaListResult) IsSetSuccess() bool {
+func (p *MetaServiceListHashIndexesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *MetaServiceReplicaListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2788,17 +10583,16 @@ func (p *MetaServiceReplicaListResult) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2813,7 +10607,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceListHashIndexesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2823,17 +10617,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewReplicaListResp()
+func (p *MetaServiceListHashIndexesResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewListHashIndexesResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceReplicaListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListHashIndexes_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2841,7 +10636,6 @@ func (p *MetaServiceReplicaListResult) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2860,7 +10654,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceListHashIndexesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2879,14 +10673,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaListResult) String() string {
+func (p *MetaServiceListHashIndexesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceReplicaListResult(%+v)", *p)
+	return fmt.Sprintf("MetaServiceListHashIndexesResult(%+v)", *p)
 }
 
-func (p *MetaServiceReplicaListResult) DeepEqual(ano *MetaServiceReplicaListResult) bool {
+func (p *MetaServiceListHashIndexesResult) DeepEqual(ano *MetaServiceListHashIndexesResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2898,7 +10692,7 @@ func (p *MetaServiceReplicaListResult) DeepEqual(ano *MetaServiceReplicaListResu
 	return true
 }
 
-func (p *MetaServiceReplicaListResult) Field0DeepEqual(src *ReplicaListResp) bool {
+func (p *MetaServiceListHashIndexesResult) Field0DeepEqual(src *ListHashIndexesResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2906,39 +10700,39 @@ func (p *MetaServiceReplicaListResult) Field0DeepEqual(src *ReplicaListResp) boo
 	return true
 }
 
-type MetaServiceReplicaStatusArgs struct {
-	Req *ReplicaStatusReq `thrift:"req,1" frugal:"1,default,ReplicaStatusReq" json:"req"`
+type MetaServiceQueryHashIndexArgs struct {
+	Req *QueryHashIndexReq `thrift:"req,1" frugal:"1,default,QueryHashIndexReq" json:"req"`
 }
 
-func NewMetaServiceReplicaStatusArgs() *MetaServiceReplicaStatusArgs {
-	return &MetaServiceReplicaStatusArgs{}
+func NewMetaServiceQueryHashIndexArgs() *MetaServiceQueryHashIndexArgs {
+	return &MetaServiceQueryHashIndexArgs{}
 }
 
-func (p *MetaServiceReplicaStatusArgs) InitDefault() {
-	*p = MetaServiceReplicaStatusArgs{}
+func (p *MetaServiceQueryHashIndexArgs) InitDefault() {
+	*p = MetaServiceQueryHashIndexArgs{}
 }
 
-var MetaServiceReplicaStatusArgs_Req_DEFAULT *ReplicaStatusReq
+var MetaServiceQueryHashIndexArgs_Req_DEFAULT *QueryHashIndexReq
 
-func (p *MetaServiceReplicaStatusArgs) GetReq() (v *ReplicaStatusReq) {
+func (p *MetaServiceQueryHashIndexArgs) GetReq() (v *QueryHashIndexReq) {
 	if !p.IsSetReq() {
-		return MetaServiceReplicaStatusArgs_Req_DEFAULT
+		return MetaServiceQueryHashIndexArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *MetaServiceReplicaStatusArgs) SetReq(val *ReplicaStatusReq) {
+func (p *MetaServiceQueryHashIndexArgs) SetReq(val *QueryHashIndexReq) {
 	p.Req = val
 }
 
-var fieldIDToName_MetaServiceReplicaStatusArgs = map[int16]string{
+var fieldIDToName_MetaServiceQueryHashIndexArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *MetaServiceReplicaStatusArgs) IsSetReq() bool {
+func (p *MetaServiceQueryHashIndexArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *MetaServiceReplicaStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2962,17 +10756,16 @@ func (p *MetaServiceReplicaStatusArgs) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2987,7 +10780,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceQueryHashIndexArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2997,17 +10790,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewReplicaStatusReq()
+func (p *MetaServiceQueryHashIndexArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewQueryHashIndexReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceReplicaStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryHashIndex_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3015,7 +10809,6 @@ func (p *MetaServiceReplicaStatusArgs) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3034,7 +10827,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3051,14 +10844,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusArgs) String() string {
+func (p *MetaServiceQueryHashIndexArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceReplicaStatusArgs(%+v)", *p)
+	return fmt.Sprintf("MetaServiceQueryHashIndexArgs(%+v)", *p)
 }
 
-func (p *MetaServiceReplicaStatusArgs) DeepEqual(ano *MetaServiceReplicaStatusArgs) bool {
+func (p *MetaServiceQueryHashIndexArgs) DeepEqual(ano *MetaServiceQueryHashIndexArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3070,7 +10863,7 @@ func (p *MetaServiceReplicaStatusArgs) DeepEqual(ano *MetaServiceReplicaStatusAr
 	return true
 }
 
-func (p *MetaServiceReplicaStatusArgs) Field1DeepEqual(src *ReplicaStatusReq) bool {
+func (p *MetaServiceQueryHashIndexArgs) Field1DeepEqual(src *QueryHashIndexReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3078,39 +10871,39 @@ func (p *MetaServiceReplicaStatusArgs) Field1DeepEqual(src *ReplicaStatusReq) bo
 	return true
 }
 
-type MetaServiceReplicaStatusResult struct {
-	Success *ReplicaStatusResp `thrift:"success,0,optional" frugal:"0,optional,ReplicaStatusResp" json:"success,omitempty"`
+type MetaServiceQueryHashIndexResult struct {
+	Success *QueryHashIndexResp `thrift:"success,0,optional" frugal:"0,optional,QueryHashIndexResp" json:"success,omitempty"`
 }
 
-func NewMetaServiceReplicaStatusResult() *MetaServiceReplicaStatusResult {
-	return &MetaServiceReplicaStatusResult{}
+func NewMetaServiceQueryHashIndexResult() *MetaServiceQueryHashIndexResult {
+	return &MetaServiceQueryHashIndexResult{}
 }
 
-func (p *MetaServiceReplicaStatusResult) InitDefault() {
-	*p = MetaServiceReplicaStatusResult{}
+func (p *MetaServiceQueryHashIndexResult) InitDefault() {
+	*p = MetaServiceQueryHashIndexResult{}
 }
 
-var MetaServiceReplicaStatusResult_Success_DEFAULT *ReplicaStatusResp
+var MetaServiceQueryHashIndexResult_Success_DEFAULT *QueryHashIndexResp
 
-func (p *MetaServiceReplicaStatusResult) GetSuccess() (v *ReplicaStatusResp) {
+func (p *MetaServiceQueryHashIndexResult) GetSuccess() (v *QueryHashIndexResp) {
 	if !p.IsSetSuccess() {
-		return MetaServiceReplicaStatusResult_Success_DEFAULT
+		return MetaServiceQueryHashIndexResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *MetaServiceReplicaStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReplicaStatusResp)
+func (p *MetaServiceQueryHashIndexResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryHashIndexResp)
 }
 
-var fieldIDToName_MetaServiceReplicaStatusResult = map[int16]string{
+var fieldIDToName_MetaServiceQueryHashIndexResult = map[int16]string{
 	0: "success",
 }
 
-func (p *MetaServiceReplicaStatusResult) IsSetSuccess() bool {
+func (p *MetaServiceQueryHashIndexResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *MetaServiceReplicaStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3134,17 +10927,16 @@ func (p *MetaServiceReplicaStatusResult) Read(iprot thrift.TProtocol) (err error
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -3159,7 +10951,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceReplicaStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MetaServiceQueryHashIndexResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3169,17 +10961,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewReplicaStatusResp()
+func (p *MetaServiceQueryHashIndexResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewQueryHashIndexResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *MetaServiceReplicaStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReplicaStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryHashIndex_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3187,7 +10980,6 @@ func (p *MetaServiceReplicaStatusResult) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3206,7 +10998,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MetaServiceQueryHashIndexResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3225,14 +11017,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *MetaServiceReplicaStatusResult) String() string {
+func (p *MetaServiceQueryHashIndexResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MetaServiceReplicaStatusResult(%+v)", *p)
+	return fmt.Sprintf("MetaServiceQueryHashIndexResult(%+v)", *p)
 }
 
-func (p *MetaServiceReplicaStatusResult) DeepEqual(ano *MetaServiceReplicaStatusResult) bool {
+func (p *MetaServiceQueryHashIndexResult) DeepEqual(ano *MetaServiceQueryHashIndexResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3244,7 +11036,7 @@ func (p *MetaServiceReplicaStatusResult) DeepEqual(ano *MetaServiceReplicaStatus
 	return true
 }
 
-func (p *MetaServiceReplicaStatusResult) Field0DeepEqual(src *ReplicaStatusResp) bool {
+func (p *MetaServiceQueryHashIndexResult) Field0DeepEqual(src *QueryHashIndexResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package metaservice
 
@@ -15,6 +15,11 @@ type Client interface {
 	RegionStatus(ctx context.Context, req *meta0.RegionStatusReq, callOptions ...callopt.Option) (r *meta0.RegionStatusResp, err error)
 	ReplicaList(ctx context.Context, req *meta0.ReplicaListReq, callOptions ...callopt.Option) (r *meta0.ReplicaListResp, err error)
 	ReplicaStatus(ctx context.Context, req *meta0.ReplicaStatusReq, callOptions ...callopt.Option) (r *meta0.ReplicaStatusResp, err error)
+	NamespaceUsage(ctx context.Context, req *meta0.NamespaceUsageReq, callOptions ...callopt.Option) (r *meta0.NamespaceUsageResp, err error)
+	CreateHashIndex(ctx context.Context, req *meta0.CreateHashIndexReq, callOptions ...callopt.Option) (r *meta0.CreateHashIndexResp, err error)
+	DropHashIndex(ctx context.Context, req *meta0.DropHashIndexReq, callOptions ...callopt.Option) (r *meta0.DropHashIndexResp, err error)
+	ListHashIndexes(ctx context.Context, req *meta0.ListHashIndexesReq, callOptions ...callopt.Option) (r *meta0.ListHashIndexesResp, err error)
+	QueryHashIndex(ctx context.Context, req *meta0.QueryHashIndexReq, callOptions ...callopt.Option) (r *meta0.QueryHashIndexResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -65,3 +70,28 @@ func (p *kMetaServiceClient) ReplicaStatus(ctx context.Context, req *meta0.Repli
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ReplicaStatus(ctx, req)
 }
+
+func (p *kMetaServiceClient) NamespaceUsage(ctx context.Context, req *meta0.NamespaceUsageReq, callOptions ...callopt.Option) (r *meta0.NamespaceUsageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.NamespaceUsage(ctx, req)
+}
+
+func (p *kMetaServiceClient) CreateHashIndex(ctx context.Context, req *meta0.CreateHashIndexReq, callOptions ...callopt.Option) (r *meta0.CreateHashIndexResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateHashIndex(ctx, req)
+}
+
+func (p *kMetaServiceClient) DropHashIndex(ctx context.Context, req *meta0.DropHashIndexReq, callOptions ...callopt.Option) (r *meta0.DropHashIndexResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DropHashIndex(ctx, req)
+}
+
+func (p *kMetaServiceClient) ListHashIndexes(ctx context.Context, req *meta0.ListHashIndexesReq, callOptions ...callopt.Option) (r *meta0.ListHashIndexesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListHashIndexes(ctx, req)
+}
+
+func (p *kMetaServiceClient) QueryHashIndex(ctx context.Context, req *meta0.QueryHashIndexReq, callOptions ...callopt.Option) (r *meta0.QueryHashIndexResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryHashIndex(ctx, req)
+}
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package metaservice
 
@@ -20,20 +20,26 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "MetaService"
 	handlerType := (*meta.MetaService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"RegionList":    kitex.NewMethodInfo(regionListHandler, newMetaServiceRegionListArgs, newMetaServiceRegionListResult, false),
-		"RegionStatus":  kitex.NewMethodInfo(regionStatusHandler, newMetaServiceRegionStatusArgs, newMetaServiceRegionStatusResult, false),
-		"ReplicaList":   kitex.NewMethodInfo(replicaListHandler, newMetaServiceReplicaListArgs, newMetaServiceReplicaListResult, false),
-		"ReplicaStatus": kitex.NewMethodInfo(replicaStatusHandler, newMetaServiceReplicaStatusArgs, newMetaServiceReplicaStatusResult, false),
+		"RegionList":      kitex.NewMethodInfo(regionListHandler, newMetaServiceRegionListArgs, newMetaServiceRegionListResult, false),
+		"RegionStatus":    kitex.NewMethodInfo(regionStatusHandler, newMetaServiceRegionStatusArgs, newMetaServiceRegionStatusResult, false),
+		"ReplicaList":     kitex.NewMethodInfo(replicaListHandler, newMetaServiceReplicaListArgs, newMetaServiceReplicaListResult, false),
+		"ReplicaStatus":   kitex.NewMethodInfo(replicaStatusHandler, newMetaServiceReplicaStatusArgs, newMetaServiceReplicaStatusResult, false),
+		"NamespaceUsage":  kitex.NewMethodInfo(namespaceUsageHandler, newMetaServiceNamespaceUsageArgs, newMetaServiceNamespaceUsageResult, false),
+		"CreateHashIndex": kitex.NewMethodInfo(createHashIndexHandler, newMetaServiceCreateHashIndexArgs, newMetaServiceCreateHashIndexResult, false),
+		"DropHashIndex":   kitex.NewMethodInfo(dropHashIndexHandler, newMetaServiceDropHashIndexArgs, newMetaServiceDropHashIndexResult, false),
+		"ListHashIndexes": kitex.NewMethodInfo(listHashIndexesHandler, newMetaServiceListHashIndexesArgs, newMetaServiceListHashIndexesResult, false),
+		"QueryHashIndex":  kitex.NewMethodInfo(queryHashIndexHandler, newMetaServiceQueryHashIndexArgs, newMetaServiceQueryHashIndexResult, false),
 	}
 	extra := map[string]interface{}{
-		"PackageName": "meta",
+		"PackageName":     "meta",
+		"ServiceFilePath": `idl/meta.thrift`,
 	}
 	svcInfo := &kitex.ServiceInfo{
 		ServiceName:     serviceName,
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.6.2",
+		KiteXGenVersion: "v0.8.0",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -111,6 +117,96 @@ func newMetaServiceReplicaStatusResult() interface{} {
 	return meta.NewMetaServiceReplicaStatusResult()
 }
 
+func namespaceUsageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*meta.MetaServiceNamespaceUsageArgs)
+	realResult := result.(*meta.MetaServiceNamespaceUsageResult)
+	success, err := handler.(meta.MetaService).NamespaceUsage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMetaServiceNamespaceUsageArgs() interface{} {
+	return meta.NewMetaServiceNamespaceUsageArgs()
+}
+
+func newMetaServiceNamespaceUsageResult() interface{} {
+	return meta.NewMetaServiceNamespaceUsageResult()
+}
+
+func createHashIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*meta.MetaServiceCreateHashIndexArgs)
+	realResult := result.(*meta.MetaServiceCreateHashIndexResult)
+	success, err := handler.(meta.MetaService).CreateHashIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMetaServiceCreateHashIndexArgs() interface{} {
+	return meta.NewMetaServiceCreateHashIndexArgs()
+}
+
+func newMetaServiceCreateHashIndexResult() interface{} {
+	return meta.NewMetaServiceCreateHashIndexResult()
+}
+
+func dropHashIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*meta.MetaServiceDropHashIndexArgs)
+	realResult := result.(*meta.MetaServiceDropHashIndexResult)
+	success, err := handler.(meta.MetaService).DropHashIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMetaServiceDropHashIndexArgs() interface{} {
+	return meta.NewMetaServiceDropHashIndexArgs()
+}
+
+func newMetaServiceDropHashIndexResult() interface{} {
+	return meta.NewMetaServiceDropHashIndexResult()
+}
+
+func listHashIndexesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*meta.MetaServiceListHashIndexesArgs)
+	realResult := result.(*meta.MetaServiceListHashIndexesResult)
+	success, err := handler.(meta.MetaService).ListHashIndexes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMetaServiceListHashIndexesArgs() interface{} {
+	return meta.NewMetaServiceListHashIndexesArgs()
+}
+
+func newMetaServiceListHashIndexesResult() interface{} {
+	return meta.NewMetaServiceListHashIndexesResult()
+}
+
+func queryHashIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*meta.MetaServiceQueryHashIndexArgs)
+	realResult := result.(*meta.MetaServiceQueryHashIndexResult)
+	success, err := handler.(meta.MetaService).QueryHashIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMetaServiceQueryHashIndexArgs() interface{} {
+	return meta.NewMetaServiceQueryHashIndexArgs()
+}
+
+func newMetaServiceQueryHashIndexResult() interface{} {
+	return meta.NewMetaServiceQueryHashIndexResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -160,3 +256,53 @@ func (p *kClient) ReplicaStatus(ctx context.Context, req *meta0.ReplicaStatusReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) NamespaceUsage(ctx context.Context, req *meta0.NamespaceUsageReq) (r *meta0.NamespaceUsageResp, err error) {
+	var _args meta.MetaServiceNamespaceUsageArgs
+	_args.Req = req
+	var _result meta.MetaServiceNamespaceUsageResult
+	if err = p.c.Call(ctx, "NamespaceUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateHashIndex(ctx context.Context, req *meta0.CreateHashIndexReq) (r *meta0.CreateHashIndexResp, err error) {
+	var _args meta.MetaServiceCreateHashIndexArgs
+	_args.Req = req
+	var _result meta.MetaServiceCreateHashIndexResult
+	if err = p.c.Call(ctx, "CreateHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DropHashIndex(ctx context.Context, req *meta0.DropHashIndexReq) (r *meta0.DropHashIndexResp, err error) {
+	var _args meta.MetaServiceDropHashIndexArgs
+	_args.Req = req
+	var _result meta.MetaServiceDropHashIndexResult
+	if err = p.c.Call(ctx, "DropHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListHashIndexes(ctx context.Context, req *meta0.ListHashIndexesReq) (r *meta0.ListHashIndexesResp, err error) {
+	var _args meta.MetaServiceListHashIndexesArgs
+	_args.Req = req
+	var _result meta.MetaServiceListHashIndexesResult
+	if err = p.c.Call(ctx, "ListHashIndexes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryHashIndex(ctx context.Context, req *meta0.QueryHashIndexReq) (r *meta0.QueryHashIndexResp, err error) {
+	var _args meta.MetaServiceQueryHashIndexArgs
+	_args.Req = req
+	var _result meta.MetaServiceQueryHashIndexResult
+	if err = p.c.Call(ctx, "QueryHashIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
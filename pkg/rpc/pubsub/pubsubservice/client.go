@@ -0,0 +1,67 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package pubsubservice
+
+import (
+	"context"
+	pubsub "github.com/T4t4KAU/TikBase/pkg/rpc/pubsub"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	Publish(ctx context.Context, req *pubsub.PublishReq, callOptions ...callopt.Option) (r *pubsub.PublishResp, err error)
+	Subscribe(ctx context.Context, req *pubsub.SubscribeReq, callOptions ...callopt.Option) (r *pubsub.SubscribeResp, err error)
+	Poll(ctx context.Context, req *pubsub.PollReq, callOptions ...callopt.Option) (r *pubsub.PollResp, err error)
+	Unsubscribe(ctx context.Context, req *pubsub.UnsubscribeReq, callOptions ...callopt.Option) (r *pubsub.UnsubscribeResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kPubSubServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kPubSubServiceClient struct {
+	*kClient
+}
+
+func (p *kPubSubServiceClient) Publish(ctx context.Context, req *pubsub.PublishReq, callOptions ...callopt.Option) (r *pubsub.PublishResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Publish(ctx, req)
+}
+
+func (p *kPubSubServiceClient) Subscribe(ctx context.Context, req *pubsub.SubscribeReq, callOptions ...callopt.Option) (r *pubsub.SubscribeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Subscribe(ctx, req)
+}
+
+func (p *kPubSubServiceClient) Poll(ctx context.Context, req *pubsub.PollReq, callOptions ...callopt.Option) (r *pubsub.PollResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Poll(ctx, req)
+}
+
+func (p *kPubSubServiceClient) Unsubscribe(ctx context.Context, req *pubsub.UnsubscribeReq, callOptions ...callopt.Option) (r *pubsub.UnsubscribeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unsubscribe(ctx, req)
+}
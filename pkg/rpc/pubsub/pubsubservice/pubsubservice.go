@@ -0,0 +1,162 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package pubsubservice
+
+import (
+	"context"
+	pubsub "github.com/T4t4KAU/TikBase/pkg/rpc/pubsub"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return pubSubServiceServiceInfo
+}
+
+var pubSubServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "PubSubService"
+	handlerType := (*pubsub.PubSubService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"Publish":     kitex.NewMethodInfo(publishHandler, newPubSubServicePublishArgs, newPubSubServicePublishResult, false),
+		"Subscribe":   kitex.NewMethodInfo(subscribeHandler, newPubSubServiceSubscribeArgs, newPubSubServiceSubscribeResult, false),
+		"Poll":        kitex.NewMethodInfo(pollHandler, newPubSubServicePollArgs, newPubSubServicePollResult, false),
+		"Unsubscribe": kitex.NewMethodInfo(unsubscribeHandler, newPubSubServiceUnsubscribeArgs, newPubSubServiceUnsubscribeResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName":     "pubsub",
+		"ServiceFilePath": `idl/pubsub.thrift`,
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.8.0",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func publishHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*pubsub.PubSubServicePublishArgs)
+	realResult := result.(*pubsub.PubSubServicePublishResult)
+	success, err := handler.(pubsub.PubSubService).Publish(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPubSubServicePublishArgs() interface{} {
+	return pubsub.NewPubSubServicePublishArgs()
+}
+
+func newPubSubServicePublishResult() interface{} {
+	return pubsub.NewPubSubServicePublishResult()
+}
+
+func subscribeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*pubsub.PubSubServiceSubscribeArgs)
+	realResult := result.(*pubsub.PubSubServiceSubscribeResult)
+	success, err := handler.(pubsub.PubSubService).Subscribe(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPubSubServiceSubscribeArgs() interface{} {
+	return pubsub.NewPubSubServiceSubscribeArgs()
+}
+
+func newPubSubServiceSubscribeResult() interface{} {
+	return pubsub.NewPubSubServiceSubscribeResult()
+}
+
+func pollHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*pubsub.PubSubServicePollArgs)
+	realResult := result.(*pubsub.PubSubServicePollResult)
+	success, err := handler.(pubsub.PubSubService).Poll(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPubSubServicePollArgs() interface{} {
+	return pubsub.NewPubSubServicePollArgs()
+}
+
+func newPubSubServicePollResult() interface{} {
+	return pubsub.NewPubSubServicePollResult()
+}
+
+func unsubscribeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*pubsub.PubSubServiceUnsubscribeArgs)
+	realResult := result.(*pubsub.PubSubServiceUnsubscribeResult)
+	success, err := handler.(pubsub.PubSubService).Unsubscribe(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPubSubServiceUnsubscribeArgs() interface{} {
+	return pubsub.NewPubSubServiceUnsubscribeArgs()
+}
+
+func newPubSubServiceUnsubscribeResult() interface{} {
+	return pubsub.NewPubSubServiceUnsubscribeResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Publish(ctx context.Context, req *pubsub.PublishReq) (r *pubsub.PublishResp, err error) {
+	var _args pubsub.PubSubServicePublishArgs
+	_args.Req = req
+	var _result pubsub.PubSubServicePublishResult
+	if err = p.c.Call(ctx, "Publish", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Subscribe(ctx context.Context, req *pubsub.SubscribeReq) (r *pubsub.SubscribeResp, err error) {
+	var _args pubsub.PubSubServiceSubscribeArgs
+	_args.Req = req
+	var _result pubsub.PubSubServiceSubscribeResult
+	if err = p.c.Call(ctx, "Subscribe", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Poll(ctx context.Context, req *pubsub.PollReq) (r *pubsub.PollResp, err error) {
+	var _args pubsub.PubSubServicePollArgs
+	_args.Req = req
+	var _result pubsub.PubSubServicePollResult
+	if err = p.c.Call(ctx, "Poll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unsubscribe(ctx context.Context, req *pubsub.UnsubscribeReq) (r *pubsub.UnsubscribeResp, err error) {
+	var _args pubsub.PubSubServiceUnsubscribeArgs
+	_args.Req = req
+	var _result pubsub.PubSubServiceUnsubscribeResult
+	if err = p.c.Call(ctx, "Unsubscribe", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
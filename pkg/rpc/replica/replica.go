@@ -1,8 +1,9 @@
-// Code generated by thriftgo (0.2.12). DO NOT EDIT.
+// Code generated by thriftgo (0.3.3). DO NOT EDIT.
 
 package replica
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
@@ -10,9 +11,12 @@ import (
 )
 
 type JoinReq struct {
-	RaftAddr    string `thrift:"raft_addr,1,required" frugal:"1,required,string" json:"raft_addr"`
-	ServiceAddr string `thrift:"service_addr,2,required" frugal:"2,required,string" json:"service_addr"`
-	NodeId      string `thrift:"node_id,3,required" frugal:"3,required,string" json:"node_id"`
+	RaftAddr    string  `thrift:"raft_addr,1,required" frugal:"1,required,string" json:"raft_addr"`
+	ServiceAddr string  `thrift:"service_addr,2,required" frugal:"2,required,string" json:"service_addr"`
+	NodeId      string  `thrift:"node_id,3,required" frugal:"3,required,string" json:"node_id"`
+	RegionId    *int32  `thrift:"region_id,4,optional" frugal:"4,optional,i32" json:"region_id,omitempty"`
+	Regions     *int32  `thrift:"regions,5,optional" frugal:"5,optional,i32" json:"regions,omitempty"`
+	ReplicaAddr *string `thrift:"replica_addr,6,optional" frugal:"6,optional,string" json:"replica_addr,omitempty"`
 }
 
 func NewJoinReq() *JoinReq {
@@ -34,6 +38,33 @@ func (p *JoinReq) GetServiceAddr() (v string) {
 func (p *JoinReq) GetNodeId() (v string) {
 	return p.NodeId
 }
+
+var JoinReq_RegionId_DEFAULT int32
+
+func (p *JoinReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return JoinReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+
+var JoinReq_Regions_DEFAULT int32
+
+func (p *JoinReq) GetRegions() (v int32) {
+	if !p.IsSetRegions() {
+		return JoinReq_Regions_DEFAULT
+	}
+	return *p.Regions
+}
+
+var JoinReq_ReplicaAddr_DEFAULT string
+
+func (p *JoinReq) GetReplicaAddr() (v string) {
+	if !p.IsSetReplicaAddr() {
+		return JoinReq_ReplicaAddr_DEFAULT
+	}
+	return *p.ReplicaAddr
+}
 func (p *JoinReq) SetRaftAddr(val string) {
 	p.RaftAddr = val
 }
@@ -43,11 +74,35 @@ func (p *JoinReq) SetServiceAddr(val string) {
 func (p *JoinReq) SetNodeId(val string) {
 	p.NodeId = val
 }
+func (p *JoinReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+func (p *JoinReq) SetRegions(val *int32) {
+	p.Regions = val
+}
+func (p *JoinReq) SetReplicaAddr(val *string) {
+	p.ReplicaAddr = val
+}
 
 var fieldIDToName_JoinReq = map[int16]string{
 	1: "raft_addr",
 	2: "service_addr",
 	3: "node_id",
+	4: "region_id",
+	5: "regions",
+	6: "replica_addr",
+}
+
+func (p *JoinReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *JoinReq) IsSetRegions() bool {
+	return p.Regions != nil
+}
+
+func (p *JoinReq) IsSetReplicaAddr() bool {
+	return p.ReplicaAddr != nil
 }
 
 func (p *JoinReq) Read(iprot thrift.TProtocol) (err error) {
@@ -78,10 +133,10 @@ func (p *JoinReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetRaftAddr = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 2:
 			if fieldTypeId == thrift.STRING {
@@ -89,10 +144,10 @@ func (p *JoinReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetServiceAddr = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		case 3:
 			if fieldTypeId == thrift.STRING {
@@ -100,17 +155,46 @@ func (p *JoinReq) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetNodeId = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
 				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -152,6 +236,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *JoinReq) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -159,8 +244,8 @@ func (p *JoinReq) ReadField1(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *JoinReq) ReadField2(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -168,8 +253,8 @@ func (p *JoinReq) ReadField2(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
-
 func (p *JoinReq) ReadField3(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -177,6 +262,33 @@ func (p *JoinReq) ReadField3(iprot thrift.TProtocol) error {
 	}
 	return nil
 }
+func (p *JoinReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+func (p *JoinReq) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Regions = &v
+	}
+	return nil
+}
+func (p *JoinReq) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.ReplicaAddr = &v
+	}
+	return nil
+}
 
 func (p *JoinReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -196,7 +308,18 @@ func (p *JoinReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -231,7 +354,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
 func (p *JoinReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("service_addr", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
@@ -248,7 +370,6 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
 func (p *JoinReq) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
@@ -265,6 +386,60 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *JoinReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *JoinReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegions() {
+		if err = oprot.WriteFieldBegin("regions", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Regions); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *JoinReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReplicaAddr() {
+		if err = oprot.WriteFieldBegin("replica_addr", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReplicaAddr); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
 func (p *JoinReq) String() string {
 	if p == nil {
@@ -288,6 +463,15 @@ func (p *JoinReq) DeepEqual(ano *JoinReq) bool {
 	if !p.Field3DeepEqual(ano.NodeId) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.RegionId) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Regions) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ReplicaAddr) {
+		return false
+	}
 	return true
 }
 
@@ -312,6 +496,42 @@ func (p *JoinReq) Field3DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *JoinReq) Field4DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+func (p *JoinReq) Field5DeepEqual(src *int32) bool {
+
+	if p.Regions == src {
+		return true
+	} else if p.Regions == nil || src == nil {
+		return false
+	}
+	if *p.Regions != *src {
+		return false
+	}
+	return true
+}
+func (p *JoinReq) Field6DeepEqual(src *string) bool {
+
+	if p.ReplicaAddr == src {
+		return true
+	} else if p.ReplicaAddr == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ReplicaAddr, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type JoinResp struct {
 	Message string `thrift:"message,1,required" frugal:"1,required,string" json:"message"`
@@ -362,17 +582,16 @@ func (p *JoinResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetMessage = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -404,6 +623,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *JoinResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -422,7 +642,6 @@ func (p *JoinResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -518,7 +737,6 @@ func (p *GetIdReq) Read(iprot thrift.TProtocol) (err error) {
 		if err = iprot.Skip(fieldTypeId); err != nil {
 			goto SkipFieldTypeError
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -546,7 +764,6 @@ func (p *GetIdReq) Write(oprot thrift.TProtocol) (err error) {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -628,17 +845,16 @@ func (p *GetIdResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetNodeId = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -670,6 +886,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *GetIdResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -688,7 +905,6 @@ func (p *GetIdResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -752,6 +968,7 @@ func (p *GetIdResp) Field1DeepEqual(src string) bool {
 }
 
 type LeaderAddrReq struct {
+	RegionId *int32 `thrift:"region_id,1,optional" frugal:"1,optional,i32" json:"region_id,omitempty"`
 }
 
 func NewLeaderAddrReq() *LeaderAddrReq {
@@ -762,7 +979,25 @@ func (p *LeaderAddrReq) InitDefault() {
 	*p = LeaderAddrReq{}
 }
 
-var fieldIDToName_LeaderAddrReq = map[int16]string{}
+var LeaderAddrReq_RegionId_DEFAULT int32
+
+func (p *LeaderAddrReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return LeaderAddrReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *LeaderAddrReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_LeaderAddrReq = map[int16]string{
+	1: "region_id",
+}
+
+func (p *LeaderAddrReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
 
 func (p *LeaderAddrReq) Read(iprot thrift.TProtocol) (err error) {
 
@@ -781,10 +1016,23 @@ func (p *LeaderAddrReq) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
 
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -798,8 +1046,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LeaderAddrReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -807,12 +1057,26 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
+func (p *LeaderAddrReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
 func (p *LeaderAddrReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
 	if err = oprot.WriteStructBegin("LeaderAddrReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -823,12 +1087,33 @@ func (p *LeaderAddrReq) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
+func (p *LeaderAddrReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
 func (p *LeaderAddrReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -842,6 +1127,22 @@ func (p *LeaderAddrReq) DeepEqual(ano *LeaderAddrReq) bool {
 	} else if p == nil || ano == nil {
 		return false
 	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *LeaderAddrReq) Field1DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
 	return true
 }
 
@@ -894,17 +1195,16 @@ func (p *LeaderAddrResp) Read(iprot thrift.TProtocol) (err error) {
 					goto ReadFieldError
 				}
 				issetAddress = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -936,6 +1236,7 @@ RequiredFieldNotSetError:
 }
 
 func (p *LeaderAddrResp) ReadField1(iprot thrift.TProtocol) error {
+
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -954,7 +1255,6 @@ func (p *LeaderAddrResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1017,288 +1317,10785 @@ func (p *LeaderAddrResp) Field1DeepEqual(src string) bool {
 	return true
 }
 
-type ReplicaService interface {
-	Join(ctx context.Context, req *JoinReq) (r *JoinResp, err error)
+type AddNonvoterReq struct {
+	RaftAddr    string `thrift:"raft_addr,1,required" frugal:"1,required,string" json:"raft_addr"`
+	ServiceAddr string `thrift:"service_addr,2,required" frugal:"2,required,string" json:"service_addr"`
+	NodeId      string `thrift:"node_id,3,required" frugal:"3,required,string" json:"node_id"`
+	RegionId    *int32 `thrift:"region_id,4,optional" frugal:"4,optional,i32" json:"region_id,omitempty"`
+}
 
-	LeaderAddr(ctx context.Context, req *LeaderAddrReq) (r *LeaderAddrResp, err error)
+func NewAddNonvoterReq() *AddNonvoterReq {
+	return &AddNonvoterReq{}
+}
 
-	GetId(ctx context.Context, req *GetIdReq) (r *GetIdResp, err error)
+func (p *AddNonvoterReq) InitDefault() {
+	*p = AddNonvoterReq{}
+}
+
+func (p *AddNonvoterReq) GetRaftAddr() (v string) {
+	return p.RaftAddr
+}
+
+func (p *AddNonvoterReq) GetServiceAddr() (v string) {
+	return p.ServiceAddr
+}
+
+func (p *AddNonvoterReq) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+var AddNonvoterReq_RegionId_DEFAULT int32
+
+func (p *AddNonvoterReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return AddNonvoterReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *AddNonvoterReq) SetRaftAddr(val string) {
+	p.RaftAddr = val
+}
+func (p *AddNonvoterReq) SetServiceAddr(val string) {
+	p.ServiceAddr = val
+}
+func (p *AddNonvoterReq) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *AddNonvoterReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_AddNonvoterReq = map[int16]string{
+	1: "raft_addr",
+	2: "service_addr",
+	3: "node_id",
+	4: "region_id",
+}
+
+func (p *AddNonvoterReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *AddNonvoterReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRaftAddr bool = false
+	var issetServiceAddr bool = false
+	var issetNodeId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRaftAddr = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetServiceAddr = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRaftAddr {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetServiceAddr {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNodeId {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddNonvoterReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddNonvoterReq[fieldId]))
+}
+
+func (p *AddNonvoterReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.RaftAddr = v
+	}
+	return nil
+}
+func (p *AddNonvoterReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.ServiceAddr = v
+	}
+	return nil
+}
+func (p *AddNonvoterReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *AddNonvoterReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *AddNonvoterReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddNonvoterReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddNonvoterReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("raft_addr", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RaftAddr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AddNonvoterReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("service_addr", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ServiceAddr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AddNonvoterReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AddNonvoterReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AddNonvoterReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddNonvoterReq(%+v)", *p)
+}
+
+func (p *AddNonvoterReq) DeepEqual(ano *AddNonvoterReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RaftAddr) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ServiceAddr) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *AddNonvoterReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.RaftAddr, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddNonvoterReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.ServiceAddr, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddNonvoterReq) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddNonvoterReq) Field4DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type AddNonvoterResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewAddNonvoterResp() *AddNonvoterResp {
+	return &AddNonvoterResp{}
+}
+
+func (p *AddNonvoterResp) InitDefault() {
+	*p = AddNonvoterResp{}
+}
+
+func (p *AddNonvoterResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *AddNonvoterResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *AddNonvoterResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *AddNonvoterResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_AddNonvoterResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *AddNonvoterResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddNonvoterResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddNonvoterResp[fieldId]))
+}
+
+func (p *AddNonvoterResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *AddNonvoterResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *AddNonvoterResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddNonvoterResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddNonvoterResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AddNonvoterResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddNonvoterResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddNonvoterResp(%+v)", *p)
+}
+
+func (p *AddNonvoterResp) DeepEqual(ano *AddNonvoterResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *AddNonvoterResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *AddNonvoterResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RemoveServerReq struct {
+	NodeId   string `thrift:"node_id,1,required" frugal:"1,required,string" json:"node_id"`
+	RegionId *int32 `thrift:"region_id,2,optional" frugal:"2,optional,i32" json:"region_id,omitempty"`
+}
+
+func NewRemoveServerReq() *RemoveServerReq {
+	return &RemoveServerReq{}
+}
+
+func (p *RemoveServerReq) InitDefault() {
+	*p = RemoveServerReq{}
+}
+
+func (p *RemoveServerReq) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+var RemoveServerReq_RegionId_DEFAULT int32
+
+func (p *RemoveServerReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return RemoveServerReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *RemoveServerReq) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *RemoveServerReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_RemoveServerReq = map[int16]string{
+	1: "node_id",
+	2: "region_id",
+}
+
+func (p *RemoveServerReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *RemoveServerReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodeId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetNodeId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveServerReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RemoveServerReq[fieldId]))
+}
+
+func (p *RemoveServerReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *RemoveServerReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *RemoveServerReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveServerReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveServerReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RemoveServerReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RemoveServerReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveServerReq(%+v)", *p)
+}
+
+func (p *RemoveServerReq) DeepEqual(ano *RemoveServerReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveServerReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RemoveServerReq) Field2DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type RemoveServerResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewRemoveServerResp() *RemoveServerResp {
+	return &RemoveServerResp{}
+}
+
+func (p *RemoveServerResp) InitDefault() {
+	*p = RemoveServerResp{}
+}
+
+func (p *RemoveServerResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *RemoveServerResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *RemoveServerResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *RemoveServerResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_RemoveServerResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *RemoveServerResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveServerResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RemoveServerResp[fieldId]))
+}
+
+func (p *RemoveServerResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *RemoveServerResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *RemoveServerResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveServerResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveServerResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RemoveServerResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RemoveServerResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveServerResp(%+v)", *p)
+}
+
+func (p *RemoveServerResp) DeepEqual(ano *RemoveServerResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveServerResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *RemoveServerResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type DemoteVoterReq struct {
+	NodeId   string `thrift:"node_id,1,required" frugal:"1,required,string" json:"node_id"`
+	RegionId *int32 `thrift:"region_id,2,optional" frugal:"2,optional,i32" json:"region_id,omitempty"`
+}
+
+func NewDemoteVoterReq() *DemoteVoterReq {
+	return &DemoteVoterReq{}
+}
+
+func (p *DemoteVoterReq) InitDefault() {
+	*p = DemoteVoterReq{}
+}
+
+func (p *DemoteVoterReq) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+var DemoteVoterReq_RegionId_DEFAULT int32
+
+func (p *DemoteVoterReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return DemoteVoterReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *DemoteVoterReq) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *DemoteVoterReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_DemoteVoterReq = map[int16]string{
+	1: "node_id",
+	2: "region_id",
+}
+
+func (p *DemoteVoterReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *DemoteVoterReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodeId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetNodeId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DemoteVoterReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DemoteVoterReq[fieldId]))
+}
+
+func (p *DemoteVoterReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *DemoteVoterReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *DemoteVoterReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DemoteVoterReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DemoteVoterReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DemoteVoterReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DemoteVoterReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DemoteVoterReq(%+v)", *p)
+}
+
+func (p *DemoteVoterReq) DeepEqual(ano *DemoteVoterReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *DemoteVoterReq) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DemoteVoterReq) Field2DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type DemoteVoterResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewDemoteVoterResp() *DemoteVoterResp {
+	return &DemoteVoterResp{}
+}
+
+func (p *DemoteVoterResp) InitDefault() {
+	*p = DemoteVoterResp{}
+}
+
+func (p *DemoteVoterResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DemoteVoterResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *DemoteVoterResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DemoteVoterResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_DemoteVoterResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *DemoteVoterResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DemoteVoterResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DemoteVoterResp[fieldId]))
+}
+
+func (p *DemoteVoterResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *DemoteVoterResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *DemoteVoterResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DemoteVoterResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DemoteVoterResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DemoteVoterResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DemoteVoterResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DemoteVoterResp(%+v)", *p)
+}
+
+func (p *DemoteVoterResp) DeepEqual(ano *DemoteVoterResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *DemoteVoterResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *DemoteVoterResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TransferLeadershipReq struct {
+	NodeId   *string `thrift:"node_id,1,optional" frugal:"1,optional,string" json:"node_id,omitempty"`
+	RegionId *int32  `thrift:"region_id,2,optional" frugal:"2,optional,i32" json:"region_id,omitempty"`
+}
+
+func NewTransferLeadershipReq() *TransferLeadershipReq {
+	return &TransferLeadershipReq{}
+}
+
+func (p *TransferLeadershipReq) InitDefault() {
+	*p = TransferLeadershipReq{}
+}
+
+var TransferLeadershipReq_NodeId_DEFAULT string
+
+func (p *TransferLeadershipReq) GetNodeId() (v string) {
+	if !p.IsSetNodeId() {
+		return TransferLeadershipReq_NodeId_DEFAULT
+	}
+	return *p.NodeId
+}
+
+var TransferLeadershipReq_RegionId_DEFAULT int32
+
+func (p *TransferLeadershipReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return TransferLeadershipReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *TransferLeadershipReq) SetNodeId(val *string) {
+	p.NodeId = val
+}
+func (p *TransferLeadershipReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_TransferLeadershipReq = map[int16]string{
+	1: "node_id",
+	2: "region_id",
+}
+
+func (p *TransferLeadershipReq) IsSetNodeId() bool {
+	return p.NodeId != nil
+}
+
+func (p *TransferLeadershipReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *TransferLeadershipReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TransferLeadershipReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TransferLeadershipReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = &v
+	}
+	return nil
+}
+func (p *TransferLeadershipReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *TransferLeadershipReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferLeadershipReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TransferLeadershipReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNodeId() {
+		if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NodeId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TransferLeadershipReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TransferLeadershipReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TransferLeadershipReq(%+v)", *p)
+}
+
+func (p *TransferLeadershipReq) DeepEqual(ano *TransferLeadershipReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *TransferLeadershipReq) Field1DeepEqual(src *string) bool {
+
+	if p.NodeId == src {
+		return true
+	} else if p.NodeId == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NodeId, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TransferLeadershipReq) Field2DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type TransferLeadershipResp struct {
+	Success bool   `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+}
+
+func NewTransferLeadershipResp() *TransferLeadershipResp {
+	return &TransferLeadershipResp{}
+}
+
+func (p *TransferLeadershipResp) InitDefault() {
+	*p = TransferLeadershipResp{}
+}
+
+func (p *TransferLeadershipResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *TransferLeadershipResp) GetMessage() (v string) {
+	return p.Message
+}
+func (p *TransferLeadershipResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *TransferLeadershipResp) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_TransferLeadershipResp = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *TransferLeadershipResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TransferLeadershipResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TransferLeadershipResp[fieldId]))
+}
+
+func (p *TransferLeadershipResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *TransferLeadershipResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *TransferLeadershipResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferLeadershipResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TransferLeadershipResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TransferLeadershipResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TransferLeadershipResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TransferLeadershipResp(%+v)", *p)
+}
+
+func (p *TransferLeadershipResp) DeepEqual(ano *TransferLeadershipResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *TransferLeadershipResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *TransferLeadershipResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type Member struct {
+	NodeId   string `thrift:"node_id,1,required" frugal:"1,required,string" json:"node_id"`
+	RaftAddr string `thrift:"raft_addr,2,required" frugal:"2,required,string" json:"raft_addr"`
+	Suffrage string `thrift:"suffrage,3,required" frugal:"3,required,string" json:"suffrage"`
+	Leader   bool   `thrift:"leader,4,required" frugal:"4,required,bool" json:"leader"`
+}
+
+func NewMember() *Member {
+	return &Member{}
+}
+
+func (p *Member) InitDefault() {
+	*p = Member{}
+}
+
+func (p *Member) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+func (p *Member) GetRaftAddr() (v string) {
+	return p.RaftAddr
+}
+
+func (p *Member) GetSuffrage() (v string) {
+	return p.Suffrage
+}
+
+func (p *Member) GetLeader() (v bool) {
+	return p.Leader
+}
+func (p *Member) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *Member) SetRaftAddr(val string) {
+	p.RaftAddr = val
+}
+func (p *Member) SetSuffrage(val string) {
+	p.Suffrage = val
+}
+func (p *Member) SetLeader(val bool) {
+	p.Leader = val
+}
+
+var fieldIDToName_Member = map[int16]string{
+	1: "node_id",
+	2: "raft_addr",
+	3: "suffrage",
+	4: "leader",
+}
+
+func (p *Member) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodeId bool = false
+	var issetRaftAddr bool = false
+	var issetSuffrage bool = false
+	var issetLeader bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRaftAddr = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuffrage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLeader = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetNodeId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRaftAddr {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuffrage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLeader {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Member[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Member[fieldId]))
+}
+
+func (p *Member) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *Member) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.RaftAddr = v
+	}
+	return nil
+}
+func (p *Member) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Suffrage = v
+	}
+	return nil
+}
+func (p *Member) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Leader = v
+	}
+	return nil
+}
+
+func (p *Member) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Member"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Member) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Member) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("raft_addr", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RaftAddr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Member) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("suffrage", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Suffrage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Member) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Leader); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *Member) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Member(%+v)", *p)
+}
+
+func (p *Member) DeepEqual(ano *Member) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RaftAddr) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Suffrage) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Leader) {
+		return false
+	}
+	return true
+}
+
+func (p *Member) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Member) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.RaftAddr, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Member) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Suffrage, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Member) Field4DeepEqual(src bool) bool {
+
+	if p.Leader != src {
+		return false
+	}
+	return true
+}
+
+type MembersReq struct {
+	RegionId *int32 `thrift:"region_id,1,optional" frugal:"1,optional,i32" json:"region_id,omitempty"`
+}
+
+func NewMembersReq() *MembersReq {
+	return &MembersReq{}
+}
+
+func (p *MembersReq) InitDefault() {
+	*p = MembersReq{}
+}
+
+var MembersReq_RegionId_DEFAULT int32
+
+func (p *MembersReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return MembersReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *MembersReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_MembersReq = map[int16]string{
+	1: "region_id",
+}
+
+func (p *MembersReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *MembersReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MembersReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MembersReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *MembersReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MembersReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MembersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MembersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MembersReq(%+v)", *p)
+}
+
+func (p *MembersReq) DeepEqual(ano *MembersReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *MembersReq) Field1DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type MembersResp struct {
+	Success bool      `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message string    `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+	Members []*Member `thrift:"members,3,required" frugal:"3,required,list<Member>" json:"members"`
+}
+
+func NewMembersResp() *MembersResp {
+	return &MembersResp{}
+}
+
+func (p *MembersResp) InitDefault() {
+	*p = MembersResp{}
+}
+
+func (p *MembersResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *MembersResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *MembersResp) GetMembers() (v []*Member) {
+	return p.Members
+}
+func (p *MembersResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *MembersResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *MembersResp) SetMembers(val []*Member) {
+	p.Members = val
+}
+
+var fieldIDToName_MembersResp = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "members",
+}
+
+func (p *MembersResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+	var issetMembers bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMembers = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMembers {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MembersResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_MembersResp[fieldId]))
+}
+
+func (p *MembersResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *MembersResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+func (p *MembersResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Members = make([]*Member, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMember()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Members = append(p.Members, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MembersResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MembersResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MembersResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *MembersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *MembersResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("members", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+		return err
+	}
+	for _, v := range p.Members {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MembersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MembersResp(%+v)", *p)
+}
+
+func (p *MembersResp) DeepEqual(ano *MembersResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Members) {
+		return false
+	}
+	return true
+}
+
+func (p *MembersResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *MembersResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MembersResp) Field3DeepEqual(src []*Member) bool {
+
+	if len(p.Members) != len(src) {
+		return false
+	}
+	for i, v := range p.Members {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type RaftTuning struct {
+	SnapshotInterval   int64 `thrift:"snapshot_interval,1,required" frugal:"1,required,i64" json:"snapshot_interval"`
+	SnapshotThreshold  int64 `thrift:"snapshot_threshold,2,required" frugal:"2,required,i64" json:"snapshot_threshold"`
+	TrailingLogs       int64 `thrift:"trailing_logs,3,required" frugal:"3,required,i64" json:"trailing_logs"`
+	HeartbeatTimeout   int64 `thrift:"heartbeat_timeout,4,required" frugal:"4,required,i64" json:"heartbeat_timeout"`
+	ElectionTimeout    int64 `thrift:"election_timeout,5,required" frugal:"5,required,i64" json:"election_timeout"`
+	LeaderLeaseTimeout int64 `thrift:"leader_lease_timeout,6,required" frugal:"6,required,i64" json:"leader_lease_timeout"`
+	MaxAppendEntries   int32 `thrift:"max_append_entries,7,required" frugal:"7,required,i32" json:"max_append_entries"`
+	RetainSnapshots    int32 `thrift:"retain_snapshots,8,required" frugal:"8,required,i32" json:"retain_snapshots"`
+}
+
+func NewRaftTuning() *RaftTuning {
+	return &RaftTuning{}
+}
+
+func (p *RaftTuning) InitDefault() {
+	*p = RaftTuning{}
+}
+
+func (p *RaftTuning) GetSnapshotInterval() (v int64) {
+	return p.SnapshotInterval
+}
+
+func (p *RaftTuning) GetSnapshotThreshold() (v int64) {
+	return p.SnapshotThreshold
+}
+
+func (p *RaftTuning) GetTrailingLogs() (v int64) {
+	return p.TrailingLogs
+}
+
+func (p *RaftTuning) GetHeartbeatTimeout() (v int64) {
+	return p.HeartbeatTimeout
+}
+
+func (p *RaftTuning) GetElectionTimeout() (v int64) {
+	return p.ElectionTimeout
+}
+
+func (p *RaftTuning) GetLeaderLeaseTimeout() (v int64) {
+	return p.LeaderLeaseTimeout
+}
+
+func (p *RaftTuning) GetMaxAppendEntries() (v int32) {
+	return p.MaxAppendEntries
+}
+
+func (p *RaftTuning) GetRetainSnapshots() (v int32) {
+	return p.RetainSnapshots
+}
+func (p *RaftTuning) SetSnapshotInterval(val int64) {
+	p.SnapshotInterval = val
+}
+func (p *RaftTuning) SetSnapshotThreshold(val int64) {
+	p.SnapshotThreshold = val
+}
+func (p *RaftTuning) SetTrailingLogs(val int64) {
+	p.TrailingLogs = val
+}
+func (p *RaftTuning) SetHeartbeatTimeout(val int64) {
+	p.HeartbeatTimeout = val
+}
+func (p *RaftTuning) SetElectionTimeout(val int64) {
+	p.ElectionTimeout = val
+}
+func (p *RaftTuning) SetLeaderLeaseTimeout(val int64) {
+	p.LeaderLeaseTimeout = val
+}
+func (p *RaftTuning) SetMaxAppendEntries(val int32) {
+	p.MaxAppendEntries = val
+}
+func (p *RaftTuning) SetRetainSnapshots(val int32) {
+	p.RetainSnapshots = val
+}
+
+var fieldIDToName_RaftTuning = map[int16]string{
+	1: "snapshot_interval",
+	2: "snapshot_threshold",
+	3: "trailing_logs",
+	4: "heartbeat_timeout",
+	5: "election_timeout",
+	6: "leader_lease_timeout",
+	7: "max_append_entries",
+	8: "retain_snapshots",
+}
+
+func (p *RaftTuning) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSnapshotInterval bool = false
+	var issetSnapshotThreshold bool = false
+	var issetTrailingLogs bool = false
+	var issetHeartbeatTimeout bool = false
+	var issetElectionTimeout bool = false
+	var issetLeaderLeaseTimeout bool = false
+	var issetMaxAppendEntries bool = false
+	var issetRetainSnapshots bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSnapshotInterval = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSnapshotThreshold = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTrailingLogs = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHeartbeatTimeout = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetElectionTimeout = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLeaderLeaseTimeout = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaxAppendEntries = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRetainSnapshots = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSnapshotInterval {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSnapshotThreshold {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTrailingLogs {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHeartbeatTimeout {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetElectionTimeout {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLeaderLeaseTimeout {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaxAppendEntries {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRetainSnapshots {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RaftTuning[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RaftTuning[fieldId]))
+}
+
+func (p *RaftTuning) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SnapshotInterval = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SnapshotThreshold = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.TrailingLogs = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.HeartbeatTimeout = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ElectionTimeout = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LeaderLeaseTimeout = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MaxAppendEntries = v
+	}
+	return nil
+}
+func (p *RaftTuning) ReadField8(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RetainSnapshots = v
+	}
+	return nil
+}
+
+func (p *RaftTuning) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftTuning"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RaftTuning) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("snapshot_interval", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SnapshotInterval); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RaftTuning) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("snapshot_threshold", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SnapshotThreshold); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RaftTuning) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("trailing_logs", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TrailingLogs); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RaftTuning) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("heartbeat_timeout", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.HeartbeatTimeout); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RaftTuning) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("election_timeout", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ElectionTimeout); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RaftTuning) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader_lease_timeout", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LeaderLeaseTimeout); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *RaftTuning) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_append_entries", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MaxAppendEntries); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *RaftTuning) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("retain_snapshots", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RetainSnapshots); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *RaftTuning) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RaftTuning(%+v)", *p)
+}
+
+func (p *RaftTuning) DeepEqual(ano *RaftTuning) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SnapshotInterval) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SnapshotThreshold) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TrailingLogs) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.HeartbeatTimeout) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ElectionTimeout) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.LeaderLeaseTimeout) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.MaxAppendEntries) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.RetainSnapshots) {
+		return false
+	}
+	return true
+}
+
+func (p *RaftTuning) Field1DeepEqual(src int64) bool {
+
+	if p.SnapshotInterval != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field2DeepEqual(src int64) bool {
+
+	if p.SnapshotThreshold != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field3DeepEqual(src int64) bool {
+
+	if p.TrailingLogs != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field4DeepEqual(src int64) bool {
+
+	if p.HeartbeatTimeout != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field5DeepEqual(src int64) bool {
+
+	if p.ElectionTimeout != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field6DeepEqual(src int64) bool {
+
+	if p.LeaderLeaseTimeout != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field7DeepEqual(src int32) bool {
+
+	if p.MaxAppendEntries != src {
+		return false
+	}
+	return true
+}
+func (p *RaftTuning) Field8DeepEqual(src int32) bool {
+
+	if p.RetainSnapshots != src {
+		return false
+	}
+	return true
+}
+
+type StatusReq struct {
+	RegionId *int32 `thrift:"region_id,1,optional" frugal:"1,optional,i32" json:"region_id,omitempty"`
+}
+
+func NewStatusReq() *StatusReq {
+	return &StatusReq{}
+}
+
+func (p *StatusReq) InitDefault() {
+	*p = StatusReq{}
+}
+
+var StatusReq_RegionId_DEFAULT int32
+
+func (p *StatusReq) GetRegionId() (v int32) {
+	if !p.IsSetRegionId() {
+		return StatusReq_RegionId_DEFAULT
+	}
+	return *p.RegionId
+}
+func (p *StatusReq) SetRegionId(val *int32) {
+	p.RegionId = val
+}
+
+var fieldIDToName_StatusReq = map[int16]string{
+	1: "region_id",
+}
+
+func (p *StatusReq) IsSetRegionId() bool {
+	return p.RegionId != nil
+}
+
+func (p *StatusReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StatusReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *StatusReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = &v
+	}
+	return nil
+}
+
+func (p *StatusReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("StatusReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *StatusReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegionId() {
+		if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RegionId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *StatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StatusReq(%+v)", *p)
+}
+
+func (p *StatusReq) DeepEqual(ano *StatusReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	return true
+}
+
+func (p *StatusReq) Field1DeepEqual(src *int32) bool {
+
+	if p.RegionId == src {
+		return true
+	} else if p.RegionId == nil || src == nil {
+		return false
+	}
+	if *p.RegionId != *src {
+		return false
+	}
+	return true
+}
+
+type Progress struct {
+	NodeId      string `thrift:"node_id,1,required" frugal:"1,required,string" json:"node_id"`
+	RaftAddr    string `thrift:"raft_addr,2,required" frugal:"2,required,string" json:"raft_addr"`
+	Suffrage    string `thrift:"suffrage,3,required" frugal:"3,required,string" json:"suffrage"`
+	MatchIndex  int64  `thrift:"match_index,4,required" frugal:"4,required,i64" json:"match_index"`
+	LagEntries  int64  `thrift:"lag_entries,5,required" frugal:"5,required,i64" json:"lag_entries"`
+	LagTime     int64  `thrift:"lag_time,6,required" frugal:"6,required,i64" json:"lag_time"`
+	LastContact int64  `thrift:"last_contact,7,required" frugal:"7,required,i64" json:"last_contact"`
+}
+
+func NewProgress() *Progress {
+	return &Progress{}
+}
+
+func (p *Progress) InitDefault() {
+	*p = Progress{}
+}
+
+func (p *Progress) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+func (p *Progress) GetRaftAddr() (v string) {
+	return p.RaftAddr
+}
+
+func (p *Progress) GetSuffrage() (v string) {
+	return p.Suffrage
+}
+
+func (p *Progress) GetMatchIndex() (v int64) {
+	return p.MatchIndex
+}
+
+func (p *Progress) GetLagEntries() (v int64) {
+	return p.LagEntries
+}
+
+func (p *Progress) GetLagTime() (v int64) {
+	return p.LagTime
+}
+
+func (p *Progress) GetLastContact() (v int64) {
+	return p.LastContact
+}
+func (p *Progress) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *Progress) SetRaftAddr(val string) {
+	p.RaftAddr = val
+}
+func (p *Progress) SetSuffrage(val string) {
+	p.Suffrage = val
+}
+func (p *Progress) SetMatchIndex(val int64) {
+	p.MatchIndex = val
+}
+func (p *Progress) SetLagEntries(val int64) {
+	p.LagEntries = val
+}
+func (p *Progress) SetLagTime(val int64) {
+	p.LagTime = val
+}
+func (p *Progress) SetLastContact(val int64) {
+	p.LastContact = val
+}
+
+var fieldIDToName_Progress = map[int16]string{
+	1: "node_id",
+	2: "raft_addr",
+	3: "suffrage",
+	4: "match_index",
+	5: "lag_entries",
+	6: "lag_time",
+	7: "last_contact",
+}
+
+func (p *Progress) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodeId bool = false
+	var issetRaftAddr bool = false
+	var issetSuffrage bool = false
+	var issetMatchIndex bool = false
+	var issetLagEntries bool = false
+	var issetLagTime bool = false
+	var issetLastContact bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRaftAddr = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuffrage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMatchIndex = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLagEntries = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLagTime = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastContact = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetNodeId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRaftAddr {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuffrage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMatchIndex {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLagEntries {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLagTime {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastContact {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Progress[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Progress[fieldId]))
+}
+
+func (p *Progress) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *Progress) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.RaftAddr = v
+	}
+	return nil
+}
+func (p *Progress) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Suffrage = v
+	}
+	return nil
+}
+func (p *Progress) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.MatchIndex = v
+	}
+	return nil
+}
+func (p *Progress) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LagEntries = v
+	}
+	return nil
+}
+func (p *Progress) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LagTime = v
+	}
+	return nil
+}
+func (p *Progress) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastContact = v
+	}
+	return nil
+}
+
+func (p *Progress) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Progress"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Progress) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Progress) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("raft_addr", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RaftAddr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Progress) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("suffrage", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Suffrage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Progress) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_index", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MatchIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Progress) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lag_entries", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LagEntries); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Progress) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lag_time", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LagTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Progress) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_contact", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastContact); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Progress) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Progress(%+v)", *p)
+}
+
+func (p *Progress) DeepEqual(ano *Progress) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RaftAddr) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Suffrage) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.MatchIndex) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.LagEntries) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.LagTime) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.LastContact) {
+		return false
+	}
+	return true
+}
+
+func (p *Progress) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.RaftAddr, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Suffrage, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field4DeepEqual(src int64) bool {
+
+	if p.MatchIndex != src {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field5DeepEqual(src int64) bool {
+
+	if p.LagEntries != src {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field6DeepEqual(src int64) bool {
+
+	if p.LagTime != src {
+		return false
+	}
+	return true
+}
+func (p *Progress) Field7DeepEqual(src int64) bool {
+
+	if p.LastContact != src {
+		return false
+	}
+	return true
+}
+
+type StatusResp struct {
+	Success       bool        `thrift:"success,1,required" frugal:"1,required,bool" json:"success"`
+	Message       string      `thrift:"message,2,required" frugal:"2,required,string" json:"message"`
+	NodeId        string      `thrift:"node_id,3,required" frugal:"3,required,string" json:"node_id"`
+	State         string      `thrift:"state,4,required" frugal:"4,required,string" json:"state"`
+	Leader        string      `thrift:"leader,5,required" frugal:"5,required,string" json:"leader"`
+	Tuning        *RaftTuning `thrift:"tuning,6,required" frugal:"6,required,RaftTuning" json:"tuning"`
+	RaftAddr      *string     `thrift:"raft_addr,7,optional" frugal:"7,optional,string" json:"raft_addr,omitempty"`
+	Term          *int64      `thrift:"term,8,optional" frugal:"8,optional,i64" json:"term,omitempty"`
+	LastIndex     *int64      `thrift:"last_index,9,optional" frugal:"9,optional,i64" json:"last_index,omitempty"`
+	CommitIndex   *int64      `thrift:"commit_index,10,optional" frugal:"10,optional,i64" json:"commit_index,omitempty"`
+	AppliedIndex  *int64      `thrift:"applied_index,11,optional" frugal:"11,optional,i64" json:"applied_index,omitempty"`
+	SnapshotIndex *int64      `thrift:"snapshot_index,12,optional" frugal:"12,optional,i64" json:"snapshot_index,omitempty"`
+	LastContact   *int64      `thrift:"last_contact,13,optional" frugal:"13,optional,i64" json:"last_contact,omitempty"`
+	Replicas      *int32      `thrift:"replicas,14,optional" frugal:"14,optional,i32" json:"replicas,omitempty"`
+	Followers     []*Progress `thrift:"followers,15,optional" frugal:"15,optional,list<Progress>" json:"followers,omitempty"`
+}
+
+func NewStatusResp() *StatusResp {
+	return &StatusResp{}
+}
+
+func (p *StatusResp) InitDefault() {
+	*p = StatusResp{}
+}
+
+func (p *StatusResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *StatusResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *StatusResp) GetNodeId() (v string) {
+	return p.NodeId
+}
+
+func (p *StatusResp) GetState() (v string) {
+	return p.State
+}
+
+func (p *StatusResp) GetLeader() (v string) {
+	return p.Leader
+}
+
+var StatusResp_Tuning_DEFAULT *RaftTuning
+
+func (p *StatusResp) GetTuning() (v *RaftTuning) {
+	if !p.IsSetTuning() {
+		return StatusResp_Tuning_DEFAULT
+	}
+	return p.Tuning
+}
+
+var StatusResp_RaftAddr_DEFAULT string
+
+func (p *StatusResp) GetRaftAddr() (v string) {
+	if !p.IsSetRaftAddr() {
+		return StatusResp_RaftAddr_DEFAULT
+	}
+	return *p.RaftAddr
+}
+
+var StatusResp_Term_DEFAULT int64
+
+func (p *StatusResp) GetTerm() (v int64) {
+	if !p.IsSetTerm() {
+		return StatusResp_Term_DEFAULT
+	}
+	return *p.Term
+}
+
+var StatusResp_LastIndex_DEFAULT int64
+
+func (p *StatusResp) GetLastIndex() (v int64) {
+	if !p.IsSetLastIndex() {
+		return StatusResp_LastIndex_DEFAULT
+	}
+	return *p.LastIndex
+}
+
+var StatusResp_CommitIndex_DEFAULT int64
+
+func (p *StatusResp) GetCommitIndex() (v int64) {
+	if !p.IsSetCommitIndex() {
+		return StatusResp_CommitIndex_DEFAULT
+	}
+	return *p.CommitIndex
+}
+
+var StatusResp_AppliedIndex_DEFAULT int64
+
+func (p *StatusResp) GetAppliedIndex() (v int64) {
+	if !p.IsSetAppliedIndex() {
+		return StatusResp_AppliedIndex_DEFAULT
+	}
+	return *p.AppliedIndex
+}
+
+var StatusResp_SnapshotIndex_DEFAULT int64
+
+func (p *StatusResp) GetSnapshotIndex() (v int64) {
+	if !p.IsSetSnapshotIndex() {
+		return StatusResp_SnapshotIndex_DEFAULT
+	}
+	return *p.SnapshotIndex
+}
+
+var StatusResp_LastContact_DEFAULT int64
+
+func (p *StatusResp) GetLastContact() (v int64) {
+	if !p.IsSetLastContact() {
+		return StatusResp_LastContact_DEFAULT
+	}
+	return *p.LastContact
+}
+
+var StatusResp_Replicas_DEFAULT int32
+
+func (p *StatusResp) GetReplicas() (v int32) {
+	if !p.IsSetReplicas() {
+		return StatusResp_Replicas_DEFAULT
+	}
+	return *p.Replicas
+}
+
+var StatusResp_Followers_DEFAULT []*Progress
+
+func (p *StatusResp) GetFollowers() (v []*Progress) {
+	if !p.IsSetFollowers() {
+		return StatusResp_Followers_DEFAULT
+	}
+	return p.Followers
+}
+func (p *StatusResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *StatusResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *StatusResp) SetNodeId(val string) {
+	p.NodeId = val
+}
+func (p *StatusResp) SetState(val string) {
+	p.State = val
+}
+func (p *StatusResp) SetLeader(val string) {
+	p.Leader = val
+}
+func (p *StatusResp) SetTuning(val *RaftTuning) {
+	p.Tuning = val
+}
+func (p *StatusResp) SetRaftAddr(val *string) {
+	p.RaftAddr = val
+}
+func (p *StatusResp) SetTerm(val *int64) {
+	p.Term = val
+}
+func (p *StatusResp) SetLastIndex(val *int64) {
+	p.LastIndex = val
+}
+func (p *StatusResp) SetCommitIndex(val *int64) {
+	p.CommitIndex = val
+}
+func (p *StatusResp) SetAppliedIndex(val *int64) {
+	p.AppliedIndex = val
+}
+func (p *StatusResp) SetSnapshotIndex(val *int64) {
+	p.SnapshotIndex = val
+}
+func (p *StatusResp) SetLastContact(val *int64) {
+	p.LastContact = val
+}
+func (p *StatusResp) SetReplicas(val *int32) {
+	p.Replicas = val
+}
+func (p *StatusResp) SetFollowers(val []*Progress) {
+	p.Followers = val
+}
+
+var fieldIDToName_StatusResp = map[int16]string{
+	1:  "success",
+	2:  "message",
+	3:  "node_id",
+	4:  "state",
+	5:  "leader",
+	6:  "tuning",
+	7:  "raft_addr",
+	8:  "term",
+	9:  "last_index",
+	10: "commit_index",
+	11: "applied_index",
+	12: "snapshot_index",
+	13: "last_contact",
+	14: "replicas",
+	15: "followers",
+}
+
+func (p *StatusResp) IsSetTuning() bool {
+	return p.Tuning != nil
+}
+
+func (p *StatusResp) IsSetRaftAddr() bool {
+	return p.RaftAddr != nil
+}
+
+func (p *StatusResp) IsSetTerm() bool {
+	return p.Term != nil
+}
+
+func (p *StatusResp) IsSetLastIndex() bool {
+	return p.LastIndex != nil
+}
+
+func (p *StatusResp) IsSetCommitIndex() bool {
+	return p.CommitIndex != nil
+}
+
+func (p *StatusResp) IsSetAppliedIndex() bool {
+	return p.AppliedIndex != nil
+}
+
+func (p *StatusResp) IsSetSnapshotIndex() bool {
+	return p.SnapshotIndex != nil
+}
+
+func (p *StatusResp) IsSetLastContact() bool {
+	return p.LastContact != nil
+}
+
+func (p *StatusResp) IsSetReplicas() bool {
+	return p.Replicas != nil
+}
+
+func (p *StatusResp) IsSetFollowers() bool {
+	return p.Followers != nil
+}
+
+func (p *StatusResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetMessage bool = false
+	var issetNodeId bool = false
+	var issetState bool = false
+	var issetLeader bool = false
+	var issetTuning bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodeId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetState = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLeader = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTuning = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNodeId {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetState {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLeader {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTuning {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StatusResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_StatusResp[fieldId]))
+}
+
+func (p *StatusResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Success = v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NodeId = v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.State = v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Leader = v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField6(iprot thrift.TProtocol) error {
+	p.Tuning = NewRaftTuning()
+
+	if err := p.Tuning.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+func (p *StatusResp) ReadField7(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.RaftAddr = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField8(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Term = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField9(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastIndex = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField10(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CommitIndex = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField11(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.AppliedIndex = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField12(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SnapshotIndex = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField13(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.LastContact = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField14(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Replicas = &v
+	}
+	return nil
+}
+func (p *StatusResp) ReadField15(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Followers = make([]*Progress, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewProgress()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Followers = append(p.Followers, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *StatusResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("StatusResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *StatusResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *StatusResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *StatusResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *StatusResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("state", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.State); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *StatusResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Leader); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *StatusResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tuning", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Tuning.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *StatusResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRaftAddr() {
+		if err = oprot.WriteFieldBegin("raft_addr", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RaftAddr); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *StatusResp) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTerm() {
+		if err = oprot.WriteFieldBegin("term", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Term); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *StatusResp) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastIndex() {
+		if err = oprot.WriteFieldBegin("last_index", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *StatusResp) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommitIndex() {
+		if err = oprot.WriteFieldBegin("commit_index", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CommitIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *StatusResp) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAppliedIndex() {
+		if err = oprot.WriteFieldBegin("applied_index", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.AppliedIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *StatusResp) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSnapshotIndex() {
+		if err = oprot.WriteFieldBegin("snapshot_index", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SnapshotIndex); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *StatusResp) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastContact() {
+		if err = oprot.WriteFieldBegin("last_contact", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastContact); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *StatusResp) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReplicas() {
+		if err = oprot.WriteFieldBegin("replicas", thrift.I32, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Replicas); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+func (p *StatusResp) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFollowers() {
+		if err = oprot.WriteFieldBegin("followers", thrift.LIST, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Followers)); err != nil {
+			return err
+		}
+		for _, v := range p.Followers {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *StatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StatusResp(%+v)", *p)
+}
+
+func (p *StatusResp) DeepEqual(ano *StatusResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Success) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.NodeId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.State) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Leader) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Tuning) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.RaftAddr) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Term) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.LastIndex) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.CommitIndex) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.AppliedIndex) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.SnapshotIndex) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.LastContact) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Replicas) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.Followers) {
+		return false
+	}
+	return true
+}
+
+func (p *StatusResp) Field1DeepEqual(src bool) bool {
+
+	if p.Success != src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.NodeId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.State, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Leader, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field6DeepEqual(src *RaftTuning) bool {
+
+	if !p.Tuning.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field7DeepEqual(src *string) bool {
+
+	if p.RaftAddr == src {
+		return true
+	} else if p.RaftAddr == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RaftAddr, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field8DeepEqual(src *int64) bool {
+
+	if p.Term == src {
+		return true
+	} else if p.Term == nil || src == nil {
+		return false
+	}
+	if *p.Term != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field9DeepEqual(src *int64) bool {
+
+	if p.LastIndex == src {
+		return true
+	} else if p.LastIndex == nil || src == nil {
+		return false
+	}
+	if *p.LastIndex != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field10DeepEqual(src *int64) bool {
+
+	if p.CommitIndex == src {
+		return true
+	} else if p.CommitIndex == nil || src == nil {
+		return false
+	}
+	if *p.CommitIndex != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field11DeepEqual(src *int64) bool {
+
+	if p.AppliedIndex == src {
+		return true
+	} else if p.AppliedIndex == nil || src == nil {
+		return false
+	}
+	if *p.AppliedIndex != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field12DeepEqual(src *int64) bool {
+
+	if p.SnapshotIndex == src {
+		return true
+	} else if p.SnapshotIndex == nil || src == nil {
+		return false
+	}
+	if *p.SnapshotIndex != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field13DeepEqual(src *int64) bool {
+
+	if p.LastContact == src {
+		return true
+	} else if p.LastContact == nil || src == nil {
+		return false
+	}
+	if *p.LastContact != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field14DeepEqual(src *int32) bool {
+
+	if p.Replicas == src {
+		return true
+	} else if p.Replicas == nil || src == nil {
+		return false
+	}
+	if *p.Replicas != *src {
+		return false
+	}
+	return true
+}
+func (p *StatusResp) Field15DeepEqual(src []*Progress) bool {
+
+	if len(p.Followers) != len(src) {
+		return false
+	}
+	for i, v := range p.Followers {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type RaftReq struct {
+	RegionId int32  `thrift:"region_id,1,required" frugal:"1,required,i32" json:"region_id"`
+	Command  []byte `thrift:"command,2,required" frugal:"2,required,binary" json:"command"`
+}
+
+func NewRaftReq() *RaftReq {
+	return &RaftReq{}
+}
+
+func (p *RaftReq) InitDefault() {
+	*p = RaftReq{}
+}
+
+func (p *RaftReq) GetRegionId() (v int32) {
+	return p.RegionId
+}
+
+func (p *RaftReq) GetCommand() (v []byte) {
+	return p.Command
+}
+func (p *RaftReq) SetRegionId(val int32) {
+	p.RegionId = val
+}
+func (p *RaftReq) SetCommand(val []byte) {
+	p.Command = val
+}
+
+var fieldIDToName_RaftReq = map[int16]string{
+	1: "region_id",
+	2: "command",
+}
+
+func (p *RaftReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRegionId bool = false
+	var issetCommand bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRegionId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommand = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRegionId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCommand {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RaftReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RaftReq[fieldId]))
+}
+
+func (p *RaftReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = v
+	}
+	return nil
+}
+func (p *RaftReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Command = []byte(v)
+	}
+	return nil
+}
+
+func (p *RaftReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RaftReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RegionId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RaftReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("command", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBinary([]byte(p.Command)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RaftReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RaftReq(%+v)", *p)
+}
+
+func (p *RaftReq) DeepEqual(ano *RaftReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Command) {
+		return false
+	}
+	return true
+}
+
+func (p *RaftReq) Field1DeepEqual(src int32) bool {
+
+	if p.RegionId != src {
+		return false
+	}
+	return true
+}
+func (p *RaftReq) Field2DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Command, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RaftResp struct {
+	Response []byte `thrift:"response,1,required" frugal:"1,required,binary" json:"response"`
+	Error    string `thrift:"error,2,required" frugal:"2,required,string" json:"error"`
+}
+
+func NewRaftResp() *RaftResp {
+	return &RaftResp{}
+}
+
+func (p *RaftResp) InitDefault() {
+	*p = RaftResp{}
+}
+
+func (p *RaftResp) GetResponse() (v []byte) {
+	return p.Response
+}
+
+func (p *RaftResp) GetError() (v string) {
+	return p.Error
+}
+func (p *RaftResp) SetResponse(val []byte) {
+	p.Response = val
+}
+func (p *RaftResp) SetError(val string) {
+	p.Error = val
+}
+
+var fieldIDToName_RaftResp = map[int16]string{
+	1: "response",
+	2: "error",
+}
+
+func (p *RaftResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetResponse bool = false
+	var issetError bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResponse = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetError = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetResponse {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetError {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RaftResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RaftResp[fieldId]))
+}
+
+func (p *RaftResp) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Response = []byte(v)
+	}
+	return nil
+}
+func (p *RaftResp) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Error = v
+	}
+	return nil
+}
+
+func (p *RaftResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RaftResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("response", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBinary([]byte(p.Response)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RaftResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("error", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Error); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RaftResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RaftResp(%+v)", *p)
+}
+
+func (p *RaftResp) DeepEqual(ano *RaftResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Response) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Error) {
+		return false
+	}
+	return true
+}
+
+func (p *RaftResp) Field1DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Response, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RaftResp) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Error, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RaftSnapshotReq struct {
+	RegionId int32  `thrift:"region_id,1,required" frugal:"1,required,i32" json:"region_id"`
+	Session  string `thrift:"session,2,required" frugal:"2,required,string" json:"session"`
+	Command  []byte `thrift:"command,3,required" frugal:"3,required,binary" json:"command"`
+	Offset   int64  `thrift:"offset,4,required" frugal:"4,required,i64" json:"offset"`
+	Data     []byte `thrift:"data,5,required" frugal:"5,required,binary" json:"data"`
+	Done     bool   `thrift:"done,6,required" frugal:"6,required,bool" json:"done"`
+}
+
+func NewRaftSnapshotReq() *RaftSnapshotReq {
+	return &RaftSnapshotReq{}
+}
+
+func (p *RaftSnapshotReq) InitDefault() {
+	*p = RaftSnapshotReq{}
+}
+
+func (p *RaftSnapshotReq) GetRegionId() (v int32) {
+	return p.RegionId
+}
+
+func (p *RaftSnapshotReq) GetSession() (v string) {
+	return p.Session
+}
+
+func (p *RaftSnapshotReq) GetCommand() (v []byte) {
+	return p.Command
+}
+
+func (p *RaftSnapshotReq) GetOffset() (v int64) {
+	return p.Offset
+}
+
+func (p *RaftSnapshotReq) GetData() (v []byte) {
+	return p.Data
+}
+
+func (p *RaftSnapshotReq) GetDone() (v bool) {
+	return p.Done
+}
+func (p *RaftSnapshotReq) SetRegionId(val int32) {
+	p.RegionId = val
+}
+func (p *RaftSnapshotReq) SetSession(val string) {
+	p.Session = val
+}
+func (p *RaftSnapshotReq) SetCommand(val []byte) {
+	p.Command = val
+}
+func (p *RaftSnapshotReq) SetOffset(val int64) {
+	p.Offset = val
+}
+func (p *RaftSnapshotReq) SetData(val []byte) {
+	p.Data = val
+}
+func (p *RaftSnapshotReq) SetDone(val bool) {
+	p.Done = val
+}
+
+var fieldIDToName_RaftSnapshotReq = map[int16]string{
+	1: "region_id",
+	2: "session",
+	3: "command",
+	4: "offset",
+	5: "data",
+	6: "done",
+}
+
+func (p *RaftSnapshotReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRegionId bool = false
+	var issetSession bool = false
+	var issetCommand bool = false
+	var issetOffset bool = false
+	var issetData bool = false
+	var issetDone bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRegionId = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSession = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommand = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOffset = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDone = true
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRegionId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSession {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCommand {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOffset {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetData {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDone {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RaftSnapshotReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RaftSnapshotReq[fieldId]))
+}
+
+func (p *RaftSnapshotReq) ReadField1(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.RegionId = v
+	}
+	return nil
+}
+func (p *RaftSnapshotReq) ReadField2(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Session = v
+	}
+	return nil
+}
+func (p *RaftSnapshotReq) ReadField3(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Command = []byte(v)
+	}
+	return nil
+}
+func (p *RaftSnapshotReq) ReadField4(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+func (p *RaftSnapshotReq) ReadField5(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		p.Data = []byte(v)
+	}
+	return nil
+}
+func (p *RaftSnapshotReq) ReadField6(iprot thrift.TProtocol) error {
+
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Done = v
+	}
+	return nil
+}
+
+func (p *RaftSnapshotReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftSnapshotReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RaftSnapshotReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("region_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RegionId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RaftSnapshotReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Session); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RaftSnapshotReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("command", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBinary([]byte(p.Command)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RaftSnapshotReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RaftSnapshotReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBinary([]byte(p.Data)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RaftSnapshotReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("done", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Done); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *RaftSnapshotReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RaftSnapshotReq(%+v)", *p)
+}
+
+func (p *RaftSnapshotReq) DeepEqual(ano *RaftSnapshotReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RegionId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Session) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Command) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Offset) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Data) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Done) {
+		return false
+	}
+	return true
+}
+
+func (p *RaftSnapshotReq) Field1DeepEqual(src int32) bool {
+
+	if p.RegionId != src {
+		return false
+	}
+	return true
+}
+func (p *RaftSnapshotReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Session, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RaftSnapshotReq) Field3DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Command, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RaftSnapshotReq) Field4DeepEqual(src int64) bool {
+
+	if p.Offset != src {
+		return false
+	}
+	return true
+}
+func (p *RaftSnapshotReq) Field5DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RaftSnapshotReq) Field6DeepEqual(src bool) bool {
+
+	if p.Done != src {
+		return false
+	}
+	return true
+}
+
+type ReplicaService interface {
+	Join(ctx context.Context, req *JoinReq) (r *JoinResp, err error)
+
+	LeaderAddr(ctx context.Context, req *LeaderAddrReq) (r *LeaderAddrResp, err error)
+
+	GetId(ctx context.Context, req *GetIdReq) (r *GetIdResp, err error)
+
+	AddNonvoter(ctx context.Context, req *AddNonvoterReq) (r *AddNonvoterResp, err error)
+
+	RemoveServer(ctx context.Context, req *RemoveServerReq) (r *RemoveServerResp, err error)
+
+	DemoteVoter(ctx context.Context, req *DemoteVoterReq) (r *DemoteVoterResp, err error)
+
+	TransferLeadership(ctx context.Context, req *TransferLeadershipReq) (r *TransferLeadershipResp, err error)
+
+	Members(ctx context.Context, req *MembersReq) (r *MembersResp, err error)
+
+	Status(ctx context.Context, req *StatusReq) (r *StatusResp, err error)
+
+	RaftAppendEntries(ctx context.Context, req *RaftReq) (r *RaftResp, err error)
+
+	RaftRequestVote(ctx context.Context, req *RaftReq) (r *RaftResp, err error)
+
+	RaftTimeoutNow(ctx context.Context, req *RaftReq) (r *RaftResp, err error)
+
+	RaftInstallSnapshot(ctx context.Context, req *RaftSnapshotReq) (r *RaftResp, err error)
 }
 
 type ReplicaServiceClient struct {
 	c thrift.TClient
 }
 
-func NewReplicaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ReplicaServiceClient {
-	return &ReplicaServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func NewReplicaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ReplicaServiceClient {
+	return &ReplicaServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewReplicaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ReplicaServiceClient {
+	return &ReplicaServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewReplicaServiceClient(c thrift.TClient) *ReplicaServiceClient {
+	return &ReplicaServiceClient{
+		c: c,
+	}
+}
+
+func (p *ReplicaServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ReplicaServiceClient) Join(ctx context.Context, req *JoinReq) (r *JoinResp, err error) {
+	var _args ReplicaServiceJoinArgs
+	_args.Req = req
+	var _result ReplicaServiceJoinResult
+	if err = p.Client_().Call(ctx, "Join", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) LeaderAddr(ctx context.Context, req *LeaderAddrReq) (r *LeaderAddrResp, err error) {
+	var _args ReplicaServiceLeaderAddrArgs
+	_args.Req = req
+	var _result ReplicaServiceLeaderAddrResult
+	if err = p.Client_().Call(ctx, "LeaderAddr", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) GetId(ctx context.Context, req *GetIdReq) (r *GetIdResp, err error) {
+	var _args ReplicaServiceGetIdArgs
+	_args.Req = req
+	var _result ReplicaServiceGetIdResult
+	if err = p.Client_().Call(ctx, "GetId", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) AddNonvoter(ctx context.Context, req *AddNonvoterReq) (r *AddNonvoterResp, err error) {
+	var _args ReplicaServiceAddNonvoterArgs
+	_args.Req = req
+	var _result ReplicaServiceAddNonvoterResult
+	if err = p.Client_().Call(ctx, "AddNonvoter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) RemoveServer(ctx context.Context, req *RemoveServerReq) (r *RemoveServerResp, err error) {
+	var _args ReplicaServiceRemoveServerArgs
+	_args.Req = req
+	var _result ReplicaServiceRemoveServerResult
+	if err = p.Client_().Call(ctx, "RemoveServer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) DemoteVoter(ctx context.Context, req *DemoteVoterReq) (r *DemoteVoterResp, err error) {
+	var _args ReplicaServiceDemoteVoterArgs
+	_args.Req = req
+	var _result ReplicaServiceDemoteVoterResult
+	if err = p.Client_().Call(ctx, "DemoteVoter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) TransferLeadership(ctx context.Context, req *TransferLeadershipReq) (r *TransferLeadershipResp, err error) {
+	var _args ReplicaServiceTransferLeadershipArgs
+	_args.Req = req
+	var _result ReplicaServiceTransferLeadershipResult
+	if err = p.Client_().Call(ctx, "TransferLeadership", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) Members(ctx context.Context, req *MembersReq) (r *MembersResp, err error) {
+	var _args ReplicaServiceMembersArgs
+	_args.Req = req
+	var _result ReplicaServiceMembersResult
+	if err = p.Client_().Call(ctx, "Members", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) Status(ctx context.Context, req *StatusReq) (r *StatusResp, err error) {
+	var _args ReplicaServiceStatusArgs
+	_args.Req = req
+	var _result ReplicaServiceStatusResult
+	if err = p.Client_().Call(ctx, "Status", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) RaftAppendEntries(ctx context.Context, req *RaftReq) (r *RaftResp, err error) {
+	var _args ReplicaServiceRaftAppendEntriesArgs
+	_args.Req = req
+	var _result ReplicaServiceRaftAppendEntriesResult
+	if err = p.Client_().Call(ctx, "RaftAppendEntries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) RaftRequestVote(ctx context.Context, req *RaftReq) (r *RaftResp, err error) {
+	var _args ReplicaServiceRaftRequestVoteArgs
+	_args.Req = req
+	var _result ReplicaServiceRaftRequestVoteResult
+	if err = p.Client_().Call(ctx, "RaftRequestVote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) RaftTimeoutNow(ctx context.Context, req *RaftReq) (r *RaftResp, err error) {
+	var _args ReplicaServiceRaftTimeoutNowArgs
+	_args.Req = req
+	var _result ReplicaServiceRaftTimeoutNowResult
+	if err = p.Client_().Call(ctx, "RaftTimeoutNow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReplicaServiceClient) RaftInstallSnapshot(ctx context.Context, req *RaftSnapshotReq) (r *RaftResp, err error) {
+	var _args ReplicaServiceRaftInstallSnapshotArgs
+	_args.Req = req
+	var _result ReplicaServiceRaftInstallSnapshotResult
+	if err = p.Client_().Call(ctx, "RaftInstallSnapshot", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ReplicaServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ReplicaService
+}
+
+func (p *ReplicaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ReplicaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ReplicaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewReplicaServiceProcessor(handler ReplicaService) *ReplicaServiceProcessor {
+	self := &ReplicaServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Join", &replicaServiceProcessorJoin{handler: handler})
+	self.AddToProcessorMap("LeaderAddr", &replicaServiceProcessorLeaderAddr{handler: handler})
+	self.AddToProcessorMap("GetId", &replicaServiceProcessorGetId{handler: handler})
+	self.AddToProcessorMap("AddNonvoter", &replicaServiceProcessorAddNonvoter{handler: handler})
+	self.AddToProcessorMap("RemoveServer", &replicaServiceProcessorRemoveServer{handler: handler})
+	self.AddToProcessorMap("DemoteVoter", &replicaServiceProcessorDemoteVoter{handler: handler})
+	self.AddToProcessorMap("TransferLeadership", &replicaServiceProcessorTransferLeadership{handler: handler})
+	self.AddToProcessorMap("Members", &replicaServiceProcessorMembers{handler: handler})
+	self.AddToProcessorMap("Status", &replicaServiceProcessorStatus{handler: handler})
+	self.AddToProcessorMap("RaftAppendEntries", &replicaServiceProcessorRaftAppendEntries{handler: handler})
+	self.AddToProcessorMap("RaftRequestVote", &replicaServiceProcessorRaftRequestVote{handler: handler})
+	self.AddToProcessorMap("RaftTimeoutNow", &replicaServiceProcessorRaftTimeoutNow{handler: handler})
+	self.AddToProcessorMap("RaftInstallSnapshot", &replicaServiceProcessorRaftInstallSnapshot{handler: handler})
+	return self
+}
+func (p *ReplicaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type replicaServiceProcessorJoin struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceJoinArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Join", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceJoinResult{}
+	var retval *JoinResp
+	if retval, err2 = p.handler.Join(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Join: "+err2.Error())
+		oprot.WriteMessageBegin("Join", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Join", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorLeaderAddr struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorLeaderAddr) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceLeaderAddrArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LeaderAddr", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceLeaderAddrResult{}
+	var retval *LeaderAddrResp
+	if retval, err2 = p.handler.LeaderAddr(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LeaderAddr: "+err2.Error())
+		oprot.WriteMessageBegin("LeaderAddr", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LeaderAddr", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorGetId struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorGetId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceGetIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceGetIdResult{}
+	var retval *GetIdResp
+	if retval, err2 = p.handler.GetId(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetId: "+err2.Error())
+		oprot.WriteMessageBegin("GetId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetId", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorAddNonvoter struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorAddNonvoter) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceAddNonvoterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddNonvoter", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceAddNonvoterResult{}
+	var retval *AddNonvoterResp
+	if retval, err2 = p.handler.AddNonvoter(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddNonvoter: "+err2.Error())
+		oprot.WriteMessageBegin("AddNonvoter", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddNonvoter", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorRemoveServer struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorRemoveServer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceRemoveServerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RemoveServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceRemoveServerResult{}
+	var retval *RemoveServerResp
+	if retval, err2 = p.handler.RemoveServer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveServer: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveServer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RemoveServer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorDemoteVoter struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorDemoteVoter) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceDemoteVoterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DemoteVoter", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceDemoteVoterResult{}
+	var retval *DemoteVoterResp
+	if retval, err2 = p.handler.DemoteVoter(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DemoteVoter: "+err2.Error())
+		oprot.WriteMessageBegin("DemoteVoter", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DemoteVoter", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorTransferLeadership struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorTransferLeadership) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceTransferLeadershipArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TransferLeadership", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceTransferLeadershipResult{}
+	var retval *TransferLeadershipResp
+	if retval, err2 = p.handler.TransferLeadership(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TransferLeadership: "+err2.Error())
+		oprot.WriteMessageBegin("TransferLeadership", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TransferLeadership", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorMembers struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceMembersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Members", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceMembersResult{}
+	var retval *MembersResp
+	if retval, err2 = p.handler.Members(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Members: "+err2.Error())
+		oprot.WriteMessageBegin("Members", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Members", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorStatus struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Status", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceStatusResult{}
+	var retval *StatusResp
+	if retval, err2 = p.handler.Status(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Status: "+err2.Error())
+		oprot.WriteMessageBegin("Status", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Status", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorRaftAppendEntries struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorRaftAppendEntries) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceRaftAppendEntriesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RaftAppendEntries", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceRaftAppendEntriesResult{}
+	var retval *RaftResp
+	if retval, err2 = p.handler.RaftAppendEntries(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RaftAppendEntries: "+err2.Error())
+		oprot.WriteMessageBegin("RaftAppendEntries", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RaftAppendEntries", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorRaftRequestVote struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorRaftRequestVote) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceRaftRequestVoteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RaftRequestVote", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceRaftRequestVoteResult{}
+	var retval *RaftResp
+	if retval, err2 = p.handler.RaftRequestVote(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RaftRequestVote: "+err2.Error())
+		oprot.WriteMessageBegin("RaftRequestVote", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RaftRequestVote", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorRaftTimeoutNow struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorRaftTimeoutNow) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceRaftTimeoutNowArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RaftTimeoutNow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceRaftTimeoutNowResult{}
+	var retval *RaftResp
+	if retval, err2 = p.handler.RaftTimeoutNow(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RaftTimeoutNow: "+err2.Error())
+		oprot.WriteMessageBegin("RaftTimeoutNow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RaftTimeoutNow", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type replicaServiceProcessorRaftInstallSnapshot struct {
+	handler ReplicaService
+}
+
+func (p *replicaServiceProcessorRaftInstallSnapshot) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReplicaServiceRaftInstallSnapshotArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RaftInstallSnapshot", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReplicaServiceRaftInstallSnapshotResult{}
+	var retval *RaftResp
+	if retval, err2 = p.handler.RaftInstallSnapshot(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RaftInstallSnapshot: "+err2.Error())
+		oprot.WriteMessageBegin("RaftInstallSnapshot", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RaftInstallSnapshot", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ReplicaServiceJoinArgs struct {
+	Req *JoinReq `thrift:"req,1" frugal:"1,default,JoinReq" json:"req"`
+}
+
+func NewReplicaServiceJoinArgs() *ReplicaServiceJoinArgs {
+	return &ReplicaServiceJoinArgs{}
+}
+
+func (p *ReplicaServiceJoinArgs) InitDefault() {
+	*p = ReplicaServiceJoinArgs{}
+}
+
+var ReplicaServiceJoinArgs_Req_DEFAULT *JoinReq
+
+func (p *ReplicaServiceJoinArgs) GetReq() (v *JoinReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceJoinArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceJoinArgs) SetReq(val *JoinReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceJoinArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceJoinArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceJoinArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceJoinArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewJoinReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceJoinArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Join_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceJoinArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceJoinArgs) DeepEqual(ano *ReplicaServiceJoinArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceJoinArgs) Field1DeepEqual(src *JoinReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceJoinResult struct {
+	Success *JoinResp `thrift:"success,0,optional" frugal:"0,optional,JoinResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceJoinResult() *ReplicaServiceJoinResult {
+	return &ReplicaServiceJoinResult{}
+}
+
+func (p *ReplicaServiceJoinResult) InitDefault() {
+	*p = ReplicaServiceJoinResult{}
+}
+
+var ReplicaServiceJoinResult_Success_DEFAULT *JoinResp
+
+func (p *ReplicaServiceJoinResult) GetSuccess() (v *JoinResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceJoinResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceJoinResult) SetSuccess(x interface{}) {
+	p.Success = x.(*JoinResp)
+}
+
+var fieldIDToName_ReplicaServiceJoinResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceJoinResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceJoinResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceJoinResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewJoinResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceJoinResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Join_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceJoinResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceJoinResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceJoinResult) DeepEqual(ano *ReplicaServiceJoinResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceJoinResult) Field0DeepEqual(src *JoinResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceLeaderAddrArgs struct {
+	Req *LeaderAddrReq `thrift:"req,1" frugal:"1,default,LeaderAddrReq" json:"req"`
+}
+
+func NewReplicaServiceLeaderAddrArgs() *ReplicaServiceLeaderAddrArgs {
+	return &ReplicaServiceLeaderAddrArgs{}
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) InitDefault() {
+	*p = ReplicaServiceLeaderAddrArgs{}
+}
+
+var ReplicaServiceLeaderAddrArgs_Req_DEFAULT *LeaderAddrReq
+
+func (p *ReplicaServiceLeaderAddrArgs) GetReq() (v *LeaderAddrReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceLeaderAddrArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceLeaderAddrArgs) SetReq(val *LeaderAddrReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceLeaderAddrArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceLeaderAddrArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewLeaderAddrReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LeaderAddr_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceLeaderAddrArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) DeepEqual(ano *ReplicaServiceLeaderAddrArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceLeaderAddrArgs) Field1DeepEqual(src *LeaderAddrReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceLeaderAddrResult struct {
+	Success *LeaderAddrResp `thrift:"success,0,optional" frugal:"0,optional,LeaderAddrResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceLeaderAddrResult() *ReplicaServiceLeaderAddrResult {
+	return &ReplicaServiceLeaderAddrResult{}
+}
+
+func (p *ReplicaServiceLeaderAddrResult) InitDefault() {
+	*p = ReplicaServiceLeaderAddrResult{}
+}
+
+var ReplicaServiceLeaderAddrResult_Success_DEFAULT *LeaderAddrResp
+
+func (p *ReplicaServiceLeaderAddrResult) GetSuccess() (v *LeaderAddrResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceLeaderAddrResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceLeaderAddrResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LeaderAddrResp)
+}
+
+var fieldIDToName_ReplicaServiceLeaderAddrResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceLeaderAddrResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceLeaderAddrResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceLeaderAddrResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewLeaderAddrResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceLeaderAddrResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LeaderAddr_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceLeaderAddrResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceLeaderAddrResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceLeaderAddrResult) DeepEqual(ano *ReplicaServiceLeaderAddrResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceLeaderAddrResult) Field0DeepEqual(src *LeaderAddrResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceGetIdArgs struct {
+	Req *GetIdReq `thrift:"req,1" frugal:"1,default,GetIdReq" json:"req"`
+}
+
+func NewReplicaServiceGetIdArgs() *ReplicaServiceGetIdArgs {
+	return &ReplicaServiceGetIdArgs{}
+}
+
+func (p *ReplicaServiceGetIdArgs) InitDefault() {
+	*p = ReplicaServiceGetIdArgs{}
+}
+
+var ReplicaServiceGetIdArgs_Req_DEFAULT *GetIdReq
+
+func (p *ReplicaServiceGetIdArgs) GetReq() (v *GetIdReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceGetIdArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceGetIdArgs) SetReq(val *GetIdReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceGetIdArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceGetIdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceGetIdArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceGetIdArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewGetIdReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceGetIdArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetId_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceGetIdArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceGetIdArgs) DeepEqual(ano *ReplicaServiceGetIdArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceGetIdArgs) Field1DeepEqual(src *GetIdReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceGetIdResult struct {
+	Success *GetIdResp `thrift:"success,0,optional" frugal:"0,optional,GetIdResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceGetIdResult() *ReplicaServiceGetIdResult {
+	return &ReplicaServiceGetIdResult{}
+}
+
+func (p *ReplicaServiceGetIdResult) InitDefault() {
+	*p = ReplicaServiceGetIdResult{}
+}
+
+var ReplicaServiceGetIdResult_Success_DEFAULT *GetIdResp
+
+func (p *ReplicaServiceGetIdResult) GetSuccess() (v *GetIdResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceGetIdResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceGetIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetIdResp)
+}
+
+var fieldIDToName_ReplicaServiceGetIdResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceGetIdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceGetIdResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceGetIdResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewGetIdResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceGetIdResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetId_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceGetIdResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceGetIdResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceGetIdResult) DeepEqual(ano *ReplicaServiceGetIdResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceGetIdResult) Field0DeepEqual(src *GetIdResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceAddNonvoterArgs struct {
+	Req *AddNonvoterReq `thrift:"req,1" frugal:"1,default,AddNonvoterReq" json:"req"`
+}
+
+func NewReplicaServiceAddNonvoterArgs() *ReplicaServiceAddNonvoterArgs {
+	return &ReplicaServiceAddNonvoterArgs{}
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) InitDefault() {
+	*p = ReplicaServiceAddNonvoterArgs{}
+}
+
+var ReplicaServiceAddNonvoterArgs_Req_DEFAULT *AddNonvoterReq
+
+func (p *ReplicaServiceAddNonvoterArgs) GetReq() (v *AddNonvoterReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceAddNonvoterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceAddNonvoterArgs) SetReq(val *AddNonvoterReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceAddNonvoterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceAddNonvoterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewAddNonvoterReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddNonvoter_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceAddNonvoterArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) DeepEqual(ano *ReplicaServiceAddNonvoterArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceAddNonvoterArgs) Field1DeepEqual(src *AddNonvoterReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceAddNonvoterResult struct {
+	Success *AddNonvoterResp `thrift:"success,0,optional" frugal:"0,optional,AddNonvoterResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceAddNonvoterResult() *ReplicaServiceAddNonvoterResult {
+	return &ReplicaServiceAddNonvoterResult{}
+}
+
+func (p *ReplicaServiceAddNonvoterResult) InitDefault() {
+	*p = ReplicaServiceAddNonvoterResult{}
+}
+
+var ReplicaServiceAddNonvoterResult_Success_DEFAULT *AddNonvoterResp
+
+func (p *ReplicaServiceAddNonvoterResult) GetSuccess() (v *AddNonvoterResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceAddNonvoterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceAddNonvoterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddNonvoterResp)
+}
+
+var fieldIDToName_ReplicaServiceAddNonvoterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceAddNonvoterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceAddNonvoterResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceAddNonvoterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewAddNonvoterResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceAddNonvoterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddNonvoter_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceAddNonvoterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceAddNonvoterResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceAddNonvoterResult) DeepEqual(ano *ReplicaServiceAddNonvoterResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceAddNonvoterResult) Field0DeepEqual(src *AddNonvoterResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceRemoveServerArgs struct {
+	Req *RemoveServerReq `thrift:"req,1" frugal:"1,default,RemoveServerReq" json:"req"`
+}
+
+func NewReplicaServiceRemoveServerArgs() *ReplicaServiceRemoveServerArgs {
+	return &ReplicaServiceRemoveServerArgs{}
+}
+
+func (p *ReplicaServiceRemoveServerArgs) InitDefault() {
+	*p = ReplicaServiceRemoveServerArgs{}
+}
+
+var ReplicaServiceRemoveServerArgs_Req_DEFAULT *RemoveServerReq
+
+func (p *ReplicaServiceRemoveServerArgs) GetReq() (v *RemoveServerReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceRemoveServerArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceRemoveServerArgs) SetReq(val *RemoveServerReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceRemoveServerArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceRemoveServerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceRemoveServerArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRemoveServerArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRemoveServerReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceRemoveServerArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveServer_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceRemoveServerArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceRemoveServerArgs) DeepEqual(ano *ReplicaServiceRemoveServerArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceRemoveServerArgs) Field1DeepEqual(src *RemoveServerReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceRemoveServerResult struct {
+	Success *RemoveServerResp `thrift:"success,0,optional" frugal:"0,optional,RemoveServerResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceRemoveServerResult() *ReplicaServiceRemoveServerResult {
+	return &ReplicaServiceRemoveServerResult{}
+}
+
+func (p *ReplicaServiceRemoveServerResult) InitDefault() {
+	*p = ReplicaServiceRemoveServerResult{}
+}
+
+var ReplicaServiceRemoveServerResult_Success_DEFAULT *RemoveServerResp
+
+func (p *ReplicaServiceRemoveServerResult) GetSuccess() (v *RemoveServerResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceRemoveServerResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceRemoveServerResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveServerResp)
+}
+
+var fieldIDToName_ReplicaServiceRemoveServerResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceRemoveServerResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceRemoveServerResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRemoveServerResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRemoveServerResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceRemoveServerResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveServer_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceRemoveServerResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceRemoveServerResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceRemoveServerResult) DeepEqual(ano *ReplicaServiceRemoveServerResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceRemoveServerResult) Field0DeepEqual(src *RemoveServerResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceDemoteVoterArgs struct {
+	Req *DemoteVoterReq `thrift:"req,1" frugal:"1,default,DemoteVoterReq" json:"req"`
+}
+
+func NewReplicaServiceDemoteVoterArgs() *ReplicaServiceDemoteVoterArgs {
+	return &ReplicaServiceDemoteVoterArgs{}
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) InitDefault() {
+	*p = ReplicaServiceDemoteVoterArgs{}
+}
+
+var ReplicaServiceDemoteVoterArgs_Req_DEFAULT *DemoteVoterReq
+
+func (p *ReplicaServiceDemoteVoterArgs) GetReq() (v *DemoteVoterReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceDemoteVoterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceDemoteVoterArgs) SetReq(val *DemoteVoterReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceDemoteVoterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceDemoteVoterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewDemoteVoterReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DemoteVoter_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceDemoteVoterArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) DeepEqual(ano *ReplicaServiceDemoteVoterArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceDemoteVoterArgs) Field1DeepEqual(src *DemoteVoterReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceDemoteVoterResult struct {
+	Success *DemoteVoterResp `thrift:"success,0,optional" frugal:"0,optional,DemoteVoterResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceDemoteVoterResult() *ReplicaServiceDemoteVoterResult {
+	return &ReplicaServiceDemoteVoterResult{}
+}
+
+func (p *ReplicaServiceDemoteVoterResult) InitDefault() {
+	*p = ReplicaServiceDemoteVoterResult{}
+}
+
+var ReplicaServiceDemoteVoterResult_Success_DEFAULT *DemoteVoterResp
+
+func (p *ReplicaServiceDemoteVoterResult) GetSuccess() (v *DemoteVoterResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceDemoteVoterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceDemoteVoterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DemoteVoterResp)
+}
+
+var fieldIDToName_ReplicaServiceDemoteVoterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceDemoteVoterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceDemoteVoterResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceDemoteVoterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewDemoteVoterResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceDemoteVoterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DemoteVoter_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceDemoteVoterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceDemoteVoterResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceDemoteVoterResult) DeepEqual(ano *ReplicaServiceDemoteVoterResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceDemoteVoterResult) Field0DeepEqual(src *DemoteVoterResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceTransferLeadershipArgs struct {
+	Req *TransferLeadershipReq `thrift:"req,1" frugal:"1,default,TransferLeadershipReq" json:"req"`
+}
+
+func NewReplicaServiceTransferLeadershipArgs() *ReplicaServiceTransferLeadershipArgs {
+	return &ReplicaServiceTransferLeadershipArgs{}
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) InitDefault() {
+	*p = ReplicaServiceTransferLeadershipArgs{}
+}
+
+var ReplicaServiceTransferLeadershipArgs_Req_DEFAULT *TransferLeadershipReq
+
+func (p *ReplicaServiceTransferLeadershipArgs) GetReq() (v *TransferLeadershipReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceTransferLeadershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceTransferLeadershipArgs) SetReq(val *TransferLeadershipReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceTransferLeadershipArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceTransferLeadershipArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTransferLeadershipReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferLeadership_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceTransferLeadershipArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) DeepEqual(ano *ReplicaServiceTransferLeadershipArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceTransferLeadershipArgs) Field1DeepEqual(src *TransferLeadershipReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceTransferLeadershipResult struct {
+	Success *TransferLeadershipResp `thrift:"success,0,optional" frugal:"0,optional,TransferLeadershipResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceTransferLeadershipResult() *ReplicaServiceTransferLeadershipResult {
+	return &ReplicaServiceTransferLeadershipResult{}
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) InitDefault() {
+	*p = ReplicaServiceTransferLeadershipResult{}
+}
+
+var ReplicaServiceTransferLeadershipResult_Success_DEFAULT *TransferLeadershipResp
+
+func (p *ReplicaServiceTransferLeadershipResult) GetSuccess() (v *TransferLeadershipResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceTransferLeadershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceTransferLeadershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TransferLeadershipResp)
+}
+
+var fieldIDToName_ReplicaServiceTransferLeadershipResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceTransferLeadershipResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTransferLeadershipResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferLeadership_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceTransferLeadershipResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) DeepEqual(ano *ReplicaServiceTransferLeadershipResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceTransferLeadershipResult) Field0DeepEqual(src *TransferLeadershipResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceMembersArgs struct {
+	Req *MembersReq `thrift:"req,1" frugal:"1,default,MembersReq" json:"req"`
+}
+
+func NewReplicaServiceMembersArgs() *ReplicaServiceMembersArgs {
+	return &ReplicaServiceMembersArgs{}
+}
+
+func (p *ReplicaServiceMembersArgs) InitDefault() {
+	*p = ReplicaServiceMembersArgs{}
+}
+
+var ReplicaServiceMembersArgs_Req_DEFAULT *MembersReq
+
+func (p *ReplicaServiceMembersArgs) GetReq() (v *MembersReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceMembersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceMembersArgs) SetReq(val *MembersReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceMembersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceMembersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceMembersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewMembersReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Members_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceMembersArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceMembersArgs) DeepEqual(ano *ReplicaServiceMembersArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceMembersArgs) Field1DeepEqual(src *MembersReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceMembersResult struct {
+	Success *MembersResp `thrift:"success,0,optional" frugal:"0,optional,MembersResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceMembersResult() *ReplicaServiceMembersResult {
+	return &ReplicaServiceMembersResult{}
+}
+
+func (p *ReplicaServiceMembersResult) InitDefault() {
+	*p = ReplicaServiceMembersResult{}
+}
+
+var ReplicaServiceMembersResult_Success_DEFAULT *MembersResp
+
+func (p *ReplicaServiceMembersResult) GetSuccess() (v *MembersResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceMembersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceMembersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MembersResp)
+}
+
+var fieldIDToName_ReplicaServiceMembersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceMembersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceMembersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceMembersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewMembersResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceMembersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Members_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceMembersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceMembersResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceMembersResult) DeepEqual(ano *ReplicaServiceMembersResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceMembersResult) Field0DeepEqual(src *MembersResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceStatusArgs struct {
+	Req *StatusReq `thrift:"req,1" frugal:"1,default,StatusReq" json:"req"`
+}
+
+func NewReplicaServiceStatusArgs() *ReplicaServiceStatusArgs {
+	return &ReplicaServiceStatusArgs{}
+}
+
+func (p *ReplicaServiceStatusArgs) InitDefault() {
+	*p = ReplicaServiceStatusArgs{}
+}
+
+var ReplicaServiceStatusArgs_Req_DEFAULT *StatusReq
+
+func (p *ReplicaServiceStatusArgs) GetReq() (v *StatusReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceStatusArgs) SetReq(val *StatusReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewStatusReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Status_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceStatusArgs(%+v)", *p)
+}
+
+func (p *ReplicaServiceStatusArgs) DeepEqual(ano *ReplicaServiceStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceStatusArgs) Field1DeepEqual(src *StatusReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceStatusResult struct {
+	Success *StatusResp `thrift:"success,0,optional" frugal:"0,optional,StatusResp" json:"success,omitempty"`
+}
+
+func NewReplicaServiceStatusResult() *ReplicaServiceStatusResult {
+	return &ReplicaServiceStatusResult{}
+}
+
+func (p *ReplicaServiceStatusResult) InitDefault() {
+	*p = ReplicaServiceStatusResult{}
+}
+
+var ReplicaServiceStatusResult_Success_DEFAULT *StatusResp
+
+func (p *ReplicaServiceStatusResult) GetSuccess() (v *StatusResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ReplicaServiceStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StatusResp)
+}
+
+var fieldIDToName_ReplicaServiceStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReplicaServiceStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplicaServiceStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewStatusResp()
+
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Status_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReplicaServiceStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceStatusResult(%+v)", *p)
+}
+
+func (p *ReplicaServiceStatusResult) DeepEqual(ano *ReplicaServiceStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ReplicaServiceStatusResult) Field0DeepEqual(src *StatusResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ReplicaServiceRaftAppendEntriesArgs struct {
+	Req *RaftReq `thrift:"req,1" frugal:"1,default,RaftReq" json:"req"`
+}
+
+func NewReplicaServiceRaftAppendEntriesArgs() *ReplicaServiceRaftAppendEntriesArgs {
+	return &ReplicaServiceRaftAppendEntriesArgs{}
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) InitDefault() {
+	*p = ReplicaServiceRaftAppendEntriesArgs{}
+}
+
+var ReplicaServiceRaftAppendEntriesArgs_Req_DEFAULT *RaftReq
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) GetReq() (v *RaftReq) {
+	if !p.IsSetReq() {
+		return ReplicaServiceRaftAppendEntriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ReplicaServiceRaftAppendEntriesArgs) SetReq(val *RaftReq) {
+	p.Req = val
+}
+
+var fieldIDToName_ReplicaServiceRaftAppendEntriesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftAppendEntriesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRaftReq()
+
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftAppendEntries_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-}
-
-func NewReplicaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ReplicaServiceClient {
-	return &ReplicaServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-}
-
-func NewReplicaServiceClient(c thrift.TClient) *ReplicaServiceClient {
-	return &ReplicaServiceClient{
-		c: c,
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ReplicaServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *ReplicaServiceRaftAppendEntriesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplicaServiceRaftAppendEntriesArgs(%+v)", *p)
 }
 
-func (p *ReplicaServiceClient) Join(ctx context.Context, req *JoinReq) (r *JoinResp, err error) {
-	var _args ReplicaServiceJoinArgs
-	_args.Req = req
-	var _result ReplicaServiceJoinResult
-	if err = p.Client_().Call(ctx, "Join", &_args, &_result); err != nil {
-		return
+func (p *ReplicaServiceRaftAppendEntriesArgs) DeepEqual(ano *ReplicaServiceRaftAppendEntriesArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ReplicaServiceClient) LeaderAddr(ctx context.Context, req *LeaderAddrReq) (r *LeaderAddrResp, err error) {
-	var _args ReplicaServiceLeaderAddrArgs
-	_args.Req = req
-	var _result ReplicaServiceLeaderAddrResult
-	if err = p.Client_().Call(ctx, "LeaderAddr", &_args, &_result); err != nil {
-		return
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *ReplicaServiceClient) GetId(ctx context.Context, req *GetIdReq) (r *GetIdResp, err error) {
-	var _args ReplicaServiceGetIdArgs
-	_args.Req = req
-	var _result ReplicaServiceGetIdResult
-	if err = p.Client_().Call(ctx, "GetId", &_args, &_result); err != nil {
-		return
+
+func (p *ReplicaServiceRaftAppendEntriesArgs) Field1DeepEqual(src *RaftReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
 
-type ReplicaServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ReplicaService
+type ReplicaServiceRaftAppendEntriesResult struct {
+	Success *RaftResp `thrift:"success,0,optional" frugal:"0,optional,RaftResp" json:"success,omitempty"`
 }
 
-func (p *ReplicaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewReplicaServiceRaftAppendEntriesResult() *ReplicaServiceRaftAppendEntriesResult {
+	return &ReplicaServiceRaftAppendEntriesResult{}
 }
 
-func (p *ReplicaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *ReplicaServiceRaftAppendEntriesResult) InitDefault() {
+	*p = ReplicaServiceRaftAppendEntriesResult{}
 }
 
-func (p *ReplicaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+var ReplicaServiceRaftAppendEntriesResult_Success_DEFAULT *RaftResp
 
-func NewReplicaServiceProcessor(handler ReplicaService) *ReplicaServiceProcessor {
-	self := &ReplicaServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Join", &replicaServiceProcessorJoin{handler: handler})
-	self.AddToProcessorMap("LeaderAddr", &replicaServiceProcessorLeaderAddr{handler: handler})
-	self.AddToProcessorMap("GetId", &replicaServiceProcessorGetId{handler: handler})
-	return self
-}
-func (p *ReplicaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+func (p *ReplicaServiceRaftAppendEntriesResult) GetSuccess() (v *RaftResp) {
+	if !p.IsSetSuccess() {
+		return ReplicaServiceRaftAppendEntriesResult_Success_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Success
+}
+func (p *ReplicaServiceRaftAppendEntriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RaftResp)
 }
 
-type replicaServiceProcessorJoin struct {
-	handler ReplicaService
+var fieldIDToName_ReplicaServiceRaftAppendEntriesResult = map[int16]string{
+	0: "success",
 }
 
-func (p *replicaServiceProcessorJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ReplicaServiceJoinArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Join", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ReplicaServiceRaftAppendEntriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ReplicaServiceJoinResult{}
-	var retval *JoinResp
-	if retval, err2 = p.handler.Join(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Join: "+err2.Error())
-		oprot.WriteMessageBegin("Join", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Join", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ReplicaServiceRaftAppendEntriesResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type replicaServiceProcessorLeaderAddr struct {
-	handler ReplicaService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftAppendEntriesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *replicaServiceProcessorLeaderAddr) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ReplicaServiceLeaderAddrArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("LeaderAddr", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ReplicaServiceRaftAppendEntriesResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRaftResp()
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ReplicaServiceLeaderAddrResult{}
-	var retval *LeaderAddrResp
-	if retval, err2 = p.handler.LeaderAddr(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LeaderAddr: "+err2.Error())
-		oprot.WriteMessageBegin("LeaderAddr", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("LeaderAddr", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *ReplicaServiceRaftAppendEntriesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RaftAppendEntries_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type replicaServiceProcessorGetId struct {
-	handler ReplicaService
+func (p *ReplicaServiceRaftAppendEntriesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *replicaServiceProcessorGetId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ReplicaServiceGetIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ReplicaServiceRaftAppendEntriesResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ReplicaServiceRaftAppendEntriesResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ReplicaServiceGetIdResult{}
-	var retval *GetIdResp
-	if retval, err2 = p.handler.GetId(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetId: "+err2.Error())
-		oprot.WriteMessageBegin("GetId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetId", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ReplicaServiceRaftAppendEntriesResult) DeepEqual(ano *ReplicaServiceRaftAppendEntriesResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *ReplicaServiceRaftAppendEntriesResult) Field0DeepEqual(src *RaftResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type ReplicaServiceJoinArgs struct {
-	Req *JoinReq `thrift:"req,1" frugal:"1,default,JoinReq" json:"req"`
+type ReplicaServiceRaftRequestVoteArgs struct {
+	Req *RaftReq `thrift:"req,1" frugal:"1,default,RaftReq" json:"req"`
 }
 
-func NewReplicaServiceJoinArgs() *ReplicaServiceJoinArgs {
-	return &ReplicaServiceJoinArgs{}
+func NewReplicaServiceRaftRequestVoteArgs() *ReplicaServiceRaftRequestVoteArgs {
+	return &ReplicaServiceRaftRequestVoteArgs{}
 }
 
-func (p *ReplicaServiceJoinArgs) InitDefault() {
-	*p = ReplicaServiceJoinArgs{}
+func (p *ReplicaServiceRaftRequestVoteArgs) InitDefault() {
+	*p = ReplicaServiceRaftRequestVoteArgs{}
 }
 
-var ReplicaServiceJoinArgs_Req_DEFAULT *JoinReq
+var ReplicaServiceRaftRequestVoteArgs_Req_DEFAULT *RaftReq
 
-func (p *ReplicaServiceJoinArgs) GetReq() (v *JoinReq) {
+func (p *ReplicaServiceRaftRequestVoteArgs) GetReq() (v *RaftReq) {
 	if !p.IsSetReq() {
-		return ReplicaServiceJoinArgs_Req_DEFAULT
+		return ReplicaServiceRaftRequestVoteArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ReplicaServiceJoinArgs) SetReq(val *JoinReq) {
+func (p *ReplicaServiceRaftRequestVoteArgs) SetReq(val *RaftReq) {
 	p.Req = val
 }
 
-var fieldIDToName_ReplicaServiceJoinArgs = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftRequestVoteArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ReplicaServiceJoinArgs) IsSetReq() bool {
+func (p *ReplicaServiceRaftRequestVoteArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ReplicaServiceJoinArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1322,17 +12119,16 @@ func (p *ReplicaServiceJoinArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1347,7 +12143,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceJoinArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftRequestVoteArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1357,17 +12153,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewJoinReq()
+func (p *ReplicaServiceRaftRequestVoteArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRaftReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceJoinArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Join_args"); err != nil {
+	if err = oprot.WriteStructBegin("RaftRequestVote_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1375,7 +12172,6 @@ func (p *ReplicaServiceJoinArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1394,7 +12190,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1411,14 +12207,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinArgs) String() string {
+func (p *ReplicaServiceRaftRequestVoteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceJoinArgs(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftRequestVoteArgs(%+v)", *p)
 }
 
-func (p *ReplicaServiceJoinArgs) DeepEqual(ano *ReplicaServiceJoinArgs) bool {
+func (p *ReplicaServiceRaftRequestVoteArgs) DeepEqual(ano *ReplicaServiceRaftRequestVoteArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1430,7 +12226,7 @@ func (p *ReplicaServiceJoinArgs) DeepEqual(ano *ReplicaServiceJoinArgs) bool {
 	return true
 }
 
-func (p *ReplicaServiceJoinArgs) Field1DeepEqual(src *JoinReq) bool {
+func (p *ReplicaServiceRaftRequestVoteArgs) Field1DeepEqual(src *RaftReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1438,39 +12234,39 @@ func (p *ReplicaServiceJoinArgs) Field1DeepEqual(src *JoinReq) bool {
 	return true
 }
 
-type ReplicaServiceJoinResult struct {
-	Success *JoinResp `thrift:"success,0,optional" frugal:"0,optional,JoinResp" json:"success,omitempty"`
+type ReplicaServiceRaftRequestVoteResult struct {
+	Success *RaftResp `thrift:"success,0,optional" frugal:"0,optional,RaftResp" json:"success,omitempty"`
 }
 
-func NewReplicaServiceJoinResult() *ReplicaServiceJoinResult {
-	return &ReplicaServiceJoinResult{}
+func NewReplicaServiceRaftRequestVoteResult() *ReplicaServiceRaftRequestVoteResult {
+	return &ReplicaServiceRaftRequestVoteResult{}
 }
 
-func (p *ReplicaServiceJoinResult) InitDefault() {
-	*p = ReplicaServiceJoinResult{}
+func (p *ReplicaServiceRaftRequestVoteResult) InitDefault() {
+	*p = ReplicaServiceRaftRequestVoteResult{}
 }
 
-var ReplicaServiceJoinResult_Success_DEFAULT *JoinResp
+var ReplicaServiceRaftRequestVoteResult_Success_DEFAULT *RaftResp
 
-func (p *ReplicaServiceJoinResult) GetSuccess() (v *JoinResp) {
+func (p *ReplicaServiceRaftRequestVoteResult) GetSuccess() (v *RaftResp) {
 	if !p.IsSetSuccess() {
-		return ReplicaServiceJoinResult_Success_DEFAULT
+		return ReplicaServiceRaftRequestVoteResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ReplicaServiceJoinResult) SetSuccess(x interface{}) {
-	p.Success = x.(*JoinResp)
+func (p *ReplicaServiceRaftRequestVoteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RaftResp)
 }
 
-var fieldIDToName_ReplicaServiceJoinResult = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftRequestVoteResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ReplicaServiceJoinResult) IsSetSuccess() bool {
+func (p *ReplicaServiceRaftRequestVoteResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ReplicaServiceJoinResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1494,17 +12290,16 @@ func (p *ReplicaServiceJoinResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1519,7 +12314,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceJoinResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftRequestVoteResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1529,17 +12324,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewJoinResp()
+func (p *ReplicaServiceRaftRequestVoteResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRaftResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceJoinResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Join_result"); err != nil {
+	if err = oprot.WriteStructBegin("RaftRequestVote_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1547,7 +12343,6 @@ func (p *ReplicaServiceJoinResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1566,7 +12361,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftRequestVoteResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1585,14 +12380,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ReplicaServiceJoinResult) String() string {
+func (p *ReplicaServiceRaftRequestVoteResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceJoinResult(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftRequestVoteResult(%+v)", *p)
 }
 
-func (p *ReplicaServiceJoinResult) DeepEqual(ano *ReplicaServiceJoinResult) bool {
+func (p *ReplicaServiceRaftRequestVoteResult) DeepEqual(ano *ReplicaServiceRaftRequestVoteResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1604,7 +12399,7 @@ func (p *ReplicaServiceJoinResult) DeepEqual(ano *ReplicaServiceJoinResult) bool
 	return true
 }
 
-func (p *ReplicaServiceJoinResult) Field0DeepEqual(src *JoinResp) bool {
+func (p *ReplicaServiceRaftRequestVoteResult) Field0DeepEqual(src *RaftResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1612,39 +12407,39 @@ func (p *ReplicaServiceJoinResult) Field0DeepEqual(src *JoinResp) bool {
 	return true
 }
 
-type ReplicaServiceLeaderAddrArgs struct {
-	Req *LeaderAddrReq `thrift:"req,1" frugal:"1,default,LeaderAddrReq" json:"req"`
+type ReplicaServiceRaftTimeoutNowArgs struct {
+	Req *RaftReq `thrift:"req,1" frugal:"1,default,RaftReq" json:"req"`
 }
 
-func NewReplicaServiceLeaderAddrArgs() *ReplicaServiceLeaderAddrArgs {
-	return &ReplicaServiceLeaderAddrArgs{}
+func NewReplicaServiceRaftTimeoutNowArgs() *ReplicaServiceRaftTimeoutNowArgs {
+	return &ReplicaServiceRaftTimeoutNowArgs{}
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) InitDefault() {
-	*p = ReplicaServiceLeaderAddrArgs{}
+func (p *ReplicaServiceRaftTimeoutNowArgs) InitDefault() {
+	*p = ReplicaServiceRaftTimeoutNowArgs{}
 }
 
-var ReplicaServiceLeaderAddrArgs_Req_DEFAULT *LeaderAddrReq
+var ReplicaServiceRaftTimeoutNowArgs_Req_DEFAULT *RaftReq
 
-func (p *ReplicaServiceLeaderAddrArgs) GetReq() (v *LeaderAddrReq) {
+func (p *ReplicaServiceRaftTimeoutNowArgs) GetReq() (v *RaftReq) {
 	if !p.IsSetReq() {
-		return ReplicaServiceLeaderAddrArgs_Req_DEFAULT
+		return ReplicaServiceRaftTimeoutNowArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ReplicaServiceLeaderAddrArgs) SetReq(val *LeaderAddrReq) {
+func (p *ReplicaServiceRaftTimeoutNowArgs) SetReq(val *RaftReq) {
 	p.Req = val
 }
 
-var fieldIDToName_ReplicaServiceLeaderAddrArgs = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftTimeoutNowArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) IsSetReq() bool {
+func (p *ReplicaServiceRaftTimeoutNowArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1668,17 +12463,16 @@ func (p *ReplicaServiceLeaderAddrArgs) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1693,7 +12487,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceLeaderAddrArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftTimeoutNowArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1703,17 +12497,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewLeaderAddrReq()
+func (p *ReplicaServiceRaftTimeoutNowArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRaftReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LeaderAddr_args"); err != nil {
+	if err = oprot.WriteStructBegin("RaftTimeoutNow_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1721,7 +12516,6 @@ func (p *ReplicaServiceLeaderAddrArgs) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1740,7 +12534,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1757,14 +12551,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) String() string {
+func (p *ReplicaServiceRaftTimeoutNowArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceLeaderAddrArgs(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftTimeoutNowArgs(%+v)", *p)
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) DeepEqual(ano *ReplicaServiceLeaderAddrArgs) bool {
+func (p *ReplicaServiceRaftTimeoutNowArgs) DeepEqual(ano *ReplicaServiceRaftTimeoutNowArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1776,7 +12570,7 @@ func (p *ReplicaServiceLeaderAddrArgs) DeepEqual(ano *ReplicaServiceLeaderAddrAr
 	return true
 }
 
-func (p *ReplicaServiceLeaderAddrArgs) Field1DeepEqual(src *LeaderAddrReq) bool {
+func (p *ReplicaServiceRaftTimeoutNowArgs) Field1DeepEqual(src *RaftReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1784,39 +12578,39 @@ func (p *ReplicaServiceLeaderAddrArgs) Field1DeepEqual(src *LeaderAddrReq) bool
 	return true
 }
 
-type ReplicaServiceLeaderAddrResult struct {
-	Success *LeaderAddrResp `thrift:"success,0,optional" frugal:"0,optional,LeaderAddrResp" json:"success,omitempty"`
+type ReplicaServiceRaftTimeoutNowResult struct {
+	Success *RaftResp `thrift:"success,0,optional" frugal:"0,optional,RaftResp" json:"success,omitempty"`
 }
 
-func NewReplicaServiceLeaderAddrResult() *ReplicaServiceLeaderAddrResult {
-	return &ReplicaServiceLeaderAddrResult{}
+func NewReplicaServiceRaftTimeoutNowResult() *ReplicaServiceRaftTimeoutNowResult {
+	return &ReplicaServiceRaftTimeoutNowResult{}
 }
 
-func (p *ReplicaServiceLeaderAddrResult) InitDefault() {
-	*p = ReplicaServiceLeaderAddrResult{}
+func (p *ReplicaServiceRaftTimeoutNowResult) InitDefault() {
+	*p = ReplicaServiceRaftTimeoutNowResult{}
 }
 
-var ReplicaServiceLeaderAddrResult_Success_DEFAULT *LeaderAddrResp
+var ReplicaServiceRaftTimeoutNowResult_Success_DEFAULT *RaftResp
 
-func (p *ReplicaServiceLeaderAddrResult) GetSuccess() (v *LeaderAddrResp) {
+func (p *ReplicaServiceRaftTimeoutNowResult) GetSuccess() (v *RaftResp) {
 	if !p.IsSetSuccess() {
-		return ReplicaServiceLeaderAddrResult_Success_DEFAULT
+		return ReplicaServiceRaftTimeoutNowResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ReplicaServiceLeaderAddrResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LeaderAddrResp)
+func (p *ReplicaServiceRaftTimeoutNowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RaftResp)
 }
 
-var fieldIDToName_ReplicaServiceLeaderAddrResult = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftTimeoutNowResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ReplicaServiceLeaderAddrResult) IsSetSuccess() bool {
+func (p *ReplicaServiceRaftTimeoutNowResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ReplicaServiceLeaderAddrResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1840,17 +12634,16 @@ func (p *ReplicaServiceLeaderAddrResult) Read(iprot thrift.TProtocol) (err error
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -1865,7 +12658,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceLeaderAddrResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftTimeoutNowResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1875,17 +12668,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewLeaderAddrResp()
+func (p *ReplicaServiceRaftTimeoutNowResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRaftResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceLeaderAddrResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LeaderAddr_result"); err != nil {
+	if err = oprot.WriteStructBegin("RaftTimeoutNow_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1893,7 +12687,6 @@ func (p *ReplicaServiceLeaderAddrResult) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1912,7 +12705,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftTimeoutNowResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1931,14 +12724,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ReplicaServiceLeaderAddrResult) String() string {
+func (p *ReplicaServiceRaftTimeoutNowResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceLeaderAddrResult(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftTimeoutNowResult(%+v)", *p)
 }
 
-func (p *ReplicaServiceLeaderAddrResult) DeepEqual(ano *ReplicaServiceLeaderAddrResult) bool {
+func (p *ReplicaServiceRaftTimeoutNowResult) DeepEqual(ano *ReplicaServiceRaftTimeoutNowResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1950,7 +12743,7 @@ func (p *ReplicaServiceLeaderAddrResult) DeepEqual(ano *ReplicaServiceLeaderAddr
 	return true
 }
 
-func (p *ReplicaServiceLeaderAddrResult) Field0DeepEqual(src *LeaderAddrResp) bool {
+func (p *ReplicaServiceRaftTimeoutNowResult) Field0DeepEqual(src *RaftResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1958,39 +12751,39 @@ func (p *ReplicaServiceLeaderAddrResult) Field0DeepEqual(src *LeaderAddrResp) bo
 	return true
 }
 
-type ReplicaServiceGetIdArgs struct {
-	Req *GetIdReq `thrift:"req,1" frugal:"1,default,GetIdReq" json:"req"`
+type ReplicaServiceRaftInstallSnapshotArgs struct {
+	Req *RaftSnapshotReq `thrift:"req,1" frugal:"1,default,RaftSnapshotReq" json:"req"`
 }
 
-func NewReplicaServiceGetIdArgs() *ReplicaServiceGetIdArgs {
-	return &ReplicaServiceGetIdArgs{}
+func NewReplicaServiceRaftInstallSnapshotArgs() *ReplicaServiceRaftInstallSnapshotArgs {
+	return &ReplicaServiceRaftInstallSnapshotArgs{}
 }
 
-func (p *ReplicaServiceGetIdArgs) InitDefault() {
-	*p = ReplicaServiceGetIdArgs{}
+func (p *ReplicaServiceRaftInstallSnapshotArgs) InitDefault() {
+	*p = ReplicaServiceRaftInstallSnapshotArgs{}
 }
 
-var ReplicaServiceGetIdArgs_Req_DEFAULT *GetIdReq
+var ReplicaServiceRaftInstallSnapshotArgs_Req_DEFAULT *RaftSnapshotReq
 
-func (p *ReplicaServiceGetIdArgs) GetReq() (v *GetIdReq) {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) GetReq() (v *RaftSnapshotReq) {
 	if !p.IsSetReq() {
-		return ReplicaServiceGetIdArgs_Req_DEFAULT
+		return ReplicaServiceRaftInstallSnapshotArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ReplicaServiceGetIdArgs) SetReq(val *GetIdReq) {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) SetReq(val *RaftSnapshotReq) {
 	p.Req = val
 }
 
-var fieldIDToName_ReplicaServiceGetIdArgs = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftInstallSnapshotArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ReplicaServiceGetIdArgs) IsSetReq() bool {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ReplicaServiceGetIdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2014,17 +12807,16 @@ func (p *ReplicaServiceGetIdArgs) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2039,7 +12831,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceGetIdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftInstallSnapshotArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2049,17 +12841,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewGetIdReq()
+func (p *ReplicaServiceRaftInstallSnapshotArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRaftSnapshotReq()
+
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceGetIdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetId_args"); err != nil {
+	if err = oprot.WriteStructBegin("RaftInstallSnapshot_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2067,7 +12860,6 @@ func (p *ReplicaServiceGetIdArgs) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2086,7 +12878,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2103,14 +12895,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdArgs) String() string {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceGetIdArgs(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftInstallSnapshotArgs(%+v)", *p)
 }
 
-func (p *ReplicaServiceGetIdArgs) DeepEqual(ano *ReplicaServiceGetIdArgs) bool {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) DeepEqual(ano *ReplicaServiceRaftInstallSnapshotArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2122,7 +12914,7 @@ func (p *ReplicaServiceGetIdArgs) DeepEqual(ano *ReplicaServiceGetIdArgs) bool {
 	return true
 }
 
-func (p *ReplicaServiceGetIdArgs) Field1DeepEqual(src *GetIdReq) bool {
+func (p *ReplicaServiceRaftInstallSnapshotArgs) Field1DeepEqual(src *RaftSnapshotReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2130,39 +12922,39 @@ func (p *ReplicaServiceGetIdArgs) Field1DeepEqual(src *GetIdReq) bool {
 	return true
 }
 
-type ReplicaServiceGetIdResult struct {
-	Success *GetIdResp `thrift:"success,0,optional" frugal:"0,optional,GetIdResp" json:"success,omitempty"`
+type ReplicaServiceRaftInstallSnapshotResult struct {
+	Success *RaftResp `thrift:"success,0,optional" frugal:"0,optional,RaftResp" json:"success,omitempty"`
 }
 
-func NewReplicaServiceGetIdResult() *ReplicaServiceGetIdResult {
-	return &ReplicaServiceGetIdResult{}
+func NewReplicaServiceRaftInstallSnapshotResult() *ReplicaServiceRaftInstallSnapshotResult {
+	return &ReplicaServiceRaftInstallSnapshotResult{}
 }
 
-func (p *ReplicaServiceGetIdResult) InitDefault() {
-	*p = ReplicaServiceGetIdResult{}
+func (p *ReplicaServiceRaftInstallSnapshotResult) InitDefault() {
+	*p = ReplicaServiceRaftInstallSnapshotResult{}
 }
 
-var ReplicaServiceGetIdResult_Success_DEFAULT *GetIdResp
+var ReplicaServiceRaftInstallSnapshotResult_Success_DEFAULT *RaftResp
 
-func (p *ReplicaServiceGetIdResult) GetSuccess() (v *GetIdResp) {
+func (p *ReplicaServiceRaftInstallSnapshotResult) GetSuccess() (v *RaftResp) {
 	if !p.IsSetSuccess() {
-		return ReplicaServiceGetIdResult_Success_DEFAULT
+		return ReplicaServiceRaftInstallSnapshotResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ReplicaServiceGetIdResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetIdResp)
+func (p *ReplicaServiceRaftInstallSnapshotResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RaftResp)
 }
 
-var fieldIDToName_ReplicaServiceGetIdResult = map[int16]string{
+var fieldIDToName_ReplicaServiceRaftInstallSnapshotResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ReplicaServiceGetIdResult) IsSetSuccess() bool {
+func (p *ReplicaServiceRaftInstallSnapshotResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ReplicaServiceGetIdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2186,17 +12978,16 @@ func (p *ReplicaServiceGetIdResult) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
+				break
+			}
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
-
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
@@ -2211,7 +13002,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceGetIdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplicaServiceRaftInstallSnapshotResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2221,17 +13012,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewGetIdResp()
+func (p *ReplicaServiceRaftInstallSnapshotResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRaftResp()
+
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ReplicaServiceGetIdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetId_result"); err != nil {
+	if err = oprot.WriteStructBegin("RaftInstallSnapshot_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2239,7 +13031,6 @@ func (p *ReplicaServiceGetIdResult) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 0
 			goto WriteFieldError
 		}
-
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2258,7 +13049,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ReplicaServiceRaftInstallSnapshotResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2277,14 +13068,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ReplicaServiceGetIdResult) String() string {
+func (p *ReplicaServiceRaftInstallSnapshotResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReplicaServiceGetIdResult(%+v)", *p)
+	return fmt.Sprintf("ReplicaServiceRaftInstallSnapshotResult(%+v)", *p)
 }
 
-func (p *ReplicaServiceGetIdResult) DeepEqual(ano *ReplicaServiceGetIdResult) bool {
+func (p *ReplicaServiceRaftInstallSnapshotResult) DeepEqual(ano *ReplicaServiceRaftInstallSnapshotResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2296,7 +13087,7 @@ func (p *ReplicaServiceGetIdResult) DeepEqual(ano *ReplicaServiceGetIdResult) bo
 	return true
 }
 
-func (p *ReplicaServiceGetIdResult) Field0DeepEqual(src *GetIdResp) bool {
+func (p *ReplicaServiceRaftInstallSnapshotResult) Field0DeepEqual(src *RaftResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
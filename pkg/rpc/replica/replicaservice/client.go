@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package replicaservice
 
@@ -14,6 +14,16 @@ type Client interface {
 	Join(ctx context.Context, req *replica.JoinReq, callOptions ...callopt.Option) (r *replica.JoinResp, err error)
 	LeaderAddr(ctx context.Context, req *replica.LeaderAddrReq, callOptions ...callopt.Option) (r *replica.LeaderAddrResp, err error)
 	GetId(ctx context.Context, req *replica.GetIdReq, callOptions ...callopt.Option) (r *replica.GetIdResp, err error)
+	AddNonvoter(ctx context.Context, req *replica.AddNonvoterReq, callOptions ...callopt.Option) (r *replica.AddNonvoterResp, err error)
+	RemoveServer(ctx context.Context, req *replica.RemoveServerReq, callOptions ...callopt.Option) (r *replica.RemoveServerResp, err error)
+	DemoteVoter(ctx context.Context, req *replica.DemoteVoterReq, callOptions ...callopt.Option) (r *replica.DemoteVoterResp, err error)
+	TransferLeadership(ctx context.Context, req *replica.TransferLeadershipReq, callOptions ...callopt.Option) (r *replica.TransferLeadershipResp, err error)
+	Members(ctx context.Context, req *replica.MembersReq, callOptions ...callopt.Option) (r *replica.MembersResp, err error)
+	Status(ctx context.Context, req *replica.StatusReq, callOptions ...callopt.Option) (r *replica.StatusResp, err error)
+	RaftAppendEntries(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error)
+	RaftRequestVote(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error)
+	RaftTimeoutNow(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error)
+	RaftInstallSnapshot(ctx context.Context, req *replica.RaftSnapshotReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +69,53 @@ func (p *kReplicaServiceClient) GetId(ctx context.Context, req *replica.GetIdReq
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetId(ctx, req)
 }
+
+func (p *kReplicaServiceClient) AddNonvoter(ctx context.Context, req *replica.AddNonvoterReq, callOptions ...callopt.Option) (r *replica.AddNonvoterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddNonvoter(ctx, req)
+}
+
+func (p *kReplicaServiceClient) RemoveServer(ctx context.Context, req *replica.RemoveServerReq, callOptions ...callopt.Option) (r *replica.RemoveServerResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveServer(ctx, req)
+}
+
+func (p *kReplicaServiceClient) DemoteVoter(ctx context.Context, req *replica.DemoteVoterReq, callOptions ...callopt.Option) (r *replica.DemoteVoterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DemoteVoter(ctx, req)
+}
+
+func (p *kReplicaServiceClient) TransferLeadership(ctx context.Context, req *replica.TransferLeadershipReq, callOptions ...callopt.Option) (r *replica.TransferLeadershipResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TransferLeadership(ctx, req)
+}
+
+func (p *kReplicaServiceClient) Members(ctx context.Context, req *replica.MembersReq, callOptions ...callopt.Option) (r *replica.MembersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Members(ctx, req)
+}
+
+func (p *kReplicaServiceClient) Status(ctx context.Context, req *replica.StatusReq, callOptions ...callopt.Option) (r *replica.StatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Status(ctx, req)
+}
+
+func (p *kReplicaServiceClient) RaftAppendEntries(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RaftAppendEntries(ctx, req)
+}
+
+func (p *kReplicaServiceClient) RaftRequestVote(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RaftRequestVote(ctx, req)
+}
+
+func (p *kReplicaServiceClient) RaftTimeoutNow(ctx context.Context, req *replica.RaftReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RaftTimeoutNow(ctx, req)
+}
+
+func (p *kReplicaServiceClient) RaftInstallSnapshot(ctx context.Context, req *replica.RaftSnapshotReq, callOptions ...callopt.Option) (r *replica.RaftResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RaftInstallSnapshot(ctx, req)
+}
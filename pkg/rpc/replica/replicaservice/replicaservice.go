@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.6.2. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package replicaservice
 
@@ -19,19 +19,30 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "ReplicaService"
 	handlerType := (*replica.ReplicaService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Join":       kitex.NewMethodInfo(joinHandler, newReplicaServiceJoinArgs, newReplicaServiceJoinResult, false),
-		"LeaderAddr": kitex.NewMethodInfo(leaderAddrHandler, newReplicaServiceLeaderAddrArgs, newReplicaServiceLeaderAddrResult, false),
-		"GetId":      kitex.NewMethodInfo(getIdHandler, newReplicaServiceGetIdArgs, newReplicaServiceGetIdResult, false),
+		"Join":                kitex.NewMethodInfo(joinHandler, newReplicaServiceJoinArgs, newReplicaServiceJoinResult, false),
+		"LeaderAddr":          kitex.NewMethodInfo(leaderAddrHandler, newReplicaServiceLeaderAddrArgs, newReplicaServiceLeaderAddrResult, false),
+		"GetId":               kitex.NewMethodInfo(getIdHandler, newReplicaServiceGetIdArgs, newReplicaServiceGetIdResult, false),
+		"AddNonvoter":         kitex.NewMethodInfo(addNonvoterHandler, newReplicaServiceAddNonvoterArgs, newReplicaServiceAddNonvoterResult, false),
+		"RemoveServer":        kitex.NewMethodInfo(removeServerHandler, newReplicaServiceRemoveServerArgs, newReplicaServiceRemoveServerResult, false),
+		"DemoteVoter":         kitex.NewMethodInfo(demoteVoterHandler, newReplicaServiceDemoteVoterArgs, newReplicaServiceDemoteVoterResult, false),
+		"TransferLeadership":  kitex.NewMethodInfo(transferLeadershipHandler, newReplicaServiceTransferLeadershipArgs, newReplicaServiceTransferLeadershipResult, false),
+		"Members":             kitex.NewMethodInfo(membersHandler, newReplicaServiceMembersArgs, newReplicaServiceMembersResult, false),
+		"Status":              kitex.NewMethodInfo(statusHandler, newReplicaServiceStatusArgs, newReplicaServiceStatusResult, false),
+		"RaftAppendEntries":   kitex.NewMethodInfo(raftAppendEntriesHandler, newReplicaServiceRaftAppendEntriesArgs, newReplicaServiceRaftAppendEntriesResult, false),
+		"RaftRequestVote":     kitex.NewMethodInfo(raftRequestVoteHandler, newReplicaServiceRaftRequestVoteArgs, newReplicaServiceRaftRequestVoteResult, false),
+		"RaftTimeoutNow":      kitex.NewMethodInfo(raftTimeoutNowHandler, newReplicaServiceRaftTimeoutNowArgs, newReplicaServiceRaftTimeoutNowResult, false),
+		"RaftInstallSnapshot": kitex.NewMethodInfo(raftInstallSnapshotHandler, newReplicaServiceRaftInstallSnapshotArgs, newReplicaServiceRaftInstallSnapshotResult, false),
 	}
 	extra := map[string]interface{}{
-		"PackageName": "replica",
+		"PackageName":     "replica",
+		"ServiceFilePath": `idl/replica.thrift`,
 	}
 	svcInfo := &kitex.ServiceInfo{
 		ServiceName:     serviceName,
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.6.2",
+		KiteXGenVersion: "v0.8.0",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -91,6 +102,186 @@ func newReplicaServiceGetIdResult() interface{} {
 	return replica.NewReplicaServiceGetIdResult()
 }
 
+func addNonvoterHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceAddNonvoterArgs)
+	realResult := result.(*replica.ReplicaServiceAddNonvoterResult)
+	success, err := handler.(replica.ReplicaService).AddNonvoter(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceAddNonvoterArgs() interface{} {
+	return replica.NewReplicaServiceAddNonvoterArgs()
+}
+
+func newReplicaServiceAddNonvoterResult() interface{} {
+	return replica.NewReplicaServiceAddNonvoterResult()
+}
+
+func removeServerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceRemoveServerArgs)
+	realResult := result.(*replica.ReplicaServiceRemoveServerResult)
+	success, err := handler.(replica.ReplicaService).RemoveServer(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceRemoveServerArgs() interface{} {
+	return replica.NewReplicaServiceRemoveServerArgs()
+}
+
+func newReplicaServiceRemoveServerResult() interface{} {
+	return replica.NewReplicaServiceRemoveServerResult()
+}
+
+func demoteVoterHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceDemoteVoterArgs)
+	realResult := result.(*replica.ReplicaServiceDemoteVoterResult)
+	success, err := handler.(replica.ReplicaService).DemoteVoter(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceDemoteVoterArgs() interface{} {
+	return replica.NewReplicaServiceDemoteVoterArgs()
+}
+
+func newReplicaServiceDemoteVoterResult() interface{} {
+	return replica.NewReplicaServiceDemoteVoterResult()
+}
+
+func transferLeadershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceTransferLeadershipArgs)
+	realResult := result.(*replica.ReplicaServiceTransferLeadershipResult)
+	success, err := handler.(replica.ReplicaService).TransferLeadership(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceTransferLeadershipArgs() interface{} {
+	return replica.NewReplicaServiceTransferLeadershipArgs()
+}
+
+func newReplicaServiceTransferLeadershipResult() interface{} {
+	return replica.NewReplicaServiceTransferLeadershipResult()
+}
+
+func membersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceMembersArgs)
+	realResult := result.(*replica.ReplicaServiceMembersResult)
+	success, err := handler.(replica.ReplicaService).Members(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceMembersArgs() interface{} {
+	return replica.NewReplicaServiceMembersArgs()
+}
+
+func newReplicaServiceMembersResult() interface{} {
+	return replica.NewReplicaServiceMembersResult()
+}
+
+func statusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceStatusArgs)
+	realResult := result.(*replica.ReplicaServiceStatusResult)
+	success, err := handler.(replica.ReplicaService).Status(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceStatusArgs() interface{} {
+	return replica.NewReplicaServiceStatusArgs()
+}
+
+func newReplicaServiceStatusResult() interface{} {
+	return replica.NewReplicaServiceStatusResult()
+}
+
+func raftAppendEntriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceRaftAppendEntriesArgs)
+	realResult := result.(*replica.ReplicaServiceRaftAppendEntriesResult)
+	success, err := handler.(replica.ReplicaService).RaftAppendEntries(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceRaftAppendEntriesArgs() interface{} {
+	return replica.NewReplicaServiceRaftAppendEntriesArgs()
+}
+
+func newReplicaServiceRaftAppendEntriesResult() interface{} {
+	return replica.NewReplicaServiceRaftAppendEntriesResult()
+}
+
+func raftRequestVoteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceRaftRequestVoteArgs)
+	realResult := result.(*replica.ReplicaServiceRaftRequestVoteResult)
+	success, err := handler.(replica.ReplicaService).RaftRequestVote(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceRaftRequestVoteArgs() interface{} {
+	return replica.NewReplicaServiceRaftRequestVoteArgs()
+}
+
+func newReplicaServiceRaftRequestVoteResult() interface{} {
+	return replica.NewReplicaServiceRaftRequestVoteResult()
+}
+
+func raftTimeoutNowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceRaftTimeoutNowArgs)
+	realResult := result.(*replica.ReplicaServiceRaftTimeoutNowResult)
+	success, err := handler.(replica.ReplicaService).RaftTimeoutNow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceRaftTimeoutNowArgs() interface{} {
+	return replica.NewReplicaServiceRaftTimeoutNowArgs()
+}
+
+func newReplicaServiceRaftTimeoutNowResult() interface{} {
+	return replica.NewReplicaServiceRaftTimeoutNowResult()
+}
+
+func raftInstallSnapshotHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*replica.ReplicaServiceRaftInstallSnapshotArgs)
+	realResult := result.(*replica.ReplicaServiceRaftInstallSnapshotResult)
+	success, err := handler.(replica.ReplicaService).RaftInstallSnapshot(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newReplicaServiceRaftInstallSnapshotArgs() interface{} {
+	return replica.NewReplicaServiceRaftInstallSnapshotArgs()
+}
+
+func newReplicaServiceRaftInstallSnapshotResult() interface{} {
+	return replica.NewReplicaServiceRaftInstallSnapshotResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -130,3 +321,103 @@ func (p *kClient) GetId(ctx context.Context, req *replica.GetIdReq) (r *replica.
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) AddNonvoter(ctx context.Context, req *replica.AddNonvoterReq) (r *replica.AddNonvoterResp, err error) {
+	var _args replica.ReplicaServiceAddNonvoterArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceAddNonvoterResult
+	if err = p.c.Call(ctx, "AddNonvoter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveServer(ctx context.Context, req *replica.RemoveServerReq) (r *replica.RemoveServerResp, err error) {
+	var _args replica.ReplicaServiceRemoveServerArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceRemoveServerResult
+	if err = p.c.Call(ctx, "RemoveServer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DemoteVoter(ctx context.Context, req *replica.DemoteVoterReq) (r *replica.DemoteVoterResp, err error) {
+	var _args replica.ReplicaServiceDemoteVoterArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceDemoteVoterResult
+	if err = p.c.Call(ctx, "DemoteVoter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TransferLeadership(ctx context.Context, req *replica.TransferLeadershipReq) (r *replica.TransferLeadershipResp, err error) {
+	var _args replica.ReplicaServiceTransferLeadershipArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceTransferLeadershipResult
+	if err = p.c.Call(ctx, "TransferLeadership", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Members(ctx context.Context, req *replica.MembersReq) (r *replica.MembersResp, err error) {
+	var _args replica.ReplicaServiceMembersArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceMembersResult
+	if err = p.c.Call(ctx, "Members", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Status(ctx context.Context, req *replica.StatusReq) (r *replica.StatusResp, err error) {
+	var _args replica.ReplicaServiceStatusArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceStatusResult
+	if err = p.c.Call(ctx, "Status", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RaftAppendEntries(ctx context.Context, req *replica.RaftReq) (r *replica.RaftResp, err error) {
+	var _args replica.ReplicaServiceRaftAppendEntriesArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceRaftAppendEntriesResult
+	if err = p.c.Call(ctx, "RaftAppendEntries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RaftRequestVote(ctx context.Context, req *replica.RaftReq) (r *replica.RaftResp, err error) {
+	var _args replica.ReplicaServiceRaftRequestVoteArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceRaftRequestVoteResult
+	if err = p.c.Call(ctx, "RaftRequestVote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RaftTimeoutNow(ctx context.Context, req *replica.RaftReq) (r *replica.RaftResp, err error) {
+	var _args replica.ReplicaServiceRaftTimeoutNowArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceRaftTimeoutNowResult
+	if err = p.c.Call(ctx, "RaftTimeoutNow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RaftInstallSnapshot(ctx context.Context, req *replica.RaftSnapshotReq) (r *replica.RaftResp, err error) {
+	var _args replica.ReplicaServiceRaftInstallSnapshotArgs
+	_args.Req = req
+	var _result replica.ReplicaServiceRaftInstallSnapshotResult
+	if err = p.c.Call(ctx, "RaftInstallSnapshot", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,75 @@
+package secure
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"net"
+	"os"
+	"time"
+)
+
+/// 副本服务的双向TLS 服务端和客户端使用同一个CA签发的证书互相验证
+/// 客户端按连接地址校验服务端证书 使用IP地址连接时证书中需要包含该IP
+
+// ServerConfig 返回服务端TLS配置 要求客户端提供证书 未启用时返回nil
+func ServerConfig(c config.TLSConfig) (*tls.Config, error) {
+	cert, pool, err := load(c)
+	if err != nil || cert == nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientConfig 返回客户端TLS配置 未启用时返回nil
+func ClientConfig(c config.TLSConfig) (*tls.Config, error) {
+	cert, pool, err := load(c)
+	if err != nil || cert == nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// 加载证书和CA
+func load(c config.TLSConfig) (*tls.Certificate, *x509.CertPool, error) {
+	if !c.Enabled() {
+		return nil, nil, nil
+	}
+	if c.CertFile == "" || c.KeyFile == "" || c.CAFile == "" {
+		return nil, nil, errno.ErrInvalidTLSConfig
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	ca, err := os.ReadFile(c.CAFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, nil, errno.ErrInvalidTLSConfig
+	}
+	return &cert, pool, nil
+}
+
+// Dialer 建立TLS连接 可作为Kitex客户端的拨号器
+type Dialer struct {
+	Config *tls.Config
+}
+
+// DialTimeout 建立连接并完成握手
+func (d *Dialer) DialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, network, address, d.Config)
+}
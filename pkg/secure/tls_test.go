@@ -0,0 +1,110 @@
+package secure
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 生成证书并写入PEM文件 parent为空时生成自签名的CA
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return cert, key
+}
+
+func tlsConfig(dir, name string) config.TLSConfig {
+	return config.TLSConfig{
+		CertFile: filepath.Join(dir, name+".pem"),
+		KeyFile:  filepath.Join(dir, name+".key"),
+		CAFile:   filepath.Join(dir, "ca.pem"),
+	}
+}
+
+func TestDialer(t *testing.T) {
+	cfg, err := ServerConfig(config.TLSConfig{})
+	assert.Nil(t, err)
+	assert.Nil(t, cfg)
+	_, err = ClientConfig(config.TLSConfig{CertFile: "node.pem"})
+	assert.Equal(t, errno.ErrInvalidTLSConfig, err)
+
+	dir := t.TempDir()
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "server", ca, caKey)
+	writeCert(t, dir, "client", ca, caKey)
+	other := t.TempDir()
+	otherCA, otherKey := writeCert(t, other, "ca", nil, nil)
+	writeCert(t, other, "client", otherCA, otherKey)
+
+	serverConfig, err := ServerConfig(tlsConfig(dir, "server"))
+	assert.Nil(t, err)
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	assert.Nil(t, err)
+	defer ln.Close()
+
+	handshakes := make(chan error)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			handshakes <- conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	// 使用同一个CA签发的证书互相验证
+	clientConfig, err := ClientConfig(tlsConfig(dir, "client"))
+	assert.Nil(t, err)
+	conn, err := (&Dialer{Config: clientConfig}).DialTimeout("tcp", ln.Addr().String(), time.Second)
+	assert.Nil(t, err)
+	assert.Nil(t, <-handshakes)
+	_ = conn.Close()
+
+	// 其他CA签发的客户端证书被拒绝
+	clientConfig, err = ClientConfig(tlsConfig(other, "client"))
+	assert.Nil(t, err)
+	clientConfig.RootCAs = serverConfig.ClientCAs
+	conn, err = (&Dialer{Config: clientConfig}).DialTimeout("tcp", ln.Addr().String(), time.Second)
+	assert.NotNil(t, <-handshakes)
+	if err == nil {
+		_ = conn.Close()
+	}
+}
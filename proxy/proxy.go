@@ -5,23 +5,25 @@ import (
 	"github.com/T4t4KAU/TikBase/engine"
 	"github.com/T4t4KAU/TikBase/iface"
 	"github.com/T4t4KAU/TikBase/pkg/config"
+	"github.com/T4t4KAU/TikBase/pkg/queue"
 )
 
 func Start(server config.ServerConfig, store config.StoreConfig, replica config.ReplicaConfig) (err error) {
-	var eng iface.Engine
+	// 键空间事件通过消息队列发布
+	mq := queue.New(queue.DefaultConfig)
+	notifier := queue.NewNotifier(mq)
 
-	// 初始化存储引擎
-	switch server.EngineName {
-	case "base":
-		cfg := store.(config.BaseStoreConfig)
-		eng, err = engine.NewBaseEngineWith(cfg)
-	case "cache":
-		cfg := store.(config.CacheStoreConfig)
-		eng, err = engine.NewCacheEngineWith(cfg)
+	// 每个分区使用独立的存储引擎
+	engines := make([]iface.Engine, replica.RegionCount())
+	for i := range engines {
+		engines[i], err = newEngine(server.EngineName, config.RegionStoreConfig(store, i), notifier)
+		if err != nil {
+			return err
+		}
 	}
 
 	// 启动region服务
-	service, err := region.New(&replica, &server, eng)
+	service, err := region.New(&replica, &server, engines, mq)
 	if err != nil {
 		panic(err)
 	}
@@ -35,3 +37,34 @@ func Start(server config.ServerConfig, store config.StoreConfig, replica config.
 
 	return nil
 }
+
+// 初始化存储引擎
+func newEngine(name string, store config.StoreConfig, notifier *queue.Notifier) (eng iface.Engine, err error) {
+	switch name {
+	case "base":
+		cfg := store.(config.BaseStoreConfig)
+		var be *engine.BaseEngine
+		be, err = engine.NewBaseEngineWith(cfg)
+		if err == nil {
+			be.SetNotifier(notifier)
+		}
+		eng = be
+	case "cache":
+		cfg := store.(config.CacheStoreConfig)
+		var ce *engine.CacheEngine
+		ce, err = engine.NewCacheEngineWith(cfg)
+		if err == nil {
+			ce.SetNotifier(notifier)
+		}
+		eng = ce
+	case "tiered":
+		cfg := store.(config.TieredStoreConfig)
+		var te *engine.TieredEngine
+		te, err = engine.NewTieredEngineWith(cfg)
+		if err == nil {
+			te.SetNotifier(notifier)
+		}
+		eng = te
+	}
+	return eng, err
+}